@@ -0,0 +1,329 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"github.com/lxn/win"
+)
+
+// Anchors specifies the edges of the container a widget in an AbsoluteLayout
+// keeps its distance to.
+type Anchors byte
+
+const (
+	AnchorLeft Anchors = 1 << iota
+	AnchorTop
+	AnchorRight
+	AnchorBottom
+
+	AnchorNone    Anchors = 0
+	AnchorTopLeft         = AnchorTop | AnchorLeft
+	AnchorAll             = AnchorLeft | AnchorTop | AnchorRight | AnchorBottom
+)
+
+// AbsoluteLayout places the widgets of a container at explicit bounds, like a
+// dialog designed in a form designer.
+//
+// The bounds are relative to the area inside the margins and are meant for
+// the design size of that area. When the container gets larger or smaller,
+// a widget keeps its distances to the edges it is anchored to. A widget
+// anchored to two opposite edges is resized, one anchored to neither of them
+// keeps its center at the same relative position. By default, widgets are
+// anchored to the top and left edges, so they do not move.
+//
+// Widgets, for which neither bounds nor anchors were set, are not laid out.
+// Spacing is not used.
+type AbsoluteLayout struct {
+	LayoutBase
+	designSize96dpi Size
+	hwnd2Placement  map[win.HWND]absolutePlacement
+}
+
+type absolutePlacement struct {
+	bounds  Rectangle // in 1/96" units in the layout, in native pixels in its item
+	anchors Anchors
+}
+
+func NewAbsoluteLayout() *AbsoluteLayout {
+	l := &AbsoluteLayout{
+		hwnd2Placement: make(map[win.HWND]absolutePlacement),
+	}
+	l.layout = l
+
+	return l
+}
+
+// DesignSize returns the size in 1/96" units of the area inside the margins,
+// that the bounds of the widgets were designed for.
+//
+// If no design size was set, it is the size that encloses the bounds of all
+// widgets.
+func (l *AbsoluteLayout) DesignSize() Size {
+	if l.designSize96dpi != (Size{}) {
+		return l.designSize96dpi
+	}
+
+	var size Size
+	for _, p := range l.hwnd2Placement {
+		size.Width = maxi(size.Width, p.bounds.X+p.bounds.Width)
+		size.Height = maxi(size.Height, p.bounds.Y+p.bounds.Height)
+	}
+
+	return size
+}
+
+// SetDesignSize sets the size in 1/96" units of the area inside the margins,
+// that the bounds of the widgets were designed for. A zero size makes the
+// layout use the size that encloses the bounds of all widgets.
+func (l *AbsoluteLayout) SetDesignSize(size Size) error {
+	if size.Width < 0 || size.Height < 0 {
+		return newError("invalid size")
+	}
+
+	if size != l.designSize96dpi {
+		l.designSize96dpi = size
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// Bounds returns the bounds in 1/96" units widget was designed with.
+func (l *AbsoluteLayout) Bounds(widget Widget) Rectangle {
+	return l.hwnd2Placement[widget.Handle()].bounds
+}
+
+// SetBounds sets the bounds in 1/96" units widget was designed with.
+func (l *AbsoluteLayout) SetBounds(widget Widget, bounds Rectangle) error {
+	if bounds.Width < 0 || bounds.Height < 0 {
+		return newError("invalid bounds")
+	}
+
+	return l.setPlacement(widget, func(p *absolutePlacement) {
+		p.bounds = bounds
+	})
+}
+
+// Anchors returns the edges widget is anchored to.
+func (l *AbsoluteLayout) Anchors(widget Widget) Anchors {
+	if p, ok := l.hwnd2Placement[widget.Handle()]; ok {
+		return p.anchors
+	}
+
+	return AnchorTopLeft
+}
+
+// SetAnchors sets the edges widget is anchored to.
+func (l *AbsoluteLayout) SetAnchors(widget Widget, anchors Anchors) error {
+	if anchors&^AnchorAll != 0 {
+		return newError("invalid Anchors value")
+	}
+
+	return l.setPlacement(widget, func(p *absolutePlacement) {
+		p.anchors = anchors
+	})
+}
+
+func (l *AbsoluteLayout) setPlacement(widget Widget, update func(p *absolutePlacement)) error {
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	handle := widget.Handle()
+
+	if !l.container.Children().containsHandle(handle) {
+		return newError("unknown widget")
+	}
+
+	p, ok := l.hwnd2Placement[handle]
+	if !ok {
+		p.anchors = AnchorTopLeft
+	}
+
+	old := p
+	update(&p)
+
+	if ok && p == old {
+		return nil
+	}
+
+	l.hwnd2Placement[handle] = p
+
+	l.container.RequestLayout()
+
+	return nil
+}
+
+func (l *AbsoluteLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	li := &absoluteLayoutItem{
+		designSize:     SizeFrom96DPI(l.DesignSize(), ctx.dpi),
+		hwnd2Placement: make(map[win.HWND]absolutePlacement, len(l.hwnd2Placement)),
+	}
+
+	for hwnd, p := range l.hwnd2Placement {
+		p.bounds = RectangleFrom96DPI(p.bounds, ctx.dpi)
+		li.hwnd2Placement[hwnd] = p
+	}
+
+	return li
+}
+
+type absoluteLayoutItem struct {
+	ContainerLayoutItemBase
+	designSize     Size // in native pixels
+	hwnd2Placement map[win.HWND]absolutePlacement
+}
+
+func (li *absoluteLayoutItem) LayoutFlags() LayoutFlags {
+	flags := ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert
+
+	for _, item := range li.children {
+		if !shouldLayoutItem(item) {
+			continue
+		}
+
+		p, ok := li.hwnd2Placement[item.Handle()]
+		if !ok {
+			continue
+		}
+
+		if p.anchors&(AnchorLeft|AnchorRight) == AnchorLeft|AnchorRight {
+			flags |= GreedyHorz
+		}
+		if p.anchors&(AnchorTop|AnchorBottom) == AnchorTop|AnchorBottom {
+			flags |= GreedyVert
+		}
+	}
+
+	return flags
+}
+
+func (li *absoluteLayoutItem) IdealSize() Size {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+
+	ideal := li.MinSize()
+	ideal.Width = maxi(ideal.Width, li.designSize.Width+margins.HNear+margins.HFar)
+	ideal.Height = maxi(ideal.Height, li.designSize.Height+margins.VNear+margins.VFar)
+
+	return ideal
+}
+
+// MinSize returns the size at which each widget gets at least its minimum
+// size and no widget anchored to the right or bottom edge is pushed out at
+// the left or top.
+func (li *absoluteLayoutItem) MinSize() Size {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+
+	var min Size
+
+	for _, item := range li.children {
+		if !shouldLayoutItem(item) {
+			continue
+		}
+
+		p, ok := li.hwnd2Placement[item.Handle()]
+		if !ok {
+			continue
+		}
+
+		itemMin := li.MinSizeEffectiveForChild(item)
+
+		min.Width = maxi(min.Width, absoluteMinExtent(p.bounds.X, p.bounds.Width, itemMin.Width, li.designSize.Width, p.anchors&AnchorLeft != 0, p.anchors&AnchorRight != 0))
+		min.Height = maxi(min.Height, absoluteMinExtent(p.bounds.Y, p.bounds.Height, itemMin.Height, li.designSize.Height, p.anchors&AnchorTop != 0, p.anchors&AnchorBottom != 0))
+	}
+
+	min.Width += margins.HNear + margins.HFar
+	min.Height += margins.VNear + margins.VFar
+
+	return min
+}
+
+func (li *absoluteLayoutItem) MinSizeForSize(size Size) Size {
+	return li.MinSize()
+}
+
+func (li *absoluteLayoutItem) HeightForWidth(width int) int {
+	return li.MinSize().Height
+}
+
+func (li *absoluteLayoutItem) PerformLayout() []LayoutResultItem {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+
+	clientSize := li.geometry.ClientSize
+	width := clientSize.Width - margins.HNear - margins.HFar
+	height := clientSize.Height - margins.VNear - margins.VFar
+
+	results := make([]LayoutResultItem, 0, len(li.children))
+
+	for _, item := range li.children {
+		if !shouldLayoutItem(item) {
+			continue
+		}
+
+		p, ok := li.hwnd2Placement[item.Handle()]
+		if !ok {
+			continue
+		}
+
+		min := li.MinSizeEffectiveForChild(item)
+
+		x, w := absolutePosition(p.bounds.X, p.bounds.Width, min.Width, li.designSize.Width, width, p.anchors&AnchorLeft != 0, p.anchors&AnchorRight != 0)
+		y, h := absolutePosition(p.bounds.Y, p.bounds.Height, min.Height, li.designSize.Height, height, p.anchors&AnchorTop != 0, p.anchors&AnchorBottom != 0)
+
+		results = append(results, LayoutResultItem{
+			Item:   item,
+			Bounds: Rectangle{margins.HNear + x, margins.VNear + y, w, h},
+		})
+	}
+
+	return results
+}
+
+// absolutePosition returns the position and size along one axis of an item
+// designed at pos with size in design space, when space is available.
+func absolutePosition(pos, size, minSize, design, space int, near, far bool) (int, int) {
+	delta := space - design
+
+	switch {
+	case near && far:
+		return pos, maxi(minSize, size+delta)
+
+	case far:
+		return pos + delta, size
+
+	case near:
+		return pos, size
+	}
+
+	if design <= 0 {
+		return pos, size
+	}
+
+	center := (2*pos + size) * space / (2 * design)
+
+	return center - size/2, size
+}
+
+// absoluteMinExtent returns the space an item designed at pos with size in
+// design space needs along one axis.
+func absoluteMinExtent(pos, size, minSize, design int, near, far bool) int {
+	switch {
+	case near && far:
+		return design - size + minSize
+
+	case far:
+		return design - pos
+
+	case near:
+		return pos + maxi(size, minSize)
+	}
+
+	return maxi(size, minSize)
+}
@@ -43,6 +43,8 @@ type Action struct {
 	checked                       bool
 	defawlt                       bool
 	exclusive                     bool
+	shield                        bool
+	shieldImage                   bool
 	id                            uint16
 }
 
@@ -301,6 +303,7 @@ func (a *Action) SetImage(value Image) (err error) {
 		old := a.image
 
 		a.image = value
+		a.shieldImage = false
 
 		if err = a.raiseChanged(); err != nil {
 			a.image = old
@@ -311,6 +314,43 @@ func (a *Action) SetImage(value Image) (err error) {
 	return
 }
 
+// Shield returns whether the Action is marked as requiring elevated
+// privileges.
+func (a *Action) Shield() bool {
+	return a.shield
+}
+
+// SetShield sets whether the Action is marked as requiring elevated
+// privileges.
+//
+// If the Action has no image, the UAC shield icon is displayed for it in menus
+// and tool bars.
+func (a *Action) SetShield(value bool) (err error) {
+	if value == a.shield {
+		return
+	}
+
+	oldImage, oldShieldImage := a.image, a.shieldImage
+
+	a.shield = value
+
+	if value && a.image == nil {
+		a.image = IconShield()
+		a.shieldImage = true
+	} else if !value && a.shieldImage {
+		a.image = nil
+		a.shieldImage = false
+	}
+
+	if err = a.raiseChanged(); err != nil {
+		a.shield = !value
+		a.image, a.shieldImage = oldImage, oldShieldImage
+		a.raiseChanged()
+	}
+
+	return
+}
+
 func (a *Action) Shortcut() Shortcut {
 	return a.shortcut
 }
@@ -0,0 +1,96 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"context"
+
+	"github.com/lxn/win"
+)
+
+// windowContextKey is the key of the Window in the contexts returned by
+// WindowBase.Context.
+type windowContextKey struct{}
+
+// AsyncEventHandler is a handler attached with Event.AttachAsync.
+type AsyncEventHandler func(ctx context.Context)
+
+// AttachAsync attaches handler, so that each time the event is published it
+// is called on a new goroutine with the context of window, see
+// WindowBase.Context. The context is canceled when window is disposed of, so
+// long running handlers can stop early.
+//
+// Handlers must not access windows directly, as they do not run on the UI
+// thread. They should do so through SynchronizeContext with ctx, which skips
+// the access once window was disposed of.
+//
+// The handler is detached when window is disposed of, see AttachScoped. The
+// returned handle can be passed to Detach. Handlers already running are not
+// affected by Detach.
+func (e *Event) AttachAsync(window Window, handler AsyncEventHandler) int {
+	wb := window.AsWindowBase()
+
+	return e.AttachScoped(window, func() {
+		ctx := wb.Context()
+		if ctx.Err() != nil {
+			return
+		}
+
+		go handler(ctx)
+	})
+}
+
+// SynchronizeContext runs f on the UI thread of the window of ctx, which must
+// have been returned by WindowBase.Context or derived from it, and waits for
+// it to return.
+//
+// If ctx is done, before f would run, f is not run and ctx.Err() is returned.
+// As the window is only disposed of on its UI thread, f can access it and its
+// descendants without checking for that.
+//
+// SynchronizeContext may be called on the UI thread as well, in which case f
+// is run directly.
+func SynchronizeContext(ctx context.Context, f func()) error {
+	window, ok := ctx.Value(windowContextKey{}).(Window)
+	if !ok {
+		return newError("ctx has no window")
+	}
+
+	wb := window.AsWindowBase()
+
+	if win.GetCurrentThreadId() == wb.group.ThreadID() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		f()
+
+		return nil
+	}
+
+	done := make(chan error, 1)
+
+	wb.Synchronize(func() {
+		if err := ctx.Err(); err != nil {
+			done <- err
+			return
+		}
+
+		f()
+
+		done <- nil
+	})
+
+	select {
+	case err := <-done:
+		return err
+
+	case <-ctx.Done():
+		// The window was disposed of, so the synchronized func may never run.
+		return ctx.Err()
+	}
+}
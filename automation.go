@@ -0,0 +1,402 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/lxn/win"
+)
+
+// JSON-RPC 2.0 error codes used by AutomationServer.
+const (
+	AutomationParseError     = -32700
+	AutomationInvalidRequest = -32600
+	AutomationMethodNotFound = -32601
+	AutomationInvalidParams  = -32602
+	AutomationCommandFailed  = -32000
+)
+
+// AutomationCommandFunc handles a command of an AutomationServer. It is called
+// on the UI thread with the raw JSON params of the request. Its result is sent
+// back encoded as JSON.
+type AutomationCommandFunc func(params json.RawMessage) (interface{}, error)
+
+// AutomationError is an error with a JSON-RPC error code, that an
+// AutomationCommandFunc may return to control the code of the response.
+type AutomationError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *AutomationError) Error() string {
+	return e.Message
+}
+
+type automationRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type automationResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      json.RawMessage  `json:"id"`
+	Result  interface{}      `json:"result,omitempty"`
+	Error   *AutomationError `json:"error,omitempty"`
+}
+
+// AutomationWindowState is the state of a window, as returned by the
+// "walk.window" and "walk.tree" methods.
+type AutomationWindowState struct {
+	Name       string                  `json:"name"`
+	Type       string                  `json:"type"`
+	Text       string                  `json:"text,omitempty"`
+	Visible    bool                    `json:"visible"`
+	Enabled    bool                    `json:"enabled"`
+	Focused    bool                    `json:"focused"`
+	Bounds     Rectangle               `json:"bounds"`
+	Properties map[string]interface{}  `json:"properties,omitempty"`
+	Children   []AutomationWindowState `json:"children,omitempty"`
+}
+
+// AutomationServer makes a walk application scriptable by external tools,
+// e.g. for end-to-end tests, without UI Automation.
+//
+// It speaks JSON-RPC 2.0 over a named pipe of an IPCServer, one request or
+// response per IPC payload. Besides the commands registered with
+// RegisterCommand, which are called by their name, it provides these methods
+// to inspect and drive the windows of its owner Form, that are looked up by
+// their name (see Window.Name):
+//
+//	walk.commands     returns the names of the registered commands
+//	walk.tree         returns the state of all windows, as a tree
+//	walk.window       {"name"}: returns the state of a window
+//	walk.getProperty  {"name", "property"}: returns the value of a property
+//	walk.setProperty  {"name", "property", "value"}: sets a property
+//	walk.setText      {"name", "text"}: sets the text of a window
+//	walk.click        {"name"}: clicks a button
+//	walk.focus        {"name"}: focuses a widget
+//
+// Properties are those registered by the windows for data binding, like
+// "Text", "Checked" or "Value".
+//
+// The pipe does not accept remote clients, but any process of the local
+// machine with access to it can control the application, so applications
+// should only create an AutomationServer when asked to, e.g. by a command
+// line flag.
+type AutomationServer struct {
+	owner    Form
+	ipc      *IPCServer
+	commands map[string]AutomationCommandFunc
+}
+
+// NewAutomationServer creates a new AutomationServer that listens on the
+// named pipe with the given name and operates on the windows of owner.
+func NewAutomationServer(owner Form, pipeName string) (*AutomationServer, error) {
+	if owner == nil {
+		return nil, newError("owner cannot be nil")
+	}
+
+	ipc, err := NewIPCServer(owner, pipeName)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &AutomationServer{
+		owner:    owner,
+		ipc:      ipc,
+		commands: make(map[string]AutomationCommandFunc),
+	}
+
+	ipc.Received().Attach(s.onReceived)
+
+	return s, nil
+}
+
+// Name returns the full name of the pipe the AutomationServer listens on.
+func (s *AutomationServer) Name() string {
+	return s.ipc.Name()
+}
+
+// Close stops the AutomationServer and closes all connections.
+func (s *AutomationServer) Close() error {
+	return s.ipc.Close()
+}
+
+// RegisterCommand registers fn as the handler of the command name. Names
+// starting with "walk." are reserved.
+func (s *AutomationServer) RegisterCommand(name string, fn AutomationCommandFunc) error {
+	if name == "" || strings.HasPrefix(name, "walk.") {
+		return newError("invalid command name")
+	}
+	if fn == nil {
+		return newError("fn cannot be nil")
+	}
+
+	s.commands[name] = fn
+
+	return nil
+}
+
+// UnregisterCommand removes the command name.
+func (s *AutomationServer) UnregisterCommand(name string) {
+	delete(s.commands, name)
+}
+
+// Notify sends a JSON-RPC notification with method and params to all
+// connected clients, e.g. to report progress of a long running command.
+func (s *AutomationServer) Notify(method string, params interface{}) error {
+	return s.ipc.Broadcast(struct {
+		JSONRPC string      `json:"jsonrpc"`
+		Method  string      `json:"method"`
+		Params  interface{} `json:"params,omitempty"`
+	}{"2.0", method, params})
+}
+
+func (s *AutomationServer) onReceived(conn *IPCConn, payload []byte) {
+	var req automationRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		s.respond(conn, automationResponse{
+			JSONRPC: "2.0",
+			ID:      json.RawMessage("null"),
+			Error:   &AutomationError{AutomationParseError, err.Error()},
+		})
+		return
+	}
+
+	result, err := s.call(&req)
+
+	if len(req.ID) == 0 {
+		// A notification, which gets no response.
+		return
+	}
+
+	resp := automationResponse{JSONRPC: "2.0", ID: req.ID}
+
+	if err != nil {
+		ae, ok := err.(*AutomationError)
+		if !ok {
+			ae = &AutomationError{AutomationCommandFailed, err.Error()}
+		}
+		resp.Error = ae
+	} else {
+		resp.Result = result
+	}
+
+	s.respond(conn, resp)
+}
+
+// respond sends resp to the client of conn. Failures, like a client that
+// disconnected while its request was processed, are published via the Error
+// event of the IPCServer.
+func (s *AutomationServer) respond(conn *IPCConn, resp automationResponse) {
+	if err := conn.send(resp); err != nil {
+		s.ipc.errorPublisher.Publish(wrapErrorNoPanic(err))
+	}
+}
+
+func (s *AutomationServer) call(req *automationRequest) (interface{}, error) {
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return nil, &AutomationError{AutomationInvalidRequest, "invalid request"}
+	}
+
+	if fn, ok := s.commands[req.Method]; ok {
+		return fn(req.Params)
+	}
+
+	var p struct {
+		Name     string          `json:"name"`
+		Property string          `json:"property"`
+		Value    json.RawMessage `json:"value"`
+		Text     string          `json:"text"`
+	}
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &AutomationError{AutomationInvalidParams, err.Error()}
+		}
+	}
+
+	switch req.Method {
+	case "walk.commands":
+		names := make([]string, 0, len(s.commands))
+		for name := range s.commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return names, nil
+
+	case "walk.tree":
+		return automationWindowState(s.owner, true), nil
+	}
+
+	window, err := s.findWindow(p.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Method {
+	case "walk.window":
+		return automationWindowState(window, false), nil
+
+	case "walk.getProperty":
+		prop, err := automationProperty(window, p.Property)
+		if err != nil {
+			return nil, err
+		}
+
+		return prop.Get(), nil
+
+	case "walk.setProperty":
+		prop, err := automationProperty(window, p.Property)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := automationPropertyValue(prop, p.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, prop.Set(value)
+
+	case "walk.setText":
+		setter, ok := window.(interface{ SetText(string) error })
+		if !ok {
+			return nil, &AutomationError{AutomationInvalidParams, "window has no text"}
+		}
+
+		return nil, setter.SetText(p.Text)
+
+	case "walk.click":
+		if _, ok := window.(interface{ raiseClicked() }); !ok {
+			return nil, &AutomationError{AutomationInvalidParams, "window is not a button"}
+		}
+		if !window.Enabled() {
+			return nil, newError("button is disabled")
+		}
+
+		win.SendMessage(window.Handle(), win.BM_CLICK, 0, 0)
+
+		return nil, nil
+
+	case "walk.focus":
+		return nil, window.SetFocus()
+	}
+
+	return nil, &AutomationError{AutomationMethodNotFound, "method not found: " + req.Method}
+}
+
+// findWindow returns the first window named name, the owner included.
+func (s *AutomationServer) findWindow(name string) (Window, error) {
+	if name == "" {
+		return nil, &AutomationError{AutomationInvalidParams, "name is required"}
+	}
+
+	var found Window
+
+	walkDescendants(s.owner, func(w Window) bool {
+		if found == nil && w.Name() == name {
+			found = w
+		}
+
+		return found == nil
+	})
+
+	if found == nil {
+		return nil, &AutomationError{AutomationInvalidParams, "no window named " + name}
+	}
+
+	return found, nil
+}
+
+func automationProperty(window Window, name string) (Property, error) {
+	prop := window.AsWindowBase().Property(name)
+	if prop == nil {
+		return nil, &AutomationError{AutomationInvalidParams, "no property named " + name}
+	}
+
+	return prop, nil
+}
+
+// automationPropertyValue decodes raw into a value of the type of the
+// current value of prop, so e.g. numbers become the ints a widget expects.
+func automationPropertyValue(prop Property, raw json.RawMessage) (interface{}, error) {
+	if len(raw) == 0 {
+		return nil, &AutomationError{AutomationInvalidParams, "value is required"}
+	}
+
+	if current := prop.Get(); current != nil {
+		v := reflect.New(reflect.TypeOf(current))
+		if err := json.Unmarshal(raw, v.Interface()); err == nil {
+			return v.Elem().Interface(), nil
+		}
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, &AutomationError{AutomationInvalidParams, err.Error()}
+	}
+
+	return value, nil
+}
+
+func automationWindowState(window Window, recursive bool) AutomationWindowState {
+	wb := window.AsWindowBase()
+
+	state := AutomationWindowState{
+		Name:    window.Name(),
+		Type:    reflect.TypeOf(window).String(),
+		Visible: window.Visible(),
+		Enabled: window.Enabled(),
+		Focused: window.Focused(),
+		Bounds:  window.Bounds(),
+	}
+
+	if texter, ok := window.(interface{ Text() string }); ok {
+		state.Text = texter.Text()
+	}
+
+	if len(wb.name2Property) > 0 {
+		state.Properties = make(map[string]interface{}, len(wb.name2Property))
+
+		for name, prop := range wb.name2Property {
+			value := prop.Get()
+			if _, err := json.Marshal(value); err != nil {
+				// Values like images cannot be sent.
+				continue
+			}
+
+			state.Properties[name] = value
+		}
+	}
+
+	if !recursive {
+		return state
+	}
+
+	switch w := window.(type) {
+	case *TabWidget:
+		for _, page := range w.Pages().items {
+			state.Children = append(state.Children, automationWindowState(page, true))
+		}
+
+	case Container:
+		if children := w.Children(); children != nil {
+			for _, child := range children.items {
+				state.Children = append(state.Children, automationWindowState(child.window, true))
+			}
+		}
+	}
+
+	return state
+}
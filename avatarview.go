@@ -0,0 +1,567 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"hash/fnv"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Presence is the availability state of a person, displayed by an AvatarView
+// as a colored dot.
+type Presence int
+
+const (
+	PresenceNone Presence = iota
+	PresenceOnline
+	PresenceAway
+	PresenceBusy
+	PresenceOffline
+)
+
+func (p Presence) color() Color {
+	switch p {
+	case PresenceOnline:
+		return RGB(92, 184, 92)
+
+	case PresenceAway:
+		return RGB(255, 170, 68)
+
+	case PresenceBusy:
+		return RGB(196, 49, 75)
+
+	case PresenceOffline:
+		return RGB(138, 136, 134)
+	}
+
+	return 0
+}
+
+// avatarPalette holds the colors an AvatarView picks from for the background
+// of initials.
+var avatarPalette = [...]Color{
+	RGB(209, 52, 56),
+	RGB(202, 80, 16),
+	RGB(152, 111, 11),
+	RGB(73, 130, 5),
+	RGB(3, 131, 135),
+	RGB(0, 120, 212),
+	RGB(0, 91, 112),
+	RGB(135, 100, 184),
+	RGB(136, 23, 152),
+	RGB(194, 57, 179),
+	RGB(122, 117, 116),
+	RGB(93, 90, 88),
+}
+
+// AvatarView displays the picture of a person clipped to a circle.
+//
+// As long as there is no picture, or it could not be loaded, the initials of
+// the display name are displayed on a colored circle instead. The color is
+// derived from the display name, so it is stable across runs.
+type AvatarView struct {
+	*CustomWidget
+	displayName                 string
+	image                       image.Image
+	imageSource                 string
+	loadGeneration              int
+	circleBitmap                *Bitmap
+	circleDiameter              int
+	initialsFont                *Font
+	fillColor                   Color
+	fillColorSet                bool
+	presence                    Presence
+	avatarSize96dpi             int
+	displayNameChangedPublisher EventPublisher
+	imageChangedPublisher       EventPublisher
+	imageSourceChangedPublisher EventPublisher
+	imageLoadFailedPublisher    ErrorEventPublisher
+	presenceChangedPublisher    EventPublisher
+}
+
+// NewAvatarView creates and initializes a new AvatarView.
+func NewAvatarView(parent Container) (*AvatarView, error) {
+	av := &AvatarView{avatarSize96dpi: 40}
+
+	cw, err := NewCustomWidgetPixels(parent, 0, func(canvas *Canvas, updateBounds Rectangle) error {
+		return av.draw(canvas)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	av.CustomWidget = cw
+
+	if err := InitWrapperWindow(av); err != nil {
+		av.Dispose()
+		return nil, err
+	}
+
+	av.SetInvalidatesOnResize(true)
+
+	av.Disposing().Attach(func() {
+		av.loadGeneration++
+		av.disposeCircleBitmap()
+		av.disposeInitialsFont()
+	})
+
+	av.MustRegisterProperty("DisplayName", NewProperty(
+		func() interface{} {
+			return av.DisplayName()
+		},
+		func(v interface{}) error {
+			av.SetDisplayName(assertStringOr(v, ""))
+			return nil
+		},
+		av.displayNameChangedPublisher.Event()))
+
+	av.MustRegisterProperty("ImageSource", NewProperty(
+		func() interface{} {
+			return av.ImageSource()
+		},
+		func(v interface{}) error {
+			av.SetImageSource(assertStringOr(v, ""))
+			return nil
+		},
+		av.imageSourceChangedPublisher.Event()))
+
+	av.MustRegisterProperty("Presence", NewProperty(
+		func() interface{} {
+			return int(av.Presence())
+		},
+		func(v interface{}) error {
+			if p, ok := v.(Presence); ok {
+				av.SetPresence(p)
+			} else {
+				av.SetPresence(Presence(assertIntOr(v, 0)))
+			}
+			return nil
+		},
+		av.presenceChangedPublisher.Event()))
+
+	return av, nil
+}
+
+// DisplayName returns the name of the person, which the initials are taken
+// from.
+func (av *AvatarView) DisplayName() string {
+	return av.displayName
+}
+
+// SetDisplayName sets the name of the person, which the initials are taken
+// from.
+func (av *AvatarView) SetDisplayName(name string) {
+	if name == av.displayName {
+		return
+	}
+
+	av.displayName = name
+
+	av.Invalidate()
+
+	av.displayNameChangedPublisher.Publish()
+}
+
+// DisplayNameChanged returns the event that is published when the display
+// name changed.
+func (av *AvatarView) DisplayNameChanged() *Event {
+	return av.displayNameChangedPublisher.Event()
+}
+
+// Initials returns the initials that are displayed while there is no image,
+// e.g. "JD" for "Jane Doe".
+func (av *AvatarView) Initials() string {
+	return avatarInitials(av.displayName)
+}
+
+func avatarInitials(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var initials []rune
+	for i, word := range words {
+		if i == 0 || i == len(words)-1 {
+			initials = append(initials, unicode.ToUpper([]rune(word)[0]))
+		}
+	}
+
+	return string(initials)
+}
+
+// FillColor returns the color of the circle the initials are displayed on.
+func (av *AvatarView) FillColor() Color {
+	if av.fillColorSet {
+		return av.fillColor
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(av.displayName)))
+
+	return avatarPalette[h.Sum32()%uint32(len(avatarPalette))]
+}
+
+// SetFillColor sets the color of the circle the initials are displayed on,
+// overriding the color derived from the display name.
+func (av *AvatarView) SetFillColor(c Color) {
+	av.fillColor = c
+	av.fillColorSet = true
+
+	av.Invalidate()
+}
+
+// Image returns the picture displayed by the AvatarView, or nil.
+func (av *AvatarView) Image() image.Image {
+	return av.image
+}
+
+// SetImage sets the picture displayed by the AvatarView.
+//
+// A pending asynchronous load started by SetImageSource is abandoned.
+func (av *AvatarView) SetImage(img image.Image) {
+	av.loadGeneration++
+	av.setImage(img)
+}
+
+func (av *AvatarView) setImage(img image.Image) {
+	av.image = img
+
+	av.disposeCircleBitmap()
+	av.Invalidate()
+
+	av.imageChangedPublisher.Publish()
+}
+
+// ImageChanged returns the event that is published when the picture changed.
+func (av *AvatarView) ImageChanged() *Event {
+	return av.imageChangedPublisher.Event()
+}
+
+// ImageSource returns the file path or http(s) URL the picture is loaded
+// from.
+func (av *AvatarView) ImageSource() string {
+	return av.imageSource
+}
+
+// SetImageSource loads the picture asynchronously from a file path or an
+// http(s) URL. The initials are displayed until loading completed.
+//
+// If loading fails, the initials stay visible and ImageLoadFailed is
+// published.
+func (av *AvatarView) SetImageSource(source string) {
+	if source == av.imageSource {
+		return
+	}
+
+	av.imageSource = source
+
+	av.SetImage(nil)
+
+	av.imageSourceChangedPublisher.Publish()
+
+	if source == "" {
+		return
+	}
+
+	generation := av.loadGeneration
+
+	go func() {
+		img, err := loadAvatarImage(source)
+
+		av.Synchronize(func() {
+			if generation != av.loadGeneration {
+				return
+			}
+
+			if err != nil {
+				av.imageLoadFailedPublisher.Publish(err)
+				return
+			}
+
+			av.setImage(img)
+		})
+	}()
+}
+
+// ImageSourceChanged returns the event that is published when the image
+// source changed.
+func (av *AvatarView) ImageSourceChanged() *Event {
+	return av.imageSourceChangedPublisher.Event()
+}
+
+// ImageLoadFailed returns the event that is published when the picture could
+// not be loaded from the image source.
+func (av *AvatarView) ImageLoadFailed() *ErrorEvent {
+	return av.imageLoadFailedPublisher.Event()
+}
+
+func loadAvatarImage(source string) (image.Image, error) {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		resp, err := http.Get(source)
+		if err != nil {
+			return nil, wrapErrorNoPanic(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, newErrorNoPanic(resp.Status)
+		}
+
+		img, _, err := image.Decode(resp.Body)
+		if err != nil {
+			return nil, wrapErrorNoPanic(err)
+		}
+
+		return img, nil
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, wrapErrorNoPanic(err)
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, wrapErrorNoPanic(err)
+	}
+
+	return img, nil
+}
+
+// Presence returns the presence state displayed by the AvatarView.
+func (av *AvatarView) Presence() Presence {
+	return av.presence
+}
+
+// SetPresence sets the presence state displayed by the AvatarView.
+// PresenceNone hides the presence dot.
+func (av *AvatarView) SetPresence(presence Presence) {
+	if presence == av.presence {
+		return
+	}
+
+	av.presence = presence
+
+	av.Invalidate()
+
+	av.presenceChangedPublisher.Publish()
+}
+
+// PresenceChanged returns the event that is published when the presence
+// state changed.
+func (av *AvatarView) PresenceChanged() *Event {
+	return av.presenceChangedPublisher.Event()
+}
+
+// AvatarSize returns the ideal diameter of the avatar in 1/96" units.
+func (av *AvatarView) AvatarSize() int {
+	return av.avatarSize96dpi
+}
+
+// SetAvatarSize sets the ideal diameter of the avatar in 1/96" units.
+func (av *AvatarView) SetAvatarSize(size int) {
+	if size == av.avatarSize96dpi {
+		return
+	}
+
+	av.avatarSize96dpi = size
+
+	av.RequestLayout()
+}
+
+func (av *AvatarView) disposeCircleBitmap() {
+	if av.circleBitmap != nil {
+		av.circleBitmap.Dispose()
+		av.circleBitmap = nil
+	}
+}
+
+func (av *AvatarView) disposeInitialsFont() {
+	if av.initialsFont != nil {
+		av.initialsFont.Dispose()
+		av.initialsFont = nil
+	}
+}
+
+// circleBounds returns the bounds of the avatar circle in native pixels.
+func (av *AvatarView) circleBounds() Rectangle {
+	cb := av.ClientBoundsPixels()
+	d := mini(cb.Width, cb.Height)
+
+	return Rectangle{(cb.Width - d) / 2, (cb.Height - d) / 2, d, d}
+}
+
+func (av *AvatarView) draw(canvas *Canvas) error {
+	bounds := av.circleBounds()
+	if bounds.Width <= 0 {
+		return nil
+	}
+
+	if av.image != nil {
+		if av.circleBitmap == nil || av.circleDiameter != bounds.Width {
+			av.disposeCircleBitmap()
+
+			bmp, err := NewBitmapFromImageForDPI(circularAvatarImage(av.image, bounds.Width), av.DPI())
+			if err != nil {
+				return err
+			}
+
+			av.circleBitmap = bmp
+			av.circleDiameter = bounds.Width
+		}
+
+		if err := canvas.DrawImageStretchedPixels(av.circleBitmap, bounds); err != nil {
+			return err
+		}
+	} else if err := av.drawInitials(canvas, bounds); err != nil {
+		return err
+	}
+
+	if av.presence != PresenceNone {
+		return av.drawPresence(canvas, bounds)
+	}
+
+	return nil
+}
+
+func (av *AvatarView) drawInitials(canvas *Canvas, bounds Rectangle) error {
+	brush, err := NewSolidColorBrush(av.FillColor())
+	if err != nil {
+		return err
+	}
+	defer brush.Dispose()
+
+	if err := canvas.FillEllipsePixels(brush, bounds); err != nil {
+		return err
+	}
+
+	initials := av.Initials()
+	if initials == "" {
+		return nil
+	}
+
+	// The initials take about 40% of the diameter.
+	pointSize := maxi(1, bounds.Width*2/5*72/av.DPI())
+	if av.initialsFont == nil || av.initialsFont.PointSize() != pointSize {
+		av.disposeInitialsFont()
+
+		font, err := NewFont(av.Font().Family(), pointSize, FontBold)
+		if err != nil {
+			return err
+		}
+
+		av.initialsFont = font
+	}
+
+	return canvas.DrawTextPixels(initials, av.initialsFont, RGB(255, 255, 255), bounds, TextCenter|TextVCenter|TextSingleLine|TextNoPrefix)
+}
+
+func (av *AvatarView) drawPresence(canvas *Canvas, bounds Rectangle) error {
+	d := maxi(IntFrom96DPI(8, av.DPI()), bounds.Width/4)
+	ring := maxi(1, d/6)
+
+	// Place the dot on the circle at the bottom right.
+	r := float64(bounds.Width) / 2
+	cx := bounds.X + int(r+r*math.Sqrt2/2)
+	cy := bounds.Y + int(r+r*math.Sqrt2/2)
+
+	dot := Rectangle{cx - d/2, cy - d/2, d, d}
+	if right := bounds.X + bounds.Width; dot.X+dot.Width > right {
+		dot.X = right - dot.Width
+	}
+	if bottom := bounds.Y + bounds.Height; dot.Y+dot.Height > bottom {
+		dot.Y = bottom - dot.Height
+	}
+
+	ringBrush, err := NewSystemColorBrush(SysColorWindow)
+	if err != nil {
+		return err
+	}
+	defer ringBrush.Dispose()
+
+	if err := canvas.FillEllipsePixels(ringBrush, dot); err != nil {
+		return err
+	}
+
+	brush, err := NewSolidColorBrush(av.presence.color())
+	if err != nil {
+		return err
+	}
+	defer brush.Dispose()
+
+	return canvas.FillEllipsePixels(brush, Rectangle{dot.X + ring, dot.Y + ring, dot.Width - 2*ring, dot.Height - 2*ring})
+}
+
+// circularAvatarImage returns the center square of src scaled to diameter x
+// diameter pixels, with everything outside of the inscribed circle made
+// transparent. The edge of the circle is anti-aliased.
+func circularAvatarImage(src image.Image, diameter int) *image.RGBA {
+	b := src.Bounds()
+	side := mini(b.Dx(), b.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2))
+
+	dst := image.NewRGBA(image.Rect(0, 0, diameter, diameter))
+	r := float64(diameter) / 2
+
+	for y := 0; y < diameter; y++ {
+		for x := 0; x < diameter; x++ {
+			dist := math.Hypot(float64(x)+0.5-r, float64(y)+0.5-r)
+			coverage := math.Max(0, math.Min(1, r-dist+0.5))
+			if coverage == 0 {
+				continue
+			}
+
+			sx := crop.Min.X + (x*2+1)*side/(diameter*2)
+			sy := crop.Min.Y + (y*2+1)*side/(diameter*2)
+			cr, cg, cb, ca := src.At(sx, sy).RGBA()
+
+			dst.SetRGBA(x, y, color.RGBA{
+				uint8(float64(cr>>8) * coverage),
+				uint8(float64(cg>>8) * coverage),
+				uint8(float64(cb>>8) * coverage),
+				uint8(float64(ca>>8) * coverage),
+			})
+		}
+	}
+
+	return dst
+}
+
+func (av *AvatarView) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	s := IntFrom96DPI(av.avatarSize96dpi, av.DPI())
+
+	return &avatarViewLayoutItem{
+		idealSize: Size{s, s},
+	}
+}
+
+type avatarViewLayoutItem struct {
+	LayoutItemBase
+	idealSize Size // in native pixels
+}
+
+func (*avatarViewLayoutItem) LayoutFlags() LayoutFlags {
+	return 0
+}
+
+func (li *avatarViewLayoutItem) IdealSize() Size {
+	return li.idealSize
+}
+
+func (li *avatarViewLayoutItem) MinSize() Size {
+	return li.idealSize
+}
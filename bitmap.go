@@ -25,6 +25,8 @@ type Bitmap struct {
 	size               Size // in native pixels
 	dpi                int
 	transparencyStatus transparencyStatus
+	canvas             *Canvas     // created with NewCanvasFromImage
+	lockedData         *BitmapData // while locked
 }
 
 type transparencyStatus byte
@@ -379,6 +381,8 @@ func (bmp *Bitmap) withPixels(f func(bi *win.BITMAPINFO, hdc win.HDC, pixels *[m
 }
 
 func (bmp *Bitmap) Dispose() {
+	mustNotBeInUse(bmp)
+
 	if bmp.hBmp != 0 {
 		win.DeleteObject(win.HGDIOBJ(bmp.hBmp))
 
@@ -0,0 +1,163 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// PixelFormat describes how the pixels of a BitmapData are stored.
+type PixelFormat int
+
+const (
+	// PixelFormatPBGRA32 stores each pixel in 4 bytes in the order blue,
+	// green, red and alpha. The color components are premultiplied by alpha,
+	// as AlphaBlend expects them.
+	PixelFormatPBGRA32 PixelFormat = iota + 1
+)
+
+// BitmapData gives direct access to the pixels of a Bitmap, see Bitmap.Lock.
+type BitmapData struct {
+	// Pix holds the pixels row by row, starting with the top row.
+	Pix []byte
+
+	// Stride is the distance in bytes between the starts of two rows in Pix.
+	Stride int
+
+	// Width and Height are the size of the bitmap in native pixels.
+	Width, Height int
+
+	// Format is the format of the pixels in Pix.
+	Format PixelFormat
+}
+
+// PixOffset returns the index of the first byte of the pixel at x, y in Pix.
+func (d *BitmapData) PixOffset(x, y int) int {
+	return y*d.Stride + x*4
+}
+
+// Lock returns the pixels of the *Bitmap for reading and changing them
+// directly, which is much faster than going through image.Image for effects
+// that touch every pixel. The changes are applied by Unlock.
+//
+// A Canvas created for the *Bitmap with NewCanvasFromImage is disposed of, so
+// its drawing is finished before the pixels are read. It must not be used
+// anymore; create a new one after Unlock if required.
+func (bmp *Bitmap) Lock() (*BitmapData, error) {
+	if bmp.lockedData != nil {
+		return nil, newError("bitmap already locked")
+	}
+	if bmp.hBmp == 0 {
+		return nil, newError("bitmap disposed")
+	}
+
+	if bmp.canvas != nil {
+		bmp.canvas.Dispose()
+	}
+
+	win.GdiFlush()
+
+	bi := bmp.bitmapInfoTopDown32()
+
+	data := &BitmapData{
+		Pix:    make([]byte, bmp.size.Width*bmp.size.Height*4),
+		Stride: bmp.size.Width * 4,
+		Width:  bmp.size.Width,
+		Height: bmp.size.Height,
+		Format: PixelFormatPBGRA32,
+	}
+
+	if len(data.Pix) > 0 {
+		hdc := win.GetDC(0)
+		if hdc == 0 {
+			return nil, newError("GetDC failed")
+		}
+		defer win.ReleaseDC(0, hdc)
+
+		if win.GetDIBits(hdc, bmp.hBmp, 0, uint32(data.Height), &data.Pix[0], &bi, win.DIB_RGB_COLORS) == 0 {
+			return nil, newError("GetDIBits failed")
+		}
+	}
+
+	bmp.lockedData = data
+
+	return data, nil
+}
+
+// Unlock applies the changes made to the pixels returned by Lock. The
+// BitmapData must not be used afterwards.
+func (bmp *Bitmap) Unlock() error {
+	data := bmp.lockedData
+	if data == nil {
+		return newError("bitmap not locked")
+	}
+
+	bmp.lockedData = nil
+
+	if len(data.Pix) == 0 {
+		return nil
+	}
+
+	bi := bmp.bitmapInfoTopDown32()
+
+	hdc := win.GetDC(0)
+	if hdc == 0 {
+		return newError("GetDC failed")
+	}
+	defer win.ReleaseDC(0, hdc)
+
+	if win.SetDIBits(hdc, bmp.hBmp, 0, uint32(data.Height), &data.Pix[0], &bi, win.DIB_RGB_COLORS) == 0 {
+		return newError("SetDIBits failed")
+	}
+
+	win.GdiFlush()
+
+	// The pixels may have become transparent or opaque.
+	bmp.transparencyStatus = transparencyUnknown
+
+	bmp.updatePackedDIB()
+
+	return nil
+}
+
+// bitmapInfoTopDown32 returns a BITMAPINFO for the pixels of the *Bitmap as
+// top-down 32 bit DIB.
+func (bmp *Bitmap) bitmapInfoTopDown32() win.BITMAPINFO {
+	var bi win.BITMAPINFO
+	bi.BmiHeader.BiSize = uint32(unsafe.Sizeof(bi.BmiHeader))
+	bi.BmiHeader.BiWidth = int32(bmp.size.Width)
+	bi.BmiHeader.BiHeight = -int32(bmp.size.Height)
+	bi.BmiHeader.BiPlanes = 1
+	bi.BmiHeader.BiBitCount = 32
+	bi.BmiHeader.BiCompression = win.BI_RGB
+
+	return bi
+}
+
+// updatePackedDIB copies the pixels of the DIB section of the *Bitmap to its
+// packed DIB, which is used e.g. by BitmapBrush.
+func (bmp *Bitmap) updatePackedDIB() {
+	var dib win.DIBSECTION
+	if win.GetObject(win.HGDIOBJ(bmp.hBmp), unsafe.Sizeof(dib), unsafe.Pointer(&dib)) == 0 || dib.DsBm.BmBits == nil {
+		return
+	}
+
+	bmih := &dib.DsBmih
+
+	bmihSize := uintptr(unsafe.Sizeof(*bmih))
+	pixelsSize := uintptr(int32(bmih.BiBitCount)*bmih.BiWidth*bmih.BiHeight) / 8
+
+	dest := win.GlobalLock(bmp.hPackedDIB)
+	if dest == nil {
+		return
+	}
+	defer win.GlobalUnlock(bmp.hPackedDIB)
+
+	win.MoveMemory(unsafe.Pointer(uintptr(dest)+bmihSize), dib.DsBm.BmBits, pixelsSize)
+}
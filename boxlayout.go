@@ -26,6 +26,7 @@ type BoxLayout struct {
 	LayoutBase
 	orientation        Orientation
 	hwnd2StretchFactor map[win.HWND]int
+	baselineAlignment  bool
 }
 
 func newBoxLayout(orientation Orientation) *BoxLayout {
@@ -71,6 +72,26 @@ func (l *BoxLayout) SetOrientation(value Orientation) error {
 	return nil
 }
 
+// BaselineAlignment returns if the widgets of a horizontal BoxLayout are
+// vertically aligned by the baseline of their text.
+func (l *BoxLayout) BaselineAlignment() bool {
+	return l.baselineAlignment
+}
+
+// SetBaselineAlignment sets if the widgets of a horizontal BoxLayout are
+// vertically aligned by the baseline of their text, so e.g. the text of a
+// Label lines up with the text of a LineEdit next to it. Widgets without
+// text keep their alignment.
+func (l *BoxLayout) SetBaselineAlignment(baselineAlignment bool) {
+	if baselineAlignment != l.baselineAlignment {
+		l.baselineAlignment = baselineAlignment
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+}
+
 func (l *BoxLayout) StretchFactor(widget Widget) int {
 	if factor, ok := l.hwnd2StretchFactor[widget.Handle()]; ok {
 		return factor
@@ -104,9 +125,9 @@ func (l *BoxLayout) SetStretchFactor(widget Widget, factor int) error {
 
 func (l *BoxLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
 	li := &boxLayoutItem{
-		size2MinSize:       make(map[Size]Size),
 		orientation:        l.orientation,
 		hwnd2StretchFactor: make(map[win.HWND]int),
+		baselineAlignment:  l.baselineAlignment && l.orientation == Horizontal,
 	}
 
 	for hwnd, sf := range l.hwnd2StretchFactor {
@@ -160,9 +181,10 @@ func (l boxLayoutItemInfoList) Swap(i, j int) {
 type boxLayoutItem struct {
 	ContainerLayoutItemBase
 	mutex              sync.Mutex
-	size2MinSize       map[Size]Size // in native pixels
+	minSizeCache       minSizeCache // in native pixels
 	orientation        Orientation
 	hwnd2StretchFactor map[win.HWND]int
+	baselineAlignment  bool
 }
 
 func (li *boxLayoutItem) LayoutFlags() LayoutFlags {
@@ -181,32 +203,42 @@ func (li *boxLayoutItem) HeightForWidth(width int) int {
 	return li.MinSizeForSize(Size{width, li.geometry.ClientSize.Height}).Height
 }
 
+func (li *boxLayoutItem) WidthForHeight(height int) int {
+	return li.MinSizeForSize(Size{li.geometry.ClientSize.Width, height}).Width
+}
+
 func (li *boxLayoutItem) MinSizeForSize(size Size) Size {
 	li.mutex.Lock()
 	defer li.mutex.Unlock()
 
-	if min, ok := li.size2MinSize[size]; ok {
+	if min, ok := li.minSizeCache.get(size); ok {
 		return min
 	}
 
 	bounds := Rectangle{Width: size.Width, Height: size.Height}
 
-	items := boxLayoutItems(li, itemsToLayout(li.children), li.orientation, li.alignment, bounds, li.margins96dpi, li.spacing96dpi, li.hwnd2StretchFactor)
+	items := boxLayoutItems(li, itemsToLayout(li.children), li.orientation, li.alignment, bounds, li.margins96dpi, li.spacing96dpi(li.orientation), li.hwnd2StretchFactor)
 
 	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi(li.orientation), li.ctx.dpi)
 	s := Size{margins.HNear + margins.HFar, margins.VNear + margins.VFar}
 
 	var maxSecondary int
 	for _, item := range items {
 		min := li.MinSizeEffectiveForChild(item.Item)
 
+		layoutHeight := item.Bounds.Height
+
 		if hfw, ok := item.Item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
 			item.Bounds.Height = hfw.HeightForWidth(item.Bounds.Width)
 		} else {
 			item.Bounds.Height = min.Height
 		}
-		item.Bounds.Width = min.Width
+		if wfh, ok := item.Item.(WidthForHeighter); ok && wfh.HasWidthForHeight() {
+			item.Bounds.Width = wfh.WidthForHeight(layoutHeight)
+		} else {
+			item.Bounds.Width = min.Width
+		}
 
 		if li.orientation == Horizontal {
 			maxSecondary = maxi(maxSecondary, item.Bounds.Height)
@@ -219,6 +251,10 @@ func (li *boxLayoutItem) MinSizeForSize(size Size) Size {
 		}
 	}
 
+	if li.baselineAlignment {
+		maxSecondary = maxi(maxSecondary, baselineExtent(itemsToLayout(li.children)))
+	}
+
 	if li.orientation == Horizontal {
 		s.Width += (len(items) - 1) * spacing
 		s.Height += maxSecondary
@@ -228,7 +264,7 @@ func (li *boxLayoutItem) MinSizeForSize(size Size) Size {
 	}
 
 	if s.Width > 0 && s.Height > 0 {
-		li.size2MinSize[size] = s
+		li.minSizeCache.put(size, s)
 	}
 
 	return s
@@ -236,7 +272,13 @@ func (li *boxLayoutItem) MinSizeForSize(size Size) Size {
 
 func (li *boxLayoutItem) PerformLayout() []LayoutResultItem {
 	cb := Rectangle{Width: li.geometry.ClientSize.Width, Height: li.geometry.ClientSize.Height}
-	return boxLayoutItems(li, itemsToLayout(li.children), li.orientation, li.alignment, cb, li.margins96dpi, li.spacing96dpi, li.hwnd2StretchFactor)
+	results := boxLayoutItems(li, itemsToLayout(li.children), li.orientation, li.alignment, cb, li.margins96dpi, li.spacing96dpi(li.orientation), li.hwnd2StretchFactor)
+
+	if li.baselineAlignment {
+		alignBaselines(results)
+	}
+
+	return results
 }
 
 func boxLayoutFlags(orientation Orientation, children []LayoutItem) LayoutFlags {
@@ -275,23 +317,31 @@ func boxLayoutItems(container ContainerLayoutItem, items []LayoutItem, orientati
 	margins := MarginsFrom96DPI(margins96dpi, dpi)
 	spacing := IntFrom96DPI(spacing96dpi, dpi)
 
+	ls := getLayoutScratch()
+	defer ls.release()
+
 	var greedyNonSpacerCount int
 	var greedySpacerCount int
 	var stretchFactorsTotal [3]int
-	stretchFactors := make([]int, len(items))
+	stretchFactors := ls.intSlice(len(items))
 	var minSizesRemaining int
-	minSizes := make([]int, len(items))
-	maxSizes := make([]int, len(items))
-	sizes := make([]int, len(items))
-	prefSizes2 := make([]int, len(items))
+	minSizes := ls.intSlice(len(items))
+	maxSizes := ls.intSlice(len(items))
+	sizes := ls.intSlice(len(items))
+	prefSizes2 := ls.intSlice(len(items))
 	var shrinkableAmount1Total int
-	shrinkableAmount1 := make([]int, len(items))
-	shrinkable2 := make([]bool, len(items))
-	growable2 := make([]bool, len(items))
-	sortedItemInfo := boxLayoutItemInfoList(make([]boxLayoutItemInfo, len(items)))
+	shrinkableAmount1 := ls.intSlice(len(items))
+	shrinkable2 := ls.boolSlice(len(items))
+	growable2 := ls.boolSlice(len(items))
+	sortedItemInfo := ls.boxLayoutItemInfos(len(items))
 
 	for i, item := range items {
 		sf := hwnd2StretchFactor[item.Handle()]
+		if sf == 0 {
+			if spacer, ok := item.(*spacerLayoutItem); ok {
+				sf = spacer.StretchFactor()
+			}
+		}
 		if sf == 0 {
 			sf = 1
 		}
@@ -302,8 +352,13 @@ func boxLayoutItems(container ContainerLayoutItem, items []LayoutItem, orientati
 		flags := item.LayoutFlags()
 
 		max := geometry.MaxSize
+		hfw, hasHFW := item.(HeightForWidther)
+		hasHFW = hasHFW && hfw.HasHeightForWidth()
+		wfh, hasWFH := item.(WidthForHeighter)
+		hasWFH = hasWFH && wfh.HasWidthForHeight()
+
 		var pref Size
-		if hfw, ok := item.(HeightForWidther); !ok || !hfw.HasHeightForWidth() {
+		if !hasHFW && !hasWFH {
 			if is, ok := item.(IdealSizer); ok {
 				pref = is.IdealSize()
 			}
@@ -312,10 +367,16 @@ func boxLayoutItems(container ContainerLayoutItem, items []LayoutItem, orientati
 		if orientation == Horizontal {
 			growable2[i] = flags&GrowableVert > 0
 
-			minSizes[i] = container.MinSizeEffectiveForChild(item).Width
+			if hasWFH {
+				minSizes[i] = wfh.WidthForHeight(bounds.Height - margins.VNear - margins.VFar)
+			} else {
+				minSizes[i] = container.MinSizeEffectiveForChild(item).Width
+			}
 
 			if max.Width > 0 {
 				maxSizes[i] = max.Width
+			} else if hasWFH && flags&GrowableHorz == 0 {
+				maxSizes[i] = minSizes[i]
 			} else if pref.Width > 0 && flags&GrowableHorz == 0 {
 				maxSizes[i] = pref.Width
 			} else {
@@ -329,7 +390,7 @@ func boxLayoutItems(container ContainerLayoutItem, items []LayoutItem, orientati
 		} else {
 			growable2[i] = flags&GrowableHorz > 0
 
-			if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+			if hasHFW {
 				minSizes[i] = hfw.HeightForWidth(bounds.Width - margins.HNear - margins.HFar)
 			} else {
 				minSizes[i] = container.MinSizeEffectiveForChild(item).Height
@@ -337,7 +398,7 @@ func boxLayoutItems(container ContainerLayoutItem, items []LayoutItem, orientati
 
 			if max.Height > 0 {
 				maxSizes[i] = max.Height
-			} else if hfw, ok := item.(HeightForWidther); ok && flags&GrowableVert == 0 && hfw.HasHeightForWidth() {
+			} else if hasHFW && flags&GrowableVert == 0 {
 				maxSizes[i] = minSizes[i]
 			} else if pref.Height > 0 && flags&GrowableVert == 0 {
 				maxSizes[i] = pref.Height
@@ -463,6 +524,8 @@ func boxLayoutItems(container ContainerLayoutItem, items []LayoutItem, orientati
 		var s2 int
 		if hfw, ok := item.(HeightForWidther); ok && orientation == Horizontal && hfw.HasHeightForWidth() {
 			s2 = hfw.HeightForWidth(s1)
+		} else if wfh, ok := item.(WidthForHeighter); ok && orientation == Vertical && wfh.HasWidthForHeight() {
+			s2 = wfh.WidthForHeight(s1)
 		} else if shrinkable2[i] || growable2[i] {
 			s2 = space2
 		} else {
@@ -552,6 +615,34 @@ func boxLayoutItems(container ContainerLayoutItem, items []LayoutItem, orientati
 
 		p1 += s1 + spacing
 
+		if ar := item.Geometry().AspectRatio; ar.Width > 0 {
+			s := sizeWithAspectRatio(Size{w, h}, ar)
+
+			switch align {
+			case AlignHNearVNear, AlignHNearVCenter, AlignHNearVFar:
+				// nop
+
+			case AlignHFarVNear, AlignHFarVCenter, AlignHFarVFar:
+				x += w - s.Width
+
+			default:
+				x += (w - s.Width) / 2
+			}
+
+			switch align {
+			case AlignHNearVNear, AlignHCenterVNear, AlignHFarVNear:
+				// nop
+
+			case AlignHNearVFar, AlignHCenterVFar, AlignHFarVFar:
+				y += h - s.Height
+
+			default:
+				y += (h - s.Height) / 2
+			}
+
+			w, h = s.Width, s.Height
+		}
+
 		results = append(results, LayoutResultItem{Item: item, Bounds: Rectangle{X: x, Y: y, Width: w, Height: h}})
 	}
 
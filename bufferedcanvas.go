@@ -0,0 +1,138 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// BufferedCanvas is a Canvas that draws into an offscreen 32bpp DIB instead of
+// its target. Flush copies the buffer to the target in one operation, so
+// drawing in several steps, like clearing the background first, does not
+// flicker.
+//
+// The BufferedCanvas uses the coordinates of its target, so code drawing on
+// the target can draw on the BufferedCanvas unchanged.
+type BufferedCanvas struct {
+	Canvas
+	target  *Canvas
+	bounds  Rectangle // in native pixels of target
+	hBmp    win.HBITMAP
+	hBmpOld win.HGDIOBJ
+}
+
+// NewBufferedCanvas creates a BufferedCanvas for bounds of target, which are in
+// native pixels. Drawing outside bounds is clipped.
+func NewBufferedCanvas(target *Canvas, bounds Rectangle) (*BufferedCanvas, error) {
+	if target == nil {
+		return nil, newError("target cannot be nil")
+	}
+
+	if bounds.Width < 1 {
+		bounds.Width = 1
+	}
+	if bounds.Height < 1 {
+		bounds.Height = 1
+	}
+
+	hdc := win.CreateCompatibleDC(target.hdc)
+	if hdc == 0 {
+		return nil, newError("CreateCompatibleDC failed")
+	}
+
+	var bih win.BITMAPINFOHEADER
+	bih.BiSize = uint32(unsafe.Sizeof(bih))
+	bih.BiWidth = int32(bounds.Width)
+	bih.BiHeight = -int32(bounds.Height) // top-down
+	bih.BiPlanes = 1
+	bih.BiBitCount = 32
+	bih.BiCompression = win.BI_RGB
+
+	var bits unsafe.Pointer
+	hBmp := win.CreateDIBSection(hdc, &bih, win.DIB_RGB_COLORS, &bits, 0, 0)
+	switch hBmp {
+	case 0, win.ERROR_INVALID_PARAMETER:
+		win.DeleteDC(hdc)
+		return nil, newError("CreateDIBSection failed")
+	}
+
+	bc := &BufferedCanvas{
+		Canvas: Canvas{
+			hdc:          hdc,
+			dpi:          target.DPI(),
+			doNotDispose: true,
+		},
+		target:  target,
+		bounds:  bounds,
+		hBmp:    hBmp,
+		hBmpOld: win.SelectObject(hdc, win.HGDIOBJ(hBmp)),
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			bc.Dispose()
+		}
+	}()
+
+	if bc.hBmpOld == 0 {
+		return nil, newError("SelectObject failed")
+	}
+
+	if _, err := bc.init(); err != nil {
+		return nil, err
+	}
+
+	win.SetViewportOrgEx(hdc, -int32(bounds.X), -int32(bounds.Y), nil)
+	if !win.SetBrushOrgEx(hdc, -int32(bounds.X), -int32(bounds.Y), nil) {
+		return nil, newError("SetBrushOrgEx failed")
+	}
+
+	succeeded = true
+
+	return bc, nil
+}
+
+// Bounds returns the bounds of the target the BufferedCanvas draws for, in
+// native pixels.
+func (bc *BufferedCanvas) Bounds() Rectangle {
+	return bc.bounds
+}
+
+// Flush copies what was drawn on the BufferedCanvas to its target.
+func (bc *BufferedCanvas) Flush() error {
+	win.GdiFlush()
+
+	if !win.BitBlt(bc.target.hdc,
+		int32(bc.bounds.X), int32(bc.bounds.Y), int32(bc.bounds.Width), int32(bc.bounds.Height),
+		bc.hdc,
+		int32(bc.bounds.X), int32(bc.bounds.Y), win.SRCCOPY) {
+		return lastError("BitBlt")
+	}
+
+	return nil
+}
+
+// Dispose releases the buffer of the BufferedCanvas without copying it to
+// the target.
+func (bc *BufferedCanvas) Dispose() {
+	if bc.hdc == 0 {
+		return
+	}
+
+	bc.Canvas.Dispose()
+
+	if bc.hBmpOld != 0 {
+		win.SelectObject(bc.hdc, bc.hBmpOld)
+	}
+	win.DeleteObject(win.HGDIOBJ(bc.hBmp))
+	win.DeleteDC(bc.hdc)
+
+	bc.hdc = 0
+}
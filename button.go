@@ -74,6 +74,14 @@ func (b *Button) ApplyDPI(dpi int) {
 	b.SetImage(b.image)
 }
 
+func (b *Button) ApplySysColors() {
+	b.WidgetBase.ApplySysColors()
+
+	if fi, ok := b.image.(*FontIcon); ok && fi.usesTextColor() {
+		b.SetImage(b.image)
+	}
+}
+
 func (b *Button) Image() Image {
 	return b.image
 }
@@ -103,6 +111,8 @@ func (b *Button) SetImage(image Image) error {
 
 	b.SendMessage(win.BM_SETIMAGE, typ, handle)
 
+	b.replaceResource(b.image, image)
+
 	b.image = image
 
 	b.RequestLayout()
@@ -233,14 +243,22 @@ func (b *Button) idealSize() Size {
 }
 
 func (b *Button) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	idealSize := b.idealSize()
+
 	return &buttonLayoutItem{
-		idealSize: b.idealSize(),
+		idealSize: idealSize,
+		baseline:  b.centeredTextBaseline(idealSize.Height),
 	}
 }
 
 type buttonLayoutItem struct {
 	LayoutItemBase
 	idealSize Size // in native pixels
+	baseline  int  // in native pixels
+}
+
+func (li *buttonLayoutItem) BaselineOffset() int {
+	return li.baseline
 }
 
 func (li *buttonLayoutItem) LayoutFlags() LayoutFlags {
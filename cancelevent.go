@@ -9,6 +9,7 @@ package walk
 type cancelEventHandlerInfo struct {
 	handler CancelEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type CancelEventHandler func(canceled *bool)
@@ -18,7 +19,7 @@ type CancelEvent struct {
 }
 
 func (e *CancelEvent) Attach(handler CancelEventHandler) int {
-	handlerInfo := cancelEventHandlerInfo{handler, false}
+	handlerInfo := cancelEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *CancelEvent) Attach(handler CancelEventHandler) int {
 }
 
 func (e *CancelEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -41,6 +43,17 @@ func (e *CancelEvent) Once(handler CancelEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *CancelEvent) AttachScoped(owner Window, handler CancelEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type CancelEventPublisher struct {
 	event CancelEvent
 }
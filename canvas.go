@@ -62,6 +62,8 @@ type Canvas struct {
 	recordingMetafile   *Metafile
 	measureTextMetafile *Metafile
 	doNotDispose        bool
+	transformStack      []xform // the world transforms to restore
+	graphicsModePrev    int32   // the graphics mode before the first push
 }
 
 func NewCanvasFromImage(image Image) (*Canvas, error) {
@@ -86,7 +88,12 @@ func NewCanvasFromImage(image Image) (*Canvas, error) {
 
 		succeeded = true
 
-		return (&Canvas{hdc: hdc, hBmpStock: hBmpStock, bitmap: img, dpi: img.dpi}).init()
+		c, err := (&Canvas{hdc: hdc, hBmpStock: hBmpStock, bitmap: img, dpi: img.dpi}).init()
+		if err == nil {
+			img.canvas = c
+		}
+
+		return c, err
 
 	case *Metafile:
 		c, err := newCanvasFromHDC(img.hdc)
@@ -141,6 +148,11 @@ func (c *Canvas) init() (*Canvas, error) {
 }
 
 func (c *Canvas) Dispose() {
+	// Canvases may draw on device contexts they do not own.
+	for len(c.transformStack) > 0 {
+		c.PopTransform()
+	}
+
 	if !c.doNotDispose && c.hdc != 0 {
 		if c.bitmap != nil {
 			win.SelectObject(c.hdc, win.HGDIOBJ(c.hBmpStock))
@@ -148,6 +160,9 @@ func (c *Canvas) Dispose() {
 			if err := c.bitmap.postProcess(); err != nil {
 				log.Printf("*Canvas.Dispose - failed to post-process bitmap: %s", err.Error())
 			}
+			if c.bitmap.canvas == c {
+				c.bitmap.canvas = nil
+			}
 		} else {
 			win.ReleaseDC(c.window.Handle(), c.hdc)
 		}
@@ -217,6 +232,83 @@ func (c *Canvas) BoundsPixels() Rectangle {
 	}
 }
 
+// PushTransform transforms everything drawn on the canvas by t, until
+// PopTransform is called. Coordinates are transformed by t first and then by
+// the transforms pushed before. The translation of t is in 1/96" units.
+func (c *Canvas) PushTransform(t Transform) error {
+	return c.PushTransformPixels(t.forDPI(c.DPI()))
+}
+
+// PushTransformPixels transforms everything drawn on the canvas by t, until
+// PopTransform is called. Coordinates are transformed by t first and then by
+// the transforms pushed before. The translation of t is in native pixels.
+func (c *Canvas) PushTransformPixels(t Transform) error {
+	if len(c.transformStack) == 0 {
+		c.graphicsModePrev = getGraphicsMode(c.hdc)
+
+		if setGraphicsMode(c.hdc, gmAdvanced) == 0 {
+			return newError("SetGraphicsMode failed")
+		}
+	}
+
+	var prev xform
+	if !getWorldTransform(c.hdc, &prev) {
+		c.maybeRestoreGraphicsMode()
+		return newError("GetWorldTransform failed")
+	}
+
+	xf := t.Then(transformFromXFORM(prev)).toXFORM()
+	if !setWorldTransform(c.hdc, &xf) {
+		c.maybeRestoreGraphicsMode()
+		return newError("SetWorldTransform failed")
+	}
+
+	c.transformStack = append(c.transformStack, prev)
+
+	return nil
+}
+
+// PopTransform removes the transform pushed last.
+func (c *Canvas) PopTransform() error {
+	n := len(c.transformStack)
+	if n == 0 {
+		return newError("no transform pushed")
+	}
+
+	prev := c.transformStack[n-1]
+	c.transformStack = c.transformStack[:n-1]
+
+	if !setWorldTransform(c.hdc, &prev) {
+		return newError("SetWorldTransform failed")
+	}
+
+	c.maybeRestoreGraphicsMode()
+
+	return nil
+}
+
+// WithTransform calls f with t pushed, see PushTransform.
+func (c *Canvas) WithTransform(t Transform, f func() error) error {
+	if err := c.PushTransform(t); err != nil {
+		return err
+	}
+	defer c.PopTransform()
+
+	return f()
+}
+
+// maybeRestoreGraphicsMode restores the graphics mode from before the first
+// transform was pushed, once no transform is pushed anymore.
+func (c *Canvas) maybeRestoreGraphicsMode() {
+	if len(c.transformStack) > 0 || c.graphicsModePrev != gmCompatible {
+		return
+	}
+
+	// The compatible mode can only be restored with the identity transform.
+	modifyWorldTransform(c.hdc, nil, mwtIdentity)
+	setGraphicsMode(c.hdc, gmCompatible)
+}
+
 func (c *Canvas) withPen(pen Pen, f func() error) error {
 	return c.withGdiObj(win.HGDIOBJ(pen.handleForDPI(c.dpi)), f)
 }
@@ -522,6 +614,222 @@ func (c *Canvas) FillRoundedRectanglePixels(brush Brush, bounds Rectangle, ellip
 	return c.roundedRectanglePixels(brush, nullPenSingleton, bounds, ellipseSize, 1)
 }
 
+// FillRectangleARGB fills a rectangle in 1/96" units with color, blending it
+// with what is already drawn according to its alpha.
+func (c *Canvas) FillRectangleARGB(color AlphaColor, bounds Rectangle) error {
+	return c.FillRectangleARGBPixels(color, RectangleFrom96DPI(bounds, c.DPI()))
+}
+
+// FillRectangleARGBPixels fills a rectangle in native pixels with color,
+// blending it with what is already drawn according to its alpha.
+func (c *Canvas) FillRectangleARGBPixels(color AlphaColor, bounds Rectangle) error {
+	return c.fillARGBPixels(color, bounds, func(hdc win.HDC) bool {
+		return win.Rectangle_(hdc, int32(bounds.X), int32(bounds.Y), int32(bounds.X+bounds.Width+1), int32(bounds.Y+bounds.Height+1))
+	})
+}
+
+// FillEllipseARGB fills an ellipse in 1/96" units with color, blending it with
+// what is already drawn according to its alpha.
+func (c *Canvas) FillEllipseARGB(color AlphaColor, bounds Rectangle) error {
+	return c.FillEllipseARGBPixels(color, RectangleFrom96DPI(bounds, c.DPI()))
+}
+
+// FillEllipseARGBPixels fills an ellipse in native pixels with color, blending
+// it with what is already drawn according to its alpha.
+func (c *Canvas) FillEllipseARGBPixels(color AlphaColor, bounds Rectangle) error {
+	return c.fillARGBPixels(color, bounds, func(hdc win.HDC) bool {
+		return win.Ellipse(hdc, int32(bounds.X), int32(bounds.Y), int32(bounds.X+bounds.Width+1), int32(bounds.Y+bounds.Height+1))
+	})
+}
+
+// FillRoundedRectangleARGB fills a rounded rectangle in 1/96" units with
+// color, blending it with what is already drawn according to its alpha.
+func (c *Canvas) FillRoundedRectangleARGB(color AlphaColor, bounds Rectangle, ellipseSize Size) error {
+	dpi := c.DPI()
+	return c.FillRoundedRectangleARGBPixels(color, RectangleFrom96DPI(bounds, dpi), SizeFrom96DPI(ellipseSize, dpi))
+}
+
+// FillRoundedRectangleARGBPixels fills a rounded rectangle in native pixels
+// with color, blending it with what is already drawn according to its alpha.
+func (c *Canvas) FillRoundedRectangleARGBPixels(color AlphaColor, bounds Rectangle, ellipseSize Size) error {
+	return c.fillARGBPixels(color, bounds, func(hdc win.HDC) bool {
+		return win.RoundRect(hdc, int32(bounds.X), int32(bounds.Y), int32(bounds.X+bounds.Width+1), int32(bounds.Y+bounds.Height+1), int32(ellipseSize.Width), int32(ellipseSize.Height))
+	})
+}
+
+// FillPolygonARGB fills the polygon through points in 1/96" units with color,
+// blending it with what is already drawn according to its alpha. The polygon
+// is closed automatically. Where it intersects itself, it is filled
+// alternately.
+func (c *Canvas) FillPolygonARGB(color AlphaColor, points []Point) error {
+	dpi := c.DPI()
+
+	pixels := make([]Point, len(points))
+	for i, p := range points {
+		pixels[i] = PointFrom96DPI(p, dpi)
+	}
+
+	return c.FillPolygonARGBPixels(color, pixels)
+}
+
+// FillPolygonARGBPixels fills the polygon through points in native pixels with
+// color, see FillPolygonARGB.
+func (c *Canvas) FillPolygonARGBPixels(color AlphaColor, points []Point) error {
+	if len(points) < 3 {
+		return nil
+	}
+
+	pts := make([]win.POINT, len(points))
+	minX, minY, maxX, maxY := points[0].X, points[0].Y, points[0].X, points[0].Y
+	for i, p := range points {
+		pts[i] = p.toPOINT()
+
+		minX, minY = mini(minX, p.X), mini(minY, p.Y)
+		maxX, maxY = maxi(maxX, p.X), maxi(maxY, p.Y)
+	}
+	bounds := Rectangle{minX, minY, maxX - minX + 1, maxY - minY + 1}
+
+	return c.fillARGBPixels(color, bounds, func(hdc win.HDC) bool {
+		return polygon(hdc, &pts[0], int32(len(pts)))
+	})
+}
+
+// fillARGBPixels fills the shape, that fill draws within bounds in native
+// pixels with the current brush and no pen, with color.
+//
+// GDI ignores the alpha of colors, so unless color is opaque, the shape is
+// drawn in white on an offscreen 32-bit DIB of the size of its bounds on the
+// device, which is then turned into color with premultiplied alpha where the
+// shape covers it and composited with AlphaBlend.
+func (c *Canvas) fillARGBPixels(color AlphaColor, bounds Rectangle, fill func(hdc win.HDC) bool) error {
+	if color.Alpha == 0 || bounds.Width <= 0 || bounds.Height <= 0 {
+		return nil
+	}
+
+	if color.Alpha == 0xff {
+		brush, err := NewSolidColorBrush(color.Color)
+		if err != nil {
+			return err
+		}
+		defer brush.Dispose()
+
+		return c.withBrushAndPen(brush, nullPenSingleton, func() error {
+			if !fill(c.hdc) {
+				return newError("drawing shape failed")
+			}
+
+			return nil
+		})
+	}
+
+	// AlphaBlend is subject to the world transform, so the shape is
+	// composited with the identity transform at its transformed bounds.
+	t := IdentityTransform()
+	if len(c.transformStack) > 0 {
+		var xf xform
+		if !getWorldTransform(c.hdc, &xf) {
+			return newError("GetWorldTransform failed")
+		}
+		t = transformFromXFORM(xf)
+
+		if !modifyWorldTransform(c.hdc, nil, mwtIdentity) {
+			return newError("ModifyWorldTransform failed")
+		}
+		defer setWorldTransform(c.hdc, &xf)
+	}
+
+	var clip win.RECT
+	switch getClipBox(c.hdc, &clip) {
+	case 0:
+		return newError("GetClipBox failed")
+
+	case win.NULLREGION:
+		return nil
+	}
+
+	device := t.transformBounds(bounds)
+	left, top := maxi(device.X, int(clip.Left)), maxi(device.Y, int(clip.Top))
+	right, bottom := mini(device.X+device.Width, int(clip.Right)), mini(device.Y+device.Height, int(clip.Bottom))
+	if right <= left || bottom <= top {
+		return nil
+	}
+	device = Rectangle{left, top, right - left, bottom - top}
+
+	hdcMem := win.CreateCompatibleDC(c.hdc)
+	if hdcMem == 0 {
+		return newError("CreateCompatibleDC failed")
+	}
+	defer win.DeleteDC(hdcMem)
+
+	var bih win.BITMAPINFOHEADER
+	bih.BiSize = uint32(unsafe.Sizeof(bih))
+	bih.BiWidth = int32(device.Width)
+	bih.BiHeight = -int32(device.Height) // top-down
+	bih.BiPlanes = 1
+	bih.BiBitCount = 32
+	bih.BiCompression = win.BI_RGB
+
+	var bits unsafe.Pointer
+	hBmp := win.CreateDIBSection(hdcMem, &bih, win.DIB_RGB_COLORS, &bits, 0, 0)
+	switch hBmp {
+	case 0, win.ERROR_INVALID_PARAMETER:
+		return newError("CreateDIBSection failed")
+	}
+	defer win.DeleteObject(win.HGDIOBJ(hBmp))
+
+	hBmpOld := win.SelectObject(hdcMem, win.HGDIOBJ(hBmp))
+	defer win.SelectObject(hdcMem, hBmpOld)
+
+	if setGraphicsMode(hdcMem, gmAdvanced) == 0 {
+		return newError("SetGraphicsMode failed")
+	}
+	xf := t.Translate(float64(-device.X), float64(-device.Y)).toXFORM()
+	if !setWorldTransform(hdcMem, &xf) {
+		return newError("SetWorldTransform failed")
+	}
+
+	hBrushOld := win.SelectObject(hdcMem, win.GetStockObject(win.WHITE_BRUSH))
+	defer win.SelectObject(hdcMem, hBrushOld)
+	hPenOld := win.SelectObject(hdcMem, win.GetStockObject(win.NULL_PEN))
+	defer win.SelectObject(hdcMem, hPenOld)
+
+	if !fill(hdcMem) {
+		return newError("drawing shape failed")
+	}
+	win.GdiFlush()
+
+	a := uint32(color.Alpha)
+	premultiplied := a<<24 |
+		uint32(color.Color.R())*a/0xff<<16 |
+		uint32(color.Color.G())*a/0xff<<8 |
+		uint32(color.Color.B())*a/0xff
+
+	pixels := (*[1 << 28]uint32)(bits)[: device.Width*device.Height : device.Width*device.Height]
+	for i, p := range pixels {
+		if p != 0 {
+			pixels[i] = premultiplied
+		}
+	}
+
+	if !win.AlphaBlend(
+		c.hdc,
+		int32(device.X),
+		int32(device.Y),
+		int32(device.Width),
+		int32(device.Height),
+		hdcMem,
+		0,
+		0,
+		int32(device.Width),
+		int32(device.Height),
+		win.BLENDFUNCTION{AlphaFormat: win.AC_SRC_ALPHA, SourceConstantAlpha: 0xff},
+	) {
+		return newError("AlphaBlend failed")
+	}
+
+	return nil
+}
+
 // GradientFillRectangle draws a gradient filled rectangle in 1/96" units.
 //
 // Deprecated: Newer applications should use GradientFillRectanglePixels.
@@ -577,9 +885,16 @@ func (c *Canvas) DrawText(text string, font *Font, color Color, bounds Rectangle
 func (c *Canvas) DrawTextPixels(text string, font *Font, color Color, bounds Rectangle, format DrawTextFormat) error {
 	return c.withFontAndTextColor(font, color, func() error {
 		rect := bounds.toRECT()
+		var textPtr *uint16
+		if format&TextModifyString == 0 {
+			textPtr = stringToUTF16Ptr(text)
+		} else {
+			textPtr = syscall.StringToUTF16Ptr(text)
+		}
+
 		ret := win.DrawTextEx(
 			c.hdc,
-			syscall.StringToUTF16Ptr(text),
+			textPtr,
 			-1,
 			&rect,
 			uint32(format)|win.DT_EDITCONTROL,
@@ -592,6 +907,32 @@ func (c *Canvas) DrawTextPixels(text string, font *Font, color Color, bounds Rec
 	})
 }
 
+// DrawTextRotated draws text rotated counterclockwise by angle degrees around
+// origin, which is the top left corner of the text before the rotation, in
+// 1/96" units. An angle of 90 draws the text from bottom to top, like the
+// label of the vertical axis of a chart.
+func (c *Canvas) DrawTextRotated(text string, font *Font, color Color, origin Point, angle float64) error {
+	return c.DrawTextRotatedPixels(text, font, color, PointFrom96DPI(origin, c.DPI()), angle)
+}
+
+// DrawTextRotatedPixels draws text rotated counterclockwise by angle degrees
+// around origin, which is the top left corner of the text before the
+// rotation, in native pixels.
+//
+// The rotation is applied with a world transform, so it combines with the
+// transforms pushed with PushTransform. Only TrueType and OpenType fonts can
+// be rotated.
+func (c *Canvas) DrawTextRotatedPixels(text string, font *Font, color Color, origin Point, angle float64) error {
+	t := RotationTransform(-angle).Translate(float64(origin.X), float64(origin.Y))
+
+	if err := c.PushTransformPixels(t); err != nil {
+		return err
+	}
+	defer c.PopTransform()
+
+	return c.DrawTextPixels(text, font, color, Rectangle{}, TextLeft|TextTop|TextNoClip|TextNoPrefix)
+}
+
 // fontHeight returns font height in native pixels.
 func (c *Canvas) fontHeight(font *Font) (height int, err error) {
 	err = c.withFontAndTextColor(font, 0, func() error {
@@ -630,7 +971,7 @@ func (c *Canvas) measureTextForDPI(text string, font *Font, bounds Rectangle, fo
 	var params win.DRAWTEXTPARAMS
 	params.CbSize = uint32(unsafe.Sizeof(params))
 
-	strPtr := syscall.StringToUTF16Ptr(text)
+	strPtr := stringToUTF16Ptr(text)
 	dtfmt := uint32(format) | win.DT_CALCRECT | win.DT_EDITCONTROL | win.DT_NOPREFIX | win.DT_WORDBREAK
 
 	height := win.DrawTextEx(
@@ -723,7 +1064,12 @@ func (c *Canvas) measureAndModifyTextPixels(text string, font *Font, bounds Rect
 	var params win.DRAWTEXTPARAMS
 	params.CbSize = uint32(unsafe.Sizeof(params))
 
-	strPtr := syscall.StringToUTF16Ptr(text)
+	var strPtr *uint16
+	if format&TextModifyString == 0 {
+		strPtr = stringToUTF16Ptr(text)
+	} else {
+		strPtr = syscall.StringToUTF16Ptr(text)
+	}
 	dtfmt := uint32(format) | win.DT_EDITCONTROL | win.DT_WORDBREAK
 
 	height := win.DrawTextEx(
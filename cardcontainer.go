@@ -0,0 +1,380 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"github.com/lxn/win"
+)
+
+// CardContainer holds multiple pages that occupy the same bounds, of which
+// only the current one is visible, like a TabWidget without the tab strip.
+// It is meant for wizards and view switchers.
+//
+// The minimum and ideal size of a CardContainer is the largest of those of
+// its pages, so switching pages does not change the layout of the
+// surrounding widgets. Pages added with AddLazyPage are created when they
+// become current for the first time and only count towards the size from
+// then on.
+type CardContainer struct {
+	*Composite
+	pages                        []*cardPage
+	currentIndex                 int
+	currentIndexChangedPublisher EventPublisher
+}
+
+// cardPage is a page of a CardContainer. Its widget is nil until a lazy page
+// is created.
+type cardPage struct {
+	widget Widget
+	create func(parent Container) (Widget, error)
+}
+
+// NewCardContainer creates and initializes a new CardContainer.
+func NewCardContainer(parent Container) (*CardContainer, error) {
+	composite, err := NewCompositeWithStyle(parent, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	cc := &CardContainer{Composite: composite, currentIndex: -1}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			cc.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(cc); err != nil {
+		return nil, err
+	}
+
+	layout := &cardLayout{cc: cc}
+	layout.layout = layout
+	if err := cc.Composite.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	cc.MustRegisterProperty("CurrentIndex", NewProperty(
+		func() interface{} {
+			return cc.CurrentIndex()
+		},
+		func(v interface{}) error {
+			return cc.SetCurrentIndex(assertIntOr(v, -1))
+		},
+		cc.CurrentIndexChanged()))
+
+	succeeded = true
+
+	return cc, nil
+}
+
+// SetLayout returns an error, because the pages of a CardContainer are laid
+// out by the CardContainer itself. Use Layout().SetMargins to change the
+// margins around the pages.
+func (cc *CardContainer) SetLayout(value Layout) error {
+	return newError("CardContainer does not support setting a layout")
+}
+
+// PageCount returns the number of pages, including lazy pages that have not
+// been created yet.
+func (cc *CardContainer) PageCount() int {
+	return len(cc.pages)
+}
+
+// Page returns the page at index, or nil if it is a lazy page that has not
+// been created yet.
+func (cc *CardContainer) Page(index int) Widget {
+	if index < 0 || index >= len(cc.pages) {
+		return nil
+	}
+
+	return cc.pages[index].widget
+}
+
+// IndexOf returns the index of page, or -1 if it is not a page of the
+// CardContainer.
+func (cc *CardContainer) IndexOf(page Widget) int {
+	for i, p := range cc.pages {
+		if p.widget == page {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// AddPage adds page as the last page and returns its index. If page does not
+// have the CardContainer as its parent yet, it is reparented.
+//
+// If it is the first page, it becomes the current page.
+func (cc *CardContainer) AddPage(page Widget) (int, error) {
+	if page == nil {
+		return -1, newError("page cannot be nil")
+	}
+	if cc.IndexOf(page) != -1 {
+		return -1, newError("page already added")
+	}
+
+	if page.Parent() != Container(cc) {
+		if err := page.SetParent(cc); err != nil {
+			return -1, err
+		}
+	}
+
+	page.SetVisible(false)
+
+	return cc.addPage(&cardPage{widget: page})
+}
+
+// AddLazyPage adds a page as the last page, that is created by calling create
+// with the CardContainer as parent when it becomes current for the first time,
+// and returns its index.
+//
+// If it is the first page, it becomes the current page and is created
+// immediately.
+func (cc *CardContainer) AddLazyPage(create func(parent Container) (Widget, error)) (int, error) {
+	if create == nil {
+		return -1, newError("create cannot be nil")
+	}
+
+	return cc.addPage(&cardPage{create: create})
+}
+
+func (cc *CardContainer) addPage(page *cardPage) (int, error) {
+	cc.pages = append(cc.pages, page)
+
+	index := len(cc.pages) - 1
+
+	if cc.currentIndex == -1 {
+		if err := cc.SetCurrentIndex(index); err != nil {
+			cc.pages = cc.pages[:index]
+			return -1, err
+		}
+	} else {
+		cc.RequestLayout()
+	}
+
+	return index, nil
+}
+
+// RemovePage removes the page at index and disposes it, if it was created.
+//
+// If it was the current page, the next page, or else the previous one,
+// becomes current.
+func (cc *CardContainer) RemovePage(index int) error {
+	if index < 0 || index >= len(cc.pages) {
+		return newError("invalid index")
+	}
+
+	page := cc.pages[index]
+
+	wasCurrent := index == cc.currentIndex
+
+	cc.pages = append(cc.pages[:index], cc.pages[index+1:]...)
+
+	if page.widget != nil {
+		page.widget.Dispose()
+	}
+
+	switch {
+	case wasCurrent:
+		cc.currentIndex = -1
+
+		if len(cc.pages) > 0 {
+			return cc.SetCurrentIndex(mini(index, len(cc.pages)-1))
+		}
+
+		cc.currentIndexChangedPublisher.Publish()
+
+	case index < cc.currentIndex:
+		// The current page does not change, only its index.
+		cc.currentIndex--
+	}
+
+	cc.RequestLayout()
+
+	return nil
+}
+
+// CurrentIndex returns the index of the current page, or -1 if there are no
+// pages.
+func (cc *CardContainer) CurrentIndex() int {
+	return cc.currentIndex
+}
+
+// SetCurrentIndex makes the page at index the current page, creating it if it
+// is a lazy page that has not been created yet.
+func (cc *CardContainer) SetCurrentIndex(index int) error {
+	if index == cc.currentIndex {
+		return nil
+	}
+
+	if index < 0 || index >= len(cc.pages) {
+		return newError("invalid index")
+	}
+
+	page := cc.pages[index]
+
+	if page.widget == nil {
+		widget, err := page.create(cc)
+		if err != nil {
+			return err
+		}
+		if widget == nil {
+			return newError("create returned no page")
+		}
+
+		if widget.Parent() != Container(cc) {
+			if err := widget.SetParent(cc); err != nil {
+				widget.Dispose()
+				return err
+			}
+		}
+
+		page.widget = widget
+		page.create = nil
+	}
+
+	var containsFocus bool
+	if cc.currentIndex != -1 {
+		old := cc.pages[cc.currentIndex].widget
+
+		focus := win.GetFocus()
+		containsFocus = focus == old.Handle() || win.IsChild(old.Handle(), focus)
+
+		old.SetVisible(false)
+	}
+
+	cc.currentIndex = index
+
+	page.widget.SetVisible(true)
+	cc.RequestLayout()
+	page.widget.Invalidate()
+
+	if containsFocus {
+		if c, ok := page.widget.(Container); ok {
+			c.AsContainerBase().focusFirstCandidateDescendant()
+		} else {
+			page.widget.SetFocus()
+		}
+	}
+
+	cc.currentIndexChangedPublisher.Publish()
+
+	return nil
+}
+
+// CurrentIndexChanged returns the event that is published after the current
+// page changed.
+func (cc *CardContainer) CurrentIndexChanged() *Event {
+	return cc.currentIndexChangedPublisher.Event()
+}
+
+// cardLayout is the layout of a CardContainer.
+type cardLayout struct {
+	LayoutBase
+	cc *CardContainer
+}
+
+func (l *cardLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	li := new(cardLayoutItem)
+
+	if index := l.cc.currentIndex; index != -1 {
+		li.current = l.cc.pages[index].widget.Handle()
+	}
+
+	return li
+}
+
+// cardLayoutItem sizes itself to fit each page, but only lays out the current
+// one.
+type cardLayoutItem struct {
+	ContainerLayoutItemBase
+	current win.HWND
+}
+
+func (li *cardLayoutItem) LayoutFlags() LayoutFlags {
+	if len(li.children) == 0 {
+		return ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert | GreedyHorz | GreedyVert
+	}
+
+	var flags LayoutFlags
+
+	for _, page := range li.children {
+		flags |= page.LayoutFlags()
+	}
+
+	return flags
+}
+
+func (li *cardLayoutItem) withMargins(size Size) Size {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+
+	return Size{
+		Width:  size.Width + margins.HNear + margins.HFar,
+		Height: size.Height + margins.VNear + margins.VFar,
+	}
+}
+
+// MinSize returns the largest minimum size of the pages, visible or not.
+func (li *cardLayoutItem) MinSize() Size {
+	var min Size
+
+	for _, page := range li.children {
+		min = maxSize(min, li.MinSizeEffectiveForChild(page))
+	}
+
+	return li.withMargins(min)
+}
+
+func (li *cardLayoutItem) MinSizeForSize(size Size) Size {
+	return li.MinSize()
+}
+
+func (li *cardLayoutItem) HeightForWidth(width int) int {
+	return li.MinSize().Height
+}
+
+func (li *cardLayoutItem) IdealSize() Size {
+	var ideal Size
+
+	for _, page := range li.children {
+		if is, ok := page.(IdealSizer); ok {
+			ideal = maxSize(ideal, is.IdealSize())
+		}
+
+		ideal = maxSize(ideal, li.MinSizeEffectiveForChild(page))
+	}
+
+	return li.withMargins(ideal)
+}
+
+func (li *cardLayoutItem) PerformLayout() []LayoutResultItem {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	clientSize := li.geometry.ClientSize
+
+	for _, page := range li.children {
+		if page.Handle() != li.current {
+			continue
+		}
+
+		return []LayoutResultItem{
+			{
+				Item: page,
+				Bounds: Rectangle{
+					X:      margins.HNear,
+					Y:      margins.VNear,
+					Width:  maxi(0, clientSize.Width-margins.HNear-margins.HFar),
+					Height: maxi(0, clientSize.Height-margins.VNear-margins.VFar),
+				},
+			},
+		}
+	}
+
+	return nil
+}
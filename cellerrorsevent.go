@@ -0,0 +1,92 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+)
+
+// CellError is the error setting the value of a cell of a TableView failed
+// with, e.g. because the pasted text is not valid for the cell.
+type CellError struct {
+	Row  int    // the row in the model
+	Col  int    // the column in the model, -1 if there is no column for Text
+	Text string // the text the cell was to be set to
+	Err  error
+}
+
+func (e *CellError) Error() string {
+	return fmt.Sprintf("cell %d, %d: %q: %s", e.Row, e.Col, e.Text, e.Err)
+}
+
+type cellErrorsEventHandlerInfo struct {
+	handler CellErrorsEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+type CellErrorsEventHandler func(errs []*CellError)
+
+type CellErrorsEvent struct {
+	handlers []cellErrorsEventHandlerInfo
+}
+
+func (e *CellErrorsEvent) Attach(handler CellErrorsEventHandler) int {
+	handlerInfo := cellErrorsEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *CellErrorsEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *CellErrorsEvent) Once(handler CellErrorsEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *CellErrorsEvent) AttachScoped(owner Window, handler CellErrorsEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type CellErrorsEventPublisher struct {
+	event CellErrorsEvent
+}
+
+func (p *CellErrorsEventPublisher) Event() *CellErrorsEvent {
+	return &p.event
+}
+
+func (p *CellErrorsEventPublisher) Publish(errs []*CellError) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(errs)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
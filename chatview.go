@@ -0,0 +1,949 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+const chatViewWindowClass = `\o/ Walk_ChatView_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClass(chatViewWindowClass)
+	})
+}
+
+const (
+	chatViewScrollTimerId = 1 + iota
+	chatViewTypingTimerId
+)
+
+// chatViewGroupInterval is the maximum time between two messages of the same
+// sender, for them to be displayed as a group.
+const chatViewGroupInterval = 5 * time.Minute
+
+var chatLinkRegexp = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// ChatMessage is a message displayed by a ChatView.
+type ChatMessage struct {
+	Sender   string
+	Text     string
+	Time     time.Time
+	Outgoing bool
+}
+
+// chatToken is a piece of text that is drawn in one go. x is relative to the
+// content area of a bubble.
+type chatToken struct {
+	text  string
+	link  string
+	x     int
+	width int
+}
+
+// chatLayout holds the word wrapped text of a message.
+type chatLayout struct {
+	maxWidth int // in native pixels, the layout is valid for
+	lines    [][]chatToken
+	size     Size // of the text, in native pixels
+}
+
+// chatRow is either a day separator or a message.
+type chatRow struct {
+	message    int // -1 for day separators
+	day        time.Time
+	showSender bool
+	showTime   bool
+	y          int
+	height     int
+}
+
+type chatHit struct {
+	bounds Rectangle // in client coordinates
+	link   string
+}
+
+// ChatView is a widget that displays a conversation as a list of message
+// bubbles.
+//
+// Outgoing messages are aligned right, incoming ones left. Consecutive
+// messages of the same sender are grouped, day separators are inserted between
+// messages of different days. URLs in messages are displayed as links.
+//
+// Only the visible messages are painted, so a ChatView can hold long
+// conversations. When a message arrives while the user has scrolled up, a
+// "new messages" pill is displayed that scrolls to the bottom when clicked.
+type ChatView struct {
+	WidgetBase
+	messages                 []ChatMessage
+	layouts                  []chatLayout
+	rows                     []chatRow
+	contentHeight            int
+	scrollPos                int
+	scrollTarget             int
+	scrollAnimating          bool
+	newMessageCount          int
+	typingText               string
+	typingPhase              int
+	opensLinks               bool
+	linkFont                 *Font
+	hits                     []chatHit
+	pillBounds               Rectangle
+	linkClickedPublisher     StringEventPublisher
+	newMessageCountPublisher EventPublisher
+}
+
+// NewChatView creates and initializes a new ChatView.
+func NewChatView(parent Container) (*ChatView, error) {
+	cv := &ChatView{opensLinks: true}
+
+	if err := InitWidget(
+		cv,
+		parent,
+		chatViewWindowClass,
+		win.WS_TABSTOP|win.WS_VISIBLE|win.WS_VSCROLL,
+		win.WS_EX_CLIENTEDGE|win.WS_EX_COMPOSITED); err != nil {
+		return nil, err
+	}
+
+	cv.Disposing().Attach(func() {
+		cv.disposeLinkFont()
+	})
+
+	return cv, nil
+}
+
+// Messages returns the messages displayed by the ChatView.
+func (cv *ChatView) Messages() []ChatMessage {
+	return append([]ChatMessage(nil), cv.messages...)
+}
+
+// SetMessages replaces the messages displayed by the ChatView and scrolls to
+// the bottom.
+func (cv *ChatView) SetMessages(messages []ChatMessage) {
+	cv.messages = append([]ChatMessage(nil), messages...)
+	cv.layouts = make([]chatLayout, len(messages))
+
+	cv.updateRows()
+	cv.setNewMessageCount(0)
+	cv.scrollTo(cv.maxScrollPos(), false)
+}
+
+// AppendMessage adds a message at the bottom of the ChatView.
+//
+// If the view was scrolled to the bottom or the message is outgoing, the
+// ChatView smoothly scrolls to the new message. Otherwise the new messages
+// pill is displayed.
+func (cv *ChatView) AppendMessage(message ChatMessage) {
+	follow := cv.atBottom() || message.Outgoing
+
+	cv.messages = append(cv.messages, message)
+	cv.layouts = append(cv.layouts, chatLayout{})
+
+	cv.updateRows()
+
+	if follow {
+		cv.ScrollToBottom()
+	} else {
+		cv.setNewMessageCount(cv.newMessageCount + 1)
+	}
+}
+
+// Clear removes all messages from the ChatView.
+func (cv *ChatView) Clear() {
+	cv.SetMessages(nil)
+}
+
+// TypingText returns the text displayed next to the typing indicator, e.g.
+// "Jane is typing".
+func (cv *ChatView) TypingText() string {
+	return cv.typingText
+}
+
+// SetTypingText shows an animated typing indicator at the bottom of the
+// ChatView, followed by text. Pass an empty string to hide the indicator.
+func (cv *ChatView) SetTypingText(text string) {
+	if text == cv.typingText {
+		return
+	}
+
+	follow := cv.atBottom()
+
+	cv.typingText = text
+
+	if text != "" {
+		win.SetTimer(cv.hWnd, chatViewTypingTimerId, 400, 0)
+	} else {
+		win.KillTimer(cv.hWnd, chatViewTypingTimerId)
+	}
+
+	cv.updateRows()
+
+	if follow {
+		cv.ScrollToBottom()
+	}
+}
+
+// OpensLinks returns whether clicked links are opened in the default web
+// browser.
+func (cv *ChatView) OpensLinks() bool {
+	return cv.opensLinks
+}
+
+// SetOpensLinks sets whether clicked links are opened in the default web
+// browser. LinkClicked is published in any case.
+func (cv *ChatView) SetOpensLinks(value bool) {
+	cv.opensLinks = value
+}
+
+// LinkClicked returns the event that is published with the URL of a link the
+// user clicked.
+func (cv *ChatView) LinkClicked() *StringEvent {
+	return cv.linkClickedPublisher.Event()
+}
+
+// NewMessageCount returns the number of messages that arrived since the user
+// last was at the bottom of the ChatView.
+func (cv *ChatView) NewMessageCount() int {
+	return cv.newMessageCount
+}
+
+// NewMessageCountChanged returns the event that is published when the number
+// of new messages changed.
+func (cv *ChatView) NewMessageCountChanged() *Event {
+	return cv.newMessageCountPublisher.Event()
+}
+
+// ScrollToBottom smoothly scrolls to the last message.
+func (cv *ChatView) ScrollToBottom() {
+	cv.scrollTo(cv.maxScrollPos(), true)
+}
+
+func (cv *ChatView) setNewMessageCount(count int) {
+	if count == cv.newMessageCount {
+		return
+	}
+
+	cv.newMessageCount = count
+
+	cv.Invalidate()
+
+	cv.newMessageCountPublisher.Publish()
+}
+
+func (cv *ChatView) disposeLinkFont() {
+	if cv.linkFont != nil {
+		cv.linkFont.Dispose()
+		cv.linkFont = nil
+	}
+}
+
+func (cv *ChatView) dpiScaled(value int) int {
+	return IntFrom96DPI(value, cv.DPI())
+}
+
+func (cv *ChatView) lineHeight() int {
+	return calculateTextSize("Wg", cv.Font(), cv.DPI(), 0, cv.hWnd).Height
+}
+
+// bubbleMaxTextWidth returns the maximum width of the text inside of a
+// bubble in native pixels.
+func (cv *ChatView) bubbleMaxTextWidth() int {
+	return maxi(cv.dpiScaled(40), cv.ClientBoundsPixels().Width*7/10-2*cv.dpiScaled(10))
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+
+	return ay == by && am == bm && ad == bd
+}
+
+// updateRows rebuilds the rows from the messages and computes their
+// positions.
+func (cv *ChatView) updateRows() {
+	cv.rows = cv.rows[:0]
+
+	for i, msg := range cv.messages {
+		var prev *ChatMessage
+		if i > 0 {
+			prev = &cv.messages[i-1]
+		}
+
+		if !msg.Time.IsZero() && (prev == nil || !sameDay(prev.Time, msg.Time)) {
+			cv.rows = append(cv.rows, chatRow{message: -1, day: msg.Time})
+			prev = nil
+		}
+
+		continues := prev != nil &&
+			prev.Outgoing == msg.Outgoing &&
+			prev.Sender == msg.Sender &&
+			msg.Time.Sub(prev.Time) < chatViewGroupInterval
+
+		if continues {
+			cv.rows[len(cv.rows)-1].showTime = false
+		}
+
+		cv.rows = append(cv.rows, chatRow{
+			message:    i,
+			showSender: !continues && !msg.Outgoing && msg.Sender != "",
+			showTime:   !msg.Time.IsZero(),
+		})
+	}
+
+	cv.layoutRows()
+}
+
+// layoutRows computes the heights and positions of the rows, word wrapping
+// the messages where needed.
+func (cv *ChatView) layoutRows() {
+	margin := cv.dpiScaled(8)
+	padding := cv.dpiScaled(6)
+	groupGap := cv.dpiScaled(10)
+	lineHeight := cv.lineHeight()
+	maxWidth := cv.bubbleMaxTextWidth()
+
+	y := margin
+
+	for i := range cv.rows {
+		row := &cv.rows[i]
+		row.y = y
+
+		if row.message < 0 {
+			row.height = lineHeight + groupGap
+		} else {
+			layout := &cv.layouts[row.message]
+			if layout.maxWidth != maxWidth {
+				*layout = cv.layoutText(cv.messages[row.message].Text, maxWidth)
+			}
+
+			row.height = layout.size.Height + 2*padding + cv.dpiScaled(2)
+			if row.showSender {
+				row.height += lineHeight
+			}
+			if row.showTime {
+				row.height += lineHeight + groupGap
+			}
+		}
+
+		y += row.height
+	}
+
+	if cv.typingText != "" {
+		y += lineHeight + 2*padding
+	}
+
+	cv.contentHeight = y + margin
+
+	cv.updateScrollBar()
+	cv.Invalidate()
+}
+
+// layoutText word wraps text so that no line is wider than maxWidth.
+func (cv *ChatView) layoutText(text string, maxWidth int) chatLayout {
+	layout := chatLayout{maxWidth: maxWidth}
+
+	hdc := win.GetDC(cv.hWnd)
+	if hdc == 0 {
+		newError("GetDC failed")
+		return layout
+	}
+	defer win.ReleaseDC(cv.hWnd, hdc)
+
+	hFontOld := win.SelectObject(hdc, win.HGDIOBJ(cv.Font().handleForDPI(cv.DPI())))
+	defer win.SelectObject(hdc, hFontOld)
+
+	measure := func(s string) int {
+		if s == "" {
+			return 0
+		}
+
+		u := utf16.Encode([]rune(s))
+
+		var size win.SIZE
+		win.GetTextExtentPoint32(hdc, &u[0], int32(len(u)), &size)
+
+		return int(size.CX)
+	}
+
+	spaceWidth := measure(" ")
+
+	var line []chatToken
+	x := 0
+
+	flush := func() {
+		width := 0
+		if len(line) > 0 {
+			last := line[len(line)-1]
+			width = last.x + last.width
+		}
+
+		layout.lines = append(layout.lines, line)
+		layout.size.Width = maxi(layout.size.Width, width)
+
+		line = nil
+		x = 0
+	}
+
+	add := func(word, link string) {
+		width := measure(word)
+
+		if x > 0 && x+width > maxWidth {
+			flush()
+		}
+
+		// Break words that do not fit into a line on their own, e.g. long
+		// URLs.
+		for width > maxWidth {
+			runes := []rune(word)
+
+			n := 1
+			for n < len(runes) && measure(string(runes[:n+1])) <= maxWidth {
+				n++
+			}
+
+			line = append(line, chatToken{string(runes[:n]), link, 0, measure(string(runes[:n]))})
+			flush()
+
+			word = string(runes[n:])
+			width = measure(word)
+		}
+
+		line = append(line, chatToken{word, link, x, width})
+		x += width + spaceWidth
+	}
+
+	for _, paragraph := range strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n") {
+		pos := 0
+
+		for _, loc := range chatLinkRegexp.FindAllStringIndex(paragraph, -1) {
+			link := strings.TrimRight(paragraph[loc[0]:loc[1]], ".,;:!?)'")
+
+			for _, word := range strings.Fields(paragraph[pos:loc[0]]) {
+				add(word, "")
+			}
+			add(link, link)
+
+			pos = loc[0] + len(link)
+		}
+
+		for _, word := range strings.Fields(paragraph[pos:]) {
+			add(word, "")
+		}
+
+		flush()
+	}
+
+	layout.size.Height = len(layout.lines) * cv.lineHeight()
+
+	return layout
+}
+
+func (cv *ChatView) maxScrollPos() int {
+	return maxi(0, cv.contentHeight-cv.ClientBoundsPixels().Height)
+}
+
+func (cv *ChatView) atBottom() bool {
+	return cv.scrollPos >= cv.maxScrollPos()-cv.dpiScaled(2)
+}
+
+func (cv *ChatView) updateScrollBar() {
+	if maxPos := cv.maxScrollPos(); cv.scrollPos > maxPos {
+		cv.scrollPos = maxPos
+	}
+
+	var si win.SCROLLINFO
+	si.CbSize = uint32(unsafe.Sizeof(si))
+	si.FMask = win.SIF_PAGE | win.SIF_POS | win.SIF_RANGE
+	si.NMax = int32(cv.contentHeight - 1)
+	si.NPage = uint32(cv.ClientBoundsPixels().Height)
+	si.NPos = int32(cv.scrollPos)
+
+	win.SetScrollInfo(cv.hWnd, win.SB_VERT, &si, true)
+}
+
+// scrollTo scrolls to pos, either immediately or animated.
+func (cv *ChatView) scrollTo(pos int, smooth bool) {
+	pos = maxi(0, mini(cv.maxScrollPos(), pos))
+
+	if smooth && pos != cv.scrollPos {
+		cv.scrollTarget = pos
+
+		if !cv.scrollAnimating {
+			cv.scrollAnimating = true
+			win.SetTimer(cv.hWnd, chatViewScrollTimerId, 15, 0)
+		}
+
+		return
+	}
+
+	cv.stopScrollAnimation()
+
+	cv.setScrollPos(pos)
+}
+
+func (cv *ChatView) setScrollPos(pos int) {
+	if pos != cv.scrollPos {
+		cv.scrollPos = pos
+
+		cv.updateScrollBar()
+		cv.Invalidate()
+	}
+
+	if cv.atBottom() {
+		cv.setNewMessageCount(0)
+	}
+}
+
+func (cv *ChatView) stopScrollAnimation() {
+	if cv.scrollAnimating {
+		cv.scrollAnimating = false
+		win.KillTimer(cv.hWnd, chatViewScrollTimerId)
+	}
+}
+
+func (cv *ChatView) animateScroll() {
+	// The target may have moved, e.g. because messages were added.
+	if cv.scrollTarget > cv.scrollPos {
+		cv.scrollTarget = cv.maxScrollPos()
+	}
+
+	delta := cv.scrollTarget - cv.scrollPos
+	if absi(delta) <= 1 {
+		cv.stopScrollAnimation()
+		cv.setScrollPos(cv.scrollTarget)
+		return
+	}
+
+	step := delta / 4
+	if step == 0 {
+		step = delta
+	}
+
+	cv.setScrollPos(cv.scrollPos + step)
+}
+
+func (cv *ChatView) formatDay(t time.Time) string {
+	now := time.Now()
+
+	switch {
+	case sameDay(t, now):
+		return tr("Today", "walk")
+
+	case sameDay(t, now.AddDate(0, 0, -1)):
+		return tr("Yesterday", "walk")
+
+	case t.Year() == now.Year():
+		return t.Format("Monday, January 2")
+	}
+
+	return t.Format("January 2, 2006")
+}
+
+func (cv *ChatView) paint(canvas *Canvas, updateBounds Rectangle) error {
+	bounds := cv.ClientBoundsPixels()
+
+	bgBrush, err := NewSystemColorBrush(SysColorWindow)
+	if err != nil {
+		return err
+	}
+	defer bgBrush.Dispose()
+
+	if err := canvas.FillRectanglePixels(bgBrush, updateBounds); err != nil {
+		return err
+	}
+
+	font := cv.Font()
+	if cv.linkFont == nil {
+		if cv.linkFont, err = NewFont(font.Family(), font.PointSize(), font.Style()|FontUnderline); err != nil {
+			return err
+		}
+	}
+
+	margin := cv.dpiScaled(8)
+	padding := cv.dpiScaled(6)
+	hPadding := cv.dpiScaled(10)
+	radius := cv.dpiScaled(14)
+	lineHeight := cv.lineHeight()
+	captionColor := Color(win.GetSysColor(win.COLOR_GRAYTEXT))
+
+	incomingBrush, err := NewSolidColorBrush(RGB(237, 235, 233))
+	if err != nil {
+		return err
+	}
+	defer incomingBrush.Dispose()
+
+	outgoingBrush, err := NewSolidColorBrush(RGB(0, 120, 212))
+	if err != nil {
+		return err
+	}
+	defer outgoingBrush.Dispose()
+
+	cv.hits = cv.hits[:0]
+
+	for i := range cv.rows {
+		row := &cv.rows[i]
+
+		y := row.y - cv.scrollPos
+		if y+row.height < 0 {
+			continue
+		}
+		if y > bounds.Height {
+			break
+		}
+
+		if row.message < 0 {
+			r := Rectangle{0, y, bounds.Width, lineHeight}
+			if err := canvas.DrawTextPixels(cv.formatDay(row.day), font, captionColor, r, TextCenter|TextSingleLine|TextNoPrefix); err != nil {
+				return err
+			}
+			continue
+		}
+
+		msg := &cv.messages[row.message]
+		layout := &cv.layouts[row.message]
+
+		bubble := Rectangle{
+			Width:  layout.size.Width + 2*hPadding,
+			Height: layout.size.Height + 2*padding,
+		}
+		if msg.Outgoing {
+			bubble.X = bounds.Width - margin - bubble.Width
+		} else {
+			bubble.X = margin
+		}
+
+		if row.showSender {
+			r := Rectangle{bubble.X + hPadding, y, bounds.Width - 2*margin, lineHeight}
+			if err := canvas.DrawTextPixels(msg.Sender, font, captionColor, r, TextLeft|TextSingleLine|TextNoPrefix|TextEndEllipsis); err != nil {
+				return err
+			}
+			y += lineHeight
+		}
+
+		bubble.Y = y
+
+		brush, textColor, linkColor := Brush(incomingBrush), RGB(0, 0, 0), RGB(0, 90, 158)
+		if msg.Outgoing {
+			brush, textColor, linkColor = outgoingBrush, RGB(255, 255, 255), RGB(255, 255, 255)
+		}
+
+		if err := canvas.FillRoundedRectanglePixels(brush, bubble, Size{radius, radius}); err != nil {
+			return err
+		}
+
+		for l, line := range layout.lines {
+			for _, tok := range line {
+				r := Rectangle{bubble.X + hPadding + tok.x, bubble.Y + padding + l*lineHeight, tok.width, lineHeight}
+
+				if tok.link == "" {
+					err = canvas.DrawTextPixels(tok.text, font, textColor, r, TextLeft|TextSingleLine|TextNoPrefix|TextNoClip)
+				} else {
+					err = canvas.DrawTextPixels(tok.text, cv.linkFont, linkColor, r, TextLeft|TextSingleLine|TextNoPrefix|TextNoClip)
+					cv.hits = append(cv.hits, chatHit{r, tok.link})
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if row.showTime {
+			r := Rectangle{margin + hPadding, bubble.Y + bubble.Height, bounds.Width - 2*(margin+hPadding), lineHeight}
+			format := TextLeft
+			if msg.Outgoing {
+				format = TextRight
+			}
+
+			if err := canvas.DrawTextPixels(msg.Time.Format("15:04"), font, captionColor, r, format|TextSingleLine|TextNoPrefix); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cv.typingText != "" {
+		if err := cv.paintTypingIndicator(canvas, incomingBrush, captionColor); err != nil {
+			return err
+		}
+	}
+
+	cv.pillBounds = Rectangle{}
+	if cv.newMessageCount > 0 && !cv.atBottom() {
+		return cv.paintNewMessagesPill(canvas)
+	}
+
+	return nil
+}
+
+func (cv *ChatView) paintTypingIndicator(canvas *Canvas, bubbleBrush Brush, captionColor Color) error {
+	margin := cv.dpiScaled(8)
+	padding := cv.dpiScaled(6)
+	lineHeight := cv.lineHeight()
+	dot := cv.dpiScaled(6)
+	gap := cv.dpiScaled(4)
+
+	bubble := Rectangle{
+		X:      margin,
+		Y:      cv.contentHeight - margin - lineHeight - 2*padding - cv.scrollPos,
+		Width:  3*dot + 2*gap + 2*cv.dpiScaled(10),
+		Height: lineHeight + 2*padding,
+	}
+
+	radius := cv.dpiScaled(14)
+	if err := canvas.FillRoundedRectanglePixels(bubbleBrush, bubble, Size{radius, radius}); err != nil {
+		return err
+	}
+
+	for i := 0; i < 3; i++ {
+		color := RGB(160, 160, 160)
+		if i == cv.typingPhase%3 {
+			color = RGB(96, 96, 96)
+		}
+
+		brush, err := NewSolidColorBrush(color)
+		if err != nil {
+			return err
+		}
+
+		r := Rectangle{bubble.X + cv.dpiScaled(10) + i*(dot+gap), bubble.Y + (bubble.Height-dot)/2, dot, dot}
+		err = canvas.FillEllipsePixels(brush, r)
+		brush.Dispose()
+		if err != nil {
+			return err
+		}
+	}
+
+	r := Rectangle{bubble.X + bubble.Width + cv.dpiScaled(6), bubble.Y, cv.ClientBoundsPixels().Width, bubble.Height}
+	return canvas.DrawTextPixels(cv.typingText, cv.Font(), captionColor, r, TextLeft|TextVCenter|TextSingleLine|TextNoPrefix|TextEndEllipsis)
+}
+
+func (cv *ChatView) paintNewMessagesPill(canvas *Canvas) error {
+	var text string
+	if cv.newMessageCount == 1 {
+		text = tr("1 new message", "walk")
+	} else {
+		text = fmt.Sprintf(tr("%d new messages", "walk"), cv.newMessageCount)
+	}
+	text = "↓ " + text
+
+	bounds := cv.ClientBoundsPixels()
+	size := calculateTextSize(text, cv.Font(), cv.DPI(), 0, cv.hWnd)
+	hPadding := cv.dpiScaled(12)
+	vPadding := cv.dpiScaled(5)
+
+	pill := Rectangle{Width: size.Width + 2*hPadding, Height: size.Height + 2*vPadding}
+	pill.X = (bounds.Width - pill.Width) / 2
+	pill.Y = bounds.Height - pill.Height - cv.dpiScaled(10)
+
+	brush, err := NewSystemColorBrush(SysColorHighlight)
+	if err != nil {
+		return err
+	}
+	defer brush.Dispose()
+
+	if err := canvas.FillRoundedRectanglePixels(brush, pill, Size{pill.Height, pill.Height}); err != nil {
+		return err
+	}
+
+	cv.pillBounds = pill
+
+	return canvas.DrawTextPixels(text, cv.Font(), Color(win.GetSysColor(win.COLOR_HIGHLIGHTTEXT)), pill, TextCenter|TextVCenter|TextSingleLine|TextNoPrefix)
+}
+
+func (cv *ChatView) linkAt(p Point) string {
+	for _, hit := range cv.hits {
+		if rectangleContains(hit.bounds, p) {
+			return hit.link
+		}
+	}
+
+	return ""
+}
+
+func (cv *ChatView) handleClick(p Point) {
+	if rectangleContains(cv.pillBounds, p) {
+		cv.ScrollToBottom()
+		return
+	}
+
+	if link := cv.linkAt(p); link != "" {
+		cv.linkClickedPublisher.Publish(link)
+
+		if cv.opensLinks {
+			shellExecute(cv.hWnd, syscall.StringToUTF16Ptr("open"), syscall.StringToUTF16Ptr(link), nil, nil, win.SW_SHOWNORMAL)
+		}
+	}
+}
+
+func (cv *ChatView) applyFont(font *Font) {
+	cv.WidgetBase.applyFont(font)
+
+	cv.disposeLinkFont()
+	cv.invalidateLayouts()
+}
+
+func (cv *ChatView) ApplyDPI(dpi int) {
+	cv.WidgetBase.ApplyDPI(dpi)
+
+	cv.invalidateLayouts()
+}
+
+func (cv *ChatView) invalidateLayouts() {
+	for i := range cv.layouts {
+		cv.layouts[i].maxWidth = 0
+	}
+
+	cv.layoutRows()
+}
+
+func (*ChatView) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	return NewGreedyLayoutItem()
+}
+
+func (cv *ChatView) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+
+		hdc := win.BeginPaint(hwnd, &ps)
+		if hdc == 0 {
+			newError("BeginPaint failed")
+			break
+		}
+		defer win.EndPaint(hwnd, &ps)
+
+		canvas, err := newCanvasFromHDC(hdc)
+		if err != nil {
+			break
+		}
+		defer canvas.Dispose()
+
+		cv.paint(canvas, rectangleFromRECT(ps.RcPaint))
+
+		return 0
+
+	case win.WM_ERASEBKGND:
+		return 1
+
+	case win.WM_TIMER:
+		switch wParam {
+		case chatViewScrollTimerId:
+			cv.animateScroll()
+
+		case chatViewTypingTimerId:
+			cv.typingPhase++
+			cv.Invalidate()
+		}
+
+		return 0
+
+	case win.WM_VSCROLL:
+		line := cv.lineHeight()
+		page := cv.ClientBoundsPixels().Height
+
+		switch win.LOWORD(uint32(wParam)) {
+		case win.SB_LINEUP:
+			cv.scrollTo(cv.scrollPos-line, false)
+
+		case win.SB_LINEDOWN:
+			cv.scrollTo(cv.scrollPos+line, false)
+
+		case win.SB_PAGEUP:
+			cv.scrollTo(cv.scrollPos-page, false)
+
+		case win.SB_PAGEDOWN:
+			cv.scrollTo(cv.scrollPos+page, false)
+
+		case win.SB_TOP:
+			cv.scrollTo(0, false)
+
+		case win.SB_BOTTOM:
+			cv.scrollTo(cv.maxScrollPos(), false)
+
+		case win.SB_THUMBTRACK, win.SB_THUMBPOSITION:
+			var si win.SCROLLINFO
+			si.CbSize = uint32(unsafe.Sizeof(si))
+			si.FMask = win.SIF_TRACKPOS
+			win.GetScrollInfo(hwnd, win.SB_VERT, &si)
+
+			cv.scrollTo(int(si.NTrackPos), false)
+		}
+
+		return 0
+
+	case win.WM_MOUSEWHEEL:
+		delta := int(int16(win.HIWORD(uint32(wParam))))
+		cv.scrollTo(cv.scrollPos-delta*3*cv.lineHeight()/120, false)
+
+		return 0
+
+	case win.WM_KEYDOWN:
+		switch Key(wParam) {
+		case KeyHome:
+			cv.scrollTo(0, true)
+
+		case KeyEnd:
+			cv.ScrollToBottom()
+
+		case KeyPrior:
+			cv.scrollTo(cv.scrollPos-cv.ClientBoundsPixels().Height, false)
+
+		case KeyNext:
+			cv.scrollTo(cv.scrollPos+cv.ClientBoundsPixels().Height, false)
+
+		case KeyUp:
+			cv.scrollTo(cv.scrollPos-cv.lineHeight(), false)
+
+		case KeyDown:
+			cv.scrollTo(cv.scrollPos+cv.lineHeight(), false)
+		}
+
+	case win.WM_LBUTTONDOWN:
+		cv.SetFocus()
+
+	case win.WM_LBUTTONUP:
+		cv.handleClick(Point{int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))})
+
+	case win.WM_MOUSEMOVE:
+		p := Point{int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))}
+		if cv.linkAt(p) != "" || rectangleContains(cv.pillBounds, p) {
+			cv.SetCursor(CursorHand())
+		} else {
+			cv.SetCursor(nil)
+		}
+
+	case win.WM_WINDOWPOSCHANGED:
+		wp := (*win.WINDOWPOS)(unsafe.Pointer(lParam))
+
+		if wp.Flags&win.SWP_NOSIZE != 0 {
+			break
+		}
+
+		follow := cv.atBottom()
+
+		cv.layoutRows()
+
+		if follow {
+			cv.scrollTo(cv.maxScrollPos(), false)
+		}
+
+	case win.WM_DESTROY:
+		cv.stopScrollAnimation()
+		win.KillTimer(hwnd, chatViewTypingTimerId)
+	}
+
+	return cv.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
+}
@@ -0,0 +1,533 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// CheckedListBox is a ListBox with a check box in front of each item.
+//
+// The check states are taken from the model, if it implements ItemCheckStater
+// or ItemChecker. Otherwise the CheckedListBox keeps them itself, following
+// the insertions and removals the model publishes.
+type CheckedListBox struct {
+	ListBox
+	checkStates                     []CheckState
+	triState                        bool
+	checkItemsResetHandlerHandle    int
+	checkItemsInsertedHandlerHandle int
+	checkItemsRemovedHandlerHandle  int
+	checkedIndexesChangedPublisher  EventPublisher
+}
+
+func NewCheckedListBox(parent Container) (*CheckedListBox, error) {
+	clb := new(CheckedListBox)
+
+	if err := clb.init(clb, parent, win.LBS_OWNERDRAWFIXED|win.LBS_HASSTRINGS); err != nil {
+		return nil, err
+	}
+
+	clb.updateItemHeight()
+
+	return clb, nil
+}
+
+// TriState returns if clicking an item cycles through the indeterminate
+// state, too.
+func (clb *CheckedListBox) TriState() bool {
+	return clb.triState
+}
+
+// SetTriState sets if clicking an item cycles through the indeterminate
+// state, too.
+func (clb *CheckedListBox) SetTriState(triState bool) {
+	clb.triState = triState
+}
+
+// SetModel sets the model of the CheckedListBox.
+//
+// See ListBox.SetModel for the supported models.
+func (clb *CheckedListBox) SetModel(mdl interface{}) error {
+	if clb.model != nil {
+		clb.model.ItemsReset().Detach(clb.checkItemsResetHandlerHandle)
+		clb.model.ItemsInserted().Detach(clb.checkItemsInsertedHandlerHandle)
+		clb.model.ItemsRemoved().Detach(clb.checkItemsRemovedHandlerHandle)
+	}
+
+	clb.checkStates = nil
+
+	if err := clb.ListBox.SetModel(mdl); err != nil {
+		return err
+	}
+
+	if clb.model == nil {
+		return nil
+	}
+
+	clb.checkStates = make([]CheckState, clb.model.ItemCount())
+
+	clb.checkItemsResetHandlerHandle = clb.model.ItemsReset().Attach(func() {
+		clb.checkStates = make([]CheckState, clb.model.ItemCount())
+	})
+
+	clb.checkItemsInsertedHandlerHandle = clb.model.ItemsInserted().Attach(func(from, to int) {
+		clb.checkStates = append(clb.checkStates[:from], append(make([]CheckState, to-from+1), clb.checkStates[from:]...)...)
+	})
+
+	clb.checkItemsRemovedHandlerHandle = clb.model.ItemsRemoved().Attach(func(from, to int) {
+		clb.checkStates = append(clb.checkStates[:from], clb.checkStates[to+1:]...)
+	})
+
+	return nil
+}
+
+// CheckState returns the check state of the item at index index.
+func (clb *CheckedListBox) CheckState(index int) CheckState {
+	if clb.model == nil || index < 0 || index >= clb.model.ItemCount() {
+		return CheckUnchecked
+	}
+
+	if cs, ok := clb.itemCheckStater(); ok {
+		return cs.CheckState(index)
+	}
+
+	if checker, ok := clb.itemChecker(); ok {
+		if checker.Checked(index) {
+			return CheckChecked
+		}
+
+		return CheckUnchecked
+	}
+
+	if index < len(clb.checkStates) {
+		return clb.checkStates[index]
+	}
+
+	return CheckUnchecked
+}
+
+// SetCheckState sets the check state of the item at index index.
+func (clb *CheckedListBox) SetCheckState(index int, state CheckState) error {
+	changed, err := clb.setCheckState(index, state)
+	if err != nil {
+		return err
+	}
+
+	if changed {
+		clb.invalidateItem(index)
+		clb.checkedIndexesChangedPublisher.Publish()
+	}
+
+	return nil
+}
+
+// Checked returns if the item at index index is checked.
+func (clb *CheckedListBox) Checked(index int) bool {
+	return clb.CheckState(index) == CheckChecked
+}
+
+// SetChecked sets if the item at index index is checked.
+func (clb *CheckedListBox) SetChecked(index int, checked bool) error {
+	state := CheckUnchecked
+	if checked {
+		state = CheckChecked
+	}
+
+	return clb.SetCheckState(index, state)
+}
+
+// CheckedIndexes returns the indexes of the checked items.
+func (clb *CheckedListBox) CheckedIndexes() []int {
+	var indexes []int
+
+	if clb.model == nil {
+		return indexes
+	}
+
+	count := clb.model.ItemCount()
+	for i := 0; i < count; i++ {
+		if clb.CheckState(i) == CheckChecked {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+// SetCheckedIndexes checks the items at indexes and unchecks all others.
+func (clb *CheckedListBox) SetCheckedIndexes(indexes []int) error {
+	if clb.model == nil {
+		return newError("model required")
+	}
+
+	states := make([]CheckState, clb.model.ItemCount())
+	for _, index := range indexes {
+		if index < 0 || index >= len(states) {
+			return newError("invalid index")
+		}
+
+		states[index] = CheckChecked
+	}
+
+	return clb.applyCheckStates(func(index int) (CheckState, bool) {
+		return states[index], true
+	})
+}
+
+// SetCheckStates sets the check state of the items at indexes to state.
+func (clb *CheckedListBox) SetCheckStates(indexes []int, state CheckState) error {
+	if clb.model == nil {
+		return newError("model required")
+	}
+
+	selected := make(map[int]bool, len(indexes))
+	for _, index := range indexes {
+		selected[index] = true
+	}
+
+	return clb.applyCheckStates(func(index int) (CheckState, bool) {
+		return state, selected[index]
+	})
+}
+
+// CheckAll checks all items.
+func (clb *CheckedListBox) CheckAll() error {
+	return clb.applyCheckStates(func(int) (CheckState, bool) {
+		return CheckChecked, true
+	})
+}
+
+// UncheckAll unchecks all items.
+func (clb *CheckedListBox) UncheckAll() error {
+	return clb.applyCheckStates(func(int) (CheckState, bool) {
+		return CheckUnchecked, true
+	})
+}
+
+// CheckedIndexesChanged returns the event that is published when check states
+// change, once per user interaction or call.
+func (clb *CheckedListBox) CheckedIndexesChanged() *Event {
+	return clb.checkedIndexesChangedPublisher.Event()
+}
+
+// applyCheckStates sets the states stateFor returns for the items, redrawing
+// and publishing only once.
+func (clb *CheckedListBox) applyCheckStates(stateFor func(index int) (CheckState, bool)) error {
+	if clb.model == nil {
+		return nil
+	}
+
+	var anyChanged bool
+	defer func() {
+		if anyChanged {
+			clb.Invalidate()
+			clb.checkedIndexesChangedPublisher.Publish()
+		}
+	}()
+
+	count := clb.model.ItemCount()
+	for i := 0; i < count; i++ {
+		state, ok := stateFor(i)
+		if !ok {
+			continue
+		}
+
+		changed, err := clb.setCheckState(i, state)
+		if err != nil {
+			return err
+		}
+
+		anyChanged = anyChanged || changed
+	}
+
+	return nil
+}
+
+func (clb *CheckedListBox) setCheckState(index int, state CheckState) (changed bool, err error) {
+	if clb.model == nil || index < 0 || index >= clb.model.ItemCount() {
+		return false, newError("invalid index")
+	}
+
+	if state < CheckUnchecked || state > CheckIndeterminate {
+		return false, newError("invalid CheckState value")
+	}
+
+	if state == clb.CheckState(index) {
+		return false, nil
+	}
+
+	if cs, ok := clb.itemCheckStater(); ok {
+		return true, cs.SetCheckState(index, state)
+	}
+
+	if checker, ok := clb.itemChecker(); ok {
+		if state == CheckIndeterminate {
+			return false, newError("the ItemChecker of the model does not support CheckIndeterminate")
+		}
+
+		return true, checker.SetChecked(index, state == CheckChecked)
+	}
+
+	clb.checkStates[index] = state
+
+	return true, nil
+}
+
+func (clb *CheckedListBox) itemCheckStater() (ItemCheckStater, bool) {
+	if cs, ok := clb.model.(ItemCheckStater); ok {
+		return cs, true
+	}
+
+	cs, ok := clb.providedModel.(ItemCheckStater)
+
+	return cs, ok
+}
+
+func (clb *CheckedListBox) itemChecker() (ItemChecker, bool) {
+	if checker, ok := clb.model.(ItemChecker); ok {
+		return checker, true
+	}
+
+	checker, ok := clb.providedModel.(ItemChecker)
+
+	return checker, ok
+}
+
+// toggle advances the check state of the item at index index, like a click
+// on its check box does.
+func (clb *CheckedListBox) toggle(index int) {
+	var state CheckState
+
+	switch clb.CheckState(index) {
+	case CheckUnchecked:
+		state = CheckChecked
+
+	case CheckChecked:
+		if clb.triState {
+			state = CheckIndeterminate
+		} else {
+			state = CheckUnchecked
+		}
+
+	default:
+		state = CheckUnchecked
+	}
+
+	clb.SetCheckState(index, state)
+}
+
+// checkBoxSize returns the size of the check boxes in native pixels.
+func (clb *CheckedListBox) checkBoxSize(hdc win.HDC, hTheme win.HTHEME) Size {
+	if hTheme != 0 {
+		var size win.SIZE
+		if win.SUCCEEDED(win.GetThemePartSize(hTheme, hdc, win.BP_CHECKBOX, win.CBS_UNCHECKEDNORMAL, nil, win.TS_DRAW, &size)) {
+			return Size{int(size.CX), int(size.CY)}
+		}
+	}
+
+	size := IntFrom96DPI(13, clb.DPI())
+
+	return Size{size, size}
+}
+
+func (clb *CheckedListBox) openButtonTheme() win.HTHEME {
+	if clb.style.highContrastActive {
+		return 0
+	}
+
+	return win.OpenThemeData(clb.hWnd, syscall.StringToUTF16Ptr("Button"))
+}
+
+// checkBoxAreaWidth returns the width of the area in front of the item texts,
+// that clicks toggle the check state in, in native pixels.
+func (clb *CheckedListBox) checkBoxAreaWidth() int {
+	hdc := win.GetDC(clb.hWnd)
+	defer win.ReleaseDC(clb.hWnd, hdc)
+
+	hTheme := clb.openButtonTheme()
+	if hTheme != 0 {
+		defer win.CloseThemeData(hTheme)
+	}
+
+	return clb.checkBoxSize(hdc, hTheme).Width + 4*IntFrom96DPI(2, clb.DPI())
+}
+
+func (clb *CheckedListBox) updateItemHeight() {
+	hdc := win.GetDC(clb.hWnd)
+	defer win.ReleaseDC(clb.hWnd, hdc)
+
+	hTheme := clb.openButtonTheme()
+	if hTheme != 0 {
+		defer win.CloseThemeData(hTheme)
+	}
+
+	padding := IntFrom96DPI(2, clb.DPI())
+
+	height := maxi(
+		clb.calculateTextSizeImpl("gM").Height,
+		clb.checkBoxSize(hdc, hTheme).Height) + 2*padding
+
+	clb.SendMessage(win.LB_SETITEMHEIGHT, 0, uintptr(height))
+}
+
+func (clb *CheckedListBox) ApplyDPI(dpi int) {
+	clb.ListBox.ApplyDPI(dpi)
+
+	clb.updateItemHeight()
+}
+
+func (clb *CheckedListBox) applyFont(font *Font) {
+	clb.ListBox.applyFont(font)
+
+	clb.updateItemHeight()
+}
+
+func (clb *CheckedListBox) drawItem(dis *win.DRAWITEMSTRUCT) {
+	index := int(int32(dis.ItemID))
+	if index < 0 || clb.model == nil || index >= clb.model.ItemCount() {
+		return
+	}
+
+	canvas, err := newCanvasFromHDC(dis.HDC)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	bgColor, textColor := SysColorWindow, SysColorWindowText
+	if dis.ItemState&win.ODS_SELECTED != 0 {
+		if clb.style.highContrastActive || clb.Focused() {
+			bgColor, textColor = SysColorHighlight, SysColorHighlightText
+		} else {
+			bgColor = SysColorBtnFace
+		}
+	}
+	if !clb.Enabled() {
+		textColor = SysColorGrayText
+	}
+
+	bgBrush, err := NewSystemColorBrush(bgColor)
+	if err != nil {
+		return
+	}
+	defer bgBrush.Dispose()
+
+	bounds := rectangleFromRECT(dis.RcItem)
+
+	canvas.FillRectanglePixels(bgBrush, bounds)
+
+	hTheme := clb.openButtonTheme()
+	if hTheme != 0 {
+		defer win.CloseThemeData(hTheme)
+	}
+
+	padding := IntFrom96DPI(2, clb.DPI())
+	boxSize := clb.checkBoxSize(dis.HDC, hTheme)
+
+	boxBounds := Rectangle{
+		X:      bounds.X + 2*padding,
+		Y:      bounds.Y + (bounds.Height-boxSize.Height)/2,
+		Width:  boxSize.Width,
+		Height: boxSize.Height,
+	}
+
+	clb.drawCheckBox(canvas, hTheme, boxBounds, clb.CheckState(index))
+
+	x := boxBounds.X + boxBounds.Width + 2*padding
+	textBounds := Rectangle{x, bounds.Y, bounds.X + bounds.Width - padding - x, bounds.Height}
+
+	canvas.DrawTextPixels(clb.itemString(index), clb.Font(), Color(win.GetSysColor(int(textColor))), textBounds, TextSingleLine|TextVCenter|TextNoPrefix|TextEndEllipsis)
+
+	if dis.ItemState&win.ODS_FOCUS != 0 {
+		win.DrawFocusRect(dis.HDC, &dis.RcItem)
+	}
+}
+
+func (clb *CheckedListBox) drawCheckBox(canvas *Canvas, hTheme win.HTHEME, bounds Rectangle, state CheckState) {
+	if hTheme != 0 {
+		var stateID int32
+		switch state {
+		case CheckChecked:
+			stateID = win.CBS_CHECKEDNORMAL
+
+		case CheckIndeterminate:
+			stateID = win.CBS_MIXEDNORMAL
+
+		default:
+			stateID = win.CBS_UNCHECKEDNORMAL
+		}
+		if !clb.Enabled() {
+			// The disabled state follows the normal, hot and pressed states.
+			stateID += 3
+		}
+
+		rc := bounds.toRECT()
+		win.DrawThemeBackground(hTheme, canvas.HDC(), win.BP_CHECKBOX, stateID, &rc, nil)
+
+		return
+	}
+
+	textColor := Color(win.GetSysColor(win.COLOR_WINDOWTEXT))
+
+	pen, err := NewCosmeticPen(PenSolid, textColor)
+	if err != nil {
+		return
+	}
+	defer pen.Dispose()
+
+	canvas.DrawRectanglePixels(pen, bounds)
+
+	if state == CheckUnchecked {
+		return
+	}
+
+	markColor := textColor
+	if state == CheckIndeterminate {
+		markColor = Color(win.GetSysColor(win.COLOR_GRAYTEXT))
+	}
+
+	brush, err := NewSolidColorBrush(markColor)
+	if err != nil {
+		return
+	}
+	defer brush.Dispose()
+
+	inset := maxi(2, bounds.Width/4)
+	canvas.FillRectanglePixels(brush, Rectangle{bounds.X + inset, bounds.Y + inset, bounds.Width - 2*inset, bounds.Height - 2*inset})
+}
+
+func (clb *CheckedListBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_DRAWITEM:
+		clb.drawItem((*win.DRAWITEMSTRUCT)(unsafe.Pointer(lParam)))
+
+		return win.TRUE
+
+	case win.WM_LBUTTONDOWN, win.WM_LBUTTONDBLCLK:
+		x := int(int16(win.LOWORD(uint32(lParam))))
+
+		result := uint32(clb.SendMessage(win.LB_ITEMFROMPOINT, 0, lParam))
+		if win.HIWORD(result) == 0 && x < clb.checkBoxAreaWidth() {
+			clb.toggle(int(win.LOWORD(result)))
+		}
+
+	case win.WM_KEYDOWN:
+		if Key(wParam) == KeySpace {
+			if index := clb.CurrentIndex(); index > -1 {
+				clb.toggle(index)
+			}
+
+			return 0
+		}
+	}
+
+	return clb.ListBox.WndProc(hwnd, msg, wParam, lParam)
+}
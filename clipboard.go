@@ -7,6 +7,11 @@
 package walk
 
 import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"strings"
 	"syscall"
 	"unsafe"
 
@@ -153,6 +158,284 @@ func (c *ClipboardService) SetText(s string) error {
 	})
 }
 
+// table returns the text of the clipboard as rows of cells. Cells are
+// separated by tabs, as spreadsheets copy them, or by commas, if there are no
+// tabs and the clipboard contains the "Csv" format.
+func (c *ClipboardService) table() ([][]string, error) {
+	var text string
+	var isCSV bool
+
+	if err := c.withOpenClipboard(func() error {
+		if !win.IsClipboardFormatAvailable(win.CF_UNICODETEXT) {
+			return nil
+		}
+
+		hMem := win.HGLOBAL(win.GetClipboardData(win.CF_UNICODETEXT))
+		if hMem == 0 {
+			return lastError("GetClipboardData")
+		}
+
+		p := win.GlobalLock(hMem)
+		if p == nil {
+			return lastError("GlobalLock()")
+		}
+		defer win.GlobalUnlock(hMem)
+
+		text = win.UTF16PtrToString((*uint16)(p))
+
+		if format := registerClipboardFormat(syscall.StringToUTF16Ptr("Csv")); format != 0 {
+			isCSV = win.IsClipboardFormatAvailable(format)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sep := '\t'
+	if isCSV && !strings.ContainsRune(text, '\t') {
+		sep = ','
+	}
+
+	return parseTable(text, sep), nil
+}
+
+// parseTable splits text into rows of cells separated by sep. Cells starting
+// with a quote may contain sep, line breaks and doubled quotes, like tableTSV
+// and tableCSV write them. A line break at the end starts no row.
+func parseTable(text string, sep rune) [][]string {
+	var rows [][]string
+	var row []string
+	var cell strings.Builder
+	var quoted bool
+	atCellStart := true
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case quoted:
+			if r != '"' {
+				cell.WriteRune(r)
+			} else if i+1 < len(runes) && runes[i+1] == '"' {
+				cell.WriteRune(r)
+				i++
+			} else {
+				quoted = false
+			}
+
+		case r == '"' && atCellStart:
+			quoted = true
+
+		case r == sep:
+			row = append(row, cell.String())
+			cell.Reset()
+			atCellStart = true
+			continue
+
+		case r == '\r' || r == '\n':
+			if r == '\r' && i+1 < len(runes) && runes[i+1] == '\n' {
+				i++
+			}
+
+			rows = append(rows, append(row, cell.String()))
+			row = nil
+			cell.Reset()
+			atCellStart = true
+			continue
+
+		default:
+			cell.WriteRune(r)
+		}
+
+		atCellStart = false
+	}
+
+	if len(row) > 0 || cell.Len() > 0 || !atCellStart {
+		rows = append(rows, append(row, cell.String()))
+	}
+
+	return rows
+}
+
+// CopyFormat is a set of formats tabular data is copied to the clipboard in,
+// see TableView.CopySelection. Applications pick the richest format they
+// understand.
+type CopyFormat int
+
+const (
+	// FormatTSV is tab separated text, as the plain text of the clipboard.
+	FormatTSV CopyFormat = 1 << iota
+
+	// FormatCSV is comma separated values, as the "Csv" clipboard format. It
+	// is the plain text of the clipboard, if FormatTSV is not copied.
+	FormatCSV
+
+	// FormatHTML is an HTML table, as the "HTML Format" clipboard format. It
+	// keeps the cells apart when pasted into spreadsheets and word
+	// processors, even if they contain tabs or line breaks.
+	FormatHTML
+)
+
+// setTable replaces the contents of the clipboard with rows of cells in
+// formats. If header is true, the first row contains the column titles.
+func (c *ClipboardService) setTable(rows [][]string, header bool, formats CopyFormat) error {
+	var text string
+	data := make(map[string][]byte)
+
+	if formats&FormatCSV != 0 {
+		csvData, err := tableCSV(rows)
+		if err != nil {
+			return err
+		}
+
+		data["Csv"] = append(csvData, 0)
+		text = string(csvData)
+	}
+
+	if formats&FormatTSV != 0 {
+		text = tableTSV(rows)
+	}
+
+	if formats&FormatHTML != 0 {
+		data["HTML Format"] = append(tableHTMLClipboardData(rows, header), 0)
+	}
+
+	return c.withOpenClipboard(func() error {
+		if !win.EmptyClipboard() {
+			return lastError("EmptyClipboard")
+		}
+
+		if text != "" {
+			utf16, err := syscall.UTF16FromString(text)
+			if err != nil {
+				return err
+			}
+
+			if err := setClipboardBytes(win.CF_UNICODETEXT, (*[1 << 30]byte)(unsafe.Pointer(&utf16[0]))[:len(utf16)*2:len(utf16)*2]); err != nil {
+				return err
+			}
+		}
+
+		for name, b := range data {
+			format := registerClipboardFormat(syscall.StringToUTF16Ptr(name))
+			if format == 0 {
+				return lastError("RegisterClipboardFormat")
+			}
+
+			if err := setClipboardBytes(format, b); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// setClipboardBytes puts b on the opened clipboard in format.
+func setClipboardBytes(format uint32, b []byte) error {
+	hMem := win.GlobalAlloc(win.GMEM_MOVEABLE, uintptr(len(b)))
+	if hMem == 0 {
+		return lastError("GlobalAlloc")
+	}
+
+	p := win.GlobalLock(hMem)
+	if p == nil {
+		win.GlobalFree(hMem)
+		return lastError("GlobalLock()")
+	}
+
+	win.MoveMemory(p, unsafe.Pointer(&b[0]), uintptr(len(b)))
+
+	win.GlobalUnlock(hMem)
+
+	if 0 == win.SetClipboardData(format, win.HANDLE(hMem)) {
+		// We need to free hMem.
+		defer win.GlobalFree(hMem)
+
+		return lastError("SetClipboardData")
+	}
+
+	// The system now owns the memory referred to by hMem.
+
+	return nil
+}
+
+// tableTSV returns rows as tab separated text. Cells containing tabs, line
+// breaks or quotes are quoted the way spreadsheets expect.
+func tableTSV(rows [][]string) string {
+	var sb strings.Builder
+
+	for _, row := range rows {
+		for i, cell := range row {
+			if i > 0 {
+				sb.WriteByte('\t')
+			}
+
+			if strings.ContainsAny(cell, "\t\r\n\"") {
+				cell = `"` + strings.Replace(cell, `"`, `""`, -1) + `"`
+			}
+
+			sb.WriteString(cell)
+		}
+
+		sb.WriteString("\r\n")
+	}
+
+	return sb.String()
+}
+
+// tableCSV returns rows as comma separated values.
+func tableCSV(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.UseCRLF = true
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// tableHTMLClipboardData returns rows as an HTML table in the "HTML Format"
+// clipboard format, which starts with a description of the byte offsets of
+// the HTML and the copied fragment within the data.
+func tableHTMLClipboardData(rows [][]string, header bool) []byte {
+	var fragment strings.Builder
+
+	fragment.WriteString("<table>")
+	for i, row := range rows {
+		tag := "td"
+		if header && i == 0 {
+			tag = "th"
+		}
+
+		fragment.WriteString("<tr>")
+		for _, cell := range row {
+			fmt.Fprintf(&fragment, "<%s>%s</%s>", tag, strings.Replace(html.EscapeString(cell), "\n", "<br>", -1), tag)
+		}
+		fragment.WriteString("</tr>")
+	}
+	fragment.WriteString("</table>")
+
+	const (
+		descriptionFormat = "Version:0.9\r\nStartHTML:%010d\r\nEndHTML:%010d\r\nStartFragment:%010d\r\nEndFragment:%010d\r\n"
+		prefix            = `<html><head><meta charset="utf-8"></head><body><!--StartFragment-->`
+		suffix            = "<!--EndFragment--></body></html>"
+	)
+
+	// The offsets have a fixed width, so the length does not depend on them.
+	startHTML := len(fmt.Sprintf(descriptionFormat, 0, 0, 0, 0))
+	startFragment := startHTML + len(prefix)
+	endFragment := startFragment + fragment.Len()
+	endHTML := endFragment + len(suffix)
+
+	return []byte(fmt.Sprintf(descriptionFormat, startHTML, endHTML, startFragment, endFragment) + prefix + fragment.String() + suffix)
+}
+
 func (c *ClipboardService) withOpenClipboard(f func() error) error {
 	if !win.OpenClipboard(c.hwnd) {
 		return lastError("OpenClipboard")
@@ -9,6 +9,7 @@ package walk
 type closeEventHandlerInfo struct {
 	handler CloseEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type CloseEventHandler func(canceled *bool, reason CloseReason)
@@ -18,7 +19,7 @@ type CloseEvent struct {
 }
 
 func (e *CloseEvent) Attach(handler CloseEventHandler) int {
-	handlerInfo := closeEventHandlerInfo{handler, false}
+	handlerInfo := closeEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *CloseEvent) Attach(handler CloseEventHandler) int {
 }
 
 func (e *CloseEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -41,6 +43,17 @@ func (e *CloseEvent) Once(handler CloseEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *CloseEvent) AttachScoped(owner Window, handler CloseEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type CloseEventPublisher struct {
 	event CloseEvent
 }
@@ -60,3 +73,27 @@ func (p *CloseEventPublisher) Publish(canceled *bool, reason CloseReason) {
 		}
 	}
 }
+
+// CloseDeferral postpones the decision whether a Form closes, e.g. until the
+// user answered a dialog or a background save finished. It is obtained from
+// FormBase.DeferClose during the Closing event.
+type CloseDeferral struct {
+	form       *FormBase
+	generation int
+	decided    bool
+}
+
+// CloseDecision reports whether the form may close. Only the first call has
+// an effect.
+//
+// It may be called from any goroutine.
+func (cd *CloseDeferral) CloseDecision(allow bool) {
+	cd.form.Synchronize(func() {
+		if cd.decided {
+			return
+		}
+		cd.decided = true
+
+		cd.form.decideClose(cd.generation, allow)
+	})
+}
@@ -23,3 +23,26 @@ func (c Color) G() byte {
 func (c Color) B() byte {
 	return byte((c >> 16) & 0xff)
 }
+
+// isDarkColor returns if c is perceived as dark, so light text is readable on
+// it.
+func isDarkColor(c Color) bool {
+	return 299*int(c.R())+587*int(c.G())+114*int(c.B()) < 128*1000
+}
+
+// AlphaColor is a Color with an opacity, as used by SmoothCanvas and the ARGB
+// fill methods of Canvas.
+type AlphaColor struct {
+	Color Color
+	Alpha byte // 0 is transparent, 255 opaque
+}
+
+// WithAlpha returns c with opacity alpha, 0 being transparent and 255 opaque.
+func (c Color) WithAlpha(alpha byte) AlphaColor {
+	return AlphaColor{c, alpha}
+}
+
+// argb returns c in the 0xAARRGGBB format of GDI+.
+func (c AlphaColor) argb() uint32 {
+	return uint32(c.Alpha)<<24 | uint32(c.Color.R())<<16 | uint32(c.Color.G())<<8 | uint32(c.Color.B())
+}
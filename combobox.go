@@ -11,6 +11,7 @@ import (
 	"math/big"
 	"reflect"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 	"unsafe"
@@ -42,8 +43,36 @@ type ComboBox struct {
 	editOrigWndProcPtr           uintptr
 	editing                      bool
 	persistent                   bool
+	ownerDraw                    bool
+	virtual                      bool
+	filterAsYouType              bool
+	filtered                     []int // model indexes of the listed items while filtering
+	imageProvider                ImageProvider
+	itemKindProvider             ListItemKindProvider
+	groupHeaderFont              *Font
+	hIml                         win.HIMAGELIST
+	usingSysIml                  bool
+	imageUintptr2Index           map[uintptr]int32
+	filePath2IconIndex           map[string]int32
 }
 
+// ComboBoxCfg configures a ComboBox created with NewComboBoxWithCfg.
+type ComboBoxCfg struct {
+	// Editable makes the ComboBox have an edit field, like one created with
+	// NewComboBox, instead of being a drop-down list, like one created with
+	// NewDropDownBox.
+	Editable bool
+
+	// Virtual makes the ComboBox ask its model for the text of an item only
+	// when drawing it, which makes populating it with huge lists fast. A
+	// virtual ComboBox cannot be editable.
+	Virtual bool
+}
+
+// maxMeasuredVirtualComboBoxItems limits the number of items the ideal width
+// of a virtual ComboBox is measured for.
+const maxMeasuredVirtualComboBoxItems = 256
+
 var comboBoxEditWndProcPtr uintptr
 
 func init() {
@@ -106,10 +135,7 @@ func NewComboBox(parent Container) (*ComboBox, error) {
 		return nil, err
 	}
 
-	editHwnd := win.GetWindow(cb.hWnd, win.GW_CHILD)
-
-	win.SetWindowLongPtr(editHwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(cb)))
-	cb.editOrigWndProcPtr = win.SetWindowLongPtr(editHwnd, win.GWLP_WNDPROC, comboBoxEditWndProcPtr)
+	cb.subclassEdit()
 
 	return cb, nil
 }
@@ -118,6 +144,61 @@ func NewDropDownBox(parent Container) (*ComboBox, error) {
 	return newComboBoxWithStyle(parent, win.CBS_DROPDOWNLIST)
 }
 
+// NewComboBoxWithCfg returns a new owner drawn ComboBox configured by cfg.
+//
+// Unlike the ComboBoxes returned by NewComboBox and NewDropDownBox, it shows
+// the images of a model that implements ImageProvider and the separators and
+// group headers of a model that implements ListItemKindProvider.
+func NewComboBoxWithCfg(parent Container, cfg *ComboBoxCfg) (*ComboBox, error) {
+	if cfg.Virtual && cfg.Editable {
+		return nil, newError("a virtual ComboBox cannot be editable")
+	}
+
+	style := uint32(win.CBS_OWNERDRAWFIXED)
+	if !cfg.Virtual {
+		style |= win.CBS_HASSTRINGS
+	}
+	if cfg.Editable {
+		style |= win.CBS_AUTOHSCROLL | win.CBS_DROPDOWN
+	} else {
+		style |= win.CBS_DROPDOWNLIST
+	}
+
+	cb, err := newComboBoxWithStyle(parent, style)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Editable {
+		cb.subclassEdit()
+	}
+
+	cb.ownerDraw = true
+	cb.virtual = cfg.Virtual
+
+	cb.updateItemHeight()
+
+	return cb, nil
+}
+
+func (cb *ComboBox) subclassEdit() {
+	editHwnd := win.GetWindow(cb.hWnd, win.GW_CHILD)
+
+	win.SetWindowLongPtr(editHwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(cb)))
+	cb.editOrigWndProcPtr = win.SetWindowLongPtr(editHwnd, win.GWLP_WNDPROC, comboBoxEditWndProcPtr)
+}
+
+func (cb *ComboBox) Dispose() {
+	cb.WidgetBase.Dispose()
+
+	cb.disposeImageListAndCaches()
+
+	if cb.groupHeaderFont != nil {
+		cb.groupHeaderFont.Dispose()
+		cb.groupHeaderFont = nil
+	}
+}
+
 func newComboBoxWithStyle(parent Container, style uint32) (*ComboBox, error) {
 	cb := &ComboBox{prevCurIndex: -1, selChangeIndex: -1, precision: 2}
 
@@ -238,6 +319,13 @@ func newComboBoxWithStyle(parent Container, style uint32) (*ComboBox, error) {
 func (cb *ComboBox) applyFont(font *Font) {
 	cb.WidgetBase.applyFont(font)
 
+	if cb.groupHeaderFont != nil {
+		cb.groupHeaderFont.Dispose()
+		cb.groupHeaderFont = nil
+	}
+
+	cb.updateItemHeight()
+
 	if cb.model != nil {
 		cb.maxItemTextWidth = cb.calculateMaxItemTextWidth()
 		cb.RequestLayout()
@@ -267,10 +355,21 @@ func (cb *ComboBox) itemString(index int) string {
 }
 
 func (cb *ComboBox) insertItemAt(index int) error {
-	str := cb.itemString(index)
-	lp := uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(str)))
+	return cb.insertItem(index, index)
+}
+
+// insertItem inserts the model item at index index at position pos of the
+// list.
+func (cb *ComboBox) insertItem(pos, index int) error {
+	var lp uintptr
+	if cb.virtual {
+		// Without CBS_HASSTRINGS, lParam is the item data.
+		lp = uintptr(index)
+	} else {
+		lp = uintptr(unsafe.Pointer(stringToUTF16Ptr(cb.itemString(index))))
+	}
 
-	if win.CB_ERR == cb.SendMessage(win.CB_INSERTSTRING, uintptr(index), lp) {
+	if win.CB_ERR == cb.SendMessage(win.CB_INSERTSTRING, uintptr(pos), lp) {
 		return newError("SendMessage(CB_INSERTSTRING)")
 	}
 
@@ -290,6 +389,7 @@ func (cb *ComboBox) resetItems() error {
 	defer cb.SetSuspended(false)
 
 	cb.selChangeIndex = -1
+	cb.filtered = nil
 
 	if win.FALSE == cb.SendMessage(win.CB_RESETCONTENT, 0, 0) {
 		return newError("SendMessage(CB_RESETCONTENT)")
@@ -306,6 +406,10 @@ func (cb *ComboBox) resetItems() error {
 
 	count := cb.model.ItemCount()
 
+	if cb.virtual {
+		cb.SendMessage(win.CB_INITSTORAGE, uintptr(count), 0)
+	}
+
 	for i := 0; i < count; i++ {
 		if err := cb.insertItemAt(i); err != nil {
 			return err
@@ -330,6 +434,11 @@ func (cb *ComboBox) attachModel() {
 	cb.itemsResetHandlerHandle = cb.model.ItemsReset().Attach(itemsResetHandler)
 
 	itemChangedHandler := func(index int) {
+		if cb.filtered != nil {
+			cb.clearFilter()
+			return
+		}
+
 		if win.CB_ERR == cb.SendMessage(win.CB_DELETESTRING, uintptr(index), 0) {
 			newError("SendMessage(CB_DELETESTRING)")
 		}
@@ -341,12 +450,22 @@ func (cb *ComboBox) attachModel() {
 	cb.itemChangedHandlerHandle = cb.model.ItemChanged().Attach(itemChangedHandler)
 
 	cb.itemsInsertedHandlerHandle = cb.model.ItemsInserted().Attach(func(from, to int) {
+		if cb.filtered != nil {
+			cb.clearFilter()
+			return
+		}
+
 		for i := from; i <= to; i++ {
 			cb.insertItemAt(i)
 		}
 	})
 
 	cb.itemsRemovedHandlerHandle = cb.model.ItemsRemoved().Attach(func(from, to int) {
+		if cb.filtered != nil {
+			cb.clearFilter()
+			return
+		}
+
 		for i := to; i >= from; i-- {
 			cb.removeItem(i)
 		}
@@ -398,6 +517,13 @@ func (cb *ComboBox) SetModel(mdl interface{}) error {
 
 	cb.model = model
 	cb.bindingValueProvider, _ = model.(BindingValueProvider)
+	cb.imageProvider, _ = model.(ImageProvider)
+	if cb.itemKindProvider, ok = model.(ListItemKindProvider); !ok {
+		cb.itemKindProvider, _ = mdl.(ListItemKindProvider)
+	}
+
+	cb.disposeImageListAndCaches()
+	cb.updateItemHeight()
 
 	if model != nil {
 		cb.attachModel()
@@ -537,9 +663,12 @@ func (cb *ComboBox) calculateMaxItemTextWidth() int {
 	var maxWidth int
 
 	count := cb.model.ItemCount()
+	if cb.virtual {
+		count = mini(count, maxMeasuredVirtualComboBoxItems)
+	}
 	for i := 0; i < count; i++ {
 		var s win.SIZE
-		str := syscall.StringToUTF16(cb.itemString(i))
+		str := stringToUTF16(cb.itemString(i))
 
 		if !win.GetTextExtentPoint32(hdc, &str[0], int32(len(str)-1), &s) {
 			newError("GetTextExtentPoint32 failed")
@@ -549,17 +678,29 @@ func (cb *ComboBox) calculateMaxItemTextWidth() int {
 		maxWidth = maxi(maxWidth, int(s.CX))
 	}
 
+	if cb.imageProvider != nil {
+		maxWidth += int(win.GetSystemMetricsForDpi(win.SM_CXSMICON, uint32(cb.DPI()))) + IntFrom96DPI(4, cb.DPI())
+	}
+	if cb.itemKindProvider != nil {
+		maxWidth += IntFrom96DPI(comboBoxGroupIndent96dpi, cb.DPI())
+	}
+
 	return maxWidth
 }
 
 func (cb *ComboBox) CurrentIndex() int {
-	return int(int32(cb.SendMessage(win.CB_GETCURSEL, 0, 0)))
+	return cb.modelIndex(int(int32(cb.SendMessage(win.CB_GETCURSEL, 0, 0))))
 }
 
 func (cb *ComboBox) SetCurrentIndex(value int) error {
-	index := int(int32(cb.SendMessage(win.CB_SETCURSEL, uintptr(value), 0)))
+	if value != -1 && cb.filtered != nil && cb.listPosition(value) == -1 {
+		cb.clearFilter()
+	}
 
-	if index != value {
+	pos := cb.listPosition(value)
+	index := int(int32(cb.SendMessage(win.CB_SETCURSEL, uintptr(pos), 0)))
+
+	if index != pos {
 		return newError("invalid index")
 	}
 
@@ -582,6 +723,14 @@ func (cb *ComboBox) CurrentIndexChanged() *Event {
 }
 
 func (cb *ComboBox) Text() string {
+	if cb.virtual {
+		if index := cb.CurrentIndex(); index > -1 {
+			return cb.itemString(index)
+		}
+
+		return ""
+	}
+
 	return cb.text()
 }
 
@@ -663,11 +812,27 @@ func (cb *ComboBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 		case win.CBN_EDITCHANGE:
 			cb.editing = true
 			cb.selChangeIndex = -1
+
+			if cb.filterAsYouType && cb.model != nil {
+				cb.applyFilter(cb.text())
+			}
+
 			cb.textChangedPublisher.Publish()
 
 		case win.CBN_SELCHANGE:
+			if selIndex > -1 && cb.itemKind(selIndex) != ListItemNormal {
+				selIndex = cb.skipUnselectableItem(selIndex)
+			}
+
 			cb.selChangeIndex = selIndex
 
+		case win.CBN_CLOSEUP:
+			if cb.filtered != nil {
+				// The selection is only final after the notifications that
+				// follow.
+				cb.Synchronize(cb.clearFilter)
+			}
+
 		case win.CBN_SELENDCANCEL:
 			if cb.selChangeIndex != -1 {
 				if cb.selChangeIndex < cb.model.ItemCount() {
@@ -693,6 +858,26 @@ func (cb *ComboBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 			cb.selChangeIndex = -1
 		}
 
+	case win.WM_MEASUREITEM:
+		if !cb.ownerDraw {
+			break
+		}
+
+		mis := (*win.MEASUREITEMSTRUCT)(unsafe.Pointer(lParam))
+
+		mis.ItemHeight = uint32(cb.itemHeight())
+
+		return win.TRUE
+
+	case win.WM_DRAWITEM:
+		if !cb.ownerDraw {
+			break
+		}
+
+		cb.drawItem((*win.DRAWITEMSTRUCT)(unsafe.Pointer(lParam)))
+
+		return win.TRUE
+
 	case win.WM_MOUSEWHEEL:
 		if !cb.Enabled() {
 			return 0
@@ -742,6 +927,7 @@ func (cb *ComboBox) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	return &comboBoxLayoutItem{
 		layoutFlags: layoutFlags,
 		idealSize:   Size{w, h},
+		baseline:    cb.centeredTextBaseline(h),
 	}
 }
 
@@ -749,6 +935,11 @@ type comboBoxLayoutItem struct {
 	LayoutItemBase
 	layoutFlags LayoutFlags
 	idealSize   Size // in native pixels
+	baseline    int  // in native pixels
+}
+
+func (li *comboBoxLayoutItem) BaselineOffset() int {
+	return li.baseline
 }
 
 func (li *comboBoxLayoutItem) LayoutFlags() LayoutFlags {
@@ -762,3 +953,346 @@ func (li *comboBoxLayoutItem) IdealSize() Size {
 func (li *comboBoxLayoutItem) MinSize() Size {
 	return li.idealSize
 }
+
+// comboBoxGroupIndent96dpi is the indentation of the items below group
+// headers in 1/96" units.
+const comboBoxGroupIndent96dpi = 10
+
+func (cb *ComboBox) ApplyDPI(dpi int) {
+	cb.WidgetBase.ApplyDPI(dpi)
+
+	cb.disposeImageListAndCaches()
+	cb.updateItemHeight()
+}
+
+// MaxVisibleItems returns the maximum number of items the drop-down list
+// shows without scrolling.
+func (cb *ComboBox) MaxVisibleItems() int {
+	return int(int32(cb.SendMessage(cbGetMinVisible, 0, 0)))
+}
+
+// SetMaxVisibleItems sets the maximum number of items the drop-down list
+// shows without scrolling.
+func (cb *ComboBox) SetMaxVisibleItems(count int) error {
+	if count < 1 {
+		return newError("count must be >= 1")
+	}
+
+	if win.FALSE == cb.SendMessage(cbSetMinVisible, uintptr(count), 0) {
+		return newError("SendMessage(CB_SETMINVISIBLE)")
+	}
+
+	return nil
+}
+
+// FilterAsYouType returns if typing in the edit field of the ComboBox drops
+// down the list, showing only the items that contain the text.
+func (cb *ComboBox) FilterAsYouType() bool {
+	return cb.filterAsYouType
+}
+
+// SetFilterAsYouType sets if typing in the edit field of the ComboBox drops
+// down the list, showing only the items that contain the text. Items are
+// matched ignoring case.
+//
+// It only has an effect on editable ComboBoxes.
+func (cb *ComboBox) SetFilterAsYouType(value bool) {
+	cb.filterAsYouType = value
+
+	if !value {
+		cb.clearFilter()
+	}
+}
+
+// modelIndex returns the model index of the item at position pos of the list.
+func (cb *ComboBox) modelIndex(pos int) int {
+	if cb.filtered == nil || pos < 0 {
+		return pos
+	}
+
+	if pos >= len(cb.filtered) {
+		return -1
+	}
+
+	return cb.filtered[pos]
+}
+
+// listPosition returns the position in the list of the item at model index
+// index, or -1 if the item is filtered out.
+func (cb *ComboBox) listPosition(index int) int {
+	if cb.filtered == nil || index < 0 {
+		return index
+	}
+
+	for pos, i := range cb.filtered {
+		if i == index {
+			return pos
+		}
+	}
+
+	return -1
+}
+
+func (cb *ComboBox) itemKind(index int) ListItemKind {
+	if cb.itemKindProvider == nil || index < 0 {
+		return ListItemNormal
+	}
+
+	return cb.itemKindProvider.ItemKind(index)
+}
+
+// skipUnselectableItem selects the nearest item that can be selected instead
+// of the separator or group header at model index index, preferring the
+// direction the selection moved in. It returns the model index of the
+// selected item.
+func (cb *ComboBox) skipUnselectableItem(index int) int {
+	count := cb.model.ItemCount()
+
+	step := 1
+	if prev := cb.selChangeIndex; prev == -1 && cb.prevCurIndex > index || prev > index {
+		step = -1
+	}
+
+	target := -1
+	for _, s := range [2]int{step, -step} {
+		for i := index + s; i >= 0 && i < count; i += s {
+			if cb.itemKind(i) == ListItemNormal && (cb.filtered == nil || cb.listPosition(i) != -1) {
+				target = i
+				break
+			}
+		}
+
+		if target != -1 {
+			break
+		}
+	}
+
+	cb.SendMessage(win.CB_SETCURSEL, uintptr(cb.listPosition(target)), 0)
+
+	return target
+}
+
+// applyFilter lists only the items that contain text and drops down the list.
+func (cb *ComboBox) applyFilter(text string) {
+	if text == "" {
+		cb.clearFilter()
+		return
+	}
+
+	needle := strings.ToLower(text)
+
+	filtered := make([]int, 0)
+
+	count := cb.model.ItemCount()
+	for i := 0; i < count; i++ {
+		if cb.itemKind(i) != ListItemNormal {
+			continue
+		}
+
+		if strings.Contains(strings.ToLower(cb.itemString(i)), needle) {
+			filtered = append(filtered, i)
+		}
+	}
+
+	start, end := cb.TextSelection()
+
+	cb.SendMessage(win.CB_RESETCONTENT, 0, 0)
+
+	cb.filtered = filtered
+
+	for pos, index := range filtered {
+		if err := cb.insertItem(pos, index); err != nil {
+			break
+		}
+	}
+
+	dropped := cb.SendMessage(win.CB_GETDROPPEDSTATE, 0, 0) != 0
+
+	if len(filtered) > 0 && !dropped {
+		cb.SendMessage(win.CB_SHOWDROPDOWN, win.TRUE, 0)
+
+		// Dropping down the list hides the cursor.
+		win.SetCursor(win.LoadCursor(0, win.MAKEINTRESOURCE(win.IDC_ARROW)))
+	} else if len(filtered) == 0 && dropped {
+		cb.SendMessage(win.CB_SHOWDROPDOWN, win.FALSE, 0)
+	}
+
+	// Resetting the content and dropping down the list change the text.
+	cb.setText(text)
+	cb.SetTextSelection(start, end)
+}
+
+// clearFilter lists all items again.
+func (cb *ComboBox) clearFilter() {
+	if cb.filtered == nil {
+		return
+	}
+
+	index := cb.CurrentIndex()
+	text := cb.text()
+	start, end := cb.TextSelection()
+
+	cb.filtered = nil
+
+	cb.SendMessage(win.CB_RESETCONTENT, 0, 0)
+
+	if cb.model == nil {
+		return
+	}
+
+	count := cb.model.ItemCount()
+	for i := 0; i < count; i++ {
+		if err := cb.insertItemAt(i); err != nil {
+			break
+		}
+	}
+
+	if index > -1 {
+		cb.SendMessage(win.CB_SETCURSEL, uintptr(index), 0)
+	}
+
+	if cb.Editable() {
+		cb.setText(text)
+		cb.SetTextSelection(start, end)
+	}
+}
+
+// itemHeight returns the height of the items of an owner drawn ComboBox in
+// native pixels.
+func (cb *ComboBox) itemHeight() int {
+	dpi := cb.DPI()
+
+	height := cb.calculateTextSizeImpl("gM").Height + IntFrom96DPI(4, dpi)
+
+	if cb.imageProvider != nil {
+		height = maxi(height, int(win.GetSystemMetricsForDpi(win.SM_CYSMICON, uint32(dpi)))+IntFrom96DPI(2, dpi))
+	}
+
+	return height
+}
+
+func (cb *ComboBox) updateItemHeight() {
+	if !cb.ownerDraw {
+		return
+	}
+
+	cb.SendMessage(win.CB_SETITEMHEIGHT, 0, uintptr(cb.itemHeight()))
+}
+
+func (cb *ComboBox) applyImageListForImage(image interface{}) {
+	cb.hIml, cb.usingSysIml, _ = imageListForImage(image, cb.DPI())
+
+	cb.imageUintptr2Index = make(map[uintptr]int32)
+	cb.filePath2IconIndex = make(map[string]int32)
+}
+
+func (cb *ComboBox) disposeImageListAndCaches() {
+	if cb.hIml != 0 && !cb.usingSysIml {
+		win.ImageList_Destroy(cb.hIml)
+	}
+	cb.hIml = 0
+
+	cb.imageUintptr2Index = nil
+	cb.filePath2IconIndex = nil
+}
+
+func (cb *ComboBox) groupHeaderFontOrDefault() *Font {
+	if cb.groupHeaderFont == nil {
+		font := cb.Font()
+
+		if f, err := NewFont(font.Family(), font.PointSize(), font.Style()|FontBold); err == nil {
+			cb.groupHeaderFont = f
+		} else {
+			return font
+		}
+	}
+
+	return cb.groupHeaderFont
+}
+
+func (cb *ComboBox) drawItem(dis *win.DRAWITEMSTRUCT) {
+	canvas, err := newCanvasFromHDC(dis.HDC)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	dpi := cb.DPI()
+	bounds := rectangleFromRECT(dis.RcItem)
+	inEditField := dis.ItemState&win.ODS_COMBOBOXEDIT != 0
+
+	index := -1
+	if pos := int(int32(dis.ItemID)); pos > -1 && cb.model != nil {
+		index = cb.modelIndex(pos)
+	}
+	kind := cb.itemKind(index)
+
+	bgColor, textColor := SysColorWindow, SysColorWindowText
+	if dis.ItemState&win.ODS_SELECTED != 0 && kind == ListItemNormal {
+		bgColor, textColor = SysColorHighlight, SysColorHighlightText
+	}
+	if dis.ItemState&win.ODS_DISABLED != 0 || kind == ListItemGroupHeader {
+		textColor = SysColorGrayText
+	}
+
+	bgBrush, err := NewSystemColorBrush(bgColor)
+	if err != nil {
+		return
+	}
+	defer bgBrush.Dispose()
+
+	canvas.FillRectanglePixels(bgBrush, bounds)
+
+	if index == -1 {
+		return
+	}
+
+	padding := IntFrom96DPI(2, dpi)
+
+	if kind == ListItemSeparator {
+		pen, err := NewCosmeticPen(PenSolid, Color(win.GetSysColor(win.COLOR_GRAYTEXT)))
+		if err != nil {
+			return
+		}
+		defer pen.Dispose()
+
+		y := bounds.Y + bounds.Height/2
+		canvas.DrawLinePixels(pen, Point{bounds.X + padding, y}, Point{bounds.X + bounds.Width - padding, y})
+
+		return
+	}
+
+	x := bounds.X + padding
+	if kind == ListItemNormal && cb.itemKindProvider != nil && !inEditField {
+		x += IntFrom96DPI(comboBoxGroupIndent96dpi, dpi)
+	}
+
+	if cb.imageProvider != nil && kind == ListItemNormal {
+		if image := cb.imageProvider.Image(index); image != nil {
+			if cb.hIml == 0 {
+				cb.applyImageListForImage(image)
+			}
+
+			if cb.hIml != 0 {
+				i := imageIndexMaybeAdd(image, cb.hIml, cb.usingSysIml, cb.imageUintptr2Index, cb.filePath2IconIndex, dpi)
+				size := int(win.GetSystemMetricsForDpi(win.SM_CXSMICON, uint32(dpi)))
+
+				win.ImageList_DrawEx(cb.hIml, i, dis.HDC, int32(x), int32(bounds.Y+(bounds.Height-size)/2), 0, 0, win.CLR_NONE, win.CLR_NONE, win.ILD_NORMAL)
+			}
+		}
+
+		x += int(win.GetSystemMetricsForDpi(win.SM_CXSMICON, uint32(dpi))) + 2*padding
+	}
+
+	font := cb.Font()
+	if kind == ListItemGroupHeader {
+		font = cb.groupHeaderFontOrDefault()
+	}
+
+	textBounds := Rectangle{x, bounds.Y, bounds.X + bounds.Width - padding - x, bounds.Height}
+	canvas.DrawTextPixels(cb.itemString(index), font, Color(win.GetSysColor(int(textColor))), textBounds, TextSingleLine|TextVCenter|TextNoPrefix|TextEndEllipsis)
+
+	if dis.ItemState&win.ODS_FOCUS != 0 {
+		win.DrawFocusRect(dis.HDC, &dis.RcItem)
+	}
+}
@@ -0,0 +1,551 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"math"
+	"sync"
+
+	"github.com/lxn/win"
+)
+
+// ConstraintAttribute specifies an edge, a size or a center of a widget in a
+// ConstraintLayout.
+type ConstraintAttribute int
+
+const (
+	ConstraintLeft ConstraintAttribute = iota
+	ConstraintTop
+	ConstraintRight
+	ConstraintBottom
+	ConstraintWidth
+	ConstraintHeight
+	ConstraintCenterX
+	ConstraintCenterY
+)
+
+// ConstraintRelation specifies how the sides of a Constraint relate.
+type ConstraintRelation int
+
+const (
+	ConstraintEqual ConstraintRelation = iota
+	ConstraintLessOrEqual
+	ConstraintGreaterOrEqual
+)
+
+// ConstraintStrength specifies how important it is to satisfy a Constraint.
+//
+// A required constraint is always satisfied. Of the others, stronger ones are
+// satisfied before weaker ones.
+type ConstraintStrength float64
+
+const (
+	StrengthRequired ConstraintStrength = 1001001000
+	StrengthStrong   ConstraintStrength = 1000000
+	StrengthMedium   ConstraintStrength = 1000
+	StrengthWeak     ConstraintStrength = 1
+)
+
+// ConstraintTerm is an attribute of a widget multiplied by a coefficient.
+type ConstraintTerm struct {
+	// Widget is the widget the attribute belongs to. Nil refers to the area
+	// inside the margins of the container.
+	Widget      Widget
+	Attribute   ConstraintAttribute
+	Coefficient float64
+}
+
+// ConstraintExpression is a sum of terms and a constant in 1/96" units.
+type ConstraintExpression struct {
+	Terms    []ConstraintTerm
+	Constant float64
+}
+
+// Attr returns an expression for attribute of widget. A nil widget refers to
+// the area inside the margins of the container.
+func Attr(widget Widget, attribute ConstraintAttribute) ConstraintExpression {
+	return ConstraintExpression{
+		Terms: []ConstraintTerm{{Widget: widget, Attribute: attribute, Coefficient: 1}},
+	}
+}
+
+// Const returns an expression for the constant value in 1/96" units.
+func Const(value float64) ConstraintExpression {
+	return ConstraintExpression{Constant: value}
+}
+
+// Plus returns e + value.
+func (e ConstraintExpression) Plus(value float64) ConstraintExpression {
+	return ConstraintExpression{Terms: e.Terms, Constant: e.Constant + value}
+}
+
+// Add returns e + other.
+func (e ConstraintExpression) Add(other ConstraintExpression) ConstraintExpression {
+	terms := make([]ConstraintTerm, 0, len(e.Terms)+len(other.Terms))
+	terms = append(terms, e.Terms...)
+	terms = append(terms, other.Terms...)
+
+	return ConstraintExpression{Terms: terms, Constant: e.Constant + other.Constant}
+}
+
+// Sub returns e - other.
+func (e ConstraintExpression) Sub(other ConstraintExpression) ConstraintExpression {
+	return e.Add(other.Times(-1))
+}
+
+// Times returns e * factor.
+func (e ConstraintExpression) Times(factor float64) ConstraintExpression {
+	terms := make([]ConstraintTerm, len(e.Terms))
+	for i, t := range e.Terms {
+		t.Coefficient *= factor
+		terms[i] = t
+	}
+
+	return ConstraintExpression{Terms: terms, Constant: e.Constant * factor}
+}
+
+// Eq returns the required constraint e == other.
+func (e ConstraintExpression) Eq(other ConstraintExpression) *Constraint {
+	return &Constraint{LHS: e, RHS: other, Relation: ConstraintEqual, Strength: StrengthRequired}
+}
+
+// LessOrEqual returns the required constraint e <= other.
+func (e ConstraintExpression) LessOrEqual(other ConstraintExpression) *Constraint {
+	return &Constraint{LHS: e, RHS: other, Relation: ConstraintLessOrEqual, Strength: StrengthRequired}
+}
+
+// GreaterOrEqual returns the required constraint e >= other.
+func (e ConstraintExpression) GreaterOrEqual(other ConstraintExpression) *Constraint {
+	return &Constraint{LHS: e, RHS: other, Relation: ConstraintGreaterOrEqual, Strength: StrengthRequired}
+}
+
+// Constraint is a linear relation between attributes of widgets, like
+//
+//	walk.Attr(button, walk.ConstraintLeft).Eq(walk.Attr(edit, walk.ConstraintRight).Plus(8))
+type Constraint struct {
+	LHS      ConstraintExpression
+	RHS      ConstraintExpression
+	Relation ConstraintRelation
+	Strength ConstraintStrength
+}
+
+// WithStrength sets the strength of c and returns c.
+func (c *Constraint) WithStrength(strength ConstraintStrength) *Constraint {
+	c.Strength = strength
+
+	return c
+}
+
+// ConstraintLayout positions and sizes the widgets of a container, so that
+// the constraints added to it are satisfied.
+//
+// Each widget also gets at least its minimum and at most its maximum size,
+// stays inside the margins of the container and, as far as the constraints
+// allow, gets its ideal size. Widgets that are not positioned by constraints
+// are placed at the top left. Spacing is not used.
+//
+// A required constraint, that contradicts the other required constraints, is
+// ignored.
+type ConstraintLayout struct {
+	LayoutBase
+	constraints []*Constraint
+}
+
+func NewConstraintLayout() *ConstraintLayout {
+	l := &ConstraintLayout{
+		LayoutBase: LayoutBase{
+			margins96dpi: Margins{9, 9, 9, 9},
+		},
+	}
+	l.layout = l
+
+	return l
+}
+
+// Constraints returns the constraints of the layout.
+func (l *ConstraintLayout) Constraints() []*Constraint {
+	constraints := make([]*Constraint, len(l.constraints))
+	copy(constraints, l.constraints)
+
+	return constraints
+}
+
+// AddConstraint adds constraint to the layout.
+//
+// The widgets constraint refers to must be children of the container. Later
+// changes to constraint take effect with the next layout.
+func (l *ConstraintLayout) AddConstraint(constraint *Constraint) error {
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	if constraint.Relation < ConstraintEqual || constraint.Relation > ConstraintGreaterOrEqual {
+		return newError("invalid ConstraintRelation value")
+	}
+	if constraint.Strength <= 0 || constraint.Strength > StrengthRequired {
+		return newError("invalid ConstraintStrength value")
+	}
+
+	children := l.container.Children()
+
+	for _, e := range []ConstraintExpression{constraint.LHS, constraint.RHS} {
+		for _, t := range e.Terms {
+			if t.Attribute < ConstraintLeft || t.Attribute > ConstraintCenterY {
+				return newError("invalid ConstraintAttribute value")
+			}
+
+			if t.Widget != nil && !children.containsHandle(t.Widget.Handle()) {
+				return newError("unknown widget")
+			}
+		}
+	}
+
+	l.constraints = append(l.constraints, constraint)
+
+	l.container.RequestLayout()
+
+	return nil
+}
+
+// RemoveConstraint removes constraint from the layout.
+func (l *ConstraintLayout) RemoveConstraint(constraint *Constraint) error {
+	for i, c := range l.constraints {
+		if c == constraint {
+			l.constraints = append(l.constraints[:i], l.constraints[i+1:]...)
+
+			if l.container != nil {
+				l.container.RequestLayout()
+			}
+
+			return nil
+		}
+	}
+
+	return newError("unknown constraint")
+}
+
+// ClearConstraints removes all constraints from the layout.
+func (l *ConstraintLayout) ClearConstraints() {
+	if len(l.constraints) == 0 {
+		return
+	}
+
+	l.constraints = nil
+
+	if l.container != nil {
+		l.container.RequestLayout()
+	}
+}
+
+func (l *ConstraintLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	scale := float64(ctx.dpi) / 96
+
+	li := &constraintLayoutItem{
+		constraints: make([]constraintLayoutConstraint, 0, len(l.constraints)),
+	}
+
+	for _, c := range l.constraints {
+		lc := constraintLayoutConstraint{
+			constant: (c.LHS.Constant - c.RHS.Constant) * scale,
+			relation: solverRelation(c.Relation),
+			strength: float64(c.Strength),
+		}
+
+		for _, t := range c.LHS.Terms {
+			lc.terms = append(lc.terms, newConstraintLayoutTerm(t, 1))
+		}
+		for _, t := range c.RHS.Terms {
+			lc.terms = append(lc.terms, newConstraintLayoutTerm(t, -1))
+		}
+
+		li.constraints = append(li.constraints, lc)
+	}
+
+	return li
+}
+
+// constraintLayoutTerm is a ConstraintTerm with the widget replaced by its
+// handle, which is 0 for the container.
+type constraintLayoutTerm struct {
+	hwnd      win.HWND
+	attribute ConstraintAttribute
+	coeff     float64
+}
+
+func newConstraintLayoutTerm(t ConstraintTerm, sign float64) constraintLayoutTerm {
+	var hwnd win.HWND
+	if t.Widget != nil {
+		hwnd = t.Widget.Handle()
+	}
+
+	return constraintLayoutTerm{hwnd, t.Attribute, t.Coefficient * sign}
+}
+
+// constraintLayoutConstraint is the constraint terms + constant <relation> 0,
+// with the constant in native pixels.
+type constraintLayoutConstraint struct {
+	terms    []constraintLayoutTerm
+	constant float64
+	relation solverRelation
+	strength float64
+}
+
+type constraintLayoutItem struct {
+	ContainerLayoutItemBase
+	mutex        sync.Mutex
+	minSizeCache minSizeCache // in native pixels
+	constraints  []constraintLayoutConstraint
+}
+
+// constraintLayoutVars are the solver variables of the bounds of an item.
+type constraintLayoutVars struct {
+	x, y, width, height int
+}
+
+func (li *constraintLayoutItem) LayoutFlags() LayoutFlags {
+	flags := ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert
+
+	for _, item := range li.children {
+		if shouldLayoutItem(item) {
+			flags |= item.LayoutFlags() & (GreedyHorz | GreedyVert)
+		}
+	}
+
+	return flags
+}
+
+func (li *constraintLayoutItem) IdealSize() Size {
+	_, size := li.solve(Size{}, false, false, float64(StrengthMedium), float64(StrengthWeak))
+
+	return size
+}
+
+func (li *constraintLayoutItem) MinSize() Size {
+	li.mutex.Lock()
+	defer li.mutex.Unlock()
+
+	if min, ok := li.minSizeCache.get(Size{}); ok {
+		return min
+	}
+
+	_, min := li.solve(Size{}, false, false, float64(StrengthWeak), float64(StrengthMedium))
+
+	li.minSizeCache.put(Size{}, min)
+
+	return min
+}
+
+func (li *constraintLayoutItem) MinSizeForSize(size Size) Size {
+	return li.MinSize()
+}
+
+func (li *constraintLayoutItem) HeightForWidth(width int) int {
+	_, size := li.solve(Size{Width: width}, true, false, float64(StrengthWeak), float64(StrengthMedium))
+
+	return size.Height
+}
+
+func (li *constraintLayoutItem) PerformLayout() []LayoutResultItem {
+	results, _ := li.solve(li.geometry.ClientSize, true, true, float64(StrengthWeak), float64(StrengthMedium))
+
+	return results
+}
+
+// solve lays out the items and returns their bounds and the size of the
+// container.
+//
+// The width and height of the container are taken from size, if fixWidth and
+// fixHeight are true, and are kept as small as possible with
+// shrinkStrength otherwise. The items keep their ideal sizes with
+// idealStrength.
+func (li *constraintLayoutItem) solve(size Size, fixWidth, fixHeight bool, idealStrength, shrinkStrength float64) ([]LayoutResultItem, Size) {
+	skipped := make(map[int]bool)
+
+	for {
+		results, s, failed := li.trySolve(size, fixWidth, fixHeight, idealStrength, shrinkStrength, skipped)
+		if failed < 0 {
+			return results, s
+		}
+
+		skipped[failed] = true
+	}
+}
+
+// trySolve is solve, skipping the constraints in skipped. If a constraint
+// cannot be satisfied, trySolve returns its index.
+func (li *constraintLayoutItem) trySolve(size Size, fixWidth, fixHeight bool, idealStrength, shrinkStrength float64, skipped map[int]bool) ([]LayoutResultItem, Size, int) {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+
+	solver := newConstraintSolver()
+
+	newVars := func() constraintLayoutVars {
+		return constraintLayoutVars{solver.newVariable(), solver.newVariable(), solver.newVariable(), solver.newVariable()}
+	}
+
+	container := newVars()
+	hwnd2Vars := map[win.HWND]constraintLayoutVars{0: container}
+	items := make([]LayoutItem, 0, len(li.children))
+
+	for _, item := range li.children {
+		if shouldLayoutItem(item) {
+			items = append(items, item)
+			hwnd2Vars[item.Handle()] = newVars()
+		}
+	}
+
+	// The implicit constraints do not contradict each other, so only the
+	// constraints of the layout can fail.
+	add := func(terms []solverTerm, constant float64, relation solverRelation, strength float64) {
+		solver.addConstraint(terms, constant, relation, strength)
+	}
+
+	required := float64(StrengthRequired)
+
+	add([]solverTerm{{container.x, 1}}, -float64(margins.HNear), solverEqual, required)
+	add([]solverTerm{{container.y, 1}}, -float64(margins.VNear), solverEqual, required)
+	add([]solverTerm{{container.width, 1}}, 0, solverGreaterOrEqual, required)
+	add([]solverTerm{{container.height, 1}}, 0, solverGreaterOrEqual, required)
+
+	if fixWidth {
+		add([]solverTerm{{container.width, 1}}, -float64(size.Width-margins.HNear-margins.HFar), solverEqual, required)
+	}
+	if fixHeight {
+		add([]solverTerm{{container.height, 1}}, -float64(size.Height-margins.VNear-margins.VFar), solverEqual, required)
+	}
+
+	for _, item := range items {
+		v := hwnd2Vars[item.Handle()]
+		min := li.MinSizeEffectiveForChild(item)
+
+		add([]solverTerm{{v.width, 1}}, -float64(min.Width), solverGreaterOrEqual, required)
+		add([]solverTerm{{v.height, 1}}, -float64(min.Height), solverGreaterOrEqual, required)
+	}
+
+	for i, c := range li.constraints {
+		if skipped[i] {
+			continue
+		}
+
+		terms, ok := constraintSolverTerms(c.terms, hwnd2Vars)
+		if !ok {
+			// The constraint refers to a widget, that is not laid out.
+			continue
+		}
+
+		if err := solver.addConstraint(terms, c.constant, c.relation, c.strength); err != nil {
+			return nil, Size{}, i
+		}
+	}
+
+	strong := float64(StrengthStrong)
+	weak := float64(StrengthWeak)
+
+	for _, item := range items {
+		v := hwnd2Vars[item.Handle()]
+
+		add([]solverTerm{{v.x, 1}, {container.x, -1}}, 0, solverGreaterOrEqual, strong)
+		add([]solverTerm{{v.y, 1}, {container.y, -1}}, 0, solverGreaterOrEqual, strong)
+		add([]solverTerm{{v.x, 1}, {v.width, 1}, {container.x, -1}, {container.width, -1}}, 0, solverLessOrEqual, strong)
+		add([]solverTerm{{v.y, 1}, {v.height, 1}, {container.y, -1}, {container.height, -1}}, 0, solverLessOrEqual, strong)
+
+		if max := item.Geometry().MaxSize; max.Width > 0 || max.Height > 0 {
+			if max.Width > 0 {
+				add([]solverTerm{{v.width, 1}}, -float64(max.Width), solverLessOrEqual, strong)
+			}
+			if max.Height > 0 {
+				add([]solverTerm{{v.height, 1}}, -float64(max.Height), solverLessOrEqual, strong)
+			}
+		}
+
+		if is, ok := item.(IdealSizer); ok {
+			ideal := is.IdealSize()
+
+			add([]solverTerm{{v.width, 1}}, -float64(ideal.Width), solverEqual, idealStrength)
+			add([]solverTerm{{v.height, 1}}, -float64(ideal.Height), solverEqual, idealStrength)
+		}
+
+		add([]solverTerm{{v.x, 1}, {container.x, -1}}, 0, solverEqual, weak)
+		add([]solverTerm{{v.y, 1}, {container.y, -1}}, 0, solverEqual, weak)
+	}
+
+	if !fixWidth {
+		add([]solverTerm{{container.width, 1}}, 0, solverEqual, shrinkStrength)
+	}
+	if !fixHeight {
+		add([]solverTerm{{container.height, 1}}, 0, solverEqual, shrinkStrength)
+	}
+
+	round := func(variable int) int {
+		return int(math.Round(solver.value(variable)))
+	}
+
+	results := make([]LayoutResultItem, 0, len(items))
+
+	for _, item := range items {
+		v := hwnd2Vars[item.Handle()]
+
+		x, y := round(v.x), round(v.y)
+
+		results = append(results, LayoutResultItem{
+			Item: item,
+			Bounds: Rectangle{
+				X:      x,
+				Y:      y,
+				Width:  maxi(0, int(math.Round(solver.value(v.x)+solver.value(v.width)))-x),
+				Height: maxi(0, int(math.Round(solver.value(v.y)+solver.value(v.height)))-y),
+			},
+		})
+	}
+
+	s := Size{
+		Width:  round(container.width) + margins.HNear + margins.HFar,
+		Height: round(container.height) + margins.VNear + margins.VFar,
+	}
+
+	return results, s, -1
+}
+
+// constraintSolverTerms returns the solver terms for terms. It returns false,
+// if a term refers to an item without variables.
+func constraintSolverTerms(terms []constraintLayoutTerm, hwnd2Vars map[win.HWND]constraintLayoutVars) ([]solverTerm, bool) {
+	solverTerms := make([]solverTerm, 0, 2*len(terms))
+
+	for _, t := range terms {
+		v, ok := hwnd2Vars[t.hwnd]
+		if !ok {
+			return nil, false
+		}
+
+		switch t.attribute {
+		case ConstraintLeft:
+			solverTerms = append(solverTerms, solverTerm{v.x, t.coeff})
+
+		case ConstraintTop:
+			solverTerms = append(solverTerms, solverTerm{v.y, t.coeff})
+
+		case ConstraintRight:
+			solverTerms = append(solverTerms, solverTerm{v.x, t.coeff}, solverTerm{v.width, t.coeff})
+
+		case ConstraintBottom:
+			solverTerms = append(solverTerms, solverTerm{v.y, t.coeff}, solverTerm{v.height, t.coeff})
+
+		case ConstraintWidth:
+			solverTerms = append(solverTerms, solverTerm{v.width, t.coeff})
+
+		case ConstraintHeight:
+			solverTerms = append(solverTerms, solverTerm{v.height, t.coeff})
+
+		case ConstraintCenterX:
+			solverTerms = append(solverTerms, solverTerm{v.x, t.coeff}, solverTerm{v.width, t.coeff / 2})
+
+		case ConstraintCenterY:
+			solverTerms = append(solverTerms, solverTerm{v.y, t.coeff}, solverTerm{v.height, t.coeff / 2})
+		}
+	}
+
+	return solverTerms, true
+}
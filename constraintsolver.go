@@ -0,0 +1,425 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"math"
+	"sort"
+)
+
+// This file contains a solver for systems of linear equalities and
+// inequalities with strengths, after the Cassowary algorithm by Badros,
+// Borning and Stuckey, as implemented by Kiwi. ConstraintLayout uses it.
+
+type solverSymbolKind byte
+
+const (
+	solverSymbolInvalid solverSymbolKind = iota
+	solverSymbolExternal
+	solverSymbolSlack
+	solverSymbolError
+	solverSymbolDummy
+)
+
+type solverSymbol struct {
+	id   int
+	kind solverSymbolKind
+}
+
+func (s solverSymbol) valid() bool {
+	return s.kind != solverSymbolInvalid
+}
+
+func (s solverSymbol) pivotable() bool {
+	return s.kind == solverSymbolSlack || s.kind == solverSymbolError
+}
+
+const solverEpsilon = 1.0e-8
+
+func nearZero(value float64) bool {
+	return math.Abs(value) < solverEpsilon
+}
+
+// solverRow is a linear expression, constant + sum of coefficient*symbol.
+type solverRow struct {
+	constant float64
+	cells    map[solverSymbol]float64
+}
+
+func newSolverRow(constant float64) *solverRow {
+	return &solverRow{constant: constant, cells: make(map[solverSymbol]float64)}
+}
+
+func (r *solverRow) copy() *solverRow {
+	c := newSolverRow(r.constant)
+	for sym, coeff := range r.cells {
+		c.cells[sym] = coeff
+	}
+
+	return c
+}
+
+// symbols returns the symbols of the row ordered by id, so pivoting does not
+// depend on map iteration order.
+func (r *solverRow) symbols() []solverSymbol {
+	syms := make([]solverSymbol, 0, len(r.cells))
+	for sym := range r.cells {
+		syms = append(syms, sym)
+	}
+
+	sort.Slice(syms, func(i, j int) bool {
+		return syms[i].id < syms[j].id
+	})
+
+	return syms
+}
+
+func (r *solverRow) insertSymbol(sym solverSymbol, coeff float64) {
+	coeff += r.cells[sym]
+
+	if nearZero(coeff) {
+		delete(r.cells, sym)
+	} else {
+		r.cells[sym] = coeff
+	}
+}
+
+func (r *solverRow) insertRow(other *solverRow, coeff float64) {
+	r.constant += other.constant * coeff
+
+	for sym, c := range other.cells {
+		r.insertSymbol(sym, c*coeff)
+	}
+}
+
+func (r *solverRow) reverseSign() {
+	r.constant = -r.constant
+
+	for sym, coeff := range r.cells {
+		r.cells[sym] = -coeff
+	}
+}
+
+// solveFor solves the row, which is 0 = constant + coeff*sym + ..., for sym.
+func (r *solverRow) solveFor(sym solverSymbol) {
+	coeff := -1.0 / r.cells[sym]
+	delete(r.cells, sym)
+
+	r.constant *= coeff
+	for s, c := range r.cells {
+		r.cells[s] = c * coeff
+	}
+}
+
+// solveForEx solves the row, which is lhs = constant + ..., for rhs.
+func (r *solverRow) solveForEx(lhs, rhs solverSymbol) {
+	r.insertSymbol(lhs, -1)
+	r.solveFor(rhs)
+}
+
+func (r *solverRow) substitute(sym solverSymbol, row *solverRow) {
+	if coeff, ok := r.cells[sym]; ok {
+		delete(r.cells, sym)
+		r.insertRow(row, coeff)
+	}
+}
+
+type solverTag struct {
+	marker solverSymbol
+	other  solverSymbol
+}
+
+type solverRelation byte
+
+const (
+	solverEqual solverRelation = iota
+	solverLessOrEqual
+	solverGreaterOrEqual
+)
+
+// solverTerm is a variable with a coefficient.
+type solverTerm struct {
+	variable int
+	coeff    float64
+}
+
+// constraintSolver finds the values of its variables, that satisfy the
+// required constraints and violate the others as little as their strengths
+// allow.
+type constraintSolver struct {
+	nextID     int
+	varSymbols []solverSymbol
+	rows       map[solverSymbol]*solverRow
+	objective  *solverRow
+	artificial *solverRow
+}
+
+func newConstraintSolver() *constraintSolver {
+	return &constraintSolver{
+		rows:      make(map[solverSymbol]*solverRow),
+		objective: newSolverRow(0),
+	}
+}
+
+func (s *constraintSolver) newSymbol(kind solverSymbolKind) solverSymbol {
+	s.nextID++
+
+	return solverSymbol{s.nextID, kind}
+}
+
+// newVariable returns a new variable.
+func (s *constraintSolver) newVariable() int {
+	s.varSymbols = append(s.varSymbols, s.newSymbol(solverSymbolExternal))
+
+	return len(s.varSymbols) - 1
+}
+
+// value returns the value of variable in the current solution.
+func (s *constraintSolver) value(variable int) float64 {
+	if row, ok := s.rows[s.varSymbols[variable]]; ok {
+		return row.constant
+	}
+
+	return 0
+}
+
+// addConstraint adds the constraint terms + constant <relation> 0 with
+// strength and updates the solution.
+//
+// If a required constraint cannot be satisfied, addConstraint returns an
+// error and the solver must not be used anymore.
+func (s *constraintSolver) addConstraint(terms []solverTerm, constant float64, relation solverRelation, strength float64) error {
+	var tag solverTag
+	row := s.createRow(terms, constant, relation, strength, &tag)
+
+	subject := s.chooseSubject(row, tag)
+
+	if !subject.valid() && s.allDummies(row) {
+		if !nearZero(row.constant) {
+			return newError("unsatisfiable constraint")
+		}
+
+		subject = tag.marker
+	}
+
+	if !subject.valid() {
+		if !s.addWithArtificialVariable(row) {
+			return newError("unsatisfiable constraint")
+		}
+	} else {
+		row.solveFor(subject)
+		s.substitute(subject, row)
+		s.rows[subject] = row
+	}
+
+	return s.optimize(s.objective)
+}
+
+func (s *constraintSolver) createRow(terms []solverTerm, constant float64, relation solverRelation, strength float64, tag *solverTag) *solverRow {
+	row := newSolverRow(constant)
+
+	for _, term := range terms {
+		if nearZero(term.coeff) {
+			continue
+		}
+
+		sym := s.varSymbols[term.variable]
+
+		if basic, ok := s.rows[sym]; ok {
+			row.insertRow(basic, term.coeff)
+		} else {
+			row.insertSymbol(sym, term.coeff)
+		}
+	}
+
+	required := strength >= float64(StrengthRequired)
+
+	switch relation {
+	case solverLessOrEqual, solverGreaterOrEqual:
+		coeff := 1.0
+		if relation == solverGreaterOrEqual {
+			coeff = -1.0
+		}
+
+		slack := s.newSymbol(solverSymbolSlack)
+		tag.marker = slack
+		row.insertSymbol(slack, coeff)
+
+		if !required {
+			errSym := s.newSymbol(solverSymbolError)
+			tag.other = errSym
+			row.insertSymbol(errSym, -coeff)
+			s.objective.insertSymbol(errSym, strength)
+		}
+
+	case solverEqual:
+		if required {
+			dummy := s.newSymbol(solverSymbolDummy)
+			tag.marker = dummy
+			row.insertSymbol(dummy, 1)
+		} else {
+			errPlus := s.newSymbol(solverSymbolError)
+			errMinus := s.newSymbol(solverSymbolError)
+			tag.marker = errPlus
+			tag.other = errMinus
+			row.insertSymbol(errPlus, -1)
+			row.insertSymbol(errMinus, 1)
+			s.objective.insertSymbol(errPlus, strength)
+			s.objective.insertSymbol(errMinus, strength)
+		}
+	}
+
+	if row.constant < 0 {
+		row.reverseSign()
+	}
+
+	return row
+}
+
+func (s *constraintSolver) chooseSubject(row *solverRow, tag solverTag) solverSymbol {
+	for _, sym := range row.symbols() {
+		if sym.kind == solverSymbolExternal {
+			return sym
+		}
+	}
+
+	if tag.marker.pivotable() && row.cells[tag.marker] < 0 {
+		return tag.marker
+	}
+
+	if tag.other.pivotable() && row.cells[tag.other] < 0 {
+		return tag.other
+	}
+
+	return solverSymbol{}
+}
+
+func (s *constraintSolver) allDummies(row *solverRow) bool {
+	for sym := range row.cells {
+		if sym.kind != solverSymbolDummy {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *constraintSolver) addWithArtificialVariable(row *solverRow) bool {
+	art := s.newSymbol(solverSymbolSlack)
+	s.rows[art] = row.copy()
+	s.artificial = row.copy()
+
+	if err := s.optimize(s.artificial); err != nil {
+		s.artificial = nil
+		return false
+	}
+
+	success := nearZero(s.artificial.constant)
+	s.artificial = nil
+
+	if r, ok := s.rows[art]; ok {
+		delete(s.rows, art)
+
+		if len(r.cells) == 0 {
+			return success
+		}
+
+		entering := s.anyPivotableSymbol(r)
+		if !entering.valid() {
+			return false
+		}
+
+		r.solveForEx(art, entering)
+		s.substitute(entering, r)
+		s.rows[entering] = r
+	}
+
+	for _, r := range s.rows {
+		delete(r.cells, art)
+	}
+	delete(s.objective.cells, art)
+
+	return success
+}
+
+func (s *constraintSolver) anyPivotableSymbol(row *solverRow) solverSymbol {
+	for _, sym := range row.symbols() {
+		if sym.pivotable() {
+			return sym
+		}
+	}
+
+	return solverSymbol{}
+}
+
+func (s *constraintSolver) substitute(sym solverSymbol, row *solverRow) {
+	for _, r := range s.rows {
+		r.substitute(sym, row)
+	}
+
+	s.objective.substitute(sym, row)
+
+	if s.artificial != nil {
+		s.artificial.substitute(sym, row)
+	}
+}
+
+// optimize pivots until objective is minimal.
+func (s *constraintSolver) optimize(objective *solverRow) error {
+	for {
+		entering := s.enteringSymbol(objective)
+		if !entering.valid() {
+			return nil
+		}
+
+		leaving, ok := s.leavingSymbol(entering)
+		if !ok {
+			return newError("objective is unbounded")
+		}
+
+		row := s.rows[leaving]
+		delete(s.rows, leaving)
+
+		row.solveForEx(leaving, entering)
+		s.substitute(entering, row)
+		s.rows[entering] = row
+	}
+}
+
+func (s *constraintSolver) enteringSymbol(objective *solverRow) solverSymbol {
+	for _, sym := range objective.symbols() {
+		if sym.kind != solverSymbolDummy && objective.cells[sym] < 0 {
+			return sym
+		}
+	}
+
+	return solverSymbol{}
+}
+
+func (s *constraintSolver) leavingSymbol(entering solverSymbol) (solverSymbol, bool) {
+	ratio := math.MaxFloat64
+	var found solverSymbol
+
+	for sym, row := range s.rows {
+		if sym.kind == solverSymbolExternal {
+			continue
+		}
+
+		coeff, ok := row.cells[entering]
+		if !ok || coeff >= 0 {
+			continue
+		}
+
+		r := -row.constant / coeff
+		if r < ratio || r == ratio && sym.id < found.id {
+			ratio = r
+			found = sym
+		}
+	}
+
+	return found, found.valid()
+}
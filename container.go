@@ -266,6 +266,10 @@ func (cb *ContainerBase) doPaint() error {
 		}
 	}
 
+	if layoutDebugOverlay {
+		return paintLayoutOverlay(cb.hWnd, canvas)
+	}
+
 	return nil
 }
 
@@ -277,7 +281,7 @@ func (cb *ContainerBase) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintp
 		}
 
 	case win.WM_PAINT:
-		if FocusEffect == nil && InteractionEffect == nil && ValidationErrorEffect == nil {
+		if FocusEffect == nil && InteractionEffect == nil && ValidationErrorEffect == nil && layoutProblemEffect == nil && !layoutDebugOverlay {
 			break
 		}
 
@@ -298,6 +302,11 @@ func (cb *ContainerBase) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintp
 						break
 					}
 
+					if od := form.AsFormBase().overlayDialog; od != nil {
+						od.handleCommand(cmdId)
+						break
+					}
+
 					dlg, ok := form.(dialogish)
 					if !ok {
 						break
@@ -420,6 +429,12 @@ func (cb *ContainerBase) onRemovingWidget(index int, widget Widget) (err error)
 }
 
 func (cb *ContainerBase) onRemovedWidget(index int, widget Widget) (err error) {
+	if cb.layout != nil {
+		if lb := cb.layout.asLayoutBase(); lb != nil {
+			lb.removeChildSizeLimits(widget.Handle())
+		}
+	}
+
 	cb.RequestLayout()
 
 	return
@@ -440,6 +455,12 @@ func (cb *ContainerBase) onClearingWidgets() (err error) {
 }
 
 func (cb *ContainerBase) onClearedWidgets() (err error) {
+	if cb.layout != nil {
+		if lb := cb.layout.asLayoutBase(); lb != nil {
+			lb.hwnd2ChildSizeLimits = nil
+		}
+	}
+
 	cb.RequestLayout()
 
 	return
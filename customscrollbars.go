@@ -0,0 +1,411 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+var customScrollBarsWndProcPtr uintptr
+
+func init() {
+	AppendToWalkInit(func() {
+		customScrollBarsWndProcPtr = syscall.NewCallback(customScrollBarsWndProc)
+	})
+}
+
+var hwnd2CustomScrollBars = make(map[win.HWND]*CustomScrollBars)
+
+// nativeScrollBarsHandler is implemented by widgets, whose native scroll bars
+// belong to a child window, e.g. the list view of a TableView.
+type nativeScrollBarsHandler interface {
+	nativeScrollBarsHandle() win.HWND
+}
+
+// positionScroller is implemented by widgets, that can not be scrolled to an
+// absolute position by WM_HSCROLL or WM_VSCROLL with SB_THUMBPOSITION.
+type positionScroller interface {
+	scrollToPosition(bar int32, pos int)
+}
+
+// CustomScrollBars replaces the native scroll bars of a widget with
+// ScrollBars.
+//
+// The native scroll bars keep working and are clipped away, so the widget
+// still manages its scrolling itself. The ScrollBars mirror their state and
+// forward user input to the widget.
+type CustomScrollBars struct {
+	target          Widget
+	hwnd            win.HWND
+	origWndProcPtr  uintptr
+	style           ScrollBarStyle
+	vertical        *ScrollBar
+	horizontal      *ScrollBar
+	geometry        customScrollBarsGeometry
+	disposingHandle int
+}
+
+// customScrollBarsGeometry records what the clip region has been computed
+// for.
+type customScrollBarsGeometry struct {
+	window     win.RECT
+	client     win.RECT
+	vertical   bool
+	horizontal bool
+}
+
+// NewCustomScrollBars replaces the native scroll bars of target with
+// ScrollBars of the given style, which must not be ScrollBarStyleSystem.
+//
+// It works with widgets that use the standard WS_HSCROLL and WS_VSCROLL
+// scroll bars, e.g. ScrollView, TableView, ListBox and TextEdit.
+func NewCustomScrollBars(target Widget, style ScrollBarStyle) (*CustomScrollBars, error) {
+	if style != ScrollBarStyleThin && style != ScrollBarStyleOverlay {
+		return nil, newError("invalid style")
+	}
+
+	hwnd := target.Handle()
+	if nsbh, ok := target.(nativeScrollBarsHandler); ok {
+		hwnd = nsbh.nativeScrollBarsHandle()
+	}
+
+	if hwnd2CustomScrollBars[hwnd] != nil {
+		return nil, newError("target already has custom scroll bars")
+	}
+
+	host := windowFromHandle(win.GetParent(hwnd))
+	if host == nil {
+		return nil, newError("target has no parent")
+	}
+
+	csb := &CustomScrollBars{
+		target: target,
+		hwnd:   hwnd,
+		style:  style,
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			csb.disposeScrollBars()
+		}
+	}()
+
+	var err error
+	if csb.vertical, err = csb.newScrollBar(host, Vertical); err != nil {
+		return nil, err
+	}
+	if csb.horizontal, err = csb.newScrollBar(host, Horizontal); err != nil {
+		return nil, err
+	}
+
+	csb.origWndProcPtr = win.SetWindowLongPtr(hwnd, win.GWLP_WNDPROC, customScrollBarsWndProcPtr)
+	if csb.origWndProcPtr == 0 {
+		return nil, lastError("SetWindowLongPtr")
+	}
+
+	hwnd2CustomScrollBars[hwnd] = csb
+
+	csb.disposingHandle = target.Disposing().Attach(csb.Dispose)
+
+	csb.update()
+
+	succeeded = true
+
+	return csb, nil
+}
+
+func (csb *CustomScrollBars) newScrollBar(host Window, orientation Orientation) (*ScrollBar, error) {
+	sb, err := newScrollBar(host, orientation)
+	if err != nil {
+		return nil, err
+	}
+
+	if container, ok := host.(Container); ok && container.Children() != nil {
+		// The scroll bar must not take part in the layout of the host.
+		sb.parent = nil
+		container.Children().Remove(sb)
+		sb.parent = container
+		win.SetParent(sb.hWnd, host.Handle())
+	}
+
+	win.ShowWindow(sb.hWnd, win.SW_HIDE)
+
+	sb.wheelTarget = csb.hwnd
+
+	if err := sb.SetStyle(csb.style); err != nil {
+		sb.Dispose()
+		return nil, err
+	}
+
+	sb.Scrolled().Attach(func() {
+		csb.scrollTarget(sb)
+	})
+
+	return sb, nil
+}
+
+// Dispose restores the native scroll bars of the target.
+func (csb *CustomScrollBars) Dispose() {
+	if csb.hwnd == 0 {
+		return
+	}
+
+	if win.GetWindowLongPtr(csb.hwnd, win.GWLP_WNDPROC) == customScrollBarsWndProcPtr {
+		win.SetWindowLongPtr(csb.hwnd, win.GWLP_WNDPROC, csb.origWndProcPtr)
+	}
+	delete(hwnd2CustomScrollBars, csb.hwnd)
+
+	setWindowRgn(csb.hwnd, 0, true)
+
+	csb.target.Disposing().Detach(csb.disposingHandle)
+
+	csb.disposeScrollBars()
+
+	csb.hwnd = 0
+}
+
+func (csb *CustomScrollBars) disposeScrollBars() {
+	if csb.vertical != nil {
+		csb.vertical.Dispose()
+		csb.vertical = nil
+	}
+	if csb.horizontal != nil {
+		csb.horizontal.Dispose()
+		csb.horizontal = nil
+	}
+}
+
+// setScrollBarStyle creates, updates or disposes *csb for target, so its
+// scroll bars get the given style.
+func setScrollBarStyle(csb **CustomScrollBars, target Widget, style ScrollBarStyle) error {
+	if style == ScrollBarStyleSystem {
+		if *csb != nil {
+			(*csb).Dispose()
+			*csb = nil
+		}
+
+		return nil
+	}
+
+	if *csb != nil {
+		return (*csb).SetStyle(style)
+	}
+
+	c, err := NewCustomScrollBars(target, style)
+	if err != nil {
+		return err
+	}
+
+	*csb = c
+
+	return nil
+}
+
+// Style returns the style of the scroll bars.
+func (csb *CustomScrollBars) Style() ScrollBarStyle {
+	return csb.style
+}
+
+// SetStyle sets the style of the scroll bars, which must not be
+// ScrollBarStyleSystem. Dispose the CustomScrollBars to restore the native
+// scroll bars.
+func (csb *CustomScrollBars) SetStyle(style ScrollBarStyle) error {
+	if style != ScrollBarStyleThin && style != ScrollBarStyleOverlay {
+		return newError("invalid style")
+	}
+
+	if err := csb.vertical.SetStyle(style); err != nil {
+		return err
+	}
+	if err := csb.horizontal.SetStyle(style); err != nil {
+		return err
+	}
+
+	csb.style = style
+
+	return nil
+}
+
+// VerticalScrollBar returns the ScrollBar that replaces the native vertical
+// scroll bar, e.g. for customizing its colors.
+func (csb *CustomScrollBars) VerticalScrollBar() *ScrollBar {
+	return csb.vertical
+}
+
+// HorizontalScrollBar returns the ScrollBar that replaces the native
+// horizontal scroll bar.
+func (csb *CustomScrollBars) HorizontalScrollBar() *ScrollBar {
+	return csb.horizontal
+}
+
+// scrollTarget scrolls the target to the value of sb, after the user changed
+// it.
+func (csb *CustomScrollBars) scrollTarget(sb *ScrollBar) {
+	var msg uint32
+	var bar int32
+	if sb.Orientation() == Vertical {
+		msg, bar = win.WM_VSCROLL, win.SB_VERT
+	} else {
+		msg, bar = win.WM_HSCROLL, win.SB_HORZ
+	}
+
+	pos := sb.Value()
+
+	if ps, ok := csb.target.(positionScroller); ok {
+		ps.scrollToPosition(bar, pos)
+	} else {
+		win.SendMessage(csb.hwnd, msg, uintptr(win.MAKELONG(win.SB_THUMBPOSITION, uint16(pos))), 0)
+		win.SendMessage(csb.hwnd, msg, win.SB_ENDSCROLL, 0)
+	}
+
+	csb.update()
+}
+
+// update mirrors the state of the native scroll bars and clips them away.
+func (csb *CustomScrollBars) update() {
+	if csb.hwnd == 0 {
+		return
+	}
+
+	style := uint32(win.GetWindowLong(csb.hwnd, win.GWL_STYLE))
+	visible := win.IsWindowVisible(csb.hwnd)
+
+	var g customScrollBarsGeometry
+	g.vertical = visible && style&win.WS_VSCROLL != 0
+	g.horizontal = visible && style&win.WS_HSCROLL != 0
+
+	win.GetWindowRect(csb.hwnd, &g.window)
+	win.GetClientRect(csb.hwnd, &g.client)
+
+	var origin win.POINT
+	win.ClientToScreen(csb.hwnd, &origin)
+	g.client.Left += origin.X
+	g.client.Top += origin.Y
+	g.client.Right += origin.X
+	g.client.Bottom += origin.Y
+
+	for _, sb := range []*ScrollBar{csb.vertical, csb.horizontal} {
+		bar := int32(win.SB_HORZ)
+		if sb.Orientation() == Vertical {
+			bar = win.SB_VERT
+		}
+
+		var si win.SCROLLINFO
+		si.CbSize = uint32(unsafe.Sizeof(si))
+		si.FMask = win.SIF_PAGE | win.SIF_POS | win.SIF_RANGE
+
+		if win.GetScrollInfo(csb.hwnd, bar, &si) {
+			sb.SetRange(int(si.NMin), int(si.NMax))
+			sb.SetPageSize(int(si.NPage))
+			sb.SetValue(int(si.NPos))
+		}
+	}
+
+	if g == csb.geometry {
+		return
+	}
+	csb.geometry = g
+
+	dpi := uint32(win.GetDpiForWindow(csb.hwnd))
+	vsbw := win.GetSystemMetricsForDpi(win.SM_CXVSCROLL, dpi)
+	hsbh := win.GetSystemMetricsForDpi(win.SM_CYHSCROLL, dpi)
+
+	vertical := win.RECT{Left: g.client.Right, Top: g.client.Top, Right: g.client.Right + vsbw, Bottom: g.client.Bottom}
+	horizontal := win.RECT{Left: g.client.Left, Top: g.client.Bottom, Right: g.client.Right, Bottom: g.client.Bottom + hsbh}
+	if g.vertical && g.horizontal {
+		// The corner between the scroll bars belongs to the vertical one.
+		vertical.Bottom += hsbh
+	}
+
+	if g.vertical || g.horizontal {
+		rgn := win.CreateRectRgn(0, 0, g.window.Right-g.window.Left, g.window.Bottom-g.window.Top)
+
+		for _, strip := range []struct {
+			visible bool
+			rc      win.RECT
+		}{{g.vertical, vertical}, {g.horizontal, horizontal}} {
+			if !strip.visible {
+				continue
+			}
+
+			stripRgn := win.CreateRectRgn(
+				strip.rc.Left-g.window.Left,
+				strip.rc.Top-g.window.Top,
+				strip.rc.Right-g.window.Left,
+				strip.rc.Bottom-g.window.Top)
+			win.CombineRgn(rgn, rgn, stripRgn, win.RGN_DIFF)
+			win.DeleteObject(win.HGDIOBJ(stripRgn))
+		}
+
+		// The window takes ownership of the region.
+		setWindowRgn(csb.hwnd, rgn, true)
+	} else {
+		setWindowRgn(csb.hwnd, 0, true)
+	}
+
+	csb.placeScrollBar(csb.vertical, g.vertical, vertical)
+	csb.placeScrollBar(csb.horizontal, g.horizontal, horizontal)
+}
+
+// placeScrollBar moves sb over the area of the native scroll bar, which is
+// given in screen coordinates.
+func (csb *CustomScrollBars) placeScrollBar(sb *ScrollBar, visible bool, rc win.RECT) {
+	if !visible {
+		win.ShowWindow(sb.hWnd, win.SW_HIDE)
+		return
+	}
+
+	host := win.GetParent(sb.hWnd)
+
+	topLeft := win.POINT{X: rc.Left, Y: rc.Top}
+	win.ScreenToClient(host, &topLeft)
+
+	win.SetWindowPos(
+		sb.hWnd,
+		win.HWND_TOP,
+		topLeft.X,
+		topLeft.Y,
+		rc.Right-rc.Left,
+		rc.Bottom-rc.Top,
+		win.SWP_NOACTIVATE|win.SWP_SHOWWINDOW)
+}
+
+func (csb *CustomScrollBars) wake() {
+	if csb.geometry.vertical {
+		csb.vertical.wake()
+	}
+	if csb.geometry.horizontal {
+		csb.horizontal.wake()
+	}
+}
+
+func customScrollBarsWndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	csb := hwnd2CustomScrollBars[hwnd]
+	if csb == nil {
+		return win.DefWindowProc(hwnd, msg, wParam, lParam)
+	}
+
+	result := win.CallWindowProc(csb.origWndProcPtr, hwnd, msg, wParam, lParam)
+
+	switch msg {
+	case win.WM_MOUSEMOVE:
+		csb.wake()
+
+	case win.WM_HSCROLL, win.WM_VSCROLL, win.WM_MOUSEWHEEL, win.WM_KEYDOWN,
+		win.WM_PAINT, win.WM_SIZE, win.WM_WINDOWPOSCHANGED, win.WM_SHOWWINDOW, win.WM_STYLECHANGED:
+		csb.update()
+
+	case win.WM_NCDESTROY:
+		csb.Dispose()
+	}
+
+	return result
+}
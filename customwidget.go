@@ -26,9 +26,10 @@ type PaintFunc func(canvas *Canvas, updateBounds Rectangle) error
 type PaintMode int
 
 const (
-	PaintNormal   PaintMode = iota // erase background before PaintFunc
-	PaintNoErase                   // PaintFunc clears background, single buffered
-	PaintBuffered                  // PaintFunc clears background, double buffered
+	PaintNormal         PaintMode = iota // erase background before PaintFunc
+	PaintNoErase                         // PaintFunc clears background, single buffered
+	PaintBuffered                        // PaintFunc clears background, double buffered
+	PaintDoubleBuffered                  // erase background before PaintFunc, both double buffered
 )
 
 type CustomWidget struct {
@@ -144,7 +145,7 @@ func (cw *CustomWidget) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintpt
 		defer canvas.Dispose()
 
 		bounds := rectangleFromRECT(ps.RcPaint)
-		if cw.paintMode == PaintBuffered {
+		if cw.paintMode == PaintBuffered || cw.paintMode == PaintDoubleBuffered {
 			err = cw.bufferedPaint(canvas, bounds)
 		} else if cw.paintPixels != nil {
 			err = cw.paintPixels(canvas, bounds)
@@ -182,58 +183,55 @@ func (cw *CustomWidget) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintpt
 	return cw.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
 }
 
-// bufferedPaint draws widget on a memory buffer. updateBounds are in native pixels.
+// bufferedPaint draws widget on a BufferedCanvas. updateBounds are in native pixels.
 func (cw *CustomWidget) bufferedPaint(canvas *Canvas, updateBounds Rectangle) error {
-	hdc := win.CreateCompatibleDC(canvas.hdc)
-	if hdc == 0 {
-		return newError("CreateCompatibleDC failed")
-	}
-	defer win.DeleteDC(hdc)
-
-	buffered := Canvas{hdc: hdc, doNotDispose: true}
-	if _, err := buffered.init(); err != nil {
+	buffered, err := NewBufferedCanvas(canvas, updateBounds)
+	if err != nil {
 		return err
 	}
+	defer buffered.Dispose()
 
-	w, h := int32(updateBounds.Width), int32(updateBounds.Height)
-	if w < 1 {
-		w = 1
-	}
-	if h < 1 {
-		h = 1
-	}
-	hbmp := win.CreateCompatibleBitmap(canvas.hdc, w, h)
-	if hbmp == 0 {
-		return lastError("CreateCompatibleBitmap failed")
-	}
-	defer win.DeleteObject(win.HGDIOBJ(hbmp))
-
-	oldbmp := win.SelectObject(buffered.hdc, win.HGDIOBJ(hbmp))
-	if oldbmp == 0 {
-		return newError("SelectObject failed")
+	if cw.paintMode == PaintDoubleBuffered {
+		if err := cw.eraseBackground(&buffered.Canvas, updateBounds); err != nil {
+			return err
+		}
 	}
-	defer win.SelectObject(buffered.hdc, oldbmp)
 
-	win.SetViewportOrgEx(buffered.hdc, -int32(updateBounds.X), -int32(updateBounds.Y), nil)
-	win.SetBrushOrgEx(buffered.hdc, -int32(updateBounds.X), -int32(updateBounds.Y), nil)
-
-	var err error
 	if cw.paintPixels != nil {
-		err = cw.paintPixels(&buffered, updateBounds)
+		err = cw.paintPixels(&buffered.Canvas, updateBounds)
 	} else {
-		err = cw.paint(&buffered, RectangleTo96DPI(updateBounds, cw.DPI()))
+		err = cw.paint(&buffered.Canvas, RectangleTo96DPI(updateBounds, cw.DPI()))
 	}
 
-	if !win.BitBlt(canvas.hdc,
-		int32(updateBounds.X), int32(updateBounds.Y), w, h,
-		buffered.hdc,
-		int32(updateBounds.X), int32(updateBounds.Y), win.SRCCOPY) {
-		return lastError("buffered BitBlt failed")
+	if flushErr := buffered.Flush(); flushErr != nil {
+		return flushErr
 	}
 
 	return err
 }
 
+// eraseBackground fills bounds, in native pixels, of canvas with the effective
+// background, like WM_ERASEBKGND does for the window.
+func (cw *CustomWidget) eraseBackground(canvas *Canvas, bounds Rectangle) error {
+	bg, wnd := cw.backgroundEffective()
+	if bg == nil {
+		return nil
+	}
+
+	var bgRC, rc win.RECT
+	win.GetWindowRect(wnd.Handle(), &bgRC)
+	win.GetWindowRect(cw.hWnd, &rc)
+
+	// The brush origin is in device coordinates, which are offset by the
+	// viewport origin of the buffer.
+	var org win.POINT
+	win.GetViewportOrgEx(canvas.hdc, &org)
+	win.SetBrushOrgEx(canvas.hdc, bgRC.Left-rc.Left+org.X, bgRC.Top-rc.Top+org.Y, nil)
+	defer win.SetBrushOrgEx(canvas.hdc, org.X, org.Y, nil)
+
+	return canvas.FillRectanglePixels(bg, bounds)
+}
+
 func (*CustomWidget) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	return NewGreedyLayoutItem()
 }
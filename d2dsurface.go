@@ -0,0 +1,632 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"syscall"
+
+	"github.com/lxn/win"
+)
+
+const d2dSurfaceWindowClass = `\o/ Walk_D2DSurface_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClass(d2dSurfaceWindowClass)
+	})
+}
+
+var (
+	d2dFactorySingleton    *id2d1Factory
+	dwriteFactorySingleton *idWriteFactory
+)
+
+// d2dFactory returns the Direct2D factory, creating it if needed. It is kept
+// until the process exits.
+func d2dFactory() (*id2d1Factory, error) {
+	if d2dFactorySingleton == nil {
+		if hr := d2d1CreateFactory(d2d1FactoryTypeSingleThreaded, &iidID2D1Factory, &d2dFactorySingleton); win.FAILED(hr) {
+			return nil, errorFromHRESULT("D2D1CreateFactory", hr)
+		}
+	}
+
+	return d2dFactorySingleton, nil
+}
+
+// dwriteFactory returns the DirectWrite factory, creating it if needed. It is
+// kept until the process exits.
+func dwriteFactory() (*idWriteFactory, error) {
+	if dwriteFactorySingleton == nil {
+		if hr := dwriteCreateFactory(dwriteFactoryTypeShared, &iidIDWriteFactory, &dwriteFactorySingleton); win.FAILED(hr) {
+			return nil, errorFromHRESULT("DWriteCreateFactory", hr)
+		}
+	}
+
+	return dwriteFactorySingleton, nil
+}
+
+// D2DPaintFunc paints the content of a D2DSurface with ctx.
+type D2DPaintFunc func(ctx *D2DContext) error
+
+// D2DSurface is a widget painted with Direct2D and DirectWrite. They are
+// hardware accelerated and draw antialiased shapes and text with alpha
+// blending, which makes them a better fit than Canvas for charts and
+// dashboards that are repainted often.
+//
+// Like CustomWidget, it takes the space its layout gives it. Its paint func
+// draws in 1/96" units, which Direct2D scales to the DPI of the widget.
+type D2DSurface struct {
+	WidgetBase
+	paint  D2DPaintFunc
+	target *id2d1HwndRenderTarget
+	brush  *id2d1SolidColorBrush
+}
+
+// NewD2DSurface creates and initializes a new D2DSurface painted by paint.
+func NewD2DSurface(parent Container, paint D2DPaintFunc) (*D2DSurface, error) {
+	ds := &D2DSurface{paint: paint}
+
+	if err := InitWidget(
+		ds,
+		parent,
+		d2dSurfaceWindowClass,
+		win.WS_VISIBLE,
+		0); err != nil {
+		return nil, err
+	}
+
+	return ds, nil
+}
+
+// PaintFunc returns the func painting the D2DSurface.
+func (ds *D2DSurface) PaintFunc() D2DPaintFunc {
+	return ds.paint
+}
+
+// SetPaintFunc sets the func painting the D2DSurface and repaints it.
+func (ds *D2DSurface) SetPaintFunc(paint D2DPaintFunc) {
+	ds.paint = paint
+
+	ds.Invalidate()
+}
+
+func (ds *D2DSurface) Dispose() {
+	ds.releaseTarget()
+
+	ds.WidgetBase.Dispose()
+}
+
+func (ds *D2DSurface) ApplyDPI(dpi int) {
+	ds.WidgetBase.ApplyDPI(dpi)
+
+	if ds.target != nil {
+		ds.target.SetDpi(float32(dpi), float32(dpi))
+	}
+
+	ds.Invalidate()
+}
+
+func (*D2DSurface) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	return NewGreedyLayoutItem()
+}
+
+func (ds *D2DSurface) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+		win.BeginPaint(hwnd, &ps)
+		defer win.EndPaint(hwnd, &ps)
+
+		if err := ds.render(); err != nil {
+			wrapError(err)
+		}
+
+		return 0
+
+	case win.WM_ERASEBKGND:
+		// Direct2D paints all of the window.
+		return 1
+
+	case win.WM_SIZE:
+		if ds.target != nil {
+			size := d2d1SizeU{uint32(win.LOWORD(uint32(lParam))), uint32(win.HIWORD(uint32(lParam)))}
+
+			if hr := ds.target.Resize(&size); win.FAILED(hr) {
+				ds.releaseTarget()
+			}
+		}
+
+		ds.Invalidate()
+	}
+
+	return ds.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
+}
+
+// ensureTarget creates the render target and the brush, unless they exist.
+func (ds *D2DSurface) ensureTarget() error {
+	if ds.target != nil {
+		return nil
+	}
+
+	factory, err := d2dFactory()
+	if err != nil {
+		return err
+	}
+
+	size := ds.ClientBoundsPixels().Size()
+	dpi := float32(ds.DPI())
+
+	rtProps := d2d1RenderTargetProperties{DpiX: dpi, DpiY: dpi}
+	hwndProps := d2d1HwndRenderTargetProperties{
+		Hwnd:      ds.hWnd,
+		PixelSize: d2d1SizeU{uint32(size.Width), uint32(size.Height)},
+	}
+
+	if hr := factory.CreateHwndRenderTarget(&rtProps, &hwndProps, &ds.target); win.FAILED(hr) {
+		ds.target = nil
+		return errorFromHRESULT("ID2D1Factory.CreateHwndRenderTarget", hr)
+	}
+
+	color := d2d1ColorF{A: 1}
+	if hr := ds.target.CreateSolidColorBrush(&color, &ds.brush); win.FAILED(hr) {
+		ds.brush = nil
+		ds.releaseTarget()
+		return errorFromHRESULT("ID2D1RenderTarget.CreateSolidColorBrush", hr)
+	}
+
+	return nil
+}
+
+// releaseTarget releases the render target and the brush, e.g. because the
+// graphics device was lost. They are created again for the next paint.
+func (ds *D2DSurface) releaseTarget() {
+	if ds.brush != nil {
+		ds.brush.Release()
+		ds.brush = nil
+	}
+
+	if ds.target != nil {
+		ds.target.Release()
+		ds.target = nil
+	}
+}
+
+// render clears the surface with the window background color and calls the
+// paint func.
+func (ds *D2DSurface) render() error {
+	if err := ds.ensureTarget(); err != nil {
+		return err
+	}
+
+	ctx := &D2DContext{surface: ds, transform: IdentityTransform()}
+
+	ds.target.BeginDraw()
+
+	ctx.SetTransform(IdentityTransform())
+	ctx.Clear(Color(win.GetSysColor(win.COLOR_WINDOW)).WithAlpha(255))
+
+	var err error
+	if ds.paint != nil {
+		err = ds.paint(ctx)
+	}
+
+	for ; ctx.clipDepth > 0; ctx.clipDepth-- {
+		ds.target.PopAxisAlignedClip()
+	}
+
+	switch hr := ds.target.EndDraw(); {
+	case hr == d2dErrRecreateTarget:
+		ds.releaseTarget()
+		ds.Invalidate()
+
+	case win.FAILED(hr) && err == nil:
+		err = errorFromHRESULT("ID2D1RenderTarget.EndDraw", hr)
+	}
+
+	return err
+}
+
+// D2DContext draws on a D2DSurface while it is painted. Coordinates are in
+// 1/96" units. Errors of the drawing methods without an error result are
+// reported after painting.
+type D2DContext struct {
+	surface   *D2DSurface
+	transform Transform
+	clipDepth int
+}
+
+// DPI returns the DPI of the D2DSurface.
+func (ctx *D2DContext) DPI() int {
+	return ctx.surface.DPI()
+}
+
+// Bounds returns the bounds of the D2DSurface in 1/96" units.
+func (ctx *D2DContext) Bounds() RectangleF {
+	size := ctx.surface.ClientBoundsPixels().Size()
+	factor := 96 / float64(ctx.DPI())
+
+	return RectangleF{Width: float64(size.Width) * factor, Height: float64(size.Height) * factor}
+}
+
+// Transform returns the transform of the coordinates.
+func (ctx *D2DContext) Transform() Transform {
+	return ctx.transform
+}
+
+// SetTransform sets the transform of the coordinates. Its translation is in
+// 1/96" units.
+func (ctx *D2DContext) SetTransform(t Transform) {
+	ctx.transform = t
+
+	xf := t.toXFORM()
+	ctx.surface.target.SetTransform(&xf)
+}
+
+// PushClip restricts drawing to bounds, until PopClip is called. Clips pushed
+// before still apply.
+func (ctx *D2DContext) PushClip(bounds RectangleF) {
+	rect := d2d1RectFFrom(bounds)
+	ctx.surface.target.PushAxisAlignedClip(&rect, d2d1AntialiasModePerPrimitive)
+
+	ctx.clipDepth++
+}
+
+// PopClip removes the clip pushed last.
+func (ctx *D2DContext) PopClip() {
+	if ctx.clipDepth == 0 {
+		return
+	}
+
+	ctx.surface.target.PopAxisAlignedClip()
+
+	ctx.clipDepth--
+}
+
+// Clear fills the D2DSurface with color.
+func (ctx *D2DContext) Clear(color AlphaColor) {
+	c := d2d1ColorFFrom(color)
+	ctx.surface.target.Clear(&c)
+}
+
+// brushFor returns the brush with its color set to color.
+func (ctx *D2DContext) brushFor(color AlphaColor) *id2d1SolidColorBrush {
+	c := d2d1ColorFFrom(color)
+	ctx.surface.brush.SetColor(&c)
+
+	return ctx.surface.brush
+}
+
+func penWidth(pen SmoothPen) float32 {
+	if pen.Width == 0 {
+		return 1
+	}
+
+	return float32(pen.Width)
+}
+
+// DrawLine draws a line from from to to.
+func (ctx *D2DContext) DrawLine(pen SmoothPen, from, to PointF) {
+	ctx.surface.target.DrawLine(
+		d2d1Point2F{float32(from.X), float32(from.Y)},
+		d2d1Point2F{float32(to.X), float32(to.Y)},
+		ctx.brushFor(pen.Color),
+		penWidth(pen))
+}
+
+// DrawRectangle draws the outline of bounds.
+func (ctx *D2DContext) DrawRectangle(pen SmoothPen, bounds RectangleF) {
+	rect := d2d1RectFFrom(bounds)
+	ctx.surface.target.DrawRectangle(&rect, ctx.brushFor(pen.Color), penWidth(pen))
+}
+
+// FillRectangle fills bounds with color.
+func (ctx *D2DContext) FillRectangle(color AlphaColor, bounds RectangleF) {
+	rect := d2d1RectFFrom(bounds)
+	ctx.surface.target.FillRectangle(&rect, ctx.brushFor(color))
+}
+
+// DrawRoundedRectangle draws the outline of bounds with corners rounded by
+// radius.
+func (ctx *D2DContext) DrawRoundedRectangle(pen SmoothPen, bounds RectangleF, radius float64) {
+	rr := d2d1RoundedRect{d2d1RectFFrom(bounds), float32(radius), float32(radius)}
+	ctx.surface.target.DrawRoundedRectangle(&rr, ctx.brushFor(pen.Color), penWidth(pen))
+}
+
+// FillRoundedRectangle fills bounds with corners rounded by radius with color.
+func (ctx *D2DContext) FillRoundedRectangle(color AlphaColor, bounds RectangleF, radius float64) {
+	rr := d2d1RoundedRect{d2d1RectFFrom(bounds), float32(radius), float32(radius)}
+	ctx.surface.target.FillRoundedRectangle(&rr, ctx.brushFor(color))
+}
+
+func d2d1EllipseFrom(bounds RectangleF) d2d1Ellipse {
+	return d2d1Ellipse{
+		Point:   d2d1Point2F{float32(bounds.X + bounds.Width/2), float32(bounds.Y + bounds.Height/2)},
+		RadiusX: float32(bounds.Width / 2),
+		RadiusY: float32(bounds.Height / 2),
+	}
+}
+
+// DrawEllipse draws the outline of the ellipse fitting into bounds.
+func (ctx *D2DContext) DrawEllipse(pen SmoothPen, bounds RectangleF) {
+	ellipse := d2d1EllipseFrom(bounds)
+	ctx.surface.target.DrawEllipse(&ellipse, ctx.brushFor(pen.Color), penWidth(pen))
+}
+
+// FillEllipse fills the ellipse fitting into bounds with color.
+func (ctx *D2DContext) FillEllipse(color AlphaColor, bounds RectangleF) {
+	ellipse := d2d1EllipseFrom(bounds)
+	ctx.surface.target.FillEllipse(&ellipse, ctx.brushFor(color))
+}
+
+// DrawPolyline draws lines connecting points, e.g. the series of a chart.
+func (ctx *D2DContext) DrawPolyline(pen SmoothPen, points []PointF) error {
+	return ctx.withPathGeometry(points, false, func(pg *id2d1PathGeometry) {
+		ctx.surface.target.DrawGeometry(pg, ctx.brushFor(pen.Color), penWidth(pen))
+	})
+}
+
+// DrawPolygon draws the outline of the polygon with corners points.
+func (ctx *D2DContext) DrawPolygon(pen SmoothPen, points []PointF) error {
+	return ctx.withPathGeometry(points, true, func(pg *id2d1PathGeometry) {
+		ctx.surface.target.DrawGeometry(pg, ctx.brushFor(pen.Color), penWidth(pen))
+	})
+}
+
+// FillPolygon fills the polygon with corners points with color.
+func (ctx *D2DContext) FillPolygon(color AlphaColor, points []PointF) error {
+	return ctx.withPathGeometry(points, true, func(pg *id2d1PathGeometry) {
+		ctx.surface.target.FillGeometry(pg, ctx.brushFor(color))
+	})
+}
+
+// withPathGeometry calls f with a path geometry of lines connecting points.
+func (ctx *D2DContext) withPathGeometry(points []PointF, closed bool, f func(pg *id2d1PathGeometry)) error {
+	if len(points) < 2 {
+		return nil
+	}
+
+	factory, err := d2dFactory()
+	if err != nil {
+		return err
+	}
+
+	var pg *id2d1PathGeometry
+	if hr := factory.CreatePathGeometry(&pg); win.FAILED(hr) {
+		return errorFromHRESULT("ID2D1Factory.CreatePathGeometry", hr)
+	}
+	defer pg.Release()
+
+	var sink *id2d1GeometrySink
+	if hr := pg.Open(&sink); win.FAILED(hr) {
+		return errorFromHRESULT("ID2D1PathGeometry.Open", hr)
+	}
+	defer sink.Release()
+
+	d2dPoints := make([]d2d1Point2F, len(points))
+	for i, p := range points {
+		d2dPoints[i] = d2d1Point2F{float32(p.X), float32(p.Y)}
+	}
+
+	begin, end := uint32(d2d1FigureBeginHollow), uint32(d2d1FigureEndOpen)
+	if closed {
+		begin, end = d2d1FigureBeginFilled, d2d1FigureEndClosed
+	}
+
+	sink.BeginFigure(d2dPoints[0], begin)
+	sink.AddLines(&d2dPoints[1], uint32(len(d2dPoints)-1))
+	sink.EndFigure(end)
+
+	if hr := sink.Close(); win.FAILED(hr) {
+		return errorFromHRESULT("ID2D1GeometrySink.Close", hr)
+	}
+
+	f(pg)
+
+	return nil
+}
+
+// DrawText draws text in font and color into bounds. The format flags are
+// supported like by D2DTextLayout.
+func (ctx *D2DContext) DrawText(text string, font *Font, color AlphaColor, bounds RectangleF, format DrawTextFormat) error {
+	layout, err := NewD2DTextLayout(text, font, format, bounds.Width, bounds.Height)
+	if err != nil {
+		return err
+	}
+	defer layout.Dispose()
+
+	ctx.DrawTextLayout(layout, PointF{bounds.X, bounds.Y}, color)
+
+	return nil
+}
+
+// DrawTextLayout draws layout in color with its top left corner at origin.
+func (ctx *D2DContext) DrawTextLayout(layout *D2DTextLayout, origin PointF, color AlphaColor) {
+	var options uint32 = d2d1DrawTextOptionsNone
+	if layout.clip {
+		options = d2d1DrawTextOptionsClip
+	}
+
+	ctx.surface.target.DrawTextLayout(d2d1Point2F{float32(origin.X), float32(origin.Y)}, layout.layout, ctx.brushFor(color), options)
+}
+
+// D2DTextLayout is text laid out by DirectWrite for drawing with
+// D2DContext.DrawTextLayout. Laying out text once and drawing it in every
+// paint is faster than drawing it with D2DContext.DrawText.
+type D2DTextLayout struct {
+	layout *idWriteTextLayout
+	clip   bool
+}
+
+// NewD2DTextLayout returns text in font laid out into a box of maxWidth and
+// maxHeight in 1/96" units.
+//
+// Of the format flags, the horizontal and vertical alignment,
+// TextSingleLine, TextWordbreak, TextNoClip, TextEndEllipsis,
+// TextWordEllipsis and TextRTLReading are supported.
+func NewD2DTextLayout(text string, font *Font, format DrawTextFormat, maxWidth, maxHeight float64) (*D2DTextLayout, error) {
+	factory, err := dwriteFactory()
+	if err != nil {
+		return nil, err
+	}
+
+	textFormat, err := newDWriteTextFormat(factory, font, format)
+	if err != nil {
+		return nil, err
+	}
+	defer textFormat.Release()
+
+	text16, err := syscall.UTF16FromString(text)
+	if err != nil {
+		return nil, err
+	}
+	length := uint32(len(text16) - 1)
+
+	l := &D2DTextLayout{clip: format&TextNoClip == 0}
+
+	if hr := factory.CreateTextLayout(&text16[0], length, textFormat, float32(maxWidth), float32(maxHeight), &l.layout); win.FAILED(hr) {
+		return nil, errorFromHRESULT("IDWriteFactory.CreateTextLayout", hr)
+	}
+
+	textRange := dwriteTextRange{0, length}
+
+	if font.Underline() {
+		if hr := l.layout.SetUnderline(true, textRange); win.FAILED(hr) {
+			l.Dispose()
+			return nil, errorFromHRESULT("IDWriteTextLayout.SetUnderline", hr)
+		}
+	}
+
+	if font.StrikeOut() {
+		if hr := l.layout.SetStrikethrough(true, textRange); win.FAILED(hr) {
+			l.Dispose()
+			return nil, errorFromHRESULT("IDWriteTextLayout.SetStrikethrough", hr)
+		}
+	}
+
+	return l, nil
+}
+
+// Dispose releases the DirectWrite text layout.
+func (l *D2DTextLayout) Dispose() {
+	if l.layout != nil {
+		l.layout.Release()
+		l.layout = nil
+	}
+}
+
+// Bounds returns the bounds of the laid out text in 1/96" units, relative to
+// the origin it is drawn at.
+func (l *D2DTextLayout) Bounds() RectangleF {
+	var m dwriteTextMetrics
+	if hr := l.layout.GetMetrics(&m); win.FAILED(hr) {
+		return RectangleF{}
+	}
+
+	return RectangleF{float64(m.Left), float64(m.Top), float64(m.Width), float64(m.Height)}
+}
+
+// LineCount returns the number of lines of the laid out text.
+func (l *D2DTextLayout) LineCount() int {
+	var m dwriteTextMetrics
+	if hr := l.layout.GetMetrics(&m); win.FAILED(hr) {
+		return 0
+	}
+
+	return int(m.LineCount)
+}
+
+// newDWriteTextFormat returns a DirectWrite text format for font, that lays out
+// text according to format.
+func newDWriteTextFormat(factory *idWriteFactory, font *Font, format DrawTextFormat) (*idWriteTextFormat, error) {
+	var weight uint32 = dwriteFontWeightNormal
+	if font.Bold() {
+		weight = dwriteFontWeightBold
+	}
+
+	var style uint32 = dwriteFontStyleNormal
+	if font.Italic() {
+		style = dwriteFontStyleItalic
+	}
+
+	// DirectWrite sizes fonts in 1/96" units.
+	size := float32(font.PointSize()) * 96 / 72
+
+	var tf *idWriteTextFormat
+	if hr := factory.CreateTextFormat(syscall.StringToUTF16Ptr(font.Family()), weight, style, dwriteFontStretchNormal, size, syscall.StringToUTF16Ptr(""), &tf); win.FAILED(hr) {
+		return nil, errorFromHRESULT("IDWriteFactory.CreateTextFormat", hr)
+	}
+
+	var alignment uint32 = dwriteTextAlignmentLeading
+	switch {
+	case format&TextCenter != 0:
+		alignment = dwriteTextAlignmentCenter
+
+	case format&TextRight != 0:
+		alignment = dwriteTextAlignmentTrailing
+	}
+
+	var paragraphAlignment uint32 = dwriteParagraphAlignmentNear
+	switch {
+	case format&TextVCenter != 0:
+		paragraphAlignment = dwriteParagraphAlignmentCenter
+
+	case format&TextBottom != 0:
+		paragraphAlignment = dwriteParagraphAlignmentFar
+	}
+
+	var wordWrapping uint32 = dwriteWordWrappingWrap
+	if format&TextWordbreak == 0 || format&TextSingleLine != 0 {
+		wordWrapping = dwriteWordWrappingNoWrap
+	}
+
+	for _, set := range []struct {
+		method string
+		hr     win.HRESULT
+	}{
+		{"SetTextAlignment", tf.SetTextAlignment(alignment)},
+		{"SetParagraphAlignment", tf.SetParagraphAlignment(paragraphAlignment)},
+		{"SetWordWrapping", tf.SetWordWrapping(wordWrapping)},
+	} {
+		if win.FAILED(set.hr) {
+			tf.Release()
+			return nil, errorFromHRESULT("IDWriteTextFormat."+set.method, set.hr)
+		}
+	}
+
+	if format&TextRTLReading != 0 {
+		if hr := tf.SetReadingDirection(dwriteReadingDirectionRightToLeft); win.FAILED(hr) {
+			tf.Release()
+			return nil, errorFromHRESULT("IDWriteTextFormat.SetReadingDirection", hr)
+		}
+	}
+
+	var granularity uint32 = dwriteTrimmingGranularityNone
+	switch {
+	case format&TextEndEllipsis != 0:
+		granularity = dwriteTrimmingGranularityCharacter
+
+	case format&TextWordEllipsis != 0:
+		granularity = dwriteTrimmingGranularityWord
+	}
+
+	if granularity != dwriteTrimmingGranularityNone {
+		var sign *idWriteInlineObject
+		if hr := factory.CreateEllipsisTrimmingSign(tf, &sign); win.FAILED(hr) {
+			tf.Release()
+			return nil, errorFromHRESULT("IDWriteFactory.CreateEllipsisTrimmingSign", hr)
+		}
+		defer sign.Release()
+
+		trimming := dwriteTrimming{Granularity: granularity}
+		if hr := tf.SetTrimming(&trimming, sign); win.FAILED(hr) {
+			tf.Release()
+			return nil, errorFromHRESULT("IDWriteTextFormat.SetTrimming", hr)
+		}
+	}
+
+	return tf, nil
+}
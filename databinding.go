@@ -33,6 +33,7 @@ type DataBinder struct {
 	errorPresenter             ErrorPresenter
 	dataSourceChangedPublisher EventPublisher
 	canSubmitChangedPublisher  EventPublisher
+	dirtyChangedPublisher      EventPublisher
 	submittedPublisher         EventPublisher
 	resetPublisher             EventPublisher
 	autoSubmitDelay            time.Duration
@@ -161,10 +162,18 @@ func (db *DataBinder) SetBoundWidgets(boundWidgets []Widget) {
 			db.property2Widget[prop] = widget
 
 			db.property2ChangedHandle[prop] = prop.Changed().Attach(func() {
-				db.dirty = true
+				// Changes, that are submitted right away, do not make the
+				// DataBinder dirty, so DirtyChanged is not published twice.
+				markDirty := func() {
+					if !db.inReset {
+						db.SetDirty(true)
+					}
+				}
 
 				if db.autoSubmit && !db.autoSubmitSuspended {
 					if db.autoSubmitDelay > 0 {
+						markDirty()
+
 						if db.autoSubmitTimer == nil {
 							db.autoSubmitTimer = time.AfterFunc(db.autoSubmitDelay, func() {
 								widget.Synchronize(func() {
@@ -178,16 +187,22 @@ func (db *DataBinder) SetBoundWidgets(boundWidgets []Widget) {
 						v := reflect.ValueOf(db.dataSource)
 						field := db.fieldBoundToProperty(v, prop)
 						if field == nil {
+							markDirty()
 							return
 						}
 
 						if err := db.submitProperty(prop, field); err != nil {
+							markDirty()
 							return
 						}
 
+						db.SetDirty(false)
+
 						db.submittedPublisher.Publish()
 					}
 				} else {
+					markDirty()
+
 					if !db.inReset {
 						db.validateProperties()
 					}
@@ -324,7 +339,7 @@ func (db *DataBinder) Reset() error {
 
 	db.validateProperties()
 
-	db.dirty = false
+	db.SetDirty(false)
 
 	db.resetPublisher.Publish()
 
@@ -346,7 +361,7 @@ func (db *DataBinder) Submit() error {
 		return err
 	}
 
-	db.dirty = false
+	db.SetDirty(false)
 
 	db.submittedPublisher.Publish()
 
@@ -357,6 +372,24 @@ func (db *DataBinder) Dirty() bool {
 	return db.dirty
 }
 
+// SetDirty marks the DataBinder as having changes that were not submitted
+// yet, or not. It is set automatically when a bound property changes and
+// cleared by Submit and Reset.
+func (db *DataBinder) SetDirty(dirty bool) {
+	if dirty == db.dirty {
+		return
+	}
+
+	db.dirty = dirty
+
+	db.dirtyChangedPublisher.Publish()
+}
+
+// DirtyChanged returns the event that is published when Dirty changed.
+func (db *DataBinder) DirtyChanged() *Event {
+	return db.dirtyChangedPublisher.Event()
+}
+
 func (db *DataBinder) submitProperty(prop Property, field DataField) error {
 	if !field.CanSet() {
 		// FIXME: handle properly
@@ -0,0 +1,541 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const dataTerminalHexBytesPerLine = 16
+
+type dataTerminalDirection int
+
+const (
+	dataTerminalNone dataTerminalDirection = iota
+	dataTerminalReceived
+	dataTerminalSent
+)
+
+// DataTerminal is a composite for device tools, that displays data received
+// from an io.ReadWriteCloser, e.g. a serial port, and allows to send data to
+// it.
+//
+// Data can be displayed and entered either as text or as hexadecimal bytes.
+// Sent input is kept in a history, that is available from the drop down list
+// of the input box.
+type DataTerminal struct {
+	*Composite
+	view                *TerminalView
+	inputComboBox       *ComboBox
+	hexCheckBox         *CheckBox
+	sendButton          *PushButton
+	port                io.ReadWriteCloser
+	portGeneration      int
+	writeChan           chan []byte
+	hex                 bool
+	echo                bool
+	lineEnding          string
+	sentTextColor       Color
+	sentParser          terminalParser
+	receivedRest        []byte
+	direction           dataTerminalDirection
+	hexColumn           int
+	lineOpen            bool
+	history             []string
+	maxHistory          int
+	bytesReceived       int64
+	bytesSent           int64
+	hexChangedPublisher EventPublisher
+	errorPublisher      ErrorEventPublisher
+}
+
+// NewDataTerminal creates and initializes a new DataTerminal.
+func NewDataTerminal(parent Container) (*DataTerminal, error) {
+	composite, err := NewCompositeWithStyle(parent, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &DataTerminal{
+		Composite:     composite,
+		echo:          true,
+		lineEnding:    "\r\n",
+		sentTextColor: RGB(97, 175, 239),
+		maxHistory:    50,
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			dt.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(dt); err != nil {
+		return nil, err
+	}
+
+	layout := NewVBoxLayout()
+	layout.SetMargins(Margins{})
+	if err := dt.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	if dt.view, err = NewTerminalView(dt); err != nil {
+		return nil, err
+	}
+
+	inputComposite, err := NewComposite(dt)
+	if err != nil {
+		return nil, err
+	}
+
+	inputLayout := NewHBoxLayout()
+	inputLayout.SetMargins(Margins{})
+	if err := inputComposite.SetLayout(inputLayout); err != nil {
+		return nil, err
+	}
+
+	if dt.inputComboBox, err = NewComboBox(inputComposite); err != nil {
+		return nil, err
+	}
+
+	dt.inputComboBox.KeyDown().Attach(func(key Key) {
+		if key == KeyReturn {
+			dt.sendInput()
+		}
+	})
+
+	if dt.hexCheckBox, err = NewCheckBox(inputComposite); err != nil {
+		return nil, err
+	}
+
+	if err := dt.hexCheckBox.SetText(tr("Hex", "walk")); err != nil {
+		return nil, err
+	}
+
+	dt.hexCheckBox.CheckedChanged().Attach(func() {
+		dt.SetHex(dt.hexCheckBox.Checked())
+	})
+
+	if dt.sendButton, err = NewPushButton(inputComposite); err != nil {
+		return nil, err
+	}
+
+	if err := dt.sendButton.SetText(tr("Send", "walk")); err != nil {
+		return nil, err
+	}
+
+	dt.sendButton.Clicked().Attach(dt.sendInput)
+
+	dt.MustRegisterProperty("Hex", NewBoolProperty(
+		func() bool {
+			return dt.Hex()
+		},
+		func(b bool) error {
+			dt.SetHex(b)
+			return nil
+		},
+		dt.hexChangedPublisher.Event()))
+
+	succeeded = true
+
+	return dt, nil
+}
+
+// TerminalView returns the *TerminalView that displays the data.
+func (dt *DataTerminal) TerminalView() *TerminalView {
+	return dt.view
+}
+
+// Port returns the io.ReadWriteCloser the DataTerminal communicates with.
+func (dt *DataTerminal) Port() io.ReadWriteCloser {
+	return dt.port
+}
+
+// SetPort sets the io.ReadWriteCloser the DataTerminal communicates with.
+//
+// Data is read from port on a separate goroutine until reading fails. A port
+// that was set before is not closed, use ClosePort for that.
+func (dt *DataTerminal) SetPort(port io.ReadWriteCloser) {
+	if dt.writeChan != nil {
+		close(dt.writeChan)
+		dt.writeChan = nil
+	}
+
+	dt.portGeneration++
+	dt.port = port
+	dt.receivedRest = nil
+
+	if port == nil {
+		return
+	}
+
+	dt.bytesReceived = 0
+	dt.bytesSent = 0
+
+	generation := dt.portGeneration
+	writeChan := make(chan []byte, 64)
+	dt.writeChan = writeChan
+
+	go dt.readPort(port, generation)
+	go dt.writePort(port, writeChan, generation)
+}
+
+// ClosePort closes the port of the DataTerminal, if any, and detaches it.
+func (dt *DataTerminal) ClosePort() error {
+	port := dt.port
+	if port == nil {
+		return nil
+	}
+
+	dt.SetPort(nil)
+
+	if err := port.Close(); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+func (dt *DataTerminal) readPort(port io.Reader, generation int) {
+	buf := make([]byte, 4096)
+
+	for {
+		n, err := port.Read(buf)
+
+		if n > 0 {
+			data := append([]byte(nil), buf[:n]...)
+
+			dt.Synchronize(func() {
+				if generation == dt.portGeneration {
+					dt.bytesReceived += int64(len(data))
+					dt.appendReceived(data)
+				}
+			})
+		}
+
+		if err != nil {
+			dt.Synchronize(func() {
+				if generation == dt.portGeneration {
+					if err != io.EOF {
+						dt.errorPublisher.Publish(wrapErrorNoPanic(err))
+					}
+
+					dt.SetPort(nil)
+				}
+			})
+
+			return
+		}
+	}
+}
+
+func (dt *DataTerminal) writePort(port io.Writer, writeChan chan []byte, generation int) {
+	for data := range writeChan {
+		if _, err := port.Write(data); err != nil {
+			dt.Synchronize(func() {
+				if generation == dt.portGeneration {
+					dt.errorPublisher.Publish(wrapErrorNoPanic(err))
+				}
+			})
+		}
+	}
+}
+
+// Hex returns whether data is displayed and entered as hexadecimal bytes.
+func (dt *DataTerminal) Hex() bool {
+	return dt.hex
+}
+
+// SetHex sets whether data is displayed and entered as hexadecimal bytes.
+func (dt *DataTerminal) SetHex(hex bool) {
+	if hex == dt.hex {
+		return
+	}
+
+	dt.hex = hex
+	dt.hexCheckBox.SetChecked(hex)
+
+	dt.startLine()
+	dt.receivedRest = nil
+
+	dt.hexChangedPublisher.Publish()
+}
+
+// HexChanged returns the event that is published when the Hex property
+// changed.
+func (dt *DataTerminal) HexChanged() *Event {
+	return dt.hexChangedPublisher.Event()
+}
+
+// Echo returns whether sent data is displayed.
+func (dt *DataTerminal) Echo() bool {
+	return dt.echo
+}
+
+// SetEcho sets whether sent data is displayed, using SentTextColor.
+func (dt *DataTerminal) SetEcho(echo bool) {
+	dt.echo = echo
+}
+
+// LineEnding returns the line ending that is appended to text input.
+func (dt *DataTerminal) LineEnding() string {
+	return dt.lineEnding
+}
+
+// SetLineEnding sets the line ending that is appended to text input. It is
+// not used for hexadecimal input.
+func (dt *DataTerminal) SetLineEnding(lineEnding string) {
+	dt.lineEnding = lineEnding
+}
+
+// SentTextColor returns the color of displayed sent data.
+func (dt *DataTerminal) SentTextColor() Color {
+	return dt.sentTextColor
+}
+
+// SetSentTextColor sets the color of displayed sent data.
+func (dt *DataTerminal) SetSentTextColor(c Color) {
+	dt.sentTextColor = c
+}
+
+// History returns the sent input, most recent first.
+func (dt *DataTerminal) History() []string {
+	return append([]string(nil), dt.history...)
+}
+
+// SetHistory sets the sent input, most recent first, e.g. to restore it from
+// settings.
+func (dt *DataTerminal) SetHistory(history []string) error {
+	if len(history) > dt.maxHistory {
+		history = history[:dt.maxHistory]
+	}
+
+	dt.history = append([]string(nil), history...)
+
+	return dt.updateHistory()
+}
+
+// MaxHistory returns the maximum number of history entries.
+func (dt *DataTerminal) MaxHistory() int {
+	return dt.maxHistory
+}
+
+// SetMaxHistory sets the maximum number of history entries.
+func (dt *DataTerminal) SetMaxHistory(maxHistory int) error {
+	if maxHistory < 0 {
+		maxHistory = 0
+	}
+
+	dt.maxHistory = maxHistory
+
+	if len(dt.history) > maxHistory {
+		return dt.SetHistory(dt.history)
+	}
+
+	return nil
+}
+
+func (dt *DataTerminal) updateHistory() error {
+	text := dt.inputComboBox.Text()
+
+	if err := dt.inputComboBox.SetModel(dt.History()); err != nil {
+		return err
+	}
+
+	return dt.inputComboBox.SetText(text)
+}
+
+// BytesReceived returns the number of bytes received from the current port.
+func (dt *DataTerminal) BytesReceived() int64 {
+	return dt.bytesReceived
+}
+
+// BytesSent returns the number of bytes sent to the current port.
+func (dt *DataTerminal) BytesSent() int64 {
+	return dt.bytesSent
+}
+
+// Error returns the event that is published when reading from or writing to
+// the port failed, or when input could not be parsed.
+func (dt *DataTerminal) Error() *ErrorEvent {
+	return dt.errorPublisher.Event()
+}
+
+// Clear removes all data from the display.
+func (dt *DataTerminal) Clear() {
+	dt.view.Clear()
+	dt.sentParser = terminalParser{}
+	dt.direction = dataTerminalNone
+	dt.hexColumn = 0
+	dt.lineOpen = false
+}
+
+// SendBytes writes data to the port.
+func (dt *DataTerminal) SendBytes(data []byte) error {
+	if dt.writeChan == nil {
+		return newError("no port")
+	}
+
+	data = append([]byte(nil), data...)
+
+	dt.writeChan <- data
+	dt.bytesSent += int64(len(data))
+
+	if dt.echo {
+		dt.appendSent(data)
+	}
+
+	return nil
+}
+
+// SendInput parses input according to the Hex property and writes the
+// resulting data to the port.
+//
+// Text input is sent followed by LineEnding. Hexadecimal input may separate
+// bytes by whitespace or commas and may prefix them with "0x".
+func (dt *DataTerminal) SendInput(input string) error {
+	var data []byte
+
+	if dt.hex {
+		var err error
+		if data, err = parseHexInput(input); err != nil {
+			return err
+		}
+	} else {
+		data = []byte(input + dt.lineEnding)
+	}
+
+	return dt.SendBytes(data)
+}
+
+func (dt *DataTerminal) sendInput() {
+	input := dt.inputComboBox.Text()
+	if input == "" {
+		return
+	}
+
+	if err := dt.SendInput(input); err != nil {
+		dt.errorPublisher.Publish(err)
+		return
+	}
+
+	history := []string{input}
+	for _, s := range dt.history {
+		if s != input {
+			history = append(history, s)
+		}
+	}
+
+	dt.inputComboBox.SetText("")
+	dt.SetHistory(history)
+}
+
+func parseHexInput(input string) ([]byte, error) {
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == ',' || r == '\r' || r == '\n'
+	})
+
+	var sb strings.Builder
+	for _, field := range fields {
+		field = strings.TrimPrefix(strings.TrimPrefix(field, "0x"), "0X")
+
+		if len(field)%2 == 1 {
+			field = "0" + field
+		}
+
+		sb.WriteString(field)
+	}
+
+	data, err := hex.DecodeString(sb.String())
+	if err != nil {
+		return nil, newError(fmt.Sprintf("invalid hexadecimal input: %s", input))
+	}
+
+	return data, nil
+}
+
+func (dt *DataTerminal) startLine() {
+	if dt.lineOpen {
+		dt.view.appendText(&dt.sentParser, "\n", dt.view.TextColor())
+	}
+
+	dt.direction = dataTerminalNone
+	dt.hexColumn = 0
+	dt.lineOpen = false
+}
+
+func (dt *DataTerminal) appendHex(data []byte, p *terminalParser, color Color) {
+	var sb strings.Builder
+
+	for _, b := range data {
+		if dt.hexColumn == dataTerminalHexBytesPerLine {
+			sb.WriteString("\n")
+			dt.hexColumn = 0
+		} else if dt.hexColumn > 0 {
+			sb.WriteString(" ")
+		}
+
+		fmt.Fprintf(&sb, "%02X", b)
+		dt.hexColumn++
+	}
+
+	dt.lineOpen = dt.hexColumn > 0
+
+	dt.view.appendText(p, sb.String(), color)
+}
+
+func (dt *DataTerminal) appendReceived(data []byte) {
+	if dt.direction != dataTerminalReceived {
+		dt.startLine()
+		dt.direction = dataTerminalReceived
+	}
+
+	if dt.hex {
+		dt.appendHex(data, &dt.view.outParser, dt.view.TextColor())
+		return
+	}
+
+	complete, rest := splitIncompleteUTF8(append(dt.receivedRest, data...))
+	dt.receivedRest = append([]byte(nil), rest...)
+
+	if len(complete) > 0 {
+		dt.view.AppendText(string(complete))
+		dt.lineOpen = complete[len(complete)-1] != '\n'
+	}
+}
+
+func (dt *DataTerminal) appendSent(data []byte) {
+	if dt.direction != dataTerminalSent {
+		dt.startLine()
+		dt.direction = dataTerminalSent
+	}
+
+	if dt.hex {
+		dt.appendHex(data, &dt.sentParser, dt.sentTextColor)
+		return
+	}
+
+	text := string(data)
+	dt.view.appendText(&dt.sentParser, text, dt.sentTextColor)
+	dt.lineOpen = !strings.HasSuffix(text, "\n")
+}
+
+func (dt *DataTerminal) Dispose() {
+	if dt.writeChan != nil {
+		close(dt.writeChan)
+		dt.writeChan = nil
+	}
+
+	dt.portGeneration++
+
+	dt.Composite.Dispose()
+}
@@ -249,14 +249,22 @@ func (*DateEdit) NeedsWmSize() bool {
 }
 
 func (de *DateEdit) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	idealSize := de.dialogBaseUnitsToPixels(Size{80, 12})
+
 	return &dateEditLayoutItem{
-		idealSize: de.dialogBaseUnitsToPixels(Size{80, 12}),
+		idealSize: idealSize,
+		baseline:  de.centeredTextBaseline(idealSize.Height),
 	}
 }
 
 type dateEditLayoutItem struct {
 	LayoutItemBase
 	idealSize Size // in native pixels
+	baseline  int  // in native pixels
+}
+
+func (li *dateEditLayoutItem) BaselineOffset() int {
+	return li.baseline
 }
 
 func (*dateEditLayoutItem) LayoutFlags() LayoutFlags {
@@ -7,6 +7,7 @@
 package declarative
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/lxn/walk"
@@ -27,6 +28,7 @@ type Action struct {
 	Shortcut    Shortcut
 	OnTriggered walk.EventHandler
 	Checkable   bool
+	Shield      bool
 }
 
 func (a Action) createAction(builder *Builder, menu *walk.Menu) (*walk.Action, error) {
@@ -57,6 +59,10 @@ func (a Action) createAction(builder *Builder, menu *walk.Menu) (*walk.Action, e
 		return nil, err
 	}
 
+	if err := action.SetShield(a.Shield); err != nil {
+		return nil, err
+	}
+
 	s := a.Shortcut
 	if err := action.SetShortcut(walk.Shortcut{s.Modifiers, s.Key}); err != nil {
 		return nil, err
@@ -212,3 +218,42 @@ func setActionBoolOrCondition(setBool func(bool) error, setCond func(walk.Condit
 
 	return nil
 }
+
+// MenuModel is a section of a menu, that is filled with actions for the items
+// of a walk.TreeModel and kept in sync with it.
+type MenuModel struct {
+	AssignTo        **walk.MenuModelBinding
+	Model           walk.TreeModel
+	OnItemTriggered walk.TreeItemEventHandler
+}
+
+func (mm MenuModel) createAction(builder *Builder, menu *walk.Menu) (*walk.Action, error) {
+	if menu == nil {
+		return nil, errors.New("MenuModel can only be used in menus")
+	}
+
+	// The invisible anchor keeps the place of the section in the menu.
+	anchor := walk.NewAction()
+	if err := anchor.SetVisible(false); err != nil {
+		return nil, err
+	}
+
+	if err := menu.Actions().Add(anchor); err != nil {
+		return nil, err
+	}
+
+	binding, err := walk.NewMenuModelBinding(menu, anchor, mm.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	if mm.OnItemTriggered != nil {
+		binding.ItemTriggered().Attach(mm.OnItemTriggered)
+	}
+
+	if mm.AssignTo != nil {
+		*mm.AssignTo = binding
+	}
+
+	return anchor, nil
+}
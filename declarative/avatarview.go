@@ -0,0 +1,93 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type AvatarView struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// AvatarView
+
+	AssignTo          **walk.AvatarView
+	AvatarSize        int
+	DisplayName       Property
+	FillColor         walk.Color
+	ImageSource       Property
+	OnImageLoadFailed walk.ErrorEventHandler
+	Presence          Property
+}
+
+func (av AvatarView) Create(builder *Builder) error {
+	w, err := walk.NewAvatarView(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if av.AssignTo != nil {
+		*av.AssignTo = w
+	}
+
+	return builder.InitWidget(av, w, func() error {
+		if av.AvatarSize > 0 {
+			w.SetAvatarSize(av.AvatarSize)
+		}
+
+		if av.FillColor != 0 {
+			w.SetFillColor(av.FillColor)
+		}
+
+		if av.OnImageLoadFailed != nil {
+			w.ImageLoadFailed().Attach(av.OnImageLoadFailed)
+		}
+
+		return nil
+	})
+}
@@ -246,6 +246,18 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 			return err
 		}
 
+		if margins := b.layoutMargins(); !margins.isZero() {
+			if err := widget.AsWidgetBase().SetLayoutMargins(margins.toW()); err != nil {
+				return err
+			}
+		}
+
+		if b.bool("PaintIsolated") {
+			// Isolated painting is an optimization, so we don't fail where
+			// it is not supported.
+			widget.AsWidgetBase().SetPaintIsolated(true)
+		}
+
 		if field := b.widgetValue.FieldByName("GraphicsEffects"); field.IsValid() {
 			for _, effect := range field.Interface().([]walk.WidgetGraphicsEffect) {
 				widget.GraphicsEffects().Add(effect)
@@ -253,6 +265,24 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 		}
 
 		if p := widget.Parent(); p != nil {
+			type ChildSizeLimiter interface {
+				SetChildMinSize(widget walk.Widget, size walk.Size) error
+				SetChildMaxSize(widget walk.Widget, size walk.Size) error
+			}
+
+			if l, ok := p.Layout().(ChildSizeLimiter); ok {
+				if size := b.size("LayoutMinSize"); size != (Size{}) {
+					if err := l.SetChildMinSize(widget, size.toW()); err != nil {
+						return err
+					}
+				}
+				if size := b.size("LayoutMaxSize"); size != (Size{}) {
+					if err := l.SetChildMaxSize(widget, size.toW()); err != nil {
+						return err
+					}
+				}
+			}
+
 			type SetStretchFactorer interface {
 				SetStretchFactor(widget walk.Widget, factor int) error
 			}
@@ -269,6 +299,20 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 					return err
 				}
 
+			case *walk.AbsoluteLayout:
+				if err := l.SetBounds(widget, b.rectangle("Bounds").toW()); err != nil {
+					return err
+				}
+
+				if err := l.SetAnchors(widget, b.anchors().toW()); err != nil {
+					return err
+				}
+
+			case *walk.DockLayout:
+				if err := l.SetDock(widget, walk.Dock(b.dock())); err != nil {
+					return err
+				}
+
 			case *walk.GridLayout:
 				csf := l.ColumnStretchFactor(column)
 				if csf < stretchFactor {
@@ -386,7 +430,11 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 			}
 		}
 
-		dataBinder := b.widgetValue.FieldByName("DataBinder").Interface().(DataBinder)
+		// Composite widgets like TransferList have no DataBinder field.
+		var dataBinder DataBinder
+		if val := b.widgetValue.FieldByName("DataBinder"); val.IsValid() {
+			dataBinder = val.Interface().(DataBinder)
+		}
 
 		if dataBinder.AssignTo != nil || dataBinder.DataSource != nil {
 			if dataB, err := dataBinder.create(); err != nil {
@@ -510,6 +558,16 @@ func (b *Builder) alignment() Alignment2D {
 	return AlignHVDefault
 }
 
+func (b *Builder) anchors() Anchors {
+	fieldValue := b.widgetValue.FieldByName("Anchors")
+
+	if fieldValue.IsValid() {
+		return fieldValue.Interface().(Anchors)
+	}
+
+	return 0
+}
+
 func (b *Builder) bool(fieldName string) bool {
 	fieldValue := b.widgetValue.FieldByName(fieldName)
 
@@ -520,6 +578,26 @@ func (b *Builder) bool(fieldName string) bool {
 	return false
 }
 
+func (b *Builder) layoutMargins() Margins {
+	fieldValue := b.widgetValue.FieldByName("LayoutMargins")
+
+	if fieldValue.IsValid() {
+		return fieldValue.Interface().(Margins)
+	}
+
+	return Margins{}
+}
+
+func (b *Builder) dock() Dock {
+	fieldValue := b.widgetValue.FieldByName("Dock")
+
+	if fieldValue.IsValid() {
+		return fieldValue.Interface().(Dock)
+	}
+
+	return DockFill
+}
+
 func (b *Builder) eventHandler(fieldName string) walk.EventHandler {
 	fieldValue := b.widgetValue.FieldByName(fieldName)
 
@@ -580,6 +658,16 @@ func (b *Builder) mouseEventHandler(fieldName string) walk.MouseEventHandler {
 	return nil
 }
 
+func (b *Builder) rectangle(fieldName string) Rectangle {
+	fieldValue := b.widgetValue.FieldByName(fieldName)
+
+	if fieldValue.IsValid() {
+		return fieldValue.Interface().(Rectangle)
+	}
+
+	return Rectangle{}
+}
+
 func (b *Builder) size(fieldName string) Size {
 	fieldValue := b.widgetValue.FieldByName(fieldName)
 
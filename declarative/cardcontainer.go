@@ -0,0 +1,134 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"errors"
+
+	"github.com/lxn/walk"
+)
+
+type CardContainer struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// CardContainer
+
+	AssignTo              **walk.CardContainer
+	CurrentIndex          Property
+	Margins               Margins
+	OnCurrentIndexChanged walk.EventHandler
+	Pages                 []Widget
+
+	// Lazy makes all pages but the first be created when they become current
+	// for the first time. Lazy pages are created with their own Builder, so
+	// they cannot refer to names or data binders outside of them.
+	Lazy bool
+}
+
+func (cc CardContainer) Create(builder *Builder) error {
+	w, err := walk.NewCardContainer(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if cc.AssignTo != nil {
+		*cc.AssignTo = w
+	}
+
+	return builder.InitWidget(cc, w, func() error {
+		if err := w.Layout().SetMargins(cc.Margins.toW()); err != nil {
+			return err
+		}
+
+		for i, page := range cc.Pages {
+			if cc.Lazy && i > 0 {
+				page := page
+
+				if _, err := w.AddLazyPage(func(parent walk.Container) (walk.Widget, error) {
+					return createLazyCardPage(page, parent)
+				}); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if err := page.Create(builder); err != nil {
+				return err
+			}
+
+			children := w.Children()
+			if _, err := w.AddPage(children.At(children.Len() - 1)); err != nil {
+				return err
+			}
+		}
+
+		if cc.OnCurrentIndexChanged != nil {
+			w.CurrentIndexChanged().Attach(cc.OnCurrentIndexChanged)
+		}
+
+		return nil
+	})
+}
+
+func createLazyCardPage(page Widget, parent walk.Container) (walk.Widget, error) {
+	builder := NewBuilder(parent)
+
+	children := parent.Children()
+	count := children.Len()
+
+	if err := page.Create(builder); err != nil {
+		return nil, err
+	}
+
+	if children.Len() == count {
+		return nil, errors.New("CardContainer: page declaration created no widget")
+	}
+
+	return children.At(children.Len() - 1), nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type ChatView struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	PaintIsolated      bool
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// ChatView
+
+	AssignTo                 **walk.ChatView
+	Messages                 []walk.ChatMessage
+	OnLinkClicked            walk.StringEventHandler
+	OnNewMessageCountChanged walk.EventHandler
+}
+
+func (cv ChatView) Create(builder *Builder) error {
+	w, err := walk.NewChatView(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if cv.AssignTo != nil {
+		*cv.AssignTo = w
+	}
+
+	return builder.InitWidget(cv, w, func() error {
+		if cv.Messages != nil {
+			w.SetMessages(cv.Messages)
+		}
+
+		if cv.OnLinkClicked != nil {
+			w.LinkClicked().Attach(cv.OnLinkClicked)
+		}
+
+		if cv.OnNewMessageCountChanged != nil {
+			w.NewMessageCountChanged().Attach(cv.OnNewMessageCountChanged)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"errors"
+
+	"github.com/lxn/walk"
+)
+
+type CheckedListBox struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// CheckedListBox
+
+	AssignTo                **walk.CheckedListBox
+	BindingMember           string
+	CheckedIndexes          []int
+	CurrentIndex            Property
+	DisplayMember           string
+	Format                  string
+	Model                   interface{}
+	OnCheckedIndexesChanged walk.EventHandler
+	OnCurrentIndexChanged   walk.EventHandler
+	OnItemActivated         walk.EventHandler
+	Precision               int
+	TriState                bool
+	Value                   Property
+}
+
+func (clb CheckedListBox) Create(builder *Builder) error {
+	if _, ok := clb.Model.([]string); ok &&
+		(clb.BindingMember != "" || clb.DisplayMember != "") {
+
+		return errors.New("CheckedListBox.Create: BindingMember and DisplayMember must be empty for []string models.")
+	}
+
+	w, err := walk.NewCheckedListBox(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if clb.AssignTo != nil {
+		*clb.AssignTo = w
+	}
+
+	return builder.InitWidget(clb, w, func() error {
+		w.SetFormat(clb.Format)
+		w.SetPrecision(clb.Precision)
+		w.SetTriState(clb.TriState)
+
+		if err := w.SetBindingMember(clb.BindingMember); err != nil {
+			return err
+		}
+		if err := w.SetDisplayMember(clb.DisplayMember); err != nil {
+			return err
+		}
+
+		if err := w.SetModel(clb.Model); err != nil {
+			return err
+		}
+
+		if len(clb.CheckedIndexes) > 0 {
+			if err := w.SetCheckedIndexes(clb.CheckedIndexes); err != nil {
+				return err
+			}
+		}
+
+		if clb.OnCheckedIndexesChanged != nil {
+			w.CheckedIndexesChanged().Attach(clb.OnCheckedIndexesChanged)
+		}
+		if clb.OnCurrentIndexChanged != nil {
+			w.CurrentIndexChanged().Attach(clb.OnCurrentIndexChanged)
+		}
+		if clb.OnItemActivated != nil {
+			w.ItemActivated().Attach(clb.OnItemActivated)
+		}
+
+		return nil
+	})
+}
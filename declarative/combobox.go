@@ -36,16 +36,24 @@ type ComboBox struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -57,14 +65,18 @@ type ComboBox struct {
 	CurrentIndex          Property
 	DisplayMember         string
 	Editable              bool
+	FilterAsYouType       bool
 	Format                string
 	MaxLength             int
+	MaxVisibleItems       int
 	Model                 interface{}
 	OnCurrentIndexChanged walk.EventHandler
 	OnEditingFinished     walk.EventHandler
 	OnTextChanged         walk.EventHandler
+	OwnerDraw             bool
 	Precision             int
 	Value                 Property
+	Virtual               bool
 }
 
 func (cb ComboBox) Create(builder *Builder) error {
@@ -76,7 +88,12 @@ func (cb ComboBox) Create(builder *Builder) error {
 
 	var w *walk.ComboBox
 	var err error
-	if cb.Editable {
+	if cb.OwnerDraw || cb.Virtual {
+		w, err = walk.NewComboBoxWithCfg(builder.Parent(), &walk.ComboBoxCfg{
+			Editable: cb.Editable,
+			Virtual:  cb.Virtual,
+		})
+	} else if cb.Editable {
 		w, err = walk.NewComboBox(builder.Parent())
 	} else {
 		w, err = walk.NewDropDownBox(builder.Parent())
@@ -94,6 +111,13 @@ func (cb ComboBox) Create(builder *Builder) error {
 		w.SetFormat(cb.Format)
 		w.SetPrecision(cb.Precision)
 		w.SetMaxLength(cb.MaxLength)
+		w.SetFilterAsYouType(cb.FilterAsYouType)
+
+		if cb.MaxVisibleItems > 0 {
+			if err := w.SetMaxVisibleItems(cb.MaxVisibleItems); err != nil {
+				return err
+			}
+		}
 
 		if err := w.SetBindingMember(cb.BindingMember); err != nil {
 			return err
@@ -33,16 +33,24 @@ type Composite struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -13,9 +13,10 @@ import (
 type PaintMode int
 
 const (
-	PaintNormal   PaintMode = iota // erase background before PaintFunc
-	PaintNoErase                   // PaintFunc clears background, single buffered
-	PaintBuffered                  // PaintFunc clears background, double buffered
+	PaintNormal         PaintMode = iota // erase background before PaintFunc
+	PaintNoErase                         // PaintFunc clears background, single buffered
+	PaintBuffered                        // PaintFunc clears background, double buffered
+	PaintDoubleBuffered                  // erase background before PaintFunc, both double buffered
 )
 
 type CustomWidget struct {
@@ -40,16 +41,25 @@ type CustomWidget struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	PaintIsolated      bool
 	Row                int
 	RowSpan            int
 	StretchFactor      int
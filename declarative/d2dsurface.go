@@ -0,0 +1,75 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type D2DSurface struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// AvatarView
+	// D2DSurface
+
+	AssignTo **walk.D2DSurface
+	Paint    walk.D2DPaintFunc
+}
+
+func (ds D2DSurface) Create(builder *Builder) error {
+	w, err := walk.NewD2DSurface(builder.Parent(), ds.Paint)
+	if err != nil {
+		return err
+	}
+
+	if ds.AssignTo != nil {
+		*ds.AssignTo = w
+	}
+
+	return builder.InitWidget(ds, w, nil)
+}
@@ -21,6 +21,7 @@ type DataBinder struct {
 	Name                string
 	OnCanSubmitChanged  walk.EventHandler
 	OnDataSourceChanged walk.EventHandler
+	OnDirtyChanged      walk.EventHandler
 	OnReset             walk.EventHandler
 	OnSubmitted         walk.EventHandler
 }
@@ -51,6 +52,9 @@ func (db DataBinder) create() (*walk.DataBinder, error) {
 	if db.OnDataSourceChanged != nil {
 		b.DataSourceChanged().Attach(db.OnDataSourceChanged)
 	}
+	if db.OnDirtyChanged != nil {
+		b.DirtyChanged().Attach(db.OnDirtyChanged)
+	}
 	if db.OnReset != nil {
 		b.ResetFinished().Attach(db.OnReset)
 	}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type DataTerminal struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// DataTerminal
+
+	AssignTo   **walk.DataTerminal
+	Hex        Property
+	LineEnding string
+	MaxHistory int
+	MaxLines   int
+	OnError    walk.ErrorEventHandler
+}
+
+func (dt DataTerminal) Create(builder *Builder) error {
+	w, err := walk.NewDataTerminal(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if dt.AssignTo != nil {
+		*dt.AssignTo = w
+	}
+
+	return builder.InitWidget(dt, w, func() error {
+		if dt.LineEnding != "" {
+			w.SetLineEnding(dt.LineEnding)
+		}
+
+		if dt.MaxHistory > 0 {
+			if err := w.SetMaxHistory(dt.MaxHistory); err != nil {
+				return err
+			}
+		}
+
+		if dt.MaxLines > 0 {
+			w.TerminalView().SetMaxLines(dt.MaxLines)
+		}
+
+		if dt.OnError != nil {
+			w.Error().Attach(dt.OnError)
+		}
+
+		return nil
+	})
+}
@@ -31,9 +31,12 @@ type Dialog struct {
 	OnMouseUp          walk.MouseEventHandler
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
+	RightToLeft        bool
 	RightToLeftLayout  bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Container
@@ -44,6 +47,7 @@ type Dialog struct {
 
 	// Form
 
+	AutoSize    bool
 	Expressions func() map[string]walk.Expression
 	Functions   map[string]func(args ...interface{}) (interface{}, error)
 	Icon        Property
@@ -125,6 +129,10 @@ func (d Dialog) Create(owner walk.Form) error {
 		return err
 	}
 
+	if err := w.SetRightToLeft(d.RightToLeft); err != nil {
+		return err
+	}
+
 	return builder.InitWidget(fi, w, func() error {
 		if d.Size.Width > 0 && d.Size.Height > 0 {
 			if err := w.SetSize(d.Size.toW()); err != nil {
@@ -132,6 +140,8 @@ func (d Dialog) Create(owner walk.Form) error {
 			}
 		}
 
+		w.SetAutoSize(d.AutoSize)
+
 		if d.DefaultButton != nil {
 			if err := w.SetDefaultButton(*d.DefaultButton); err != nil {
 				return err
@@ -181,3 +191,54 @@ func (d Dialog) Run(owner walk.Form) (int, error) {
 
 	return (*d.AssignTo).Run(), nil
 }
+
+// ShowAsync creates the dialog and shows it like walk.Dialog.ShowAsync does.
+//
+// If owner is not nil, ShowAsync may be called from any goroutine, because
+// the dialog is then created on the UI thread of owner.
+func (d Dialog) ShowAsync(owner walk.Form) <-chan walk.DialogResult {
+	if owner == nil {
+		return d.showAsync(nil)
+	}
+
+	results := make(chan walk.DialogResult, 1)
+
+	owner.Synchronize(func() {
+		forwardDialogResults(d.showAsync(owner), results)
+	})
+
+	return results
+}
+
+func (d Dialog) showAsync(owner walk.Form) <-chan walk.DialogResult {
+	var w *walk.Dialog
+
+	if d.AssignTo == nil {
+		d.AssignTo = &w
+	}
+
+	if err := d.Create(owner); err != nil {
+		return dialogError(err)
+	}
+
+	return (*d.AssignTo).ShowAsync()
+}
+
+func dialogError(err error) <-chan walk.DialogResult {
+	results := make(chan walk.DialogResult, 1)
+
+	results <- walk.DialogResult{Err: err}
+	close(results)
+
+	return results
+}
+
+func forwardDialogResults(from <-chan walk.DialogResult, to chan<- walk.DialogResult) {
+	go func() {
+		for result := range from {
+			to <- result
+		}
+
+		close(to)
+	}()
+}
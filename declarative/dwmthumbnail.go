@@ -0,0 +1,92 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+	"github.com/lxn/win"
+)
+
+type DwmThumbnail struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// DwmThumbnail
+
+	AssignTo             **walk.DwmThumbnail
+	Source               win.HWND
+	SourceClientAreaOnly bool
+	SourceRect           Rectangle
+	StretchToFill        bool
+}
+
+func (dt DwmThumbnail) Create(builder *Builder) error {
+	w, err := walk.NewDwmThumbnail(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if dt.AssignTo != nil {
+		*dt.AssignTo = w
+	}
+
+	return builder.InitWidget(dt, w, func() error {
+		if err := w.SetKeepAspectRatio(!dt.StretchToFill); err != nil {
+			return err
+		}
+
+		if err := w.SetSourceClientAreaOnly(dt.SourceClientAreaOnly); err != nil {
+			return err
+		}
+
+		if err := w.SetSourceRect(dt.SourceRect.toW()); err != nil {
+			return err
+		}
+
+		return w.SetSource(dt.Source)
+	})
+}
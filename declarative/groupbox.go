@@ -32,16 +32,24 @@ type GroupBox struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -54,10 +62,13 @@ type GroupBox struct {
 
 	// GroupBox
 
-	AssignTo  **walk.GroupBox
-	Checkable bool
-	Checked   Property
-	Title     string
+	AssignTo           **walk.GroupBox
+	Checkable          bool
+	Checked            Property
+	Collapsible        bool
+	Collapsed          Property
+	OnCollapsedChanged walk.EventHandler
+	Title              string
 }
 
 func (gb GroupBox) Create(builder *Builder) error {
@@ -82,6 +93,11 @@ func (gb GroupBox) Create(builder *Builder) error {
 		}
 
 		w.SetCheckable(gb.Checkable)
+		w.SetCollapsible(gb.Collapsible)
+
+		if gb.OnCollapsedChanged != nil {
+			w.CollapsedChanged().Attach(gb.OnCollapsedChanged)
+		}
 
 		return nil
 	})
@@ -41,16 +41,24 @@ type Label struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -59,10 +67,13 @@ type Label struct {
 
 	AssignTo      **walk.Label
 	EllipsisMode  EllipsisMode
+	MaxLines      int
 	NoPrefix      bool
 	Text          Property
 	TextAlignment Alignment1D
 	TextColor     walk.Color
+	Wrap          bool
+	WrapEllipsis  bool
 }
 
 func (l Label) Create(builder *Builder) error {
@@ -91,6 +102,16 @@ func (l Label) Create(builder *Builder) error {
 
 		w.SetTextColor(l.TextColor)
 
+		if err := w.SetWrap(l.Wrap); err != nil {
+			return err
+		}
+		if err := w.SetMaxLines(l.MaxLines); err != nil {
+			return err
+		}
+		if err := w.SetWrapEllipsis(l.WrapEllipsis); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -70,12 +70,34 @@ func setLayoutSpacing(layout walk.Layout, spacing int, spacingZero bool) error {
 	return layout.SetSpacing(spacing)
 }
 
+// setLayoutHVSpacing sets the horizontal and vertical spacing of layout,
+// where they are > 0.
+func setLayoutHVSpacing(layout interface {
+	SetHorizontalSpacing(value int) error
+	SetVerticalSpacing(value int) error
+}, horizontal, vertical int) error {
+	if horizontal > 0 {
+		if err := layout.SetHorizontalSpacing(horizontal); err != nil {
+			return err
+		}
+	}
+
+	if vertical > 0 {
+		if err := layout.SetVerticalSpacing(vertical); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type HBox struct {
-	Margins     Margins
-	Alignment   Alignment2D
-	Spacing     int
-	MarginsZero bool
-	SpacingZero bool
+	Margins           Margins
+	Alignment         Alignment2D
+	Spacing           int
+	MarginsZero       bool
+	SpacingZero       bool
+	BaselineAlignment bool
 }
 
 func (hb HBox) Create() (walk.Layout, error) {
@@ -93,6 +115,8 @@ func (hb HBox) Create() (walk.Layout, error) {
 		return nil, err
 	}
 
+	l.SetBaselineAlignment(hb.BaselineAlignment)
+
 	return l, nil
 }
 
@@ -123,13 +147,21 @@ func (vb VBox) Create() (walk.Layout, error) {
 }
 
 type Grid struct {
-	Rows        int
-	Columns     int
-	Margins     Margins
-	Alignment   Alignment2D
-	Spacing     int
-	MarginsZero bool
-	SpacingZero bool
+	Rows              int
+	Columns           int
+	Margins           Margins
+	Alignment         Alignment2D
+	Spacing           int
+	HorizontalSpacing int // if > 0, replaces Spacing between columns
+	VerticalSpacing   int // if > 0, replaces Spacing between rows
+	MarginsZero       bool
+	SpacingZero       bool
+	RowSizeModes      []walk.GridSizeMode
+	ColumnSizeModes   []walk.GridSizeMode
+	RowSpacings       map[int]int // spacing below a row
+	ColumnSpacings    map[int]int // spacing right of a column
+	BaselineAlignment bool
+	OverlapPolicy     walk.GridOverlapPolicy
 }
 
 func (g Grid) Create() (walk.Layout, error) {
@@ -139,6 +171,25 @@ func (g Grid) Create() (walk.Layout, error) {
 
 	l := walk.NewGridLayout()
 
+	if err := l.SetColumns(g.Columns); err != nil {
+		return nil, err
+	}
+
+	l.SetBaselineAlignment(g.BaselineAlignment)
+	l.SetOverlapPolicy(g.OverlapPolicy)
+
+	for row, mode := range g.RowSizeModes {
+		if err := l.SetRowSizeMode(row, mode); err != nil {
+			return nil, err
+		}
+	}
+
+	for col, mode := range g.ColumnSizeModes {
+		if err := l.SetColumnSizeMode(col, mode); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := setLayoutMargins(l, g.Margins, g.MarginsZero); err != nil {
 		return nil, err
 	}
@@ -147,6 +198,22 @@ func (g Grid) Create() (walk.Layout, error) {
 		return nil, err
 	}
 
+	if err := setLayoutHVSpacing(l, g.HorizontalSpacing, g.VerticalSpacing); err != nil {
+		return nil, err
+	}
+
+	for row, spacing := range g.RowSpacings {
+		if err := l.SetRowSpacing(row, spacing); err != nil {
+			return nil, err
+		}
+	}
+
+	for col, spacing := range g.ColumnSpacings {
+		if err := l.SetColumnSpacing(col, spacing); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := l.SetAlignment(walk.Alignment2D(g.Alignment)); err != nil {
 		return nil, err
 	}
@@ -155,11 +222,13 @@ func (g Grid) Create() (walk.Layout, error) {
 }
 
 type Flow struct {
-	Margins     Margins
-	Alignment   Alignment2D
-	Spacing     int
-	MarginsZero bool
-	SpacingZero bool
+	Margins           Margins
+	Alignment         Alignment2D
+	Spacing           int
+	HorizontalSpacing int // if > 0, replaces Spacing between the widgets of a line
+	VerticalSpacing   int // if > 0, replaces Spacing between lines
+	MarginsZero       bool
+	SpacingZero       bool
 }
 
 func (f Flow) Create() (walk.Layout, error) {
@@ -173,9 +242,219 @@ func (f Flow) Create() (walk.Layout, error) {
 		return nil, err
 	}
 
+	if err := setLayoutHVSpacing(l, f.HorizontalSpacing, f.VerticalSpacing); err != nil {
+		return nil, err
+	}
+
 	if err := l.SetAlignment(walk.Alignment2D(f.Alignment)); err != nil {
 		return nil, err
 	}
 
 	return l, nil
 }
+
+type Form struct {
+	Margins           Margins
+	LabelAlignment    Alignment1D
+	Spacing           int
+	HorizontalSpacing int // if > 0, replaces Spacing between labels and fields
+	VerticalSpacing   int // if > 0, replaces Spacing between rows
+	MarginsZero       bool
+	SpacingZero       bool
+	BaselineAlignment bool
+}
+
+func (f Form) Create() (walk.Layout, error) {
+	l := walk.NewFormLayout()
+
+	if err := setLayoutMargins(l, f.Margins, f.MarginsZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutSpacing(l, f.Spacing, f.SpacingZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutHVSpacing(l, f.HorizontalSpacing, f.VerticalSpacing); err != nil {
+		return nil, err
+	}
+
+	if err := l.SetLabelAlignment(walk.Alignment1D(f.LabelAlignment)); err != nil {
+		return nil, err
+	}
+
+	l.SetBaselineAlignment(f.BaselineAlignment)
+
+	return l, nil
+}
+
+type Dock int
+
+const (
+	DockFill   = Dock(walk.DockFill)
+	DockTop    = Dock(walk.DockTop)
+	DockBottom = Dock(walk.DockBottom)
+	DockLeft   = Dock(walk.DockLeft)
+	DockRight  = Dock(walk.DockRight)
+)
+
+// DockLayout docks the widgets to the edges of the container in the order of
+// the widgets, according to their Dock field.
+type DockLayout struct {
+	Margins           Margins
+	Spacing           int
+	HorizontalSpacing int // if > 0, replaces Spacing next to left and right docked widgets
+	VerticalSpacing   int // if > 0, replaces Spacing next to top and bottom docked widgets
+	MarginsZero       bool
+	SpacingZero       bool
+}
+
+func (dl DockLayout) Create() (walk.Layout, error) {
+	l := walk.NewDockLayout()
+
+	if err := setLayoutMargins(l, dl.Margins, dl.MarginsZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutSpacing(l, dl.Spacing, dl.SpacingZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutHVSpacing(l, dl.HorizontalSpacing, dl.VerticalSpacing); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+type Anchors byte
+
+const (
+	AnchorLeft   = Anchors(walk.AnchorLeft)
+	AnchorTop    = Anchors(walk.AnchorTop)
+	AnchorRight  = Anchors(walk.AnchorRight)
+	AnchorBottom = Anchors(walk.AnchorBottom)
+
+	// AnchorNone anchors a widget to no edge. It is needed, because the zero
+	// value of Anchors stands for AnchorTopLeft.
+	AnchorNone Anchors = 1 << 7
+
+	AnchorTopLeft = AnchorTop | AnchorLeft
+	AnchorAll     = AnchorLeft | AnchorTop | AnchorRight | AnchorBottom
+)
+
+func (a Anchors) toW() walk.Anchors {
+	switch {
+	case a == 0:
+		return walk.AnchorTopLeft
+
+	case a&AnchorNone != 0:
+		return walk.AnchorNone
+	}
+
+	return walk.Anchors(a)
+}
+
+// AbsoluteLayout places the widgets at the bounds given by their Bounds
+// field and keeps their distances to the edges given by their Anchors field.
+type AbsoluteLayout struct {
+	Margins    Margins
+	DesignSize Size
+}
+
+func (al AbsoluteLayout) Create() (walk.Layout, error) {
+	l := walk.NewAbsoluteLayout()
+
+	if err := l.SetMargins(al.Margins.toW()); err != nil {
+		return nil, err
+	}
+
+	if err := l.SetDesignSize(al.DesignSize.toW()); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// UniformGrid divides the container into cells of equal size, that are
+// filled with the widgets row by row.
+type UniformGrid struct {
+	Rows              int
+	Columns           int
+	MinCellSize       Size
+	Margins           Margins
+	Spacing           int
+	HorizontalSpacing int // if > 0, replaces Spacing between columns
+	VerticalSpacing   int // if > 0, replaces Spacing between rows
+	MarginsZero       bool
+	SpacingZero       bool
+}
+
+func (ug UniformGrid) Create() (walk.Layout, error) {
+	l := walk.NewUniformGridLayout()
+
+	if err := l.SetRows(ug.Rows); err != nil {
+		return nil, err
+	}
+
+	if err := l.SetColumns(ug.Columns); err != nil {
+		return nil, err
+	}
+
+	if err := l.SetMinCellSize(ug.MinCellSize.toW()); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutMargins(l, ug.Margins, ug.MarginsZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutSpacing(l, ug.Spacing, ug.SpacingZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutHVSpacing(l, ug.HorizontalSpacing, ug.VerticalSpacing); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+type Masonry struct {
+	Columns           int // defaults to 3
+	MinColumnWidth    int
+	Margins           Margins
+	Spacing           int
+	HorizontalSpacing int // if > 0, replaces Spacing between columns
+	VerticalSpacing   int // if > 0, replaces Spacing between widgets in a column
+	MarginsZero       bool
+	SpacingZero       bool
+}
+
+func (m Masonry) Create() (walk.Layout, error) {
+	l := walk.NewMasonryLayout()
+
+	if m.Columns > 0 {
+		if err := l.SetColumns(m.Columns); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := l.SetMinColumnWidth(m.MinColumnWidth); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutMargins(l, m.Margins, m.MarginsZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutSpacing(l, m.Spacing, m.SpacingZero); err != nil {
+		return nil, err
+	}
+
+	if err := setLayoutHVSpacing(l, m.HorizontalSpacing, m.VerticalSpacing); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
@@ -35,16 +35,24 @@ type ListBox struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -61,8 +69,10 @@ type ListBox struct {
 	MultiSelection           bool
 	OnCurrentIndexChanged    walk.EventHandler
 	OnItemActivated          walk.EventHandler
+	OnItemsReordered         walk.IntRangeEventHandler
 	OnSelectedIndexesChanged walk.EventHandler
 	Precision                int
+	Reorderable              bool
 	Value                    Property
 }
 
@@ -111,6 +121,10 @@ func (lb ListBox) Create(builder *Builder) error {
 			return err
 		}
 
+		if err := w.SetReorderable(lb.Reorderable); err != nil {
+			return err
+		}
+
 		if lb.OnCurrentIndexChanged != nil {
 			w.CurrentIndexChanged().Attach(lb.OnCurrentIndexChanged)
 		}
@@ -120,6 +134,9 @@ func (lb ListBox) Create(builder *Builder) error {
 		if lb.OnItemActivated != nil {
 			w.ItemActivated().Attach(lb.OnItemActivated)
 		}
+		if lb.OnItemsReordered != nil {
+			w.ItemsReordered().Attach(lb.OnItemsReordered)
+		}
 
 		return nil
 	})
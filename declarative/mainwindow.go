@@ -29,9 +29,12 @@ type MainWindow struct {
 	OnMouseUp          walk.MouseEventHandler
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
+	RightToLeft        bool
 	RightToLeftLayout  bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Container
@@ -42,9 +45,10 @@ type MainWindow struct {
 
 	// Form
 
-	Icon  Property
-	Size  Size
-	Title Property
+	AutoSize bool
+	Icon     Property
+	Size     Size
+	Title    Property
 
 	// MainWindow
 
@@ -52,6 +56,7 @@ type MainWindow struct {
 	Bounds            Rectangle
 	Expressions       func() map[string]walk.Expression
 	Functions         map[string]func(args ...interface{}) (interface{}, error)
+	KioskMode         bool
 	MenuItems         []MenuItem
 	OnDropFiles       walk.DropFilesEventHandler
 	StatusBarItems    []StatusBarItem
@@ -121,6 +126,10 @@ func (mw MainWindow) Create() error {
 		return err
 	}
 
+	if err := w.SetRightToLeft(mw.RightToLeft); err != nil {
+		return err
+	}
+
 	return builder.InitWidget(fi, w, func() error {
 		if len(mw.ToolBar.Items) > 0 {
 			var tb *walk.ToolBar
@@ -162,6 +171,8 @@ func (mw MainWindow) Create() error {
 			}
 		}
 
+		w.SetAutoSize(mw.AutoSize)
+
 		imageList, err := walk.NewImageListForDPI(walk.SizeFrom96DPI(walk.Size{16, 16}, builder.dpi), 0, builder.dpi)
 		if err != nil {
 			return err
@@ -188,6 +199,12 @@ func (mw MainWindow) Create() error {
 		}
 
 		builder.Defer(func() error {
+			if mw.KioskMode {
+				if err := w.SetKioskMode(true); err != nil {
+					return err
+				}
+			}
+
 			if mw.Visible != false {
 				w.Show()
 			}
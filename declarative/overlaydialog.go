@@ -0,0 +1,126 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type OverlayDialog struct {
+	// Window
+
+	Background Brush
+	Font       Font
+	MaxSize    Size
+	MinSize    Size
+	Name       string
+
+	// Container
+
+	Children   []Widget
+	DataBinder DataBinder
+	Layout     Layout
+
+	// OverlayDialog
+
+	AssignTo      **walk.OverlayDialog
+	CancelButton  **walk.PushButton
+	DefaultButton **walk.PushButton
+	DimOpacity    byte
+	OnFinished    walk.IntEventHandler
+}
+
+func (od OverlayDialog) Create(form walk.Form) error {
+	w, err := walk.NewOverlayDialog(form)
+	if err != nil {
+		return err
+	}
+
+	if od.AssignTo != nil {
+		*od.AssignTo = w
+	}
+
+	if od.DimOpacity > 0 {
+		w.SetDimOpacity(od.DimOpacity)
+	}
+
+	ci := Composite{
+		Background: od.Background,
+		Font:       od.Font,
+		MaxSize:    od.MaxSize,
+		MinSize:    od.MinSize,
+		Name:       od.Name,
+		Children:   od.Children,
+		DataBinder: od.DataBinder,
+		Layout:     od.Layout,
+	}
+
+	builder := NewBuilder(nil)
+
+	w.SetSuspended(true)
+	builder.Defer(func() error {
+		w.SetSuspended(false)
+		return nil
+	})
+
+	return builder.InitWidget(ci, w, func() error {
+		if od.DefaultButton != nil {
+			if err := w.SetDefaultButton(*od.DefaultButton); err != nil {
+				return err
+			}
+		}
+		if od.CancelButton != nil {
+			if err := w.SetCancelButton(*od.CancelButton); err != nil {
+				return err
+			}
+		}
+
+		if od.OnFinished != nil {
+			w.Finished().Attach(od.OnFinished)
+		}
+
+		return nil
+	})
+}
+
+func (od OverlayDialog) Show(form walk.Form) error {
+	var w *walk.OverlayDialog
+
+	if od.AssignTo == nil {
+		od.AssignTo = &w
+	}
+
+	if err := od.Create(form); err != nil {
+		return err
+	}
+
+	return (*od.AssignTo).Show()
+}
+
+// ShowAsync creates the dialog and shows it like walk.OverlayDialog.ShowAsync
+// does. It may be called from any goroutine, because the dialog is created on
+// the UI thread of form.
+func (od OverlayDialog) ShowAsync(form walk.Form) <-chan walk.DialogResult {
+	results := make(chan walk.DialogResult, 1)
+
+	form.Synchronize(func() {
+		var w *walk.OverlayDialog
+
+		if od.AssignTo == nil {
+			od.AssignTo = &w
+		}
+
+		if err := od.Create(form); err != nil {
+			forwardDialogResults(dialogError(err), results)
+			return
+		}
+
+		forwardDialogResults((*od.AssignTo).ShowAsync(), results)
+	})
+
+	return results
+}
@@ -32,16 +32,24 @@ type PushButton struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -56,6 +64,7 @@ type PushButton struct {
 
 	AssignTo       **walk.PushButton
 	ImageAboveText bool
+	Shield         bool
 }
 
 func (pb PushButton) Create(builder *Builder) error {
@@ -73,6 +82,10 @@ func (pb PushButton) Create(builder *Builder) error {
 			return err
 		}
 
+		if pb.Shield {
+			w.SetShield(true)
+		}
+
 		if pb.OnClicked != nil {
 			w.Clicked().Attach(pb.OnClicked)
 		}
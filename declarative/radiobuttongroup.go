@@ -16,9 +16,11 @@ import (
 )
 
 type RadioButtonGroup struct {
-	Buttons    []RadioButton
-	DataMember string
-	Optional   bool
+	AssignTo       **walk.RadioButtonGroup
+	Buttons        []RadioButton
+	DataMember     string
+	OnValueChanged walk.EventHandler
+	Optional       bool
 }
 
 func (rbg RadioButtonGroup) Create(builder *Builder) error {
@@ -44,10 +46,19 @@ func (rbg RadioButtonGroup) Create(builder *Builder) error {
 		}
 	}
 
+	group := first.Group()
+
+	if rbg.AssignTo != nil {
+		*rbg.AssignTo = group
+	}
+
+	if rbg.OnValueChanged != nil {
+		group.ValueChanged().Attach(rbg.OnValueChanged)
+	}
+
 	parent := builder.Parent()
 
 	builder.Defer(func() error {
-		group := first.Group()
 
 		validator := newRadioButtonGroupValidator(group, parent)
 
@@ -0,0 +1,165 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type HRuler struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// Ruler
+
+	AssignTo        **walk.Ruler
+	Guides          []walk.Length
+	OnGuidesChanged walk.EventHandler
+	Unit            walk.RulerUnit
+}
+
+func (r HRuler) Create(builder *Builder) error {
+	w, err := walk.NewHRuler(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if r.AssignTo != nil {
+		*r.AssignTo = w
+	}
+
+	return builder.InitWidget(r, w, func() error {
+		w.SetUnit(r.Unit)
+
+		if len(r.Guides) > 0 {
+			w.SetGuides(r.Guides)
+		}
+
+		if r.OnGuidesChanged != nil {
+			w.GuidesChanged().Attach(r.OnGuidesChanged)
+		}
+
+		return nil
+	})
+}
+
+type VRuler struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// Ruler
+
+	AssignTo        **walk.Ruler
+	Guides          []walk.Length
+	OnGuidesChanged walk.EventHandler
+	Unit            walk.RulerUnit
+}
+
+func (r VRuler) Create(builder *Builder) error {
+	w, err := walk.NewVRuler(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if r.AssignTo != nil {
+		*r.AssignTo = w
+	}
+
+	return builder.InitWidget(r, w, func() error {
+		w.SetUnit(r.Unit)
+
+		if len(r.Guides) > 0 {
+			w.SetGuides(r.Guides)
+		}
+
+		if r.OnGuidesChanged != nil {
+			w.GuidesChanged().Attach(r.OnGuidesChanged)
+		}
+
+		return nil
+	})
+}
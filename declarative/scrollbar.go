@@ -0,0 +1,107 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type ScrollBar struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// ScrollBar
+
+	AssignTo       **walk.ScrollBar
+	LineSize       int
+	Maximum        int
+	Minimum        int
+	OnScrolled     walk.EventHandler
+	OnValueChanged walk.EventHandler
+	Orientation    Orientation
+	PageSize       int
+	Style          walk.ScrollBarStyle
+	Value          Property
+}
+
+func (sb ScrollBar) Create(builder *Builder) error {
+	w, err := walk.NewScrollBar(builder.Parent(), walk.Orientation(sb.Orientation))
+	if err != nil {
+		return err
+	}
+
+	if sb.AssignTo != nil {
+		*sb.AssignTo = w
+	}
+
+	return builder.InitWidget(sb, w, func() error {
+		if err := w.SetStyle(sb.Style); err != nil {
+			return err
+		}
+
+		if sb.Maximum > sb.Minimum {
+			if err := w.SetRange(sb.Minimum, sb.Maximum); err != nil {
+				return err
+			}
+		}
+		if sb.PageSize > 0 {
+			w.SetPageSize(sb.PageSize)
+		}
+		if sb.LineSize > 0 {
+			w.SetLineSize(sb.LineSize)
+		}
+
+		if sb.OnValueChanged != nil {
+			w.ValueChanged().Attach(sb.OnValueChanged)
+		}
+		if sb.OnScrolled != nil {
+			w.Scrolled().Attach(sb.OnScrolled)
+		}
+
+		return nil
+	})
+}
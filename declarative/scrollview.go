@@ -32,16 +32,24 @@ type ScrollView struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -54,9 +62,13 @@ type ScrollView struct {
 
 	// ScrollView
 
-	AssignTo        **walk.ScrollView
-	HorizontalFixed bool
-	VerticalFixed   bool
+	AssignTo           **walk.ScrollView
+	HorizontalFixed    bool
+	OnRefreshRequested walk.EventHandler
+	Overscroll         bool
+	PullToRefresh      bool
+	ScrollBarStyle     walk.ScrollBarStyle
+	VerticalFixed      bool
 }
 
 func (sv ScrollView) Create(builder *Builder) error {
@@ -76,8 +88,20 @@ func (sv ScrollView) Create(builder *Builder) error {
 	})
 
 	w.SetScrollbars(!sv.HorizontalFixed, !sv.VerticalFixed)
+	w.SetOverscroll(sv.Overscroll)
+	w.SetPullToRefresh(sv.PullToRefresh)
 
 	return builder.InitWidget(sv, w, func() error {
+		if sv.ScrollBarStyle != walk.ScrollBarStyleSystem {
+			if err := w.SetScrollBarStyle(sv.ScrollBarStyle); err != nil {
+				return err
+			}
+		}
+
+		if sv.OnRefreshRequested != nil {
+			w.RefreshRequested().Attach(sv.OnRefreshRequested)
+		}
+
 		return nil
 	})
 }
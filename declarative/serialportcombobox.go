@@ -0,0 +1,92 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type SerialPortComboBox struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// SerialPortComboBox
+
+	AssignTo              **walk.SerialPortComboBox
+	OnCurrentIndexChanged walk.EventHandler
+	OnPortsChanged        walk.EventHandler
+	PortName              string
+}
+
+func (spcb SerialPortComboBox) Create(builder *Builder) error {
+	w, err := walk.NewSerialPortComboBox(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if spcb.AssignTo != nil {
+		*spcb.AssignTo = w
+	}
+
+	return builder.InitWidget(spcb, w, func() error {
+		if spcb.PortName != "" {
+			if err := w.SetPortName(spcb.PortName); err != nil {
+				return err
+			}
+		}
+
+		if spcb.OnCurrentIndexChanged != nil {
+			w.CurrentIndexChanged().Attach(spcb.OnCurrentIndexChanged)
+		}
+
+		if spcb.OnPortsChanged != nil {
+			w.PortsChanged().Attach(spcb.OnPortsChanged)
+		}
+
+		return nil
+	})
+}
@@ -21,6 +21,7 @@ type HSpacer struct {
 
 	Column        int
 	ColumnSpan    int
+	Dock          Dock
 	Row           int
 	RowSpan       int
 	StretchFactor int
@@ -60,6 +61,7 @@ type VSpacer struct {
 
 	Column        int
 	ColumnSpan    int
+	Dock          Dock
 	Row           int
 	RowSpan       int
 	StretchFactor int
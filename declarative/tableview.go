@@ -33,16 +33,24 @@ type TableView struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -50,25 +58,42 @@ type TableView struct {
 	// TableView
 
 	AlternatingRowBG            bool
+	AlternatingRowColors        *walk.TableViewRowColors
 	AssignTo                    **walk.TableView
+	AutoRowHeight               bool
 	CellStyler                  walk.CellStyler
+	CellToolTip                 func(row, col int) string
+	CellToolTipProvider         walk.CellToolTipProvider
 	CheckBoxes                  bool
+	ClippedCellToolTipsDisabled bool
 	Columns                     []TableViewColumn
+	CopyFormats                 walk.CopyFormat
+	CopyIncludesHeader          bool
 	ColumnsOrderable            Property
 	ColumnsSizable              Property
 	CustomHeaderHeight          int
 	CustomRowHeight             int
+	GridlineColor               walk.Color
+	HoverColors                 *walk.TableViewRowColors
+	HoverHighlight              bool
 	ItemStateChangedEventDelay  int
 	HeaderHidden                bool
+	ImageList                   *walk.ManagedImageList
 	LastColumnStretched         bool
 	Model                       interface{}
 	MultiSelection              bool
 	NotSortableByHeaderClick    bool
 	OnCurrentIndexChanged       walk.EventHandler
 	OnItemActivated             walk.EventHandler
+	OnPasteRejected             walk.CellErrorsEventHandler
 	OnSelectedIndexesChanged    walk.EventHandler
+	ScrollBarStyle              walk.ScrollBarStyle
+	SearchColumn                int
+	SearchMode                  walk.SearchMode
+	SelectionColors             *walk.TableViewRowColors
 	SelectionHiddenWithoutFocus bool
 	StyleCell                   func(style *walk.CellStyle)
+	VisibleGridlines            walk.TableViewGridlines
 }
 
 type tvStyler struct {
@@ -94,6 +119,12 @@ func (scf styleCellFunc) StyleCell(style *walk.CellStyle) {
 	scf(style)
 }
 
+type cellToolTipFunc func(row, col int) string
+
+func (cttf cellToolTipFunc) CellToolTip(row, col int) string {
+	return cttf(row, col)
+}
+
 func (tv TableView) Create(builder *Builder) error {
 	var w *walk.TableView
 	var err error
@@ -117,10 +148,22 @@ func (tv TableView) Create(builder *Builder) error {
 			}
 		}
 
+		if tv.ImageList != nil {
+			if err := w.SetImageList(tv.ImageList); err != nil {
+				return err
+			}
+		}
+
 		if err := w.SetModel(tv.Model); err != nil {
 			return err
 		}
 
+		if tv.ScrollBarStyle != walk.ScrollBarStyleSystem {
+			if err := w.SetScrollBarStyle(tv.ScrollBarStyle); err != nil {
+				return err
+			}
+		}
+
 		defaultStyler, _ := tv.Model.(walk.CellStyler)
 
 		if tv.CellStyler != nil {
@@ -160,7 +203,31 @@ func (tv TableView) Create(builder *Builder) error {
 			w.SetCellStyler(styler)
 		}
 
+		if tv.CellToolTip != nil {
+			w.SetCellToolTipProvider(cellToolTipFunc(tv.CellToolTip))
+		} else if tv.CellToolTipProvider != nil {
+			w.SetCellToolTipProvider(tv.CellToolTipProvider)
+		}
+		w.SetClippedCellToolTips(!tv.ClippedCellToolTipsDisabled)
+
 		w.SetAlternatingRowBG(tv.AlternatingRowBG)
+		w.SetAlternatingRowColors(tv.AlternatingRowColors)
+		w.SetSelectionColors(tv.SelectionColors)
+		w.SetHoverHighlight(tv.HoverHighlight)
+		w.SetHoverColors(tv.HoverColors)
+		w.SetVisibleGridlines(tv.VisibleGridlines)
+		if err := w.SetSearchColumn(tv.SearchColumn); err != nil {
+			return err
+		}
+		w.SetSearchMode(tv.SearchMode)
+		if tv.CopyFormats != 0 {
+			w.SetCopyFormats(tv.CopyFormats)
+		}
+		w.SetCopyIncludesHeader(tv.CopyIncludesHeader)
+		if tv.GridlineColor != 0 {
+			w.SetGridlineColor(tv.GridlineColor)
+		}
+		w.SetAutoRowHeight(tv.AutoRowHeight)
 		w.SetCheckBoxes(tv.CheckBoxes)
 		w.SetItemStateChangedEventDelay(tv.ItemStateChangedEventDelay)
 		if err := w.SetLastColumnStretched(tv.LastColumnStretched); err != nil {
@@ -185,6 +252,9 @@ func (tv TableView) Create(builder *Builder) error {
 		if tv.OnItemActivated != nil {
 			w.ItemActivated().Attach(tv.OnItemActivated)
 		}
+		if tv.OnPasteRejected != nil {
+			w.PasteRejected().Attach(tv.OnPasteRejected)
+		}
 
 		return nil
 	})
@@ -20,18 +20,23 @@ const (
 )
 
 type TableViewColumn struct {
-	Name       string
-	DataMember string
-	Format     string
-	Title      string
-	Alignment  Alignment1D
-	Precision  int
-	Width      int
-	Hidden     bool
-	Frozen     bool
-	StyleCell  func(style *walk.CellStyle)
-	LessFunc   func(i, j int) bool
-	FormatFunc func(value interface{}) string
+	Name              string
+	DataMember        string
+	Format            string
+	Title             string
+	Alignment         Alignment1D
+	Precision         int
+	Width             int
+	Hidden            bool
+	Frozen            bool
+	Multiline         bool
+	Ellipsis          walk.CellEllipsis
+	VerticalAlignment Alignment1D
+	AutoSizeMode      walk.ColumnAutoSizeMode
+	FillWeight        int
+	StyleCell         func(style *walk.CellStyle)
+	LessFunc          func(i, j int) bool
+	FormatFunc        func(value interface{}) string
 }
 
 func (tvc TableViewColumn) Create(tv *walk.TableView) error {
@@ -62,6 +67,23 @@ func (tvc TableViewColumn) Create(tv *walk.TableView) error {
 	if err := w.SetWidth(tvc.Width); err != nil {
 		return err
 	}
+	if err := w.SetMultiline(tvc.Multiline); err != nil {
+		return err
+	}
+	if err := w.SetEllipsis(tvc.Ellipsis); err != nil {
+		return err
+	}
+	if err := w.SetVerticalAlignment(walk.Alignment1D(tvc.VerticalAlignment)); err != nil {
+		return err
+	}
+	if err := w.SetAutoSizeMode(tvc.AutoSizeMode); err != nil {
+		return err
+	}
+	if tvc.FillWeight > 0 {
+		if err := w.SetFillWeight(tvc.FillWeight); err != nil {
+			return err
+		}
+	}
 	w.SetLessFunc(tvc.LessFunc)
 	w.SetFormatFunc(tvc.FormatFunc)
 
@@ -48,23 +48,34 @@ type TextLabel struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
 
 	// static
 
-	TextColor walk.Color
+	MaxLines     int
+	TextColor    walk.Color
+	Wrap         bool
+	WrapEllipsis bool
 
 	// Text
 
@@ -96,6 +107,16 @@ func (tl TextLabel) Create(builder *Builder) error {
 			return err
 		}
 
+		if err := w.SetWrap(tl.Wrap); err != nil {
+			return err
+		}
+		if err := w.SetMaxLines(tl.MaxLines); err != nil {
+			return err
+		}
+		if err := w.SetWrapEllipsis(tl.WrapEllipsis); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -0,0 +1,107 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"github.com/lxn/walk"
+)
+
+type ThumbnailGrid struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	PaintIsolated      bool
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// ThumbnailGrid
+
+	AssignTo               **walk.ThumbnailGrid
+	CacheSize              int
+	FilePaths              []string
+	OnCurrentIndexChanged  walk.EventHandler
+	OnItemActivated        walk.EventHandler
+	OnSelectionChanged     walk.EventHandler
+	OnThumbnailSizeChanged walk.EventHandler
+	ThumbnailSize          Property
+}
+
+func (tg ThumbnailGrid) Create(builder *Builder) error {
+	w, err := walk.NewThumbnailGrid(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if tg.AssignTo != nil {
+		*tg.AssignTo = w
+	}
+
+	return builder.InitWidget(tg, w, func() error {
+		if tg.CacheSize > 0 {
+			w.SetCacheSize(tg.CacheSize)
+		}
+
+		if tg.FilePaths != nil {
+			w.SetFilePaths(tg.FilePaths)
+		}
+
+		if tg.OnCurrentIndexChanged != nil {
+			w.CurrentIndexChanged().Attach(tg.OnCurrentIndexChanged)
+		}
+
+		if tg.OnItemActivated != nil {
+			w.ItemActivated().Attach(tg.OnItemActivated)
+		}
+
+		if tg.OnSelectionChanged != nil {
+			w.SelectionChanged().Attach(tg.OnSelectionChanged)
+		}
+
+		if tg.OnThumbnailSizeChanged != nil {
+			w.ThumbnailSizeChanged().Attach(tg.OnThumbnailSizeChanged)
+		}
+
+		return nil
+	})
+}
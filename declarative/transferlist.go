@@ -0,0 +1,110 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package declarative
+
+import (
+	"errors"
+
+	"github.com/lxn/walk"
+)
+
+type TransferList struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipIcon        Property
+	ToolTipText        Property
+	ToolTipTitle       Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
+	Column             int
+	ColumnSpan         int
+	Dock               Dock
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+
+	// TransferList
+
+	AssignTo                 **walk.TransferList
+	AvailableTitle           string
+	DisplayMember            string
+	Model                    interface{}
+	OnSelectedIndexesChanged walk.EventHandler
+	SelectedIndexes          Property
+	SelectedTitle            string
+}
+
+func (tl TransferList) Create(builder *Builder) error {
+	if _, ok := tl.Model.([]string); ok && tl.DisplayMember != "" {
+		return errors.New("TransferList.Create: DisplayMember must be empty for []string models.")
+	}
+
+	w, err := walk.NewTransferList(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if tl.AssignTo != nil {
+		*tl.AssignTo = w
+	}
+
+	return builder.InitWidget(tl, w, func() error {
+		if tl.AvailableTitle != "" {
+			if err := w.SetAvailableTitle(tl.AvailableTitle); err != nil {
+				return err
+			}
+		}
+		if tl.SelectedTitle != "" {
+			if err := w.SetSelectedTitle(tl.SelectedTitle); err != nil {
+				return err
+			}
+		}
+
+		if err := w.SetDisplayMember(tl.DisplayMember); err != nil {
+			return err
+		}
+
+		if err := w.SetModel(tl.Model); err != nil {
+			return err
+		}
+
+		if tl.OnSelectedIndexesChanged != nil {
+			w.SelectedIndexesChanged().Attach(tl.OnSelectedIndexesChanged)
+		}
+
+		return nil
+	})
+}
@@ -32,16 +32,24 @@ type TreeView struct {
 	OnSizeChanged      walk.EventHandler
 	Persistent         bool
 	RightToLeftReading bool
+	ToolTipIcon        Property
 	ToolTipText        Property
+	ToolTipTitle       Property
 	Visible            Property
 
 	// Widget
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	Anchors            Anchors
+	Bounds             Rectangle
 	Column             int
 	ColumnSpan         int
+	Dock               Dock
 	GraphicsEffects    []walk.WidgetGraphicsEffect
+	LayoutMargins      Margins
+	LayoutMaxSize      Size
+	LayoutMinSize      Size
 	Row                int
 	RowSpan            int
 	StretchFactor      int
@@ -49,11 +57,13 @@ type TreeView struct {
 	// TreeView
 
 	AssignTo             **walk.TreeView
+	ImageList            *walk.ManagedImageList
 	ItemHeight           int
 	Model                walk.TreeModel
 	OnCurrentItemChanged walk.EventHandler
 	OnExpandedChanged    walk.TreeItemEventHandler
 	OnItemActivated      walk.EventHandler
+	SearchMode           walk.SearchMode
 }
 
 func (tv TreeView) Create(builder *Builder) error {
@@ -71,10 +81,18 @@ func (tv TreeView) Create(builder *Builder) error {
 			w.SetItemHeight(w.IntFrom96DPI(tv.ItemHeight)) // VERIFY: Item height should resize on DPI change.
 		}
 
+		if tv.ImageList != nil {
+			if err := w.SetImageList(tv.ImageList); err != nil {
+				return err
+			}
+		}
+
 		if err := w.SetModel(tv.Model); err != nil {
 			return err
 		}
 
+		w.SetSearchMode(tv.SearchMode)
+
 		if tv.OnCurrentItemChanged != nil {
 			w.CurrentItemChanged().Attach(tv.OnCurrentItemChanged)
 		}
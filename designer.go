@@ -0,0 +1,957 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"sort"
+
+	"github.com/lxn/win"
+)
+
+const designerOverlayWindowClass = `\o/ Walk_DesignerOverlay_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClass(designerOverlayWindowClass)
+	})
+}
+
+// designers holds the Designers, that are enabled.
+var designers []*Designer
+
+// Designer puts a container and all of its descendants into design mode, so
+// a designer tool can host live widgets.
+//
+// In design mode, mouse and keyboard input does not reach the widgets.
+// Instead, clicking a widget selects it, which is shown by adorners drawn
+// over it, and dragging moves or resizes it. In a container without layout,
+// this changes the bounds of the widgets. In a container with a layout,
+// dragging a widget onto a sibling moves it to the position of the sibling
+// in the list of children and resizing sets its minimum size.
+type Designer struct {
+	root                      Container
+	enabled                   bool
+	gridSize                  int // in 1/96" units
+	selection                 []Widget
+	widget2BoundsHandle       map[Widget]int
+	overlay                   *designerOverlay
+	hRuler                    *Ruler
+	vRuler                    *Ruler
+	hGuidesChangedHandle      int
+	vGuidesChangedHandle      int
+	drag                      designerDrag
+	rootBoundsChangedHandle   int
+	rootDisposingHandle       int
+	formBoundsChangedHandle   int
+	selectionChangedPublisher EventPublisher
+	layoutChangedPublisher    EventPublisher
+}
+
+type designerDrag struct {
+	active  bool
+	moved   bool
+	handle  int   // index of the resize handle or -1 for moving
+	start   Point // cursor position in screen pixels
+	items   []designerDragItem
+	primary Widget
+}
+
+type designerDragItem struct {
+	widget Widget
+	bounds Rectangle // in native pixels of the parent
+}
+
+// NewDesigner creates a Designer for root and enables it.
+func NewDesigner(root Container) (*Designer, error) {
+	if root == nil {
+		return nil, newError("root cannot be nil")
+	}
+
+	d := &Designer{
+		root:                root,
+		gridSize:            8,
+		widget2BoundsHandle: make(map[Widget]int),
+	}
+
+	var err error
+	if d.overlay, err = newDesignerOverlay(d); err != nil {
+		return nil, err
+	}
+
+	d.rootBoundsChangedHandle = root.BoundsChanged().Attach(d.updateOverlay)
+	d.rootDisposingHandle = root.Disposing().Attach(d.Dispose)
+	if form := root.Form(); form != nil {
+		d.formBoundsChangedHandle = form.BoundsChanged().Attach(d.updateOverlay)
+	}
+
+	d.SetEnabled(true)
+
+	return d, nil
+}
+
+// Dispose disables the Designer and releases its resources.
+func (d *Designer) Dispose() {
+	if d.overlay == nil {
+		return
+	}
+
+	d.SetEnabled(false)
+	d.setSelection(nil)
+	d.SetRulers(nil, nil)
+
+	d.root.BoundsChanged().Detach(d.rootBoundsChangedHandle)
+	d.root.Disposing().Detach(d.rootDisposingHandle)
+	if form := d.root.Form(); form != nil {
+		form.BoundsChanged().Detach(d.formBoundsChangedHandle)
+	}
+
+	d.overlay.Dispose()
+	d.overlay = nil
+}
+
+// Root returns the container the Designer puts into design mode.
+func (d *Designer) Root() Container {
+	return d.root
+}
+
+// Enabled returns if the root of the Designer is in design mode.
+func (d *Designer) Enabled() bool {
+	return d.enabled
+}
+
+// SetEnabled sets if the root of the Designer is in design mode.
+func (d *Designer) SetEnabled(enabled bool) {
+	if enabled == d.enabled {
+		return
+	}
+
+	d.enabled = enabled
+
+	if enabled {
+		designers = append(designers, d)
+
+		// Keep keyboard input away from the widgets.
+		if hwndFocus := win.GetFocus(); hwndFocus != d.root.Handle() && win.IsChild(d.root.Handle(), hwndFocus) {
+			win.SetFocus(d.root.Handle())
+		}
+	} else {
+		d.cancelDrag()
+
+		for i, designer := range designers {
+			if designer == d {
+				designers = append(designers[:i], designers[i+1:]...)
+				break
+			}
+		}
+	}
+
+	setMainLoopDesignModeActive(len(designers) > 0)
+
+	d.updateOverlay()
+}
+
+// GridSize returns the size of the grid in 1/96" units, that moving and
+// resizing widgets in containers without layout snaps to.
+func (d *Designer) GridSize() int {
+	return d.gridSize
+}
+
+// SetGridSize sets the size of the grid in 1/96" units, that moving and
+// resizing widgets in containers without layout snaps to. Pass 0 to disable
+// snapping.
+func (d *Designer) SetGridSize(value int) error {
+	if value < 0 {
+		return newError("value must be >= 0")
+	}
+
+	d.gridSize = value
+
+	return nil
+}
+
+// Rulers returns the rulers set with SetRulers.
+func (d *Designer) Rulers() (horizontal, vertical *Ruler) {
+	return d.hRuler, d.vRuler
+}
+
+// SetRulers sets the rulers along the root. Their origin is aligned to the
+// root, their markers follow the mouse, the guides are drawn over the root
+// and moving and resizing widgets snaps to them before the grid. Either may
+// be nil.
+func (d *Designer) SetRulers(horizontal, vertical *Ruler) {
+	if d.hRuler != nil {
+		d.hRuler.GuidesChanged().Detach(d.hGuidesChangedHandle)
+	}
+	if d.vRuler != nil {
+		d.vRuler.GuidesChanged().Detach(d.vGuidesChangedHandle)
+	}
+
+	d.hRuler, d.vRuler = horizontal, vertical
+
+	if horizontal != nil {
+		horizontal.SetView(d.root)
+		d.hGuidesChangedHandle = horizontal.GuidesChanged().Attach(d.updateOverlay)
+	}
+	if vertical != nil {
+		vertical.SetView(d.root)
+		d.vGuidesChangedHandle = vertical.GuidesChanged().Attach(d.updateOverlay)
+	}
+
+	d.updateOverlay()
+}
+
+// hasGuides returns if any of the rulers has guides.
+func (d *Designer) hasGuides() bool {
+	return d.hRuler != nil && len(d.hRuler.guides) > 0 || d.vRuler != nil && len(d.vRuler.guides) > 0
+}
+
+// updateRulerMarkers moves the markers of the rulers to pt, in screen pixels.
+func (d *Designer) updateRulerMarkers(pt Point) {
+	var origin win.POINT
+	win.ClientToScreen(d.root.Handle(), &origin)
+
+	dpi := d.root.DPI()
+
+	if d.hRuler != nil {
+		d.hRuler.SetMarker(LengthFromPixels(pt.X-int(origin.X), dpi))
+	}
+	if d.vRuler != nil {
+		d.vRuler.SetMarker(LengthFromPixels(pt.Y-int(origin.Y), dpi))
+	}
+}
+
+// Selection returns the selected widgets. The first one is the primary
+// selection, which can be resized.
+func (d *Designer) Selection() []Widget {
+	return append([]Widget(nil), d.selection...)
+}
+
+// SetSelection selects widgets, which must be descendants of the root.
+func (d *Designer) SetSelection(widgets ...Widget) error {
+	for _, w := range widgets {
+		if w == nil || !win.IsChild(d.root.Handle(), w.Handle()) {
+			return newError("widget is no descendant of the root")
+		}
+	}
+
+	d.setSelection(widgets)
+
+	return nil
+}
+
+// SelectionChanged returns the event that is published when the selection
+// changed.
+func (d *Designer) SelectionChanged() *Event {
+	return d.selectionChangedPublisher.Event()
+}
+
+// LayoutChanged returns the event that is published after the user moved or
+// resized widgets.
+func (d *Designer) LayoutChanged() *Event {
+	return d.layoutChangedPublisher.Event()
+}
+
+// DesignMode returns if the *WindowBase is in design mode, i.e. it is the
+// root or a descendant of the root of an enabled Designer.
+func (wb *WindowBase) DesignMode() bool {
+	return designerForHWND(wb.hWnd) != nil
+}
+
+func designerForHWND(hwnd win.HWND) *Designer {
+	for _, d := range designers {
+		if hwndRoot := d.root.Handle(); hwnd == hwndRoot || win.IsChild(hwndRoot, hwnd) {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// handleDesignModeMessage handles mouse and keyboard input for windows in
+// design mode. It returns true if msg must not be dispatched.
+func handleDesignModeMessage(msg *win.MSG) bool {
+	isMouse := msg.Message >= win.WM_MOUSEFIRST && msg.Message <= win.WM_MOUSELAST
+	isNCMouse := msg.Message >= win.WM_NCMOUSEMOVE && msg.Message <= win.WM_NCXBUTTONDBLCLK
+	isKey := msg.Message >= win.WM_KEYFIRST && msg.Message <= win.WM_KEYLAST
+	if !isMouse && !isNCMouse && !isKey {
+		return false
+	}
+
+	d := designerForHWND(msg.HWnd)
+	if d == nil {
+		return false
+	}
+
+	// The non-client area of a root form, like its title bar, keeps working.
+	if isNCMouse && msg.HWnd == d.root.Handle() {
+		return false
+	}
+
+	pt := Point{int(msg.Pt.X), int(msg.Pt.Y)}
+
+	switch msg.Message {
+	case win.WM_LBUTTONDOWN, win.WM_NCLBUTTONDOWN:
+		d.beginDrag(msg.HWnd, pt, win.GetKeyState(win.VK_CONTROL) < 0)
+
+	case win.WM_MOUSEMOVE, win.WM_NCMOUSEMOVE:
+		d.updateRulerMarkers(pt)
+
+		if d.drag.active && msg.Message == win.WM_MOUSEMOVE && msg.WParam&win.MK_LBUTTON == 0 {
+			// The capture was lost, so the button up was missed.
+			d.endDrag(pt)
+		} else if d.drag.active {
+			d.continueDrag(pt)
+		} else {
+			d.updateCursor(pt)
+		}
+
+	case win.WM_LBUTTONUP, win.WM_NCLBUTTONUP:
+		d.endDrag(pt)
+
+	case win.WM_KEYDOWN:
+		d.handleKeyDown(Key(msg.WParam))
+	}
+
+	return true
+}
+
+// widgetAt returns the widget to select for hwnd. It is the outermost
+// widget below the root, that is not a container registered with
+// RegisterLayoutWidgetType, so widgets that create their children
+// themselves are selected as a whole.
+func (d *Designer) widgetAt(hwnd win.HWND) Widget {
+	hwndRoot := d.root.Handle()
+
+	var chain []Widget // from inner to outer
+	for ; hwnd != 0 && hwnd != hwndRoot; hwnd = win.GetParent(hwnd) {
+		if w, ok := windowFromHandle(hwnd).(Widget); ok {
+			chain = append(chain, w)
+		}
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		w := chain[i]
+		if i == 0 {
+			return w
+		}
+
+		if t, ok := layoutWidgetTypes[layoutWidgetTypeName(w)]; !ok || !t.Container {
+			return w
+		}
+	}
+
+	return nil
+}
+
+func (d *Designer) setSelection(widgets []Widget) {
+	for w, handle := range d.widget2BoundsHandle {
+		w.BoundsChanged().Detach(handle)
+		delete(d.widget2BoundsHandle, w)
+	}
+
+	d.selection = append(d.selection[:0], widgets...)
+
+	for _, w := range d.selection {
+		d.widget2BoundsHandle[w] = w.BoundsChanged().Attach(d.updateOverlay)
+	}
+
+	d.updateOverlay()
+
+	d.selectionChangedPublisher.Publish()
+}
+
+func (d *Designer) isSelected(widget Widget) bool {
+	for _, w := range d.selection {
+		if w == widget {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleSize returns the size of the resize handles in native pixels.
+func (d *Designer) handleSize() int {
+	return d.root.AsWindowBase().IntFrom96DPI(7)
+}
+
+// screenBounds returns the bounds of widget in screen pixels.
+func screenBounds(widget Widget) Rectangle {
+	var rc win.RECT
+	win.GetWindowRect(widget.Handle(), &rc)
+
+	return rectangleFromRECT(rc)
+}
+
+// resizeHandles returns the bounds of the resize handles of r, starting at
+// the top left corner, clockwise.
+func resizeHandles(r Rectangle, size int) [8]Rectangle {
+	xs := [3]int{r.X, r.X + r.Width/2, r.X + r.Width}
+	ys := [3]int{r.Y, r.Y + r.Height/2, r.Y + r.Height}
+
+	cells := [8][2]int{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}}
+
+	var handles [8]Rectangle
+	for i, c := range cells {
+		handles[i] = Rectangle{xs[c[0]] - size/2, ys[c[1]] - size/2, size, size}
+	}
+
+	return handles
+}
+
+// resizeHandleAt returns the index of the resize handle of the primary
+// selection at pt, in screen pixels, or -1.
+func (d *Designer) resizeHandleAt(pt Point) int {
+	if len(d.selection) == 0 {
+		return -1
+	}
+
+	for i, h := range resizeHandles(screenBounds(d.selection[0]), d.handleSize()) {
+		if pt.X >= h.X && pt.X < h.X+h.Width && pt.Y >= h.Y && pt.Y < h.Y+h.Height {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (d *Designer) updateCursor(pt Point) {
+	switch d.resizeHandleAt(pt) {
+	case 0, 4:
+		win.SetCursor(CursorSizeNWSE().handle())
+
+	case 2, 6:
+		win.SetCursor(CursorSizeNESW().handle())
+
+	case 1, 5:
+		win.SetCursor(CursorSizeNS().handle())
+
+	case 3, 7:
+		win.SetCursor(CursorSizeWE().handle())
+
+	default:
+		win.SetCursor(CursorArrow().handle())
+	}
+}
+
+func (d *Designer) beginDrag(hwnd win.HWND, pt Point, toggle bool) {
+	handle := d.resizeHandleAt(pt)
+
+	if handle == -1 {
+		widget := d.widgetAt(hwnd)
+
+		switch {
+		case widget == nil:
+			d.setSelection(nil)
+			return
+
+		case toggle && d.isSelected(widget):
+			var selection []Widget
+			for _, w := range d.selection {
+				if w != widget {
+					selection = append(selection, w)
+				}
+			}
+			d.setSelection(selection)
+			return
+
+		case toggle:
+			d.setSelection(append(d.Selection(), widget))
+
+		case !d.isSelected(widget):
+			d.setSelection([]Widget{widget})
+		}
+	}
+
+	d.drag = designerDrag{
+		active: true,
+		handle: handle,
+		start:  pt,
+	}
+
+	if handle == -1 {
+		for _, w := range d.selection {
+			d.drag.items = append(d.drag.items, designerDragItem{w, w.BoundsPixels()})
+		}
+		if widget := d.widgetAt(hwnd); widget != nil {
+			d.drag.primary = widget
+		}
+	} else {
+		d.drag.items = []designerDragItem{{d.selection[0], d.selection[0].BoundsPixels()}}
+		d.drag.primary = d.selection[0]
+	}
+
+	win.SetCapture(d.root.Handle())
+}
+
+func (d *Designer) continueDrag(pt Point) {
+	dx, dy := pt.X-d.drag.start.X, pt.Y-d.drag.start.Y
+
+	if !d.drag.moved {
+		if absi(dx) < int(win.GetSystemMetrics(win.SM_CXDRAG)) && absi(dy) < int(win.GetSystemMetrics(win.SM_CYDRAG)) {
+			return
+		}
+
+		d.drag.moved = true
+	}
+
+	if d.drag.handle == -1 {
+		win.SetCursor(CursorSizeAll().handle())
+
+		for _, item := range d.drag.items {
+			if item.widget.Parent().Layout() != nil {
+				continue
+			}
+
+			offset := d.offsetInRoot(item.widget.Parent())
+
+			b := item.bounds
+			b.X = d.snapPos(b.X+dx, offset.X, d.hRuler)
+			b.Y = d.snapPos(b.Y+dy, offset.Y, d.vRuler)
+
+			item.widget.SetBoundsPixels(b)
+		}
+
+		return
+	}
+
+	item := d.drag.items[0]
+	b := d.resizedBounds(item.bounds, d.offsetInRoot(item.widget.Parent()), d.drag.handle, dx, dy)
+
+	if item.widget.Parent().Layout() == nil {
+		item.widget.SetBoundsPixels(b)
+	} else {
+		wb := item.widget.AsWindowBase()
+		item.widget.SetMinMaxSize(wb.SizeTo96DPI(b.Size()), item.widget.MaxSize())
+	}
+}
+
+// resizedBounds returns bounds, in native pixels of a parent at offset from
+// the root, resized by dx, dy at the resize handle with index handle.
+func (d *Designer) resizedBounds(bounds Rectangle, offset Point, handle, dx, dy int) Rectangle {
+	left, top := bounds.X, bounds.Y
+	right, bottom := bounds.X+bounds.Width, bounds.Y+bounds.Height
+
+	switch handle {
+	case 0, 6, 7:
+		left = d.snapPos(left+dx, offset.X, d.hRuler)
+
+	case 2, 3, 4:
+		right = d.snapPos(right+dx, offset.X, d.hRuler)
+	}
+
+	switch handle {
+	case 0, 1, 2:
+		top = d.snapPos(top+dy, offset.Y, d.vRuler)
+
+	case 4, 5, 6:
+		bottom = d.snapPos(bottom+dy, offset.Y, d.vRuler)
+	}
+
+	minSize := d.handleSize()
+	if right-left < minSize {
+		if handle == 0 || handle == 6 || handle == 7 {
+			left = right - minSize
+		} else {
+			right = left + minSize
+		}
+	}
+	if bottom-top < minSize {
+		if handle == 0 || handle == 1 || handle == 2 {
+			top = bottom - minSize
+		} else {
+			bottom = top + minSize
+		}
+	}
+
+	return Rectangle{left, top, right - left, bottom - top}
+}
+
+// offsetInRoot returns the position of the client area of container relative
+// to that of the root, in native pixels.
+func (d *Designer) offsetInRoot(container Container) Point {
+	var ptContainer, ptRoot win.POINT
+	win.ClientToScreen(container.Handle(), &ptContainer)
+	win.ClientToScreen(d.root.Handle(), &ptRoot)
+
+	return Point{int(ptContainer.X - ptRoot.X), int(ptContainer.Y - ptRoot.Y)}
+}
+
+// snapPos snaps value, in native pixels of a parent at offset from the root,
+// to a guide of ruler, if one is near, or else to the grid.
+func (d *Designer) snapPos(value, offset int, ruler *Ruler) int {
+	if ruler != nil {
+		dpi := d.root.DPI()
+
+		if g, ok := ruler.SnapToGuide(LengthFromPixels(value+offset, dpi), DIP(4)); ok {
+			return g.Pixels(dpi) - offset
+		}
+	}
+
+	return d.snap(value)
+}
+
+func (d *Designer) snap(value int) int {
+	grid := d.root.AsWindowBase().IntFrom96DPI(d.gridSize)
+	if grid <= 1 {
+		return value
+	}
+
+	if value < 0 {
+		return -((-value + grid/2) / grid * grid)
+	}
+
+	return (value + grid/2) / grid * grid
+}
+
+func (d *Designer) endDrag(pt Point) {
+	if !d.drag.active {
+		return
+	}
+
+	drag := d.drag
+	d.drag = designerDrag{}
+
+	win.ReleaseCapture()
+
+	if !drag.moved {
+		return
+	}
+
+	if drag.handle == -1 && drag.primary != nil && drag.primary.Parent().Layout() != nil {
+		d.moveToSibling(drag.primary, pt)
+	}
+
+	d.updateOverlay()
+
+	d.layoutChangedPublisher.Publish()
+}
+
+// moveToSibling moves widget to the position of the sibling at pt, in screen
+// pixels, in the list of children of its parent.
+func (d *Designer) moveToSibling(widget Widget, pt Point) {
+	target := d.widgetAt(win.WindowFromPoint(win.POINT{X: int32(pt.X), Y: int32(pt.Y)}))
+	if target == nil || target == widget || target.Parent() != widget.Parent() {
+		return
+	}
+
+	children := widget.Parent().Children()
+
+	index := children.Index(target)
+	if err := children.Remove(widget); err != nil {
+		return
+	}
+	children.Insert(index, widget)
+}
+
+func (d *Designer) cancelDrag() {
+	if !d.drag.active {
+		return
+	}
+
+	drag := d.drag
+	d.drag = designerDrag{}
+
+	win.ReleaseCapture()
+
+	if !drag.moved {
+		return
+	}
+
+	for _, item := range drag.items {
+		if item.widget.Parent().Layout() == nil {
+			item.widget.SetBoundsPixels(item.bounds)
+		}
+	}
+}
+
+func (d *Designer) handleKeyDown(key Key) {
+	if key == KeyEscape {
+		d.cancelDrag()
+		return
+	}
+
+	if d.drag.active {
+		return
+	}
+
+	var dx, dy int
+	switch key {
+	case KeyLeft:
+		dx = -1
+
+	case KeyRight:
+		dx = 1
+
+	case KeyUp:
+		dy = -1
+
+	case KeyDown:
+		dy = 1
+
+	default:
+		return
+	}
+
+	if ShiftDown() && d.gridSize > 0 {
+		grid := d.root.AsWindowBase().IntFrom96DPI(d.gridSize)
+		dx, dy = dx*grid, dy*grid
+	}
+
+	var moved bool
+	for _, w := range d.selection {
+		if w.Parent().Layout() != nil {
+			continue
+		}
+
+		b := w.BoundsPixels()
+		b.X += dx
+		b.Y += dy
+		w.SetBoundsPixels(b)
+
+		moved = true
+	}
+
+	if moved {
+		d.layoutChangedPublisher.Publish()
+	}
+}
+
+func (d *Designer) updateOverlay() {
+	if d.overlay == nil {
+		return
+	}
+
+	hwndRoot := d.root.Handle()
+
+	if !d.enabled || len(d.selection) == 0 && !d.hasGuides() || !win.IsWindowVisible(hwndRoot) || win.IsIconic(win.GetAncestor(hwndRoot, win.GA_ROOT)) {
+		d.overlay.SetVisible(false)
+		return
+	}
+
+	var rc win.RECT
+	win.GetClientRect(hwndRoot, &rc)
+
+	var origin win.POINT
+	win.ClientToScreen(hwndRoot, &origin)
+
+	hs := d.handleSize()
+	bounds := Rectangle{int(origin.X) - hs, int(origin.Y) - hs, int(rc.Right) + 2*hs, int(rc.Bottom) + 2*hs}
+
+	d.overlay.origin = bounds.Location()
+	d.overlay.rootBounds = Rectangle{hs, hs, int(rc.Right), int(rc.Bottom)}
+
+	win.SetWindowPos(d.overlay.hWnd, 0, int32(bounds.X), int32(bounds.Y), int32(bounds.Width), int32(bounds.Height), win.SWP_NOACTIVATE|win.SWP_NOZORDER|win.SWP_SHOWWINDOW)
+	d.overlay.Invalidate()
+}
+
+// designerOverlay is a click-through window owned by the form of the root
+// of a Designer, that draws the selection adorners.
+type designerOverlay struct {
+	WindowBase
+	designer   *Designer
+	origin     Point     // in screen pixels
+	rootBounds Rectangle // client area of the root in native pixels of the overlay
+}
+
+var designerOverlayColorKey = RGB(255, 0, 254)
+
+func newDesignerOverlay(d *Designer) (*designerOverlay, error) {
+	ov := &designerOverlay{designer: d}
+
+	if err := InitWindow(
+		ov,
+		nil,
+		designerOverlayWindowClass,
+		win.WS_POPUP,
+		win.WS_EX_LAYERED|win.WS_EX_TRANSPARENT|win.WS_EX_TOOLWINDOW|win.WS_EX_NOACTIVATE); err != nil {
+		return nil, err
+	}
+
+	if !setLayeredWindowAttributes(ov.hWnd, win.COLORREF(designerOverlayColorKey), 255, lwaColorKey) {
+		ov.Dispose()
+		return nil, lastError("SetLayeredWindowAttributes")
+	}
+
+	// Owned windows stay above their owner.
+	if form := d.root.Form(); form != nil {
+		win.SetWindowLongPtr(ov.hWnd, win.GWLP_HWNDPARENT, uintptr(form.Handle()))
+	}
+
+	return ov, nil
+}
+
+func (ov *designerOverlay) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_ERASEBKGND:
+		return 1
+
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+
+		hdc := win.BeginPaint(hwnd, &ps)
+		defer win.EndPaint(hwnd, &ps)
+
+		ov.paint(hdc)
+
+		return 0
+	}
+
+	return ov.WindowBase.WndProc(hwnd, msg, wParam, lParam)
+}
+
+// paint draws the guides of the rulers, an outline around the selected
+// widgets and the resize handles of the primary selection. Everything else has the color key, so it is
+// transparent.
+func (ov *designerOverlay) paint(hdc win.HDC) {
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	keyBrush, err := NewSolidColorBrush(designerOverlayColorKey)
+	if err != nil {
+		return
+	}
+	defer keyBrush.Dispose()
+
+	canvas.FillRectanglePixels(keyBrush, ov.ClientBoundsPixels())
+
+	accentBrush, err := NewSolidColorBrush(RGB(0, 120, 215))
+	if err != nil {
+		return
+	}
+	defer accentBrush.Dispose()
+
+	pen, err := NewGeometricPen(PenSolid|PenInsideFrame, 1, accentBrush)
+	if err != nil {
+		return
+	}
+	defer pen.Dispose()
+
+	handleBrush, err := NewSolidColorBrush(RGB(255, 255, 255))
+	if err != nil {
+		return
+	}
+	defer handleBrush.Dispose()
+
+	d := ov.designer
+
+	for _, ruler := range [...]*Ruler{d.hRuler, d.vRuler} {
+		if ruler != nil {
+			ruler.DrawGuidesPixels(canvas, ov.rootBounds)
+		}
+	}
+
+	for i, w := range d.selection {
+		b := screenBounds(w)
+		b.X -= ov.origin.X
+		b.Y -= ov.origin.Y
+
+		canvas.DrawRectanglePixels(pen, b)
+
+		if i > 0 {
+			continue
+		}
+
+		for _, h := range resizeHandles(b, d.handleSize()) {
+			canvas.FillRectanglePixels(handleBrush, h)
+			canvas.DrawRectanglePixels(pen, h)
+		}
+	}
+}
+
+// DesignProperty is a property of a window as listed by DesignProperties.
+type DesignProperty struct {
+	Name     string
+	Category string
+	Property Property
+}
+
+var designPropertyCategories = map[string]string{
+	"DisplayName":   "Appearance",
+	"Decimals":      "Appearance",
+	"Format":        "Appearance",
+	"Hex":           "Appearance",
+	"Icon":          "Appearance",
+	"Image":         "Appearance",
+	"ImageSource":   "Appearance",
+	"Margin":        "Appearance",
+	"Prefix":        "Appearance",
+	"Suffix":        "Appearance",
+	"Text":          "Appearance",
+	"ThumbnailSize": "Appearance",
+	"Title":         "Appearance",
+	"ToolTipIcon":   "Appearance",
+	"ToolTipText":   "Appearance",
+	"ToolTipTitle":  "Appearance",
+	"Vertical":      "Appearance",
+
+	"Collapsed":                "Behavior",
+	"ColumnsOrderable":         "Behavior",
+	"ColumnsSizable":           "Behavior",
+	"Enabled":                  "Behavior",
+	"NativeContextMenuEnabled": "Behavior",
+	"ReadOnly":                 "Behavior",
+	"ShortcutsEnabled":         "Behavior",
+	"Visible":                  "Behavior",
+
+	"CheckState":      "Data",
+	"Checked":         "Data",
+	"CheckedValue":    "Data",
+	"CurrentIndex":    "Data",
+	"CurrentItem":     "Data",
+	"Date":            "Data",
+	"MaxValue":        "Data",
+	"MinValue":        "Data",
+	"Presence":        "Data",
+	"SelectedIndexes": "Data",
+	"URL":             "Data",
+	"Value":           "Data",
+
+	"CurrentItemLevel": "State",
+	"Focused":          "State",
+	"HasCurrentItem":   "State",
+	"HasCurrentPage":   "State",
+	"ItemCount":        "State",
+	"SelectedCount":    "State",
+	"TextNotEmpty":     "State",
+}
+
+// RegisterDesignPropertyCategory sets the category DesignProperties reports
+// for properties named name. Properties without category are reported in
+// category "Misc".
+func RegisterDesignPropertyCategory(name, category string) {
+	designPropertyCategories[name] = category
+}
+
+// DesignProperties returns the properties of window with their categories,
+// sorted by category and name, for display in a property grid.
+func DesignProperties(window Window) []DesignProperty {
+	wb := window.AsWindowBase()
+
+	props := make([]DesignProperty, 0, len(wb.name2Property))
+	for name, p := range wb.name2Property {
+		category, ok := designPropertyCategories[name]
+		if !ok {
+			category = "Misc"
+		}
+
+		props = append(props, DesignProperty{name, category, p})
+	}
+
+	sort.Slice(props, func(i, j int) bool {
+		if props[i].Category != props[j].Category {
+			return props[i].Category < props[j].Category
+		}
+
+		return props[i].Name < props[j].Name
+	})
+
+	return props
+}
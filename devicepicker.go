@@ -0,0 +1,587 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lxn/win"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	dbtDevNodesChanged = 0x0007
+
+	devicePickerTimerId = 1
+)
+
+// USBDevice describes a USB device that is present in the system.
+type USBDevice struct {
+	VendorID     uint16
+	ProductID    uint16
+	InstanceID   string // The device instance ID, e.g. `USB\VID_046D&PID_C52B\5&2A0E0A2F&0&2`
+	Description  string
+	Manufacturer string
+}
+
+// USBDevices returns the USB devices currently present in the system.
+//
+// Interfaces of composite devices are not returned separately.
+func USBDevices() ([]USBDevice, error) {
+	devInfo, err := windows.SetupDiGetClassDevsEx(nil, "USB", 0, windows.DIGCF_ALLCLASSES|windows.DIGCF_PRESENT, 0, "")
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer devInfo.Close()
+
+	var devices []USBDevice
+
+	for i := 0; ; i++ {
+		data, err := devInfo.EnumDeviceInfo(i)
+		if err != nil {
+			if err == windows.ERROR_NO_MORE_ITEMS {
+				break
+			}
+			continue
+		}
+
+		instanceID, err := devInfo.DeviceInstanceID(data)
+		if err != nil {
+			continue
+		}
+
+		vendorID, productID, ok := parseUSBInstanceID(instanceID)
+		if !ok {
+			continue
+		}
+
+		device := USBDevice{
+			VendorID:   vendorID,
+			ProductID:  productID,
+			InstanceID: instanceID,
+		}
+
+		if s, ok := deviceRegistryString(devInfo, data, windows.SPDRP_FRIENDLYNAME); ok {
+			device.Description = s
+		} else if s, ok := deviceRegistryString(devInfo, data, windows.SPDRP_DEVICEDESC); ok {
+			device.Description = s
+		}
+
+		if s, ok := deviceRegistryString(devInfo, data, windows.SPDRP_MFG); ok {
+			device.Manufacturer = s
+		}
+
+		devices = append(devices, device)
+	}
+
+	return devices, nil
+}
+
+func deviceRegistryString(devInfo windows.DevInfo, data *windows.DevInfoData, property windows.SPDRP) (string, bool) {
+	value, err := devInfo.DeviceRegistryProperty(data, property)
+	if err != nil {
+		return "", false
+	}
+
+	s, ok := value.(string)
+
+	return s, ok && s != ""
+}
+
+// parseUSBInstanceID extracts vendor and product ID from a device instance ID
+// like `USB\VID_046D&PID_C52B\...`. Interfaces (`&MI_xx`) are rejected.
+func parseUSBInstanceID(instanceID string) (vendorID, productID uint16, ok bool) {
+	parts := strings.Split(strings.ToUpper(instanceID), `\`)
+	if len(parts) < 2 || parts[0] != "USB" {
+		return 0, 0, false
+	}
+
+	var haveVID, havePID bool
+
+	for _, field := range strings.Split(parts[1], "&") {
+		switch {
+		case strings.HasPrefix(field, "VID_"):
+			v, err := strconv.ParseUint(field[4:], 16, 16)
+			if err != nil {
+				return 0, 0, false
+			}
+			vendorID, haveVID = uint16(v), true
+
+		case strings.HasPrefix(field, "PID_"):
+			v, err := strconv.ParseUint(field[4:], 16, 16)
+			if err != nil {
+				return 0, 0, false
+			}
+			productID, havePID = uint16(v), true
+
+		case strings.HasPrefix(field, "MI_"):
+			return 0, 0, false
+		}
+	}
+
+	return vendorID, productID, haveVID && havePID
+}
+
+// BLEDevice describes a Bluetooth LE device found by a BLEScanner.
+type BLEDevice struct {
+	Address string
+	Name    string
+	RSSI    int // in dBm
+}
+
+// BLEScanner is implemented by Bluetooth LE stacks to feed a
+// BLEDevicePickerDialog.
+//
+// Walk does not contain a Bluetooth stack itself, so applications provide an
+// adapter for the one they use.
+type BLEScanner interface {
+	// StartScan starts scanning for advertising devices. found is called for
+	// each received advertisement and may be called from any goroutine.
+	StartScan(found func(device BLEDevice)) error
+
+	// StopScan stops a scan started by StartScan.
+	StopScan() error
+}
+
+// USBDevicePickerDialog lets the user pick one of the USB devices present in
+// the system. The list is refreshed when devices are added or removed.
+type USBDevicePickerDialog struct {
+	Title     string
+	VendorID  uint16 // If not 0, only devices with this vendor ID are listed.
+	ProductID uint16 // If not 0, only devices with this product ID are listed.
+	Device    USBDevice
+}
+
+// Show runs the dialog and returns whether the user accepted it. The selected
+// device is then available from the Device field.
+func (dlg *USBDevicePickerDialog) Show(owner Form) (accepted bool, err error) {
+	title := dlg.Title
+	if title == "" {
+		title = tr("Select USB Device", "walk")
+	}
+
+	var devices []USBDevice
+
+	dpd, err := newDevicePickerDialog(owner, title, []string{
+		tr("Device", "walk"),
+		tr("Manufacturer", "walk"),
+		tr("Vendor ID", "walk"),
+		tr("Product ID", "walk"),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer dpd.Dispose()
+
+	dpd.refresh = func() error {
+		all, err := USBDevices()
+		if err != nil {
+			return err
+		}
+
+		devices = devices[:0]
+		rows := make([][]string, 0, len(all))
+
+		for _, device := range all {
+			if dlg.VendorID != 0 && device.VendorID != dlg.VendorID ||
+				dlg.ProductID != 0 && device.ProductID != dlg.ProductID {
+				continue
+			}
+
+			devices = append(devices, device)
+			rows = append(rows, []string{
+				device.Description,
+				device.Manufacturer,
+				fmt.Sprintf("%04X", device.VendorID),
+				fmt.Sprintf("%04X", device.ProductID),
+			})
+		}
+
+		dpd.model.setRows(rows, func(i int) string {
+			return devices[i].InstanceID
+		})
+
+		return nil
+	}
+
+	dpd.deviceChanged = func() {
+		dpd.startTimer(500 * time.Millisecond)
+	}
+	dpd.timeout = func() {
+		dpd.stopTimer()
+		dpd.refresh()
+	}
+
+	if err := dpd.refresh(); err != nil {
+		return false, err
+	}
+
+	if dpd.Run() != DlgCmdOK {
+		return false, nil
+	}
+
+	dlg.Device = devices[dpd.selected]
+
+	return true, nil
+}
+
+// BLEDevicePickerDialog lets the user pick one of the Bluetooth LE devices
+// found by a BLEScanner, along with their signal strength.
+//
+// Devices that have not been seen for a while are removed from the list.
+type BLEDevicePickerDialog struct {
+	Title   string
+	Scanner BLEScanner
+	Device  BLEDevice
+}
+
+const bleDeviceExpiry = 15 * time.Second
+
+type bleDeviceEntry struct {
+	device   BLEDevice
+	lastSeen time.Time
+}
+
+// Show runs the dialog and returns whether the user accepted it. The selected
+// device is then available from the Device field.
+//
+// Scanning is started when the dialog is shown and stopped when it closes.
+func (dlg *BLEDevicePickerDialog) Show(owner Form) (accepted bool, err error) {
+	if dlg.Scanner == nil {
+		return false, newError("Scanner must not be nil")
+	}
+
+	title := dlg.Title
+	if title == "" {
+		title = tr("Select Bluetooth Device", "walk")
+	}
+
+	dpd, err := newDevicePickerDialog(owner, title, []string{
+		tr("Name", "walk"),
+		tr("Address", "walk"),
+		tr("Signal", "walk"),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer dpd.Dispose()
+
+	var entries []*bleDeviceEntry
+
+	var mutex sync.Mutex
+	scanning := true
+
+	update := func() {
+		rows := make([][]string, len(entries))
+		for i, e := range entries {
+			rows[i] = []string{e.device.Name, e.device.Address, fmt.Sprintf("%d dBm", e.device.RSSI)}
+		}
+
+		dpd.model.setRows(rows, func(i int) string {
+			return entries[i].device.Address
+		})
+	}
+
+	found := func(device BLEDevice) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if !scanning {
+			return
+		}
+
+		dpd.Synchronize(func() {
+			if !scanning {
+				return
+			}
+
+			for _, e := range entries {
+				if e.device.Address == device.Address {
+					if device.Name == "" {
+						device.Name = e.device.Name
+					}
+
+					e.device = device
+					e.lastSeen = time.Now()
+					update()
+					return
+				}
+			}
+
+			entries = append(entries, &bleDeviceEntry{device, time.Now()})
+			update()
+		})
+	}
+
+	dpd.refresh = func() error {
+		entries = nil
+		update()
+
+		return nil
+	}
+
+	dpd.timeout = func() {
+		now := time.Now()
+
+		var kept []*bleDeviceEntry
+		for _, e := range entries {
+			if now.Sub(e.lastSeen) < bleDeviceExpiry {
+				kept = append(kept, e)
+			}
+		}
+
+		if len(kept) != len(entries) {
+			entries = kept
+			update()
+		}
+	}
+
+	if err := dlg.Scanner.StartScan(found); err != nil {
+		return false, wrapError(err)
+	}
+
+	dpd.startTimer(time.Second)
+
+	result := dpd.Run()
+
+	mutex.Lock()
+	scanning = false
+	mutex.Unlock()
+
+	if err := dlg.Scanner.StopScan(); err != nil {
+		return false, wrapError(err)
+	}
+
+	if result != DlgCmdOK {
+		return false, nil
+	}
+
+	dlg.Device = entries[dpd.selected].device
+
+	return true, nil
+}
+
+// devicePickerDialog is the dialog shared by the device picker dialogs.
+type devicePickerDialog struct {
+	*Dialog
+	tableView     *TableView
+	model         *devicePickerModel
+	okButton      *PushButton
+	selected      int
+	refresh       func() error
+	deviceChanged func()
+	timeout       func()
+}
+
+func newDevicePickerDialog(owner Form, title string, columnTitles []string) (*devicePickerDialog, error) {
+	d, err := NewDialog(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	dpd := &devicePickerDialog{Dialog: d, model: new(devicePickerModel), selected: -1}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			dpd.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(dpd); err != nil {
+		return nil, err
+	}
+
+	if err := dpd.SetTitle(title); err != nil {
+		return nil, err
+	}
+
+	if err := dpd.SetLayout(NewVBoxLayout()); err != nil {
+		return nil, err
+	}
+
+	if err := dpd.SetMinMaxSize(Size{480, 320}, Size{}); err != nil {
+		return nil, err
+	}
+
+	if dpd.tableView, err = NewTableView(dpd); err != nil {
+		return nil, err
+	}
+
+	for _, columnTitle := range columnTitles {
+		column := NewTableViewColumn()
+
+		if err := column.SetTitle(columnTitle); err != nil {
+			return nil, err
+		}
+
+		if err := dpd.tableView.Columns().Add(column); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := dpd.tableView.SetModel(dpd.model); err != nil {
+		return nil, err
+	}
+
+	dpd.model.tableView = dpd.tableView
+
+	buttons, err := NewComposite(dpd)
+	if err != nil {
+		return nil, err
+	}
+
+	buttonsLayout := NewHBoxLayout()
+	buttonsLayout.SetMargins(Margins{})
+	if err := buttons.SetLayout(buttonsLayout); err != nil {
+		return nil, err
+	}
+
+	refreshButton, err := NewPushButton(buttons)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := refreshButton.SetText(tr("Refresh", "walk")); err != nil {
+		return nil, err
+	}
+
+	refreshButton.Clicked().Attach(func() {
+		if dpd.refresh != nil {
+			dpd.refresh()
+		}
+	})
+
+	if _, err := NewHSpacer(buttons); err != nil {
+		return nil, err
+	}
+
+	if dpd.okButton, err = NewPushButton(buttons); err != nil {
+		return nil, err
+	}
+
+	if err := dpd.okButton.SetText(tr("OK", "walk")); err != nil {
+		return nil, err
+	}
+
+	dpd.okButton.Clicked().Attach(dpd.accept)
+
+	cancelButton, err := NewPushButton(buttons)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cancelButton.SetText(tr("Cancel", "walk")); err != nil {
+		return nil, err
+	}
+
+	cancelButton.Clicked().Attach(dpd.Cancel)
+
+	if err := dpd.SetDefaultButton(dpd.okButton); err != nil {
+		return nil, err
+	}
+
+	if err := dpd.SetCancelButton(cancelButton); err != nil {
+		return nil, err
+	}
+
+	dpd.tableView.ItemActivated().Attach(dpd.accept)
+
+	dpd.tableView.CurrentIndexChanged().Attach(dpd.updateOKButton)
+	dpd.updateOKButton()
+
+	succeeded = true
+
+	return dpd, nil
+}
+
+func (dpd *devicePickerDialog) accept() {
+	if dpd.selected = dpd.tableView.CurrentIndex(); dpd.selected > -1 {
+		dpd.Accept()
+	}
+}
+
+func (dpd *devicePickerDialog) updateOKButton() {
+	dpd.okButton.SetEnabled(dpd.tableView.CurrentIndex() > -1)
+}
+
+func (dpd *devicePickerDialog) startTimer(interval time.Duration) {
+	win.SetTimer(dpd.hWnd, devicePickerTimerId, uint32(interval/time.Millisecond), 0)
+}
+
+func (dpd *devicePickerDialog) stopTimer() {
+	win.KillTimer(dpd.hWnd, devicePickerTimerId)
+}
+
+func (dpd *devicePickerDialog) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_DEVICECHANGE:
+		if wParam == dbtDevNodesChanged && dpd.deviceChanged != nil {
+			dpd.deviceChanged()
+		}
+
+	case win.WM_TIMER:
+		if wParam == devicePickerTimerId && dpd.timeout != nil {
+			dpd.timeout()
+			return 0
+		}
+
+	case win.WM_DESTROY:
+		dpd.stopTimer()
+	}
+
+	return dpd.Dialog.WndProc(hwnd, msg, wParam, lParam)
+}
+
+// devicePickerModel is the model of the device list of a devicePickerDialog.
+//
+// Rows are identified by a key, so the current row is kept when the list is
+// updated.
+type devicePickerModel struct {
+	TableModelBase
+	tableView *TableView
+	rows      [][]string
+}
+
+func (m *devicePickerModel) RowCount() int {
+	return len(m.rows)
+}
+
+func (m *devicePickerModel) Value(row, col int) interface{} {
+	return m.rows[row][col]
+}
+
+func (m *devicePickerModel) setRows(rows [][]string, key func(i int) string) {
+	var currentKey string
+	if m.tableView != nil {
+		if i := m.tableView.CurrentIndex(); i > -1 && i < len(m.rows) {
+			currentKey = m.rows[i][len(m.rows[i])-1]
+		}
+	}
+
+	// The key is kept as a hidden last value of each row.
+	m.rows = make([][]string, len(rows))
+	current := -1
+	for i, row := range rows {
+		k := key(i)
+		m.rows[i] = append(row, k)
+
+		if currentKey != "" && k == currentKey {
+			current = i
+		}
+	}
+
+	m.PublishRowsReset()
+
+	if m.tableView != nil {
+		m.tableView.SetCurrentIndex(current)
+	}
+}
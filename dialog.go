@@ -238,6 +238,43 @@ func (dlg *Dialog) Run() int {
 	return dlg.result
 }
 
+// DialogResult is the outcome of a dialog that was shown asynchronously.
+type DialogResult struct {
+	Result int   // The result the dialog was closed with, e.g. DlgCmdOK.
+	Err    error // Why the dialog could not be shown, if it could not.
+}
+
+// ShowAsync shows the dialog modally, like Run, but returns immediately.
+//
+// The returned channel receives the result once, when the dialog has been
+// closed, and is closed afterwards. It is buffered, so the result is not lost
+// if nobody receives it. This allows a goroutine to wait for the result
+// without blocking the UI thread, on which ShowAsync must be called.
+func (dlg *Dialog) ShowAsync() <-chan DialogResult {
+	results := make(chan DialogResult, 1)
+
+	owner := dlg.owner
+	if owner != nil {
+		win.EnableWindow(owner.Handle(), false)
+	}
+
+	dlg.Disposing().Attach(func() {
+		if owner != nil && !owner.IsDisposed() {
+			win.EnableWindow(owner.Handle(), true)
+		}
+
+		results <- DialogResult{Result: dlg.result}
+		close(results)
+	})
+
+	dlg.started = true
+	dlg.startingPublisher.Publish()
+
+	dlg.Show()
+
+	return results
+}
+
 func (dlg *Dialog) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
 	switch msg {
 	case win.WM_COMMAND:
@@ -0,0 +1,820 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// This file contains bindings for the parts of Direct2D and DirectWrite, that
+// D2DSurface uses. Methods taking structs by value are in the
+// direct2d_32bit.go and direct2d_64bit.go files.
+
+const (
+	d2d1FactoryTypeSingleThreaded = 0
+
+	d2d1AntialiasModePerPrimitive = 0
+
+	d2d1DrawTextOptionsNone = 0
+	d2d1DrawTextOptionsClip = 2
+
+	d2d1FigureBeginFilled = 0
+	d2d1FigureBeginHollow = 1
+
+	d2d1FigureEndOpen   = 0
+	d2d1FigureEndClosed = 1
+
+	d2dErrRecreateTarget win.HRESULT = -0x7766fff4 // 0x8899000C
+)
+
+const (
+	dwriteFactoryTypeShared = 0
+
+	dwriteFontStretchNormal = 5
+
+	dwriteFontStyleNormal = 0
+	dwriteFontStyleItalic = 2
+
+	dwriteFontWeightNormal = 400
+	dwriteFontWeightBold   = 700
+
+	dwriteParagraphAlignmentNear   = 0
+	dwriteParagraphAlignmentFar    = 1
+	dwriteParagraphAlignmentCenter = 2
+
+	dwriteReadingDirectionRightToLeft = 1
+
+	dwriteTextAlignmentLeading  = 0
+	dwriteTextAlignmentTrailing = 1
+	dwriteTextAlignmentCenter   = 2
+
+	dwriteTrimmingGranularityNone      = 0
+	dwriteTrimmingGranularityCharacter = 1
+	dwriteTrimmingGranularityWord      = 2
+
+	dwriteWordWrappingWrap   = 0
+	dwriteWordWrappingNoWrap = 1
+)
+
+var (
+	libd2d1   = syscall.NewLazyDLL("d2d1.dll")
+	libdwrite = syscall.NewLazyDLL("dwrite.dll")
+
+	procD2D1CreateFactory   = libd2d1.NewProc("D2D1CreateFactory")
+	procDWriteCreateFactory = libdwrite.NewProc("DWriteCreateFactory")
+
+	iidID2D1Factory   = syscall.GUID{Data1: 0x06152247, Data2: 0x6F50, Data3: 0x465A, Data4: [8]byte{0x92, 0x45, 0x11, 0x8B, 0xFD, 0x3B, 0x60, 0x07}}
+	iidIDWriteFactory = syscall.GUID{Data1: 0xB859EE5A, Data2: 0xD838, Data3: 0x4B5B, Data4: [8]byte{0xA2, 0xE8, 0x1A, 0xDC, 0x7D, 0x93, 0xDB, 0x48}}
+)
+
+type d2d1ColorF struct {
+	R, G, B, A float32
+}
+
+func d2d1ColorFFrom(c AlphaColor) d2d1ColorF {
+	return d2d1ColorF{
+		R: float32(c.Color.R()) / 255,
+		G: float32(c.Color.G()) / 255,
+		B: float32(c.Color.B()) / 255,
+		A: float32(c.Alpha) / 255,
+	}
+}
+
+type d2d1Point2F struct {
+	X, Y float32
+}
+
+type d2d1RectF struct {
+	Left, Top, Right, Bottom float32
+}
+
+func d2d1RectFFrom(r RectangleF) d2d1RectF {
+	return d2d1RectF{float32(r.X), float32(r.Y), float32(r.X + r.Width), float32(r.Y + r.Height)}
+}
+
+type d2d1RoundedRect struct {
+	Rect             d2d1RectF
+	RadiusX, RadiusY float32
+}
+
+type d2d1Ellipse struct {
+	Point            d2d1Point2F
+	RadiusX, RadiusY float32
+}
+
+type d2d1SizeU struct {
+	Width, Height uint32
+}
+
+type d2d1PixelFormat struct {
+	Format    uint32
+	AlphaMode uint32
+}
+
+type d2d1RenderTargetProperties struct {
+	Type        uint32
+	PixelFormat d2d1PixelFormat
+	DpiX, DpiY  float32
+	Usage       uint32
+	MinLevel    uint32
+}
+
+type d2d1HwndRenderTargetProperties struct {
+	Hwnd           win.HWND
+	PixelSize      d2d1SizeU
+	PresentOptions uint32
+}
+
+type dwriteTrimming struct {
+	Granularity    uint32
+	Delimiter      uint32
+	DelimiterCount uint32
+}
+
+type dwriteTextMetrics struct {
+	Left                             float32
+	Top                              float32
+	Width                            float32
+	WidthIncludingTrailingWhitespace float32
+	Height                           float32
+	LayoutWidth                      float32
+	LayoutHeight                     float32
+	MaxBidiReorderingDepth           uint32
+	LineCount                        uint32
+}
+
+type dwriteTextRange struct {
+	StartPosition, Length uint32
+}
+
+func d2d1CreateFactory(factoryType uint32, riid *syscall.GUID, factory **id2d1Factory) win.HRESULT {
+	ret, _, _ := syscall.Syscall6(procD2D1CreateFactory.Addr(), 4,
+		uintptr(factoryType),
+		uintptr(unsafe.Pointer(riid)),
+		0,
+		uintptr(unsafe.Pointer(factory)),
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func dwriteCreateFactory(factoryType uint32, iid *syscall.GUID, factory **idWriteFactory) win.HRESULT {
+	ret, _, _ := syscall.Syscall(procDWriteCreateFactory.Addr(), 3,
+		uintptr(factoryType),
+		uintptr(unsafe.Pointer(iid)),
+		uintptr(unsafe.Pointer(factory)))
+
+	return win.HRESULT(ret)
+}
+
+func float32Arg(f float32) uintptr {
+	return uintptr(math.Float32bits(f))
+}
+
+type id2d1FactoryVtbl struct {
+	QueryInterface                 uintptr
+	AddRef                         uintptr
+	Release                        uintptr
+	ReloadSystemMetrics            uintptr
+	GetDesktopDpi                  uintptr
+	CreateRectangleGeometry        uintptr
+	CreateRoundedRectangleGeometry uintptr
+	CreateEllipseGeometry          uintptr
+	CreateGeometryGroup            uintptr
+	CreateTransformedGeometry      uintptr
+	CreatePathGeometry             uintptr
+	CreateStrokeStyle              uintptr
+	CreateDrawingStateBlock        uintptr
+	CreateWicBitmapRenderTarget    uintptr
+	CreateHwndRenderTarget         uintptr
+}
+
+type id2d1Factory struct {
+	LpVtbl *id2d1FactoryVtbl
+}
+
+func (obj *id2d1Factory) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type id2d1HwndRenderTargetVtbl struct {
+	QueryInterface               uintptr
+	AddRef                       uintptr
+	Release                      uintptr
+	GetFactory                   uintptr
+	CreateBitmap                 uintptr
+	CreateBitmapFromWicBitmap    uintptr
+	CreateSharedBitmap           uintptr
+	CreateBitmapBrush            uintptr
+	CreateSolidColorBrush        uintptr
+	CreateGradientStopCollection uintptr
+	CreateLinearGradientBrush    uintptr
+	CreateRadialGradientBrush    uintptr
+	CreateCompatibleRenderTarget uintptr
+	CreateLayer                  uintptr
+	CreateMesh                   uintptr
+	DrawLine                     uintptr
+	DrawRectangle                uintptr
+	FillRectangle                uintptr
+	DrawRoundedRectangle         uintptr
+	FillRoundedRectangle         uintptr
+	DrawEllipse                  uintptr
+	FillEllipse                  uintptr
+	DrawGeometry                 uintptr
+	FillGeometry                 uintptr
+	FillMesh                     uintptr
+	FillOpacityMask              uintptr
+	DrawBitmap                   uintptr
+	DrawText                     uintptr
+	DrawTextLayout               uintptr
+	DrawGlyphRun                 uintptr
+	SetTransform                 uintptr
+	GetTransform                 uintptr
+	SetAntialiasMode             uintptr
+	GetAntialiasMode             uintptr
+	SetTextAntialiasMode         uintptr
+	GetTextAntialiasMode         uintptr
+	SetTextRenderingParams       uintptr
+	GetTextRenderingParams       uintptr
+	SetTags                      uintptr
+	GetTags                      uintptr
+	PushLayer                    uintptr
+	PopLayer                     uintptr
+	Flush                        uintptr
+	SaveDrawingState             uintptr
+	RestoreDrawingState          uintptr
+	PushAxisAlignedClip          uintptr
+	PopAxisAlignedClip           uintptr
+	Clear                        uintptr
+	BeginDraw                    uintptr
+	EndDraw                      uintptr
+	GetPixelFormat               uintptr
+	SetDpi                       uintptr
+	GetDpi                       uintptr
+	GetSize                      uintptr
+	GetPixelSize                 uintptr
+	GetMaximumBitmapSize         uintptr
+	IsSupported                  uintptr
+	CheckWindowState             uintptr
+	Resize                       uintptr
+}
+
+type id2d1HwndRenderTarget struct {
+	LpVtbl *id2d1HwndRenderTargetVtbl
+}
+
+func (obj *id2d1HwndRenderTarget) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type id2d1SolidColorBrushVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	GetFactory     uintptr
+	SetOpacity     uintptr
+	SetTransform   uintptr
+	GetOpacity     uintptr
+	GetTransform   uintptr
+	SetColor       uintptr
+}
+
+type id2d1SolidColorBrush struct {
+	LpVtbl *id2d1SolidColorBrushVtbl
+}
+
+func (obj *id2d1SolidColorBrush) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type id2d1PathGeometryVtbl struct {
+	QueryInterface       uintptr
+	AddRef               uintptr
+	Release              uintptr
+	GetFactory           uintptr
+	GetBounds            uintptr
+	GetWidenedBounds     uintptr
+	StrokeContainsPoint  uintptr
+	FillContainsPoint    uintptr
+	CompareWithGeometry  uintptr
+	Simplify             uintptr
+	Tessellate           uintptr
+	CombineWithGeometry  uintptr
+	Outline              uintptr
+	ComputeArea          uintptr
+	ComputeLength        uintptr
+	ComputePointAtLength uintptr
+	Widen                uintptr
+	Open                 uintptr
+}
+
+type id2d1PathGeometry struct {
+	LpVtbl *id2d1PathGeometryVtbl
+}
+
+func (obj *id2d1PathGeometry) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type id2d1GeometrySinkVtbl struct {
+	QueryInterface  uintptr
+	AddRef          uintptr
+	Release         uintptr
+	SetFillMode     uintptr
+	SetSegmentFlags uintptr
+	BeginFigure     uintptr
+	AddLines        uintptr
+	AddBeziers      uintptr
+	EndFigure       uintptr
+	Close           uintptr
+}
+
+type id2d1GeometrySink struct {
+	LpVtbl *id2d1GeometrySinkVtbl
+}
+
+func (obj *id2d1GeometrySink) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type idWriteFactoryVtbl struct {
+	QueryInterface                 uintptr
+	AddRef                         uintptr
+	Release                        uintptr
+	GetSystemFontCollection        uintptr
+	CreateCustomFontCollection     uintptr
+	RegisterFontCollectionLoader   uintptr
+	UnregisterFontCollectionLoader uintptr
+	CreateFontFileReference        uintptr
+	CreateCustomFontFileReference  uintptr
+	CreateFontFace                 uintptr
+	CreateRenderingParams          uintptr
+	CreateMonitorRenderingParams   uintptr
+	CreateCustomRenderingParams    uintptr
+	RegisterFontFileLoader         uintptr
+	UnregisterFontFileLoader       uintptr
+	CreateTextFormat               uintptr
+	CreateTypography               uintptr
+	GetGdiInterop                  uintptr
+	CreateTextLayout               uintptr
+	CreateGdiCompatibleTextLayout  uintptr
+	CreateEllipsisTrimmingSign     uintptr
+}
+
+type idWriteFactory struct {
+	LpVtbl *idWriteFactoryVtbl
+}
+
+func (obj *idWriteFactory) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type idWriteTextFormatVtbl struct {
+	QueryInterface          uintptr
+	AddRef                  uintptr
+	Release                 uintptr
+	SetTextAlignment        uintptr
+	SetParagraphAlignment   uintptr
+	SetWordWrapping         uintptr
+	SetReadingDirection     uintptr
+	SetFlowDirection        uintptr
+	SetIncrementalTabStop   uintptr
+	SetTrimming             uintptr
+	SetLineSpacing          uintptr
+	GetTextAlignment        uintptr
+	GetParagraphAlignment   uintptr
+	GetWordWrapping         uintptr
+	GetReadingDirection     uintptr
+	GetFlowDirection        uintptr
+	GetIncrementalTabStop   uintptr
+	GetTrimming             uintptr
+	GetLineSpacing          uintptr
+	GetFontCollection       uintptr
+	GetFontFamilyNameLength uintptr
+	GetFontFamilyName       uintptr
+	GetFontWeight           uintptr
+	GetFontStyle            uintptr
+	GetFontStretch          uintptr
+	GetFontSize             uintptr
+	GetLocaleNameLength     uintptr
+	GetLocaleName           uintptr
+}
+
+type idWriteTextFormat struct {
+	LpVtbl *idWriteTextFormatVtbl
+}
+
+func (obj *idWriteTextFormat) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type idWriteTextLayoutVtbl struct {
+	idWriteTextFormatVtbl
+	SetMaxWidth             uintptr
+	SetMaxHeight            uintptr
+	SetFontCollection       uintptr
+	SetFontFamilyName       uintptr
+	SetFontWeight           uintptr
+	SetFontStyle            uintptr
+	SetFontStretch          uintptr
+	SetFontSize             uintptr
+	SetUnderline            uintptr
+	SetStrikethrough        uintptr
+	SetDrawingEffect        uintptr
+	SetInlineObject         uintptr
+	SetTypography           uintptr
+	SetLocaleName           uintptr
+	GetMaxWidth             uintptr
+	GetMaxHeight            uintptr
+	GetFontCollection       uintptr
+	GetFontFamilyNameLength uintptr
+	GetFontFamilyName       uintptr
+	GetFontWeight           uintptr
+	GetFontStyle            uintptr
+	GetFontStretch          uintptr
+	GetFontSize             uintptr
+	GetUnderline            uintptr
+	GetStrikethrough        uintptr
+	GetDrawingEffect        uintptr
+	GetInlineObject         uintptr
+	GetTypography           uintptr
+	GetLocaleNameLength     uintptr
+	GetLocaleName           uintptr
+	Draw                    uintptr
+	GetLineMetrics          uintptr
+	GetMetrics              uintptr
+}
+
+type idWriteTextLayout struct {
+	LpVtbl *idWriteTextLayoutVtbl
+}
+
+func (obj *idWriteTextLayout) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type idWriteInlineObjectVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+}
+
+type idWriteInlineObject struct {
+	LpVtbl *idWriteInlineObjectVtbl
+}
+
+func (obj *idWriteInlineObject) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+func (f *id2d1Factory) CreatePathGeometry(pathGeometry **id2d1PathGeometry) win.HRESULT {
+	ret, _, _ := syscall.Syscall(f.LpVtbl.CreatePathGeometry, 2,
+		uintptr(unsafe.Pointer(f)),
+		uintptr(unsafe.Pointer(pathGeometry)),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (f *id2d1Factory) CreateHwndRenderTarget(renderTargetProperties *d2d1RenderTargetProperties, hwndRenderTargetProperties *d2d1HwndRenderTargetProperties, hwndRenderTarget **id2d1HwndRenderTarget) win.HRESULT {
+	ret, _, _ := syscall.Syscall6(f.LpVtbl.CreateHwndRenderTarget, 4,
+		uintptr(unsafe.Pointer(f)),
+		uintptr(unsafe.Pointer(renderTargetProperties)),
+		uintptr(unsafe.Pointer(hwndRenderTargetProperties)),
+		uintptr(unsafe.Pointer(hwndRenderTarget)),
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (rt *id2d1HwndRenderTarget) CreateSolidColorBrush(color *d2d1ColorF, solidColorBrush **id2d1SolidColorBrush) win.HRESULT {
+	ret, _, _ := syscall.Syscall6(rt.LpVtbl.CreateSolidColorBrush, 4,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(color)),
+		0,
+		uintptr(unsafe.Pointer(solidColorBrush)),
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (rt *id2d1HwndRenderTarget) DrawRectangle(rect *d2d1RectF, brush *id2d1SolidColorBrush, strokeWidth float32) {
+	syscall.Syscall6(rt.LpVtbl.DrawRectangle, 5,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(rect)),
+		uintptr(unsafe.Pointer(brush)),
+		float32Arg(strokeWidth),
+		0,
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) FillRectangle(rect *d2d1RectF, brush *id2d1SolidColorBrush) {
+	syscall.Syscall(rt.LpVtbl.FillRectangle, 3,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(rect)),
+		uintptr(unsafe.Pointer(brush)))
+}
+
+func (rt *id2d1HwndRenderTarget) DrawRoundedRectangle(roundedRect *d2d1RoundedRect, brush *id2d1SolidColorBrush, strokeWidth float32) {
+	syscall.Syscall6(rt.LpVtbl.DrawRoundedRectangle, 5,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(roundedRect)),
+		uintptr(unsafe.Pointer(brush)),
+		float32Arg(strokeWidth),
+		0,
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) FillRoundedRectangle(roundedRect *d2d1RoundedRect, brush *id2d1SolidColorBrush) {
+	syscall.Syscall(rt.LpVtbl.FillRoundedRectangle, 3,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(roundedRect)),
+		uintptr(unsafe.Pointer(brush)))
+}
+
+func (rt *id2d1HwndRenderTarget) DrawEllipse(ellipse *d2d1Ellipse, brush *id2d1SolidColorBrush, strokeWidth float32) {
+	syscall.Syscall6(rt.LpVtbl.DrawEllipse, 5,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(ellipse)),
+		uintptr(unsafe.Pointer(brush)),
+		float32Arg(strokeWidth),
+		0,
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) FillEllipse(ellipse *d2d1Ellipse, brush *id2d1SolidColorBrush) {
+	syscall.Syscall(rt.LpVtbl.FillEllipse, 3,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(ellipse)),
+		uintptr(unsafe.Pointer(brush)))
+}
+
+func (rt *id2d1HwndRenderTarget) DrawGeometry(geometry *id2d1PathGeometry, brush *id2d1SolidColorBrush, strokeWidth float32) {
+	syscall.Syscall6(rt.LpVtbl.DrawGeometry, 5,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(geometry)),
+		uintptr(unsafe.Pointer(brush)),
+		float32Arg(strokeWidth),
+		0,
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) FillGeometry(geometry *id2d1PathGeometry, brush *id2d1SolidColorBrush) {
+	syscall.Syscall6(rt.LpVtbl.FillGeometry, 4,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(geometry)),
+		uintptr(unsafe.Pointer(brush)),
+		0,
+		0,
+		0)
+}
+
+// SetTransform sets the transform of the render target. D2D1_MATRIX_3X2_F has
+// the layout of XFORM.
+func (rt *id2d1HwndRenderTarget) SetTransform(transform *xform) {
+	syscall.Syscall(rt.LpVtbl.SetTransform, 2,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(transform)),
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) PushAxisAlignedClip(clipRect *d2d1RectF, antialiasMode uint32) {
+	syscall.Syscall(rt.LpVtbl.PushAxisAlignedClip, 3,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(clipRect)),
+		uintptr(antialiasMode))
+}
+
+func (rt *id2d1HwndRenderTarget) PopAxisAlignedClip() {
+	syscall.Syscall(rt.LpVtbl.PopAxisAlignedClip, 1,
+		uintptr(unsafe.Pointer(rt)),
+		0,
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) Clear(clearColor *d2d1ColorF) {
+	syscall.Syscall(rt.LpVtbl.Clear, 2,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(clearColor)),
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) BeginDraw() {
+	syscall.Syscall(rt.LpVtbl.BeginDraw, 1,
+		uintptr(unsafe.Pointer(rt)),
+		0,
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) EndDraw() win.HRESULT {
+	ret, _, _ := syscall.Syscall(rt.LpVtbl.EndDraw, 3,
+		uintptr(unsafe.Pointer(rt)),
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (rt *id2d1HwndRenderTarget) SetDpi(dpiX, dpiY float32) {
+	syscall.Syscall(rt.LpVtbl.SetDpi, 3,
+		uintptr(unsafe.Pointer(rt)),
+		float32Arg(dpiX),
+		float32Arg(dpiY))
+}
+
+func (rt *id2d1HwndRenderTarget) Resize(pixelSize *d2d1SizeU) win.HRESULT {
+	ret, _, _ := syscall.Syscall(rt.LpVtbl.Resize, 2,
+		uintptr(unsafe.Pointer(rt)),
+		uintptr(unsafe.Pointer(pixelSize)),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (b *id2d1SolidColorBrush) SetColor(color *d2d1ColorF) {
+	syscall.Syscall(b.LpVtbl.SetColor, 2,
+		uintptr(unsafe.Pointer(b)),
+		uintptr(unsafe.Pointer(color)),
+		0)
+}
+
+func (pg *id2d1PathGeometry) Open(geometrySink **id2d1GeometrySink) win.HRESULT {
+	ret, _, _ := syscall.Syscall(pg.LpVtbl.Open, 2,
+		uintptr(unsafe.Pointer(pg)),
+		uintptr(unsafe.Pointer(geometrySink)),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (gs *id2d1GeometrySink) AddLines(points *d2d1Point2F, pointsCount uint32) {
+	syscall.Syscall(gs.LpVtbl.AddLines, 3,
+		uintptr(unsafe.Pointer(gs)),
+		uintptr(unsafe.Pointer(points)),
+		uintptr(pointsCount))
+}
+
+func (gs *id2d1GeometrySink) EndFigure(figureEnd uint32) {
+	syscall.Syscall(gs.LpVtbl.EndFigure, 2,
+		uintptr(unsafe.Pointer(gs)),
+		uintptr(figureEnd),
+		0)
+}
+
+func (gs *id2d1GeometrySink) Close() win.HRESULT {
+	ret, _, _ := syscall.Syscall(gs.LpVtbl.Close, 1,
+		uintptr(unsafe.Pointer(gs)),
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (f *idWriteFactory) CreateTextFormat(fontFamilyName *uint16, fontWeight, fontStyle, fontStretch uint32, fontSize float32, localeName *uint16, textFormat **idWriteTextFormat) win.HRESULT {
+	ret, _, _ := syscall.Syscall9(f.LpVtbl.CreateTextFormat, 9,
+		uintptr(unsafe.Pointer(f)),
+		uintptr(unsafe.Pointer(fontFamilyName)),
+		0,
+		uintptr(fontWeight),
+		uintptr(fontStyle),
+		uintptr(fontStretch),
+		float32Arg(fontSize),
+		uintptr(unsafe.Pointer(localeName)),
+		uintptr(unsafe.Pointer(textFormat)))
+
+	return win.HRESULT(ret)
+}
+
+func (f *idWriteFactory) CreateTextLayout(str *uint16, stringLength uint32, textFormat *idWriteTextFormat, maxWidth, maxHeight float32, textLayout **idWriteTextLayout) win.HRESULT {
+	ret, _, _ := syscall.Syscall9(f.LpVtbl.CreateTextLayout, 7,
+		uintptr(unsafe.Pointer(f)),
+		uintptr(unsafe.Pointer(str)),
+		uintptr(stringLength),
+		uintptr(unsafe.Pointer(textFormat)),
+		float32Arg(maxWidth),
+		float32Arg(maxHeight),
+		uintptr(unsafe.Pointer(textLayout)),
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (f *idWriteFactory) CreateEllipsisTrimmingSign(textFormat *idWriteTextFormat, trimmingSign **idWriteInlineObject) win.HRESULT {
+	ret, _, _ := syscall.Syscall(f.LpVtbl.CreateEllipsisTrimmingSign, 3,
+		uintptr(unsafe.Pointer(f)),
+		uintptr(unsafe.Pointer(textFormat)),
+		uintptr(unsafe.Pointer(trimmingSign)))
+
+	return win.HRESULT(ret)
+}
+
+func (tf *idWriteTextFormat) SetTextAlignment(textAlignment uint32) win.HRESULT {
+	ret, _, _ := syscall.Syscall(tf.LpVtbl.SetTextAlignment, 2,
+		uintptr(unsafe.Pointer(tf)),
+		uintptr(textAlignment),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (tf *idWriteTextFormat) SetParagraphAlignment(paragraphAlignment uint32) win.HRESULT {
+	ret, _, _ := syscall.Syscall(tf.LpVtbl.SetParagraphAlignment, 2,
+		uintptr(unsafe.Pointer(tf)),
+		uintptr(paragraphAlignment),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (tf *idWriteTextFormat) SetWordWrapping(wordWrapping uint32) win.HRESULT {
+	ret, _, _ := syscall.Syscall(tf.LpVtbl.SetWordWrapping, 2,
+		uintptr(unsafe.Pointer(tf)),
+		uintptr(wordWrapping),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (tf *idWriteTextFormat) SetReadingDirection(readingDirection uint32) win.HRESULT {
+	ret, _, _ := syscall.Syscall(tf.LpVtbl.SetReadingDirection, 2,
+		uintptr(unsafe.Pointer(tf)),
+		uintptr(readingDirection),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (tf *idWriteTextFormat) SetTrimming(trimmingOptions *dwriteTrimming, trimmingSign *idWriteInlineObject) win.HRESULT {
+	ret, _, _ := syscall.Syscall(tf.LpVtbl.SetTrimming, 3,
+		uintptr(unsafe.Pointer(tf)),
+		uintptr(unsafe.Pointer(trimmingOptions)),
+		uintptr(unsafe.Pointer(trimmingSign)))
+
+	return win.HRESULT(ret)
+}
+
+func (tl *idWriteTextLayout) GetMetrics(textMetrics *dwriteTextMetrics) win.HRESULT {
+	ret, _, _ := syscall.Syscall(tl.LpVtbl.GetMetrics, 2,
+		uintptr(unsafe.Pointer(tl)),
+		uintptr(unsafe.Pointer(textMetrics)),
+		0)
+
+	return win.HRESULT(ret)
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows,386 windows,arm
+
+package walk
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// On 32-bit Windows, structs passed by value take one argument per 4 bytes.
+
+func (rt *id2d1HwndRenderTarget) DrawLine(point0, point1 d2d1Point2F, brush *id2d1SolidColorBrush, strokeWidth float32) {
+	syscall.Syscall9(rt.LpVtbl.DrawLine, 8,
+		uintptr(unsafe.Pointer(rt)),
+		float32Arg(point0.X),
+		float32Arg(point0.Y),
+		float32Arg(point1.X),
+		float32Arg(point1.Y),
+		uintptr(unsafe.Pointer(brush)),
+		float32Arg(strokeWidth),
+		0,
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) DrawTextLayout(origin d2d1Point2F, textLayout *idWriteTextLayout, brush *id2d1SolidColorBrush, options uint32) {
+	syscall.Syscall6(rt.LpVtbl.DrawTextLayout, 6,
+		uintptr(unsafe.Pointer(rt)),
+		float32Arg(origin.X),
+		float32Arg(origin.Y),
+		uintptr(unsafe.Pointer(textLayout)),
+		uintptr(unsafe.Pointer(brush)),
+		uintptr(options))
+}
+
+func (gs *id2d1GeometrySink) BeginFigure(startPoint d2d1Point2F, figureBegin uint32) {
+	syscall.Syscall6(gs.LpVtbl.BeginFigure, 4,
+		uintptr(unsafe.Pointer(gs)),
+		float32Arg(startPoint.X),
+		float32Arg(startPoint.Y),
+		uintptr(figureBegin),
+		0,
+		0)
+}
+
+func (tl *idWriteTextLayout) SetUnderline(hasUnderline bool, textRange dwriteTextRange) win.HRESULT {
+	ret, _, _ := syscall.Syscall6(tl.LpVtbl.SetUnderline, 4,
+		uintptr(unsafe.Pointer(tl)),
+		uintptr(win.BoolToBOOL(hasUnderline)),
+		uintptr(textRange.StartPosition),
+		uintptr(textRange.Length),
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func (tl *idWriteTextLayout) SetStrikethrough(hasStrikethrough bool, textRange dwriteTextRange) win.HRESULT {
+	ret, _, _ := syscall.Syscall6(tl.LpVtbl.SetStrikethrough, 4,
+		uintptr(unsafe.Pointer(tl)),
+		uintptr(win.BoolToBOOL(hasStrikethrough)),
+		uintptr(textRange.StartPosition),
+		uintptr(textRange.Length),
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
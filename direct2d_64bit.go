@@ -0,0 +1,69 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows,!386,!arm
+
+package walk
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// On 64-bit Windows, structs of 8 bytes passed by value take one argument.
+
+func (p d2d1Point2F) arg() uintptr {
+	return float32Arg(p.X) | float32Arg(p.Y)<<32
+}
+
+func (r dwriteTextRange) arg() uintptr {
+	return uintptr(r.StartPosition) | uintptr(r.Length)<<32
+}
+
+func (rt *id2d1HwndRenderTarget) DrawLine(point0, point1 d2d1Point2F, brush *id2d1SolidColorBrush, strokeWidth float32) {
+	syscall.Syscall6(rt.LpVtbl.DrawLine, 6,
+		uintptr(unsafe.Pointer(rt)),
+		point0.arg(),
+		point1.arg(),
+		uintptr(unsafe.Pointer(brush)),
+		float32Arg(strokeWidth),
+		0)
+}
+
+func (rt *id2d1HwndRenderTarget) DrawTextLayout(origin d2d1Point2F, textLayout *idWriteTextLayout, brush *id2d1SolidColorBrush, options uint32) {
+	syscall.Syscall6(rt.LpVtbl.DrawTextLayout, 5,
+		uintptr(unsafe.Pointer(rt)),
+		origin.arg(),
+		uintptr(unsafe.Pointer(textLayout)),
+		uintptr(unsafe.Pointer(brush)),
+		uintptr(options),
+		0)
+}
+
+func (gs *id2d1GeometrySink) BeginFigure(startPoint d2d1Point2F, figureBegin uint32) {
+	syscall.Syscall(gs.LpVtbl.BeginFigure, 3,
+		uintptr(unsafe.Pointer(gs)),
+		startPoint.arg(),
+		uintptr(figureBegin))
+}
+
+func (tl *idWriteTextLayout) SetUnderline(hasUnderline bool, textRange dwriteTextRange) win.HRESULT {
+	ret, _, _ := syscall.Syscall(tl.LpVtbl.SetUnderline, 3,
+		uintptr(unsafe.Pointer(tl)),
+		uintptr(win.BoolToBOOL(hasUnderline)),
+		textRange.arg())
+
+	return win.HRESULT(ret)
+}
+
+func (tl *idWriteTextLayout) SetStrikethrough(hasStrikethrough bool, textRange dwriteTextRange) win.HRESULT {
+	ret, _, _ := syscall.Syscall(tl.LpVtbl.SetStrikethrough, 3,
+		uintptr(unsafe.Pointer(tl)),
+		uintptr(win.BoolToBOOL(hasStrikethrough)),
+		textRange.arg())
+
+	return win.HRESULT(ret)
+}
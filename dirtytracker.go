@@ -0,0 +1,388 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"time"
+)
+
+// DirtyTracker tracks whether the document edited in a Form has unsaved
+// changes.
+//
+// It combines the Dirty state of any number of DataBinders with a state that
+// is set manually, marks the title of the form with an asterisk while there
+// are unsaved changes, asks the user whether to save them when the form is
+// closing and optionally saves them periodically.
+//
+// All methods must be called on the UI thread of the form.
+type DirtyTracker struct {
+	form                    Form
+	binders                 []*DataBinder
+	binder2DirtyHandle      map[*DataBinder]int
+	closingHandle           int
+	manualDirty             bool
+	dirty                   bool
+	title                   string
+	documentName            string
+	dirtyMarker             string
+	promptOnClose           bool
+	saveFunc                func() error
+	autoSaveFunc            func() error
+	autoSaveInterval        time.Duration
+	autoSaveTicker          *time.Ticker
+	autoSaveDone            chan struct{}
+	dirtyChangedPublisher   EventPublisher
+	autoSavedPublisher      EventPublisher
+	autoSaveFailedPublisher ErrorEventPublisher
+}
+
+// NewDirtyTracker creates a new DirtyTracker for form.
+//
+// The current title of form is used as the title, that is marked while there
+// are unsaved changes.
+func NewDirtyTracker(form Form) *DirtyTracker {
+	dt := &DirtyTracker{
+		form:               form,
+		binder2DirtyHandle: make(map[*DataBinder]int),
+		title:              form.Title(),
+		dirtyMarker:        "*",
+		promptOnClose:      true,
+	}
+
+	dt.closingHandle = form.Closing().Attach(dt.onClosing)
+
+	form.Disposing().Attach(dt.stopAutoSave)
+
+	return dt
+}
+
+// Dispose detaches the DirtyTracker from its form and DataBinders.
+func (dt *DirtyTracker) Dispose() {
+	dt.stopAutoSave()
+
+	for db, handle := range dt.binder2DirtyHandle {
+		db.DirtyChanged().Detach(handle)
+	}
+	dt.binders = nil
+	dt.binder2DirtyHandle = make(map[*DataBinder]int)
+
+	if dt.closingHandle >= 0 {
+		dt.form.Closing().Detach(dt.closingHandle)
+		dt.closingHandle = -1
+	}
+}
+
+// AddDataBinder makes the DirtyTracker dirty while db is dirty.
+func (dt *DirtyTracker) AddDataBinder(db *DataBinder) {
+	if _, ok := dt.binder2DirtyHandle[db]; ok {
+		return
+	}
+
+	dt.binders = append(dt.binders, db)
+	dt.binder2DirtyHandle[db] = db.DirtyChanged().Attach(dt.update)
+
+	dt.update()
+}
+
+// RemoveDataBinder stops tracking db.
+func (dt *DirtyTracker) RemoveDataBinder(db *DataBinder) {
+	handle, ok := dt.binder2DirtyHandle[db]
+	if !ok {
+		return
+	}
+
+	db.DirtyChanged().Detach(handle)
+	delete(dt.binder2DirtyHandle, db)
+
+	for i, b := range dt.binders {
+		if b == db {
+			dt.binders = append(dt.binders[:i], dt.binders[i+1:]...)
+			break
+		}
+	}
+
+	dt.update()
+}
+
+// Dirty returns whether there are unsaved changes.
+func (dt *DirtyTracker) Dirty() bool {
+	return dt.dirty
+}
+
+// SetDirty marks the document as having unsaved changes, e.g. after edits
+// that are not covered by a DataBinder. Passing false clears the manual
+// state, but not the one of the DataBinders. Use MarkClean for that.
+func (dt *DirtyTracker) SetDirty(dirty bool) {
+	dt.manualDirty = dirty
+
+	dt.update()
+}
+
+// MarkClean clears the dirty state, including the one of all DataBinders.
+// It is called automatically after the document has been saved.
+func (dt *DirtyTracker) MarkClean() {
+	dt.manualDirty = false
+
+	for _, db := range dt.binders {
+		db.SetDirty(false)
+	}
+
+	dt.update()
+}
+
+// DirtyChanged returns the event that is published when Dirty changed.
+func (dt *DirtyTracker) DirtyChanged() *Event {
+	return dt.dirtyChangedPublisher.Event()
+}
+
+// Title returns the title of the form, without the dirty marker.
+func (dt *DirtyTracker) Title() string {
+	return dt.title
+}
+
+// SetTitle sets the title of the form. While there are unsaved changes, it is
+// prefixed with the dirty marker.
+func (dt *DirtyTracker) SetTitle(title string) error {
+	dt.title = title
+
+	return dt.updateTitle()
+}
+
+// DirtyMarker returns the text that is prepended to the title while there
+// are unsaved changes. The default is "*".
+func (dt *DirtyTracker) DirtyMarker() string {
+	return dt.dirtyMarker
+}
+
+// SetDirtyMarker sets the text that is prepended to the title while there are
+// unsaved changes. An empty marker leaves the title alone.
+func (dt *DirtyTracker) SetDirtyMarker(marker string) error {
+	dt.dirtyMarker = marker
+
+	return dt.updateTitle()
+}
+
+// DocumentName returns the name of the document used in the prompt on close.
+func (dt *DirtyTracker) DocumentName() string {
+	return dt.documentName
+}
+
+// SetDocumentName sets the name of the document used in the prompt on close,
+// e.g. the base name of its file.
+func (dt *DirtyTracker) SetDocumentName(name string) {
+	dt.documentName = name
+}
+
+// PromptOnClose returns whether the user is asked whether to save unsaved
+// changes when the form is closing. The default is true.
+func (dt *DirtyTracker) PromptOnClose() bool {
+	return dt.promptOnClose
+}
+
+// SetPromptOnClose sets whether the user is asked whether to save unsaved
+// changes when the form is closing.
+func (dt *DirtyTracker) SetPromptOnClose(prompt bool) {
+	dt.promptOnClose = prompt
+}
+
+// SetSaveFunc sets the function that saves the document. It is called by
+// Save, when the user chooses to save on close and, if there is no auto-save
+// function, for auto-saving.
+func (dt *DirtyTracker) SetSaveFunc(save func() error) {
+	dt.saveFunc = save
+}
+
+// Save saves the document using the save function and, if that succeeds,
+// marks it clean.
+func (dt *DirtyTracker) Save() error {
+	if dt.saveFunc == nil {
+		return newError("no save function")
+	}
+
+	if err := dt.saveFunc(); err != nil {
+		return err
+	}
+
+	dt.MarkClean()
+
+	return nil
+}
+
+// AutoSaveInterval returns the interval of auto-saving, or 0 if it is
+// disabled.
+func (dt *DirtyTracker) AutoSaveInterval() time.Duration {
+	return dt.autoSaveInterval
+}
+
+// SetAutoSaveInterval sets the interval, in which the document is auto-saved
+// while there are unsaved changes. 0 disables auto-saving.
+func (dt *DirtyTracker) SetAutoSaveInterval(interval time.Duration) {
+	if interval < 0 {
+		interval = 0
+	}
+
+	dt.autoSaveInterval = interval
+
+	dt.stopAutoSave()
+	dt.updateAutoSave()
+}
+
+// SetAutoSaveFunc sets a function that is called for auto-saving instead of
+// the save function, e.g. to write a recovery file. Unlike with the save
+// function, the document stays dirty afterwards.
+func (dt *DirtyTracker) SetAutoSaveFunc(autoSave func() error) {
+	dt.autoSaveFunc = autoSave
+}
+
+// AutoSaved returns the event that is published after the document was
+// auto-saved.
+func (dt *DirtyTracker) AutoSaved() *Event {
+	return dt.autoSavedPublisher.Event()
+}
+
+// AutoSaveFailed returns the event that is published when auto-saving failed.
+func (dt *DirtyTracker) AutoSaveFailed() *ErrorEvent {
+	return dt.autoSaveFailedPublisher.Event()
+}
+
+func (dt *DirtyTracker) update() {
+	dirty := dt.manualDirty
+	for _, db := range dt.binders {
+		if db.Dirty() {
+			dirty = true
+			break
+		}
+	}
+
+	if dirty == dt.dirty {
+		return
+	}
+
+	dt.dirty = dirty
+
+	dt.updateTitle()
+	dt.updateAutoSave()
+
+	dt.dirtyChangedPublisher.Publish()
+}
+
+func (dt *DirtyTracker) updateTitle() error {
+	title := dt.title
+	if dt.dirty {
+		title = dt.dirtyMarker + title
+	}
+
+	if title == dt.form.Title() {
+		return nil
+	}
+
+	return dt.form.SetTitle(title)
+}
+
+func (dt *DirtyTracker) updateAutoSave() {
+	if !dt.dirty || dt.autoSaveInterval == 0 {
+		dt.stopAutoSave()
+		return
+	}
+
+	if dt.autoSaveTicker != nil {
+		return
+	}
+
+	ticker := time.NewTicker(dt.autoSaveInterval)
+	done := make(chan struct{})
+
+	dt.autoSaveTicker = ticker
+	dt.autoSaveDone = done
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				dt.form.Synchronize(func() {
+					if dt.autoSaveTicker == ticker {
+						dt.autoSave()
+					}
+				})
+
+			case <-done:
+				return
+			}
+		}
+	}()
+}
+
+func (dt *DirtyTracker) stopAutoSave() {
+	if dt.autoSaveTicker == nil {
+		return
+	}
+
+	dt.autoSaveTicker.Stop()
+	close(dt.autoSaveDone)
+
+	dt.autoSaveTicker = nil
+	dt.autoSaveDone = nil
+}
+
+func (dt *DirtyTracker) autoSave() {
+	if !dt.dirty {
+		return
+	}
+
+	var err error
+	if dt.autoSaveFunc != nil {
+		err = dt.autoSaveFunc()
+	} else if dt.saveFunc != nil {
+		err = dt.Save()
+	} else {
+		return
+	}
+
+	if err != nil {
+		dt.autoSaveFailedPublisher.Publish(err)
+		return
+	}
+
+	dt.autoSavedPublisher.Publish()
+}
+
+func (dt *DirtyTracker) onClosing(canceled *bool, reason CloseReason) {
+	if *canceled || !dt.promptOnClose || !dt.dirty {
+		return
+	}
+
+	title := dt.title
+	if title == "" {
+		title = App().ProductName()
+	}
+
+	if dt.saveFunc == nil {
+		if MsgBox(dt.form, title, tr("There are unsaved changes. Do you want to discard them?", "walk"), MsgBoxYesNo|MsgBoxIconWarning|MsgBoxDefButton2) != DlgCmdYes {
+			*canceled = true
+		}
+		return
+	}
+
+	message := tr("Do you want to save your changes?", "walk")
+	if dt.documentName != "" {
+		message = fmt.Sprintf(tr("Do you want to save the changes to %s?", "walk"), dt.documentName)
+	}
+
+	switch MsgBox(dt.form, title, message, MsgBoxYesNoCancel|MsgBoxIconWarning) {
+	case DlgCmdYes:
+		if err := dt.Save(); err != nil {
+			MsgBox(dt.form, title, err.Error(), MsgBoxOK|MsgBoxIconError)
+			*canceled = true
+		}
+
+	case DlgCmdNo:
+
+	default:
+		*canceled = true
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"sync"
+
+	"github.com/lxn/win"
+)
+
+// Dock specifies the edge of the container a widget in a DockLayout is
+// docked to.
+type Dock int
+
+const (
+	// DockFill makes the widget fill the space left by the docked widgets.
+	// It is the default.
+	DockFill Dock = iota
+	DockTop
+	DockBottom
+	DockLeft
+	DockRight
+)
+
+// DockLayout docks the widgets of a container to its edges, in the order of
+// the widgets, like the toolbars, side panels and status bar of a main
+// window around the document area.
+//
+// Each widget docked to an edge spans the space that is left along that
+// edge, so earlier widgets enclose later ones. Widgets docked to the top or
+// bottom get their ideal height, widgets docked to the left or right their
+// ideal width. The widgets with DockFill share the remaining space, stacked
+// from top to bottom.
+type DockLayout struct {
+	LayoutBase
+	hwnd2Dock map[win.HWND]Dock
+}
+
+func NewDockLayout() *DockLayout {
+	l := &DockLayout{
+		LayoutBase: LayoutBase{
+			margins96dpi: Margins{9, 9, 9, 9},
+			spacing96dpi: 6,
+		},
+		hwnd2Dock: make(map[win.HWND]Dock),
+	}
+	l.layout = l
+
+	return l
+}
+
+// Dock returns the edge widget is docked to.
+func (l *DockLayout) Dock(widget Widget) Dock {
+	return l.hwnd2Dock[widget.Handle()]
+}
+
+// SetDock sets the edge widget is docked to.
+func (l *DockLayout) SetDock(widget Widget, dock Dock) error {
+	if dock != l.Dock(widget) {
+		if l.container == nil {
+			return newError("container required")
+		}
+
+		handle := widget.Handle()
+
+		if !l.container.Children().containsHandle(handle) {
+			return newError("unknown widget")
+		}
+		if dock < DockFill || dock > DockRight {
+			return newError("invalid Dock value")
+		}
+
+		if dock == DockFill {
+			delete(l.hwnd2Dock, handle)
+		} else {
+			l.hwnd2Dock[handle] = dock
+		}
+
+		l.container.RequestLayout()
+	}
+
+	return nil
+}
+
+func (l *DockLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	li := &dockLayoutItem{
+		hwnd2Dock: make(map[win.HWND]Dock, len(l.hwnd2Dock)),
+	}
+
+	for hwnd, dock := range l.hwnd2Dock {
+		li.hwnd2Dock[hwnd] = dock
+	}
+
+	return li
+}
+
+type dockLayoutItem struct {
+	ContainerLayoutItemBase
+	mutex        sync.Mutex
+	minSizeCache minSizeCache // in native pixels
+	hwnd2Dock    map[win.HWND]Dock
+}
+
+// dockLayoutEdgeItem is an item docked to an edge, with its size along the
+// axis it is docked on and its minimum size.
+type dockLayoutEdgeItem struct {
+	dock    Dock
+	extent  int  // in native pixels
+	minSize Size // in native pixels
+}
+
+func (li *dockLayoutItem) LayoutFlags() LayoutFlags {
+	return boxLayoutFlags(Horizontal, li.children)
+}
+
+func (li *dockLayoutItem) IdealSize() Size {
+	return li.MinSize()
+}
+
+func (li *dockLayoutItem) MinSize() Size {
+	return li.MinSizeForSize(li.geometry.ClientSize)
+}
+
+func (li *dockLayoutItem) HeightForWidth(width int) int {
+	return li.MinSizeForSize(Size{width, li.geometry.ClientSize.Height}).Height
+}
+
+func (li *dockLayoutItem) MinSizeForSize(size Size) Size {
+	li.mutex.Lock()
+	defer li.mutex.Unlock()
+
+	if min, ok := li.minSizeCache.get(size); ok {
+		return min
+	}
+
+	_, s := li.layoutItems(size)
+
+	if s.Width > 0 && s.Height > 0 {
+		li.minSizeCache.put(size, s)
+	}
+
+	return s
+}
+
+func (li *dockLayoutItem) PerformLayout() []LayoutResultItem {
+	items, _ := li.layoutItems(li.geometry.ClientSize)
+
+	return items
+}
+
+// extent returns the size of item along the axis it is docked on, for the
+// given size along the other axis.
+func (li *dockLayoutItem) extent(item LayoutItem, dock Dock, cross int) int {
+	min := li.MinSizeEffectiveForChild(item)
+	max := item.Geometry().MaxSize
+
+	var ideal Size
+	if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+		if dock == DockTop || dock == DockBottom {
+			return maxi(min.Height, hfw.HeightForWidth(cross))
+		}
+	} else if is, ok := item.(IdealSizer); ok {
+		ideal = is.IdealSize()
+	}
+
+	if dock == DockTop || dock == DockBottom {
+		extent := maxi(min.Height, ideal.Height)
+		if max.Height > 0 {
+			extent = maxi(min.Height, mini(extent, max.Height))
+		}
+
+		return extent
+	}
+
+	extent := maxi(min.Width, ideal.Width)
+	if max.Width > 0 {
+		extent = maxi(min.Width, mini(extent, max.Width))
+	}
+
+	return extent
+}
+
+// layoutItems returns the bounds of the items for the client size and the
+// minimum size the layout needs at that size.
+func (li *dockLayoutItem) layoutItems(size Size) ([]LayoutResultItem, Size) {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	hSpacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
+	vSpacing := IntFrom96DPI(li.spacing96dpi(Vertical), li.ctx.dpi)
+
+	rest := Rectangle{
+		X:      margins.HNear,
+		Y:      margins.VNear,
+		Width:  size.Width - margins.HNear - margins.HFar,
+		Height: size.Height - margins.VNear - margins.VFar,
+	}
+
+	results := make([]LayoutResultItem, 0, len(li.children))
+	var edgeItems []dockLayoutEdgeItem
+	var fillItems []LayoutItem
+
+	for _, item := range li.children {
+		if !shouldLayoutItem(item) {
+			continue
+		}
+
+		dock := li.hwnd2Dock[item.Handle()]
+		if dock == DockFill {
+			fillItems = append(fillItems, item)
+			continue
+		}
+
+		var bounds Rectangle
+		var extent int
+
+		switch dock {
+		case DockTop, DockBottom:
+			extent = li.extent(item, dock, rest.Width)
+			h := mini(extent, maxi(0, rest.Height))
+
+			bounds = Rectangle{rest.X, rest.Y, rest.Width, h}
+			if dock == DockBottom {
+				bounds.Y = rest.Y + rest.Height - h
+			} else {
+				rest.Y += h + vSpacing
+			}
+			rest.Height -= h + vSpacing
+
+		default:
+			extent = li.extent(item, dock, rest.Height)
+			w := mini(extent, maxi(0, rest.Width))
+
+			bounds = Rectangle{rest.X, rest.Y, w, rest.Height}
+			if dock == DockRight {
+				bounds.X = rest.X + rest.Width - w
+			} else {
+				rest.X += w + hSpacing
+			}
+			rest.Width -= w + hSpacing
+		}
+
+		bounds.Width = maxi(0, bounds.Width)
+		bounds.Height = maxi(0, bounds.Height)
+
+		results = append(results, LayoutResultItem{Item: item, Bounds: bounds})
+		edgeItems = append(edgeItems, dockLayoutEdgeItem{dock, extent, li.MinSizeEffectiveForChild(item)})
+	}
+
+	// The minimum size is accumulated from the inside out.
+	var min Size
+
+	if n := len(fillItems); n > 0 {
+		y := rest.Y
+		available := maxi(0, rest.Height-(n-1)*vSpacing)
+
+		for i, item := range fillItems {
+			h := available / (n - i)
+			available -= h
+
+			results = append(results, LayoutResultItem{Item: item, Bounds: Rectangle{rest.X, y, maxi(0, rest.Width), h}})
+
+			y += h + vSpacing
+
+			itemMin := li.MinSizeEffectiveForChild(item)
+			if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+				itemMin.Height = hfw.HeightForWidth(maxi(itemMin.Width, rest.Width))
+			}
+
+			min.Width = maxi(min.Width, itemMin.Width)
+			min.Height += itemMin.Height
+		}
+
+		min.Height += (n - 1) * vSpacing
+	}
+
+	inner := len(fillItems) > 0
+	for i := len(edgeItems) - 1; i >= 0; i-- {
+		ei := edgeItems[i]
+
+		var hs, vs int
+		if inner {
+			hs, vs = hSpacing, vSpacing
+		}
+
+		if ei.dock == DockTop || ei.dock == DockBottom {
+			min.Height += ei.extent + vs
+			min.Width = maxi(min.Width, ei.minSize.Width)
+		} else {
+			min.Width += ei.extent + hs
+			min.Height = maxi(min.Height, ei.minSize.Height)
+		}
+
+		inner = true
+	}
+
+	if !inner {
+		return results, Size{}
+	}
+
+	min.Width += margins.HNear + margins.HFar
+	min.Height += margins.VNear + margins.VFar
+
+	return results, min
+}
@@ -0,0 +1,678 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const downloadProgressInterval = 200 * time.Millisecond
+
+// DownloadState is the state of a Download.
+type DownloadState int
+
+const (
+	DownloadQueued DownloadState = iota
+	DownloadRunning
+	DownloadPaused
+	DownloadCompleted
+	DownloadFailed
+	DownloadCanceled
+)
+
+func (s DownloadState) String() string {
+	switch s {
+	case DownloadQueued:
+		return tr("Queued", "walk")
+
+	case DownloadRunning:
+		return tr("Downloading", "walk")
+
+	case DownloadPaused:
+		return tr("Paused", "walk")
+
+	case DownloadCompleted:
+		return tr("Completed", "walk")
+
+	case DownloadFailed:
+		return tr("Failed", "walk")
+
+	case DownloadCanceled:
+		return tr("Canceled", "walk")
+	}
+
+	return ""
+}
+
+// Finished returns whether the state is final, i.e. DownloadCompleted,
+// DownloadFailed or DownloadCanceled.
+func (s DownloadState) Finished() bool {
+	return s >= DownloadCompleted
+}
+
+type downloadEventHandlerInfo struct {
+	handler DownloadEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+type DownloadEventHandler func(download *Download)
+
+type DownloadEvent struct {
+	handlers []downloadEventHandlerInfo
+}
+
+func (e *DownloadEvent) Attach(handler DownloadEventHandler) int {
+	handlerInfo := downloadEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *DownloadEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *DownloadEvent) Once(handler DownloadEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *DownloadEvent) AttachScoped(owner Window, handler DownloadEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type DownloadEventPublisher struct {
+	event DownloadEvent
+}
+
+func (p *DownloadEventPublisher) Event() *DownloadEvent {
+	return &p.event
+}
+
+func (p *DownloadEventPublisher) Publish(download *Download) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(download)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
+
+// DownloadRequest describes a file to download.
+type DownloadRequest struct {
+	URL      string
+	FilePath string // The path of the downloaded file.
+	SHA256   string // If not empty, the hex encoded SHA-256 checksum the file must have.
+	Header   http.Header
+}
+
+// Download is a file download managed by a Downloader.
+//
+// All methods must be called on the UI thread of the owner window of the
+// Downloader and all events are published there.
+type Download struct {
+	downloader       *Downloader
+	request          DownloadRequest
+	state            DownloadState
+	err              error
+	bytesReceived    int64
+	totalBytes       int64
+	speed            int64
+	cancel           context.CancelFunc
+	generation       int
+	changedPublisher EventPublisher
+}
+
+// URL returns the URL of the download.
+func (d *Download) URL() string {
+	return d.request.URL
+}
+
+// FilePath returns the path of the downloaded file.
+func (d *Download) FilePath() string {
+	return d.request.FilePath
+}
+
+// State returns the state of the download.
+func (d *Download) State() DownloadState {
+	return d.state
+}
+
+// Err returns why the download failed, if it did.
+func (d *Download) Err() error {
+	return d.err
+}
+
+// BytesReceived returns how many bytes of the file have been downloaded.
+func (d *Download) BytesReceived() int64 {
+	return d.bytesReceived
+}
+
+// TotalBytes returns the size of the file, or -1 if it is not known (yet).
+func (d *Download) TotalBytes() int64 {
+	return d.totalBytes
+}
+
+// Speed returns the current download speed in bytes per second.
+func (d *Download) Speed() int64 {
+	return d.speed
+}
+
+// Changed returns the event that is published when the state or progress of
+// the download changed.
+func (d *Download) Changed() *Event {
+	return d.changedPublisher.Event()
+}
+
+// Pause stops a queued or running download, keeping the data downloaded so
+// far, so it can be resumed later.
+func (d *Download) Pause() {
+	if d.state != DownloadQueued && d.state != DownloadRunning {
+		return
+	}
+
+	d.stop()
+	d.setState(DownloadPaused, nil)
+
+	d.downloader.schedule()
+}
+
+// Resume queues a paused or failed download again. It continues where it
+// stopped, if the server supports range requests.
+func (d *Download) Resume() {
+	if d.state != DownloadPaused && d.state != DownloadFailed {
+		return
+	}
+
+	d.setState(DownloadQueued, nil)
+
+	d.downloader.schedule()
+}
+
+// Cancel stops the download and deletes the data downloaded so far.
+func (d *Download) Cancel() {
+	if d.state.Finished() && d.state != DownloadFailed {
+		return
+	}
+
+	d.stop()
+	os.Remove(d.partFilePath())
+	d.bytesReceived = 0
+	d.setState(DownloadCanceled, nil)
+
+	d.downloader.schedule()
+}
+
+func (d *Download) partFilePath() string {
+	return d.request.FilePath + ".part"
+}
+
+func (d *Download) stop() {
+	d.generation++
+
+	if d.cancel != nil {
+		d.cancel()
+		d.cancel = nil
+	}
+
+	d.speed = 0
+}
+
+func (d *Download) setState(state DownloadState, err error) {
+	d.state = state
+	d.err = err
+
+	d.changedPublisher.Publish()
+}
+
+func (d *Download) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	d.generation++
+	d.cancel = cancel
+	d.setState(DownloadRunning, nil)
+
+	go d.run(ctx, d.generation)
+}
+
+// run performs the download on its own goroutine. Results are applied on the
+// UI thread, as long as generation is still current.
+func (d *Download) run(ctx context.Context, generation int) {
+	dl := d.downloader
+
+	report := func(f func()) {
+		dl.owner.Synchronize(func() {
+			if generation == d.generation {
+				f()
+			}
+		})
+	}
+
+	err := d.transfer(ctx, report)
+	if ctx.Err() != nil {
+		return
+	}
+
+	report(func() {
+		d.cancel = nil
+		d.speed = 0
+
+		if err != nil {
+			d.setState(DownloadFailed, err)
+		} else {
+			d.setState(DownloadCompleted, nil)
+		}
+
+		dl.schedule()
+	})
+}
+
+func (d *Download) transfer(ctx context.Context, report func(func())) error {
+	dl := d.downloader
+	partPath := d.partFilePath()
+
+	var offset int64
+	if fi, err := os.Stat(partPath); err == nil {
+		offset = fi.Size()
+	}
+
+	req, err := http.NewRequest("GET", d.request.URL, nil)
+	if err != nil {
+		return wrapErrorNoPanic(err)
+	}
+	req = req.WithContext(ctx)
+
+	for k, v := range d.request.Header {
+		req.Header[k] = v
+	}
+
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := dl.client().Do(req)
+	if err != nil {
+		return wrapErrorNoPanic(err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_WRONLY | os.O_CREATE
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// The server ignored the range, so start over.
+		offset = 0
+		flags |= os.O_TRUNC
+
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The part file is complete already.
+		return d.finish(partPath)
+
+	default:
+		return newErrorNoPanic(fmt.Sprintf("HTTP %s", resp.Status))
+	}
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+	}
+
+	file, err := os.OpenFile(partPath, flags, 0666)
+	if err != nil {
+		return wrapErrorNoPanic(err)
+	}
+
+	received := offset
+	lastReport := time.Now()
+	lastReportReceived := received
+
+	report(func() {
+		d.bytesReceived = received
+		d.totalBytes = total
+		d.changedPublisher.Publish()
+	})
+
+	buf := make([]byte, 32*1024)
+
+	for {
+		n, readErr := resp.Body.Read(buf)
+
+		if n > 0 {
+			if err := dl.limiter.wait(ctx, n); err != nil {
+				file.Close()
+				return err
+			}
+
+			if _, err := file.Write(buf[:n]); err != nil {
+				file.Close()
+				return wrapErrorNoPanic(err)
+			}
+
+			received += int64(n)
+
+			if now := time.Now(); now.Sub(lastReport) >= downloadProgressInterval {
+				r := received
+				speed := int64(float64(received-lastReportReceived) / now.Sub(lastReport).Seconds())
+
+				report(func() {
+					d.bytesReceived = r
+					d.speed = speed
+					d.changedPublisher.Publish()
+				})
+
+				lastReport, lastReportReceived = now, received
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			file.Close()
+			return wrapErrorNoPanic(readErr)
+		}
+	}
+
+	if err := file.Close(); err != nil {
+		return wrapErrorNoPanic(err)
+	}
+
+	if total >= 0 && received != total {
+		return newErrorNoPanic("incomplete download")
+	}
+
+	report(func() {
+		d.bytesReceived = received
+	})
+
+	return d.finish(partPath)
+}
+
+// finish verifies the checksum of the part file and moves it to its final
+// path.
+func (d *Download) finish(partPath string) error {
+	if expected := d.request.SHA256; expected != "" {
+		file, err := os.Open(partPath)
+		if err != nil {
+			return wrapErrorNoPanic(err)
+		}
+
+		hash := sha256.New()
+		_, err = io.Copy(hash, file)
+		file.Close()
+		if err != nil {
+			return wrapErrorNoPanic(err)
+		}
+
+		if actual := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(actual, expected) {
+			os.Remove(partPath)
+			return newErrorNoPanic(tr("Checksum mismatch", "walk"))
+		}
+	}
+
+	os.Remove(d.request.FilePath)
+
+	if err := os.Rename(partPath, d.request.FilePath); err != nil {
+		return wrapErrorNoPanic(err)
+	}
+
+	return nil
+}
+
+// bandwidthLimiter limits the combined throughput of all downloads of a
+// Downloader.
+type bandwidthLimiter struct {
+	mutex sync.Mutex
+	rate  int64 // bytes per second, 0 means unlimited
+	next  time.Time
+}
+
+func (l *bandwidthLimiter) setRate(rate int64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.rate = rate
+	l.next = time.Time{}
+}
+
+func (l *bandwidthLimiter) wait(ctx context.Context, n int) error {
+	l.mutex.Lock()
+
+	if l.rate <= 0 {
+		l.mutex.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(int64(n) * int64(time.Second) / l.rate))
+
+	l.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Downloader downloads files via HTTP(S), running a limited number of
+// downloads in parallel.
+//
+// Interrupted downloads are resumed using range requests, if the server
+// supports them. Data is written to a file with the extension ".part", that is
+// renamed when the download completed and its checksum, if any, was verified.
+//
+// All methods must be called on the UI thread of the owner window and all
+// events are published there.
+type Downloader struct {
+	owner            Window
+	httpClient       *http.Client
+	maxParallel      int
+	limiter          bandwidthLimiter
+	downloads        []*Download
+	addedPublisher   DownloadEventPublisher
+	removedPublisher DownloadEventPublisher
+}
+
+// NewDownloader creates a new Downloader, that publishes its events on the UI
+// thread of owner.
+func NewDownloader(owner Window) *Downloader {
+	return &Downloader{
+		owner:       owner,
+		maxParallel: 3,
+	}
+}
+
+// HTTPClient returns the *http.Client used for downloads.
+func (dl *Downloader) HTTPClient() *http.Client {
+	return dl.client()
+}
+
+// SetHTTPClient sets the *http.Client used for downloads, e.g. to configure
+// a proxy or authentication. If client is nil, http.DefaultClient is used.
+func (dl *Downloader) SetHTTPClient(client *http.Client) {
+	dl.httpClient = client
+}
+
+func (dl *Downloader) client() *http.Client {
+	if dl.httpClient != nil {
+		return dl.httpClient
+	}
+
+	return http.DefaultClient
+}
+
+// MaxParallel returns the maximum number of downloads that run at the same
+// time.
+func (dl *Downloader) MaxParallel() int {
+	return dl.maxParallel
+}
+
+// SetMaxParallel sets the maximum number of downloads that run at the same
+// time.
+func (dl *Downloader) SetMaxParallel(maxParallel int) {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+
+	dl.maxParallel = maxParallel
+
+	dl.schedule()
+}
+
+// BandwidthLimit returns the maximum combined download rate in bytes per
+// second, or 0 if there is no limit.
+func (dl *Downloader) BandwidthLimit() int64 {
+	dl.limiter.mutex.Lock()
+	defer dl.limiter.mutex.Unlock()
+
+	return dl.limiter.rate
+}
+
+// SetBandwidthLimit sets the maximum combined download rate in bytes per
+// second. 0 means there is no limit.
+func (dl *Downloader) SetBandwidthLimit(bytesPerSecond int64) {
+	dl.limiter.setRate(bytesPerSecond)
+}
+
+// Downloads returns all downloads of the Downloader, in the order they were
+// added.
+func (dl *Downloader) Downloads() []*Download {
+	return append([]*Download(nil), dl.downloads...)
+}
+
+// DownloadAdded returns the event that is published when a download was
+// added.
+func (dl *Downloader) DownloadAdded() *DownloadEvent {
+	return dl.addedPublisher.Event()
+}
+
+// DownloadRemoved returns the event that is published when a download was
+// removed.
+func (dl *Downloader) DownloadRemoved() *DownloadEvent {
+	return dl.removedPublisher.Event()
+}
+
+// Add queues a new download.
+func (dl *Downloader) Add(request DownloadRequest) *Download {
+	d := &Download{
+		downloader: dl,
+		request:    request,
+		totalBytes: -1,
+	}
+
+	dl.downloads = append(dl.downloads, d)
+	dl.addedPublisher.Publish(d)
+
+	dl.schedule()
+
+	return d
+}
+
+// Remove cancels the download, if it is not finished yet, and removes it
+// from the Downloader.
+func (dl *Downloader) Remove(d *Download) {
+	for i, download := range dl.downloads {
+		if download == d {
+			if !d.state.Finished() {
+				d.Cancel()
+			}
+
+			dl.downloads = append(dl.downloads[:i], dl.downloads[i+1:]...)
+			dl.removedPublisher.Publish(d)
+			return
+		}
+	}
+}
+
+// RemoveFinished removes all completed and canceled downloads.
+func (dl *Downloader) RemoveFinished() {
+	for _, d := range dl.Downloads() {
+		if d.state == DownloadCompleted || d.state == DownloadCanceled {
+			dl.Remove(d)
+		}
+	}
+}
+
+// Close pauses all queued and running downloads, keeping their data for
+// resuming.
+func (dl *Downloader) Close() {
+	for _, d := range dl.downloads {
+		if d.state == DownloadQueued || d.state == DownloadRunning {
+			d.stop()
+			d.setState(DownloadPaused, nil)
+		}
+	}
+}
+
+// schedule starts queued downloads, as long as there are less than
+// maxParallel running.
+func (dl *Downloader) schedule() {
+	running := 0
+	for _, d := range dl.downloads {
+		if d.state == DownloadRunning {
+			running++
+		}
+	}
+
+	for _, d := range dl.downloads {
+		if running >= dl.maxParallel {
+			return
+		}
+
+		if d.state == DownloadQueued {
+			d.start()
+			running++
+		}
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+const downloadRowProgressMax = 1000
+
+// formatByteCount formats a number of bytes for display, e.g. "1.5 MB".
+func formatByteCount(n int64) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 4; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTP"[exp])
+}
+
+// DownloadRow is a composite that displays the progress of a Download and
+// lets the user pause, resume, cancel or remove it.
+type DownloadRow struct {
+	*Composite
+	download      *Download
+	nameLabel     *Label
+	statusLabel   *Label
+	progressBar   *ProgressBar
+	pauseButton   *PushButton
+	cancelButton  *PushButton
+	changedHandle int
+}
+
+// NewDownloadRow creates and initializes a new DownloadRow for download.
+func NewDownloadRow(parent Container, download *Download) (*DownloadRow, error) {
+	composite, err := NewCompositeWithStyle(parent, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	dr := &DownloadRow{Composite: composite, download: download}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			dr.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(dr); err != nil {
+		return nil, err
+	}
+
+	layout := NewHBoxLayout()
+	layout.SetMargins(Margins{})
+	if err := dr.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	info, err := NewComposite(dr)
+	if err != nil {
+		return nil, err
+	}
+
+	infoLayout := NewVBoxLayout()
+	infoLayout.SetMargins(Margins{})
+	infoLayout.SetSpacing(2)
+	if err := info.SetLayout(infoLayout); err != nil {
+		return nil, err
+	}
+
+	if dr.nameLabel, err = NewLabel(info); err != nil {
+		return nil, err
+	}
+	dr.nameLabel.SetEllipsisMode(EllipsisPath)
+	dr.nameLabel.SetText(filepath.Base(download.FilePath()))
+	dr.nameLabel.SetToolTipText(download.URL())
+
+	if dr.progressBar, err = NewProgressBar(info); err != nil {
+		return nil, err
+	}
+	dr.progressBar.SetRange(0, downloadRowProgressMax)
+
+	if dr.statusLabel, err = NewLabel(info); err != nil {
+		return nil, err
+	}
+	dr.statusLabel.SetEllipsisMode(EllipsisEnd)
+
+	if dr.pauseButton, err = NewPushButton(dr); err != nil {
+		return nil, err
+	}
+	dr.pauseButton.Clicked().Attach(func() {
+		if dr.download.State() == DownloadQueued || dr.download.State() == DownloadRunning {
+			dr.download.Pause()
+		} else {
+			dr.download.Resume()
+		}
+	})
+
+	if dr.cancelButton, err = NewPushButton(dr); err != nil {
+		return nil, err
+	}
+	dr.cancelButton.Clicked().Attach(func() {
+		if state := dr.download.State(); state == DownloadCompleted || state == DownloadCanceled {
+			dr.download.downloader.Remove(dr.download)
+		} else {
+			dr.download.Cancel()
+		}
+	})
+
+	dr.changedHandle = download.Changed().Attach(dr.update)
+	dr.Disposing().Attach(func() {
+		dr.download.Changed().Detach(dr.changedHandle)
+	})
+
+	dr.update()
+
+	succeeded = true
+
+	return dr, nil
+}
+
+// Download returns the Download displayed by the DownloadRow.
+func (dr *DownloadRow) Download() *Download {
+	return dr.download
+}
+
+func (dr *DownloadRow) update() {
+	d := dr.download
+	state := d.State()
+	received, total := d.BytesReceived(), d.TotalBytes()
+
+	dr.progressBar.SetMarqueeMode(state == DownloadRunning && total < 0)
+	if total > 0 {
+		dr.progressBar.SetValue(int(received * downloadRowProgressMax / total))
+	} else if state == DownloadCompleted {
+		dr.progressBar.SetValue(downloadRowProgressMax)
+	} else {
+		dr.progressBar.SetValue(0)
+	}
+
+	var status string
+	switch state {
+	case DownloadRunning:
+		if total >= 0 {
+			status = fmt.Sprintf(tr("%s of %s", "walk"), formatByteCount(received), formatByteCount(total))
+		} else {
+			status = formatByteCount(received)
+		}
+
+		if speed := d.Speed(); speed > 0 {
+			status += fmt.Sprintf(" (%s/s)", formatByteCount(speed))
+		}
+
+	case DownloadFailed:
+		status = state.String()
+		if err := d.Err(); err != nil {
+			status += ": " + err.Error()
+		}
+
+	case DownloadCompleted:
+		status = fmt.Sprintf("%s - %s", state, formatByteCount(received))
+
+	default:
+		status = state.String()
+	}
+
+	dr.statusLabel.SetText(status)
+
+	switch state {
+	case DownloadQueued, DownloadRunning:
+		dr.pauseButton.SetText(tr("Pause", "walk"))
+		dr.pauseButton.SetEnabled(true)
+
+	case DownloadPaused, DownloadFailed:
+		dr.pauseButton.SetText(tr("Resume", "walk"))
+		dr.pauseButton.SetEnabled(true)
+
+	default:
+		dr.pauseButton.SetEnabled(false)
+	}
+
+	if state == DownloadCompleted || state == DownloadCanceled {
+		dr.cancelButton.SetText(tr("Remove", "walk"))
+	} else {
+		dr.cancelButton.SetText(tr("Cancel", "walk"))
+	}
+}
+
+// DownloadsPane is a scrollable list of DownloadRows, that is kept in sync
+// with the downloads of a Downloader.
+type DownloadsPane struct {
+	*ScrollView
+	downloader    *Downloader
+	rows          map[*Download]*DownloadRow
+	addedHandle   int
+	removedHandle int
+}
+
+// NewDownloadsPane creates and initializes a new DownloadsPane for
+// downloader.
+func NewDownloadsPane(parent Container, downloader *Downloader) (*DownloadsPane, error) {
+	if downloader == nil {
+		return nil, newError("downloader must not be nil")
+	}
+
+	sv, err := NewScrollView(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	dp := &DownloadsPane{
+		ScrollView: sv,
+		downloader: downloader,
+		rows:       make(map[*Download]*DownloadRow),
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			dp.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(dp); err != nil {
+		return nil, err
+	}
+
+	sv.SetScrollbars(false, true)
+
+	layout := NewVBoxLayout()
+	layout.SetAlignment(AlignHNearVNear)
+	if err := dp.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	for _, d := range downloader.Downloads() {
+		if err := dp.addRow(d); err != nil {
+			return nil, err
+		}
+	}
+
+	dp.addedHandle = downloader.DownloadAdded().Attach(func(d *Download) {
+		dp.addRow(d)
+	})
+	dp.removedHandle = downloader.DownloadRemoved().Attach(dp.removeRow)
+
+	dp.Disposing().Attach(func() {
+		downloader.DownloadAdded().Detach(dp.addedHandle)
+		downloader.DownloadRemoved().Detach(dp.removedHandle)
+	})
+
+	succeeded = true
+
+	return dp, nil
+}
+
+// Downloader returns the Downloader displayed by the DownloadsPane.
+func (dp *DownloadsPane) Downloader() *Downloader {
+	return dp.downloader
+}
+
+func (dp *DownloadsPane) addRow(d *Download) error {
+	row, err := NewDownloadRow(dp, d)
+	if err != nil {
+		return err
+	}
+
+	dp.rows[d] = row
+
+	return nil
+}
+
+func (dp *DownloadsPane) removeRow(d *Download) {
+	if row, ok := dp.rows[d]; ok {
+		delete(dp.rows, d)
+		row.Dispose()
+	}
+}
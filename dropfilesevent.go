@@ -15,6 +15,7 @@ import (
 type dropFilesEventHandlerInfo struct {
 	handler DropFilesEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type DropFilesEventHandler func([]string)
@@ -29,7 +30,7 @@ func (e *DropFilesEvent) Attach(handler DropFilesEventHandler) int {
 		win.DragAcceptFiles(e.hWnd, true)
 	}
 
-	handlerInfo := dropFilesEventHandlerInfo{handler, false}
+	handlerInfo := dropFilesEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -44,6 +45,7 @@ func (e *DropFilesEvent) Attach(handler DropFilesEventHandler) int {
 }
 
 func (e *DropFilesEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 
 	for _, h := range e.handlers {
@@ -60,6 +62,17 @@ func (e *DropFilesEvent) Once(handler DropFilesEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *DropFilesEvent) AttachScoped(owner Window, handler DropFilesEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type DropFilesEventPublisher struct {
 	event DropFilesEvent
 }
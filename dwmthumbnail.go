@@ -0,0 +1,285 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"github.com/lxn/win"
+)
+
+const dwmThumbnailWindowClass = `\o/ Walk_DwmThumbnail_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClass(dwmThumbnailWindowClass)
+	})
+}
+
+// DwmThumbnail is a widget that displays a live thumbnail of another top-level
+// window, as rendered by the Desktop Window Manager.
+type DwmThumbnail struct {
+	WidgetBase
+	source               win.HWND
+	thumbnail            uintptr
+	sourceRect           Rectangle // in native pixels of the source window
+	sourceClientAreaOnly bool
+	opacity              byte
+	keepAspectRatio      bool
+}
+
+// NewDwmThumbnail creates and initializes a new DwmThumbnail.
+func NewDwmThumbnail(parent Container) (*DwmThumbnail, error) {
+	dt := &DwmThumbnail{
+		opacity:         255,
+		keepAspectRatio: true,
+	}
+
+	if err := InitWidget(
+		dt,
+		parent,
+		dwmThumbnailWindowClass,
+		win.WS_VISIBLE,
+		0); err != nil {
+		return nil, err
+	}
+
+	return dt, nil
+}
+
+// Source returns the handle of the window whose thumbnail is displayed.
+func (dt *DwmThumbnail) Source() win.HWND {
+	return dt.source
+}
+
+// SetSource sets the handle of the top-level window whose thumbnail is
+// displayed. Pass 0 to display nothing.
+func (dt *DwmThumbnail) SetSource(source win.HWND) error {
+	if source == dt.source {
+		return nil
+	}
+
+	if err := dt.unregister(); err != nil {
+		return err
+	}
+
+	dt.source = source
+
+	if source == 0 {
+		return nil
+	}
+
+	if hr := dwmRegisterThumbnail(win.GetAncestor(dt.hWnd, win.GA_ROOT), source, &dt.thumbnail); win.FAILED(hr) {
+		dt.source = 0
+		return errorFromHRESULT("DwmRegisterThumbnail", hr)
+	}
+
+	return dt.update()
+}
+
+// SourceSize returns the size of the source window, in native pixels.
+func (dt *DwmThumbnail) SourceSize() (Size, error) {
+	if dt.thumbnail == 0 {
+		return Size{}, nil
+	}
+
+	var size win.SIZE
+	if hr := dwmQueryThumbnailSourceSize(dt.thumbnail, &size); win.FAILED(hr) {
+		return Size{}, errorFromHRESULT("DwmQueryThumbnailSourceSize", hr)
+	}
+
+	return sizeFromSIZE(size), nil
+}
+
+// SourceRect returns the region of the source window that is displayed, in
+// native pixels.
+//
+// An empty Rectangle means the whole window is displayed.
+func (dt *DwmThumbnail) SourceRect() Rectangle {
+	return dt.sourceRect
+}
+
+// SetSourceRect sets the region of the source window that is displayed, in
+// native pixels.
+//
+// Pass an empty Rectangle to display the whole window.
+func (dt *DwmThumbnail) SetSourceRect(sourceRect Rectangle) error {
+	if sourceRect == dt.sourceRect {
+		return nil
+	}
+
+	dt.sourceRect = sourceRect
+
+	return dt.update()
+}
+
+// SourceClientAreaOnly returns whether only the client area of the source
+// window is displayed.
+func (dt *DwmThumbnail) SourceClientAreaOnly() bool {
+	return dt.sourceClientAreaOnly
+}
+
+// SetSourceClientAreaOnly sets whether only the client area of the source
+// window is displayed.
+func (dt *DwmThumbnail) SetSourceClientAreaOnly(clientAreaOnly bool) error {
+	if clientAreaOnly == dt.sourceClientAreaOnly {
+		return nil
+	}
+
+	dt.sourceClientAreaOnly = clientAreaOnly
+
+	return dt.update()
+}
+
+// Opacity returns the opacity of the thumbnail, where 255 is fully opaque.
+func (dt *DwmThumbnail) Opacity() byte {
+	return dt.opacity
+}
+
+// SetOpacity sets the opacity of the thumbnail, where 255 is fully opaque.
+func (dt *DwmThumbnail) SetOpacity(opacity byte) error {
+	if opacity == dt.opacity {
+		return nil
+	}
+
+	dt.opacity = opacity
+
+	return dt.update()
+}
+
+// KeepAspectRatio returns whether the thumbnail is scaled uniformly and
+// centered, instead of being stretched to fill the DwmThumbnail.
+func (dt *DwmThumbnail) KeepAspectRatio() bool {
+	return dt.keepAspectRatio
+}
+
+// SetKeepAspectRatio sets whether the thumbnail is scaled uniformly and
+// centered, instead of being stretched to fill the DwmThumbnail.
+func (dt *DwmThumbnail) SetKeepAspectRatio(keepAspectRatio bool) error {
+	if keepAspectRatio == dt.keepAspectRatio {
+		return nil
+	}
+
+	dt.keepAspectRatio = keepAspectRatio
+
+	return dt.update()
+}
+
+func (dt *DwmThumbnail) unregister() error {
+	if dt.thumbnail == 0 {
+		return nil
+	}
+
+	hr := dwmUnregisterThumbnail(dt.thumbnail)
+	dt.thumbnail = 0
+
+	if win.FAILED(hr) {
+		return errorFromHRESULT("DwmUnregisterThumbnail", hr)
+	}
+
+	return nil
+}
+
+func (dt *DwmThumbnail) update() error {
+	return dt.updateVisible(win.IsWindowVisible(dt.hWnd))
+}
+
+// updateVisible is like update, but shows the thumbnail if visible is true,
+// e.g. during WM_SHOWWINDOW, when IsWindowVisible does not reflect the new
+// state yet.
+func (dt *DwmThumbnail) updateVisible(visible bool) error {
+	if dt.thumbnail == 0 {
+		return nil
+	}
+
+	// The thumbnail is rendered into the client area of the top-level
+	// window, so we have to translate our client rectangle.
+	var rc win.RECT
+	if !win.GetClientRect(dt.hWnd, &rc) {
+		return lastError("GetClientRect")
+	}
+
+	root := win.GetAncestor(dt.hWnd, win.GA_ROOT)
+
+	topLeft := win.POINT{X: rc.Left, Y: rc.Top}
+	win.ClientToScreen(dt.hWnd, &topLeft)
+	win.ScreenToClient(root, &topLeft)
+
+	dest := Rectangle{int(topLeft.X), int(topLeft.Y), int(rc.Right - rc.Left), int(rc.Bottom - rc.Top)}
+
+	props := dwmThumbnailProperties{
+		DwFlags:               dwmTnpRectDestination | dwmTnpOpacity | dwmTnpVisible | dwmTnpSourceClientAreaOnly,
+		Opacity:               dt.opacity,
+		FVisible:              win.BoolToBOOL(visible),
+		FSourceClientAreaOnly: win.BoolToBOOL(dt.sourceClientAreaOnly),
+	}
+
+	sourceSize := dt.sourceRect.Size()
+	if dt.sourceRect.Width > 0 && dt.sourceRect.Height > 0 {
+		props.DwFlags |= dwmTnpRectSource
+		props.RcSource = dt.sourceRect.toRECT()
+	} else if dt.keepAspectRatio {
+		sourceSize, _ = dt.SourceSize()
+	}
+
+	if dt.keepAspectRatio && sourceSize.Width > 0 && sourceSize.Height > 0 && dest.Width > 0 && dest.Height > 0 {
+		if dest.Width*sourceSize.Height > dest.Height*sourceSize.Width {
+			width := dest.Height * sourceSize.Width / sourceSize.Height
+			dest.X += (dest.Width - width) / 2
+			dest.Width = width
+		} else {
+			height := dest.Width * sourceSize.Height / sourceSize.Width
+			dest.Y += (dest.Height - height) / 2
+			dest.Height = height
+		}
+	}
+
+	props.RcDestination = dest.toRECT()
+
+	if hr := dwmUpdateThumbnailProperties(dt.thumbnail, &props); win.FAILED(hr) {
+		return errorFromHRESULT("DwmUpdateThumbnailProperties", hr)
+	}
+
+	return nil
+}
+
+func (*DwmThumbnail) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	return &dwmThumbnailLayoutItem{
+		idealSize: SizeFrom96DPI(Size{160, 120}, ctx.dpi),
+	}
+}
+
+func (dt *DwmThumbnail) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_WINDOWPOSCHANGED:
+		dt.update()
+
+	case win.WM_SHOWWINDOW:
+		dt.updateVisible(wParam != 0)
+
+	case win.WM_PAINT:
+		// Our ancestors may have moved us relative to the top-level window
+		// without us being notified, so we refresh the destination here.
+		dt.update()
+
+	case win.WM_DESTROY:
+		dt.unregister()
+	}
+
+	return dt.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
+}
+
+type dwmThumbnailLayoutItem struct {
+	LayoutItemBase
+	idealSize Size // in native pixels
+}
+
+func (*dwmThumbnailLayoutItem) LayoutFlags() LayoutFlags {
+	return ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert | GreedyHorz | GreedyVert
+}
+
+func (li *dwmThumbnailLayoutItem) IdealSize() Size {
+	return li.idealSize
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+const (
+	elevatedBrokerArgPrefix    = "-walk-elevated-broker="
+	elevatedBrokerPIDArgPrefix = "-walk-elevated-broker-pid="
+
+	// elevatedBrokerPipeSDDL grants access to SYSTEM and administrators only.
+	// As the pipe is owned by the unelevated user, the OWNER RIGHTS entry
+	// reduces the implicit rights of the owner to reading the descriptor, so
+	// that other processes of the user can not grant themselves access.
+	elevatedBrokerPipeSDDL = "D:P(A;;GA;;;SY)(A;;GA;;;BA)(A;;RC;;;OW)"
+)
+
+// IsElevated returns whether the current process runs with elevated, i.e.
+// administrator, privileges.
+func IsElevated() bool {
+	process, err := syscall.GetCurrentProcess()
+	if err != nil {
+		return false
+	}
+
+	var token syscall.Token
+	if err := syscall.OpenProcessToken(process, syscall.TOKEN_QUERY, &token); err != nil {
+		return false
+	}
+	defer token.Close()
+
+	var elevation uint32
+	var returnedLen uint32
+	if err := syscall.GetTokenInformation(
+		token,
+		syscall.TokenElevation,
+		(*byte)(unsafe.Pointer(&elevation)),
+		uint32(unsafe.Sizeof(elevation)),
+		&returnedLen); err != nil {
+
+		return false
+	}
+
+	return elevation != 0
+}
+
+// RelaunchElevated starts the executable of the current process again with
+// the given command line arguments and elevated privileges.
+//
+// The user is asked for consent by UAC. If they decline, an error is returned.
+// The current process keeps running, so it is up to the caller to exit it if
+// appropriate.
+func RelaunchElevated(args []string) error {
+	return relaunchElevated(0, args)
+}
+
+func relaunchElevated(hwnd win.HWND, args []string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return wrapError(err)
+	}
+
+	escaped := make([]string, len(args))
+	for i, arg := range args {
+		escaped[i] = syscall.EscapeArg(arg)
+	}
+
+	cwd, _ := os.Getwd()
+
+	var cwdPtr *uint16
+	if cwd != "" {
+		cwdPtr = syscall.StringToUTF16Ptr(cwd)
+	}
+
+	if ret := shellExecute(
+		hwnd,
+		syscall.StringToUTF16Ptr("runas"),
+		syscall.StringToUTF16Ptr(exe),
+		syscall.StringToUTF16Ptr(strings.Join(escaped, " ")),
+		cwdPtr,
+		win.SW_SHOWNORMAL); ret <= 32 {
+
+		return newError(fmt.Sprintf("ShellExecute failed: %d", ret))
+	}
+
+	return nil
+}
+
+// StartElevatedBroker starts the executable of the current process again with
+// the given command line arguments and elevated privileges, as a broker for
+// operations that require them.
+//
+// The returned *IPCServer publishes its Connected event, once the broker
+// connected via DialElevatedBroker. Requests can then be sent to the broker
+// via the connection, and its responses are published on the UI thread of
+// owner.
+//
+// The pipe gets a random name, accepts a single connection and may only be
+// opened by administrators, so other, unelevated processes of the user can
+// neither connect to it nor impersonate it. The broker should validate all
+// requests it receives nevertheless.
+func StartElevatedBroker(owner Window, args ...string) (*IPCServer, error) {
+	var random [16]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return nil, wrapError(err)
+	}
+	name := "walk-elevated-broker-" + hex.EncodeToString(random[:])
+
+	sd, err := securityDescriptorFromString(elevatedBrokerPipeSDDL)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	// If another process created a pipe with our name already, we fail here
+	// thanks to fileFlagFirstPipeInstance, instead of sharing the name.
+	server, err := newIPCServer(owner, name, 1, sd, fileFlagFirstPipeInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	var hwnd win.HWND
+	if owner != nil {
+		hwnd = owner.Handle()
+	}
+
+	args = append(
+		append([]string(nil), args...),
+		elevatedBrokerArgPrefix+name,
+		elevatedBrokerPIDArgPrefix+strconv.Itoa(os.Getpid()))
+
+	if err := relaunchElevated(hwnd, args); err != nil {
+		server.Close()
+		return nil, err
+	}
+
+	return server, nil
+}
+
+// IsElevatedBroker returns whether the current process was started via
+// StartElevatedBroker.
+func IsElevatedBroker() bool {
+	_, ok := elevatedBrokerArg(elevatedBrokerArgPrefix)
+	return ok
+}
+
+// DialElevatedBroker connects the broker process to the application that
+// started it via StartElevatedBroker.
+//
+// It fails if the pipe is not served by the process that started the broker.
+//
+// Events of the connection are published on the UI thread of owner.
+func DialElevatedBroker(owner Window, timeout time.Duration) (*IPCConn, error) {
+	name, ok := elevatedBrokerArg(elevatedBrokerArgPrefix)
+	if !ok {
+		return nil, newError("process was not started as elevated broker")
+	}
+
+	pidArg, _ := elevatedBrokerArg(elevatedBrokerPIDArgPrefix)
+	launcherPID, err := strconv.ParseUint(pidArg, 10, 32)
+	if err != nil {
+		return nil, newError("process was started without valid launcher process id")
+	}
+
+	conn, err := DialIPC(owner, name, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var serverPID uint32
+	if err := getNamedPipeServerProcessId(conn.handle, &serverPID); err != nil {
+		conn.Close()
+		return nil, wrapError(err)
+	}
+
+	if serverPID != uint32(launcherPID) {
+		conn.Close()
+		return nil, newError(fmt.Sprintf("pipe is served by process %d instead of launcher process %d", serverPID, launcherPID))
+	}
+
+	return conn, nil
+}
+
+func elevatedBrokerArg(prefix string) (string, bool) {
+	for _, arg := range os.Args[1:] {
+		if strings.HasPrefix(arg, prefix) {
+			return strings.TrimPrefix(arg, prefix), true
+		}
+	}
+
+	return "", false
+}
+
+// securityDescriptorFromString returns the security descriptor in
+// self-relative format, that is described by sddl.
+func securityDescriptorFromString(sddl string) ([]byte, error) {
+	sddl16, err := syscall.UTF16PtrFromString(sddl)
+	if err != nil {
+		return nil, err
+	}
+
+	var sd uintptr
+	var size uint32
+	if err := convertStringSecurityDescriptorToSecurityDescriptor(sddl16, sddlRevision1, &sd, &size); err != nil {
+		return nil, err
+	}
+	defer syscall.LocalFree(syscall.Handle(sd))
+
+	// A self-relative security descriptor holds no pointers, so we can keep a
+	// copy in Go memory.
+	buf := make([]byte, size)
+	copy(buf, (*[1 << 20]byte)(unsafe.Pointer(sd))[:size:size])
+
+	return buf, nil
+}
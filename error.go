@@ -31,6 +31,12 @@ func (err *Error) Inner() error {
 	return err.inner
 }
 
+// Unwrap returns the inner error, so that *Error works with errors.Is and
+// errors.As.
+func (err *Error) Unwrap() error {
+	return err.inner
+}
+
 func (err *Error) Message() string {
 	if err.message != "" {
 		return err.message
@@ -0,0 +1,304 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/lxn/win"
+)
+
+// ErrorDialogOptions configures the dialog displayed by ShowError.
+type ErrorDialogOptions struct {
+	// Title is the title of the dialog. The default is the product name of the
+	// application or "Error".
+	Title string
+
+	// Message is displayed above the error. The default is the message of the
+	// outermost error.
+	Message string
+
+	// Warning selects the warning icon instead of the error icon.
+	Warning bool
+
+	// ShowDetails makes the details area initially expanded.
+	ShowDetails bool
+
+	// Report, if not nil, is called when the user clicks the report button,
+	// e.g. to send the error to a bug tracker. The error it returns, if any,
+	// is displayed.
+	Report func(err error) error
+
+	// ReportText is the text of the report button. The default is "Report".
+	ReportText string
+}
+
+// ShowError displays err in a modal dialog owned by owner.
+//
+// The dialog shows a short message and lets the user expand a details area,
+// that contains the chain of wrapped errors and, for errors created by walk,
+// the stack trace. The details can be copied to the clipboard and, if
+// opts.Report is set, reported. opts may be nil.
+//
+// If the dialog can not be created, ShowError falls back to MsgBox.
+func ShowError(owner Form, err error, opts *ErrorDialogOptions) {
+	if err == nil {
+		return
+	}
+
+	if opts == nil {
+		opts = &ErrorDialogOptions{}
+	}
+
+	title := opts.Title
+	if title == "" {
+		title = App().ProductName()
+	}
+	if title == "" {
+		title = tr("Error", "walk")
+	}
+
+	chain := errorChain(err)
+
+	message := opts.Message
+	if message == "" {
+		message = errorMessage(chain[0], nil)
+	}
+
+	details := errorDetails(chain)
+
+	style := MsgBoxIconError
+	if opts.Warning {
+		style = MsgBoxIconWarning
+	}
+
+	if e := showErrorDialog(owner, err, title, message, details, opts); e != nil {
+		MsgBox(owner, title, message+"\n\n"+details, MsgBoxOK|style)
+	}
+}
+
+// errorChain returns err and all errors it wraps, outermost first.
+func errorChain(err error) []error {
+	var chain []error
+
+	for err != nil && len(chain) < 100 {
+		chain = append(chain, err)
+
+		if u, ok := err.(interface{ Unwrap() error }); ok {
+			err = u.Unwrap()
+		} else {
+			err = nil
+		}
+	}
+
+	return chain
+}
+
+// errorMessage returns the message of err, without the message of inner, if
+// err just appends it.
+func errorMessage(err, inner error) string {
+	if walkErr, ok := err.(*Error); ok {
+		if walkErr.message == "" && inner != nil {
+			return ""
+		}
+
+		return walkErr.Message()
+	}
+
+	msg := err.Error()
+
+	if inner != nil {
+		msg = strings.TrimSuffix(msg, ": "+inner.Error())
+	}
+
+	return msg
+}
+
+// errorDetails formats the chain of errors and the innermost stack trace.
+func errorDetails(chain []error) string {
+	var buf bytes.Buffer
+	var stack []byte
+
+	for i, err := range chain {
+		var inner error
+		if i+1 < len(chain) {
+			inner = chain[i+1]
+		}
+
+		if msg := errorMessage(err, inner); msg != "" {
+			if buf.Len() > 0 {
+				buf.WriteString("\r\n")
+			}
+			fmt.Fprintf(&buf, "%T: %s", err, msg)
+		}
+
+		if walkErr, ok := err.(*Error); ok && len(walkErr.stack) > 0 {
+			stack = walkErr.stack
+		}
+	}
+
+	if len(stack) > 0 {
+		buf.WriteString("\r\n\r\n")
+		buf.WriteString(tr("Stack:", "walk"))
+		buf.WriteString("\r\n")
+		buf.WriteString(strings.Replace(strings.TrimSpace(string(stack)), "\n", "\r\n", -1))
+	}
+
+	return buf.String()
+}
+
+func showErrorDialog(owner Form, err error, title, message, details string, opts *ErrorDialogOptions) error {
+	dlg, e := NewDialog(owner)
+	if e != nil {
+		return e
+	}
+	defer dlg.Dispose()
+
+	dlg.SetTitle(title)
+
+	layout := NewGridLayout()
+	layout.SetSpacing(12)
+	if e := dlg.SetLayout(layout); e != nil {
+		return e
+	}
+
+	icon := IconError()
+	if opts.Warning {
+		icon = IconWarning()
+	}
+
+	iv, e := NewImageView(dlg)
+	if e != nil {
+		return e
+	}
+	iv.SetImage(icon)
+	layout.SetRange(iv, Rectangle{0, 0, 1, 1})
+
+	messageLabel, e := NewTextLabel(dlg)
+	if e != nil {
+		return e
+	}
+	messageLabel.SetText(message)
+	messageLabel.SetMinMaxSize(Size{320, 0}, Size{})
+	layout.SetRange(messageLabel, Rectangle{1, 0, 1, 1})
+
+	detailsEdit, e := NewTextEditWithStyle(dlg, win.WS_VSCROLL|win.WS_HSCROLL)
+	if e != nil {
+		return e
+	}
+	detailsEdit.SetReadOnly(true)
+	detailsEdit.SetText(details)
+	detailsEdit.SetMinMaxSize(Size{480, 200}, Size{})
+	if font, e := NewFont("Consolas", 9, 0); e == nil {
+		detailsEdit.SetFont(font)
+		dlg.Disposing().Attach(font.Dispose)
+	}
+	layout.SetRange(detailsEdit, Rectangle{0, 1, 2, 1})
+
+	buttons, e := NewComposite(dlg)
+	if e != nil {
+		return e
+	}
+	buttonsLayout := NewHBoxLayout()
+	buttonsLayout.SetMargins(Margins{})
+	if e := buttons.SetLayout(buttonsLayout); e != nil {
+		return e
+	}
+	layout.SetRange(buttons, Rectangle{0, 2, 2, 1})
+
+	detailsButton, e := NewPushButton(buttons)
+	if e != nil {
+		return e
+	}
+
+	copyButton, e := NewPushButton(buttons)
+	if e != nil {
+		return e
+	}
+	copyButton.SetText(tr("&Copy", "walk"))
+	copyButton.Clicked().Attach(func() {
+		Clipboard().SetText(strings.Join([]string{title, message, details}, "\r\n\r\n"))
+	})
+
+	if opts.Report != nil {
+		reportButton, e := NewPushButton(buttons)
+		if e != nil {
+			return e
+		}
+
+		text := opts.ReportText
+		if text == "" {
+			text = tr("&Report", "walk")
+		}
+		reportButton.SetText(text)
+
+		reportButton.Clicked().Attach(func() {
+			if e := opts.Report(err); e != nil {
+				MsgBox(dlg, title, e.Error(), MsgBoxOK|MsgBoxIconError)
+				return
+			}
+
+			reportButton.SetText(tr("Reported", "walk"))
+			reportButton.SetEnabled(false)
+		})
+	}
+
+	if _, e := NewHSpacer(buttons); e != nil {
+		return e
+	}
+
+	okButton, e := NewPushButton(buttons)
+	if e != nil {
+		return e
+	}
+	okButton.SetText(tr("OK", "walk"))
+	okButton.Clicked().Attach(dlg.Accept)
+
+	if e := dlg.SetDefaultButton(okButton); e != nil {
+		return e
+	}
+	if e := dlg.SetCancelButton(okButton); e != nil {
+		return e
+	}
+
+	var collapsedHeight int
+
+	setDetailsVisible := func(visible bool) {
+		detailsEdit.SetVisible(visible)
+
+		if visible {
+			detailsButton.SetText(tr("Hide &details", "walk"))
+		} else {
+			detailsButton.SetText(tr("Show &details", "walk"))
+
+			if collapsedHeight > 0 {
+				dlg.SetSizePixels(Size{dlg.WidthPixels(), collapsedHeight})
+			}
+		}
+	}
+
+	detailsButton.Clicked().Attach(func() {
+		if !detailsEdit.Visible() && collapsedHeight == 0 {
+			collapsedHeight = dlg.HeightPixels()
+		}
+
+		setDetailsVisible(!detailsEdit.Visible())
+	})
+
+	setDetailsVisible(opts.ShowDetails && details != "")
+	detailsButton.SetVisible(details != "")
+
+	dlg.Starting().Once(func() {
+		okButton.SetFocus()
+	})
+
+	dlg.Run()
+
+	return nil
+}
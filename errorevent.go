@@ -9,6 +9,7 @@ package walk
 type errorEventHandlerInfo struct {
 	handler ErrorEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type ErrorEventHandler func(err error)
@@ -18,7 +19,7 @@ type ErrorEvent struct {
 }
 
 func (e *ErrorEvent) Attach(handler ErrorEventHandler) int {
-	handlerInfo := errorEventHandlerInfo{handler, false}
+	handlerInfo := errorEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *ErrorEvent) Attach(handler ErrorEventHandler) int {
 }
 
 func (e *ErrorEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -41,6 +43,17 @@ func (e *ErrorEvent) Once(handler ErrorEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *ErrorEvent) AttachScoped(owner Window, handler ErrorEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type ErrorEventPublisher struct {
 	event ErrorEvent
 }
@@ -9,6 +9,7 @@ package walk
 type eventHandlerInfo struct {
 	handler EventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type EventHandler func()
@@ -18,7 +19,7 @@ type Event struct {
 }
 
 func (e *Event) Attach(handler EventHandler) int {
-	handlerInfo := eventHandlerInfo{handler, false}
+	handlerInfo := eventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *Event) Attach(handler EventHandler) int {
 }
 
 func (e *Event) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -0,0 +1,547 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"sort"
+	"strings"
+)
+
+// Extension is a module of a larger application, that contributes actions,
+// settings pages, tool panes and table context menu items to the host
+// application through an ExtensionRegistry.
+type Extension interface {
+	// ID returns the unique id of the extension.
+	ID() string
+
+	// Dependencies returns the ids of the extensions that must be started
+	// before this one.
+	Dependencies() []string
+
+	// Start is called when the registry is started, after the extensions
+	// this one depends on. The extension makes its contributions through ctx.
+	Start(ctx *ExtensionContext) error
+
+	// Stop is called when the registry is stopped, before the extensions
+	// this one depends on. Its contributions are removed afterwards.
+	Stop() error
+}
+
+// SettingsPage is a page an Extension contributes to the settings of the host
+// application.
+type SettingsPage struct {
+	// Title is shown in the list of settings pages.
+	Title string
+
+	// Create creates the page with parent as its parent.
+	Create func(parent Container) (Widget, error)
+}
+
+// ToolPane is a pane an Extension contributes to the tool windows of the host
+// application, e.g. a docked panel.
+type ToolPane struct {
+	// ID is the id of the pane, e.g. to persist its state.
+	ID string
+
+	// Title is shown in the caption or tab of the pane.
+	Title string
+
+	// Create creates the pane with parent as its parent.
+	Create func(parent Container) (Widget, error)
+}
+
+// TableContextMenuItem is an action an Extension contributes to the context
+// menu of the TableViews with a certain name.
+type TableContextMenuItem struct {
+	// TableName is the name of the TableViews whose context menus get the
+	// action, see Window.Name.
+	TableName string
+
+	// Action is added to the context menus.
+	Action *Action
+}
+
+// ExtensionContext is passed to Extension.Start to make contributions, that
+// are associated with the extension.
+type ExtensionContext struct {
+	registry  *ExtensionRegistry
+	extension Extension
+}
+
+// Extension returns the extension the context was created for.
+func (ctx *ExtensionContext) Extension() Extension {
+	return ctx.extension
+}
+
+// Registry returns the registry the extension is started by.
+func (ctx *ExtensionContext) Registry() *ExtensionRegistry {
+	return ctx.registry
+}
+
+// AddAction contributes action to the extension point point, e.g.
+// "menu/tools". Extension points are defined by the host application.
+func (ctx *ExtensionContext) AddAction(point string, action *Action) error {
+	if action == nil {
+		return newError("action cannot be nil")
+	}
+
+	ctx.registry.add(&extensionContribution{
+		extension: ctx.extension,
+		point:     point,
+		action:    action,
+	})
+
+	return nil
+}
+
+// AddSettingsPage contributes a settings page.
+func (ctx *ExtensionContext) AddSettingsPage(page *SettingsPage) error {
+	if page == nil || page.Create == nil {
+		return newError("page must have a Create func")
+	}
+
+	ctx.registry.add(&extensionContribution{
+		extension:    ctx.extension,
+		settingsPage: page,
+	})
+
+	return nil
+}
+
+// AddToolPane contributes a tool pane.
+func (ctx *ExtensionContext) AddToolPane(pane *ToolPane) error {
+	if pane == nil || pane.Create == nil {
+		return newError("pane must have a Create func")
+	}
+
+	ctx.registry.add(&extensionContribution{
+		extension: ctx.extension,
+		toolPane:  pane,
+	})
+
+	return nil
+}
+
+// AddTableContextMenuItem contributes an action to the context menus of the
+// TableViews attached to the registry with AttachTableView.
+func (ctx *ExtensionContext) AddTableContextMenuItem(item *TableContextMenuItem) error {
+	if item == nil || item.Action == nil {
+		return newError("item must have an Action")
+	}
+
+	ctx.registry.add(&extensionContribution{
+		extension:            ctx.extension,
+		tableContextMenuItem: item,
+	})
+
+	return nil
+}
+
+// extensionContribution is a single contribution of an extension. Exactly
+// one of action, settingsPage, toolPane and tableContextMenuItem is set.
+type extensionContribution struct {
+	extension            Extension
+	point                string
+	action               *Action
+	settingsPage         *SettingsPage
+	toolPane             *ToolPane
+	tableContextMenuItem *TableContextMenuItem
+}
+
+// extensionActionPlacement records an action added to an ActionList by the
+// registry, so it can be removed when its extension stops.
+type extensionActionPlacement struct {
+	extension Extension
+	list      *ActionList
+	action    *Action
+}
+
+// ExtensionRegistry holds the extensions of a host application and starts
+// and stops them in the order of their dependencies.
+//
+// Go's plugin package does not support Windows, so extensions are compiled
+// into the application and register themselves, typically from an init func
+// with RegisterExtension:
+//
+//	func init() {
+//		walk.RegisterExtension(new(myExtension))
+//	}
+//
+// The host application then starts the registry once its main window exists
+// and places the contributions with Actions, AddActionsTo, SettingsPages,
+// ToolPanes and AttachTableView.
+type ExtensionRegistry struct {
+	extensions       []Extension
+	started          []Extension
+	contributions    []*extensionContribution
+	placements       []extensionActionPlacement
+	tableViews       []*TableView
+	isStarted        bool
+	startedPublisher EventPublisher
+	stoppedPublisher EventPublisher
+}
+
+var defaultExtensionRegistry = NewExtensionRegistry()
+
+// DefaultExtensionRegistry returns the registry RegisterExtension adds to.
+func DefaultExtensionRegistry() *ExtensionRegistry {
+	return defaultExtensionRegistry
+}
+
+// RegisterExtension registers ext with the DefaultExtensionRegistry. It
+// panics if ext cannot be registered, because it is meant to be called from
+// init funcs.
+func RegisterExtension(ext Extension) {
+	if err := defaultExtensionRegistry.Register(ext); err != nil {
+		panic(err)
+	}
+}
+
+// NewExtensionRegistry returns a new, empty ExtensionRegistry.
+func NewExtensionRegistry() *ExtensionRegistry {
+	return new(ExtensionRegistry)
+}
+
+// Register adds ext to the registry. Extensions cannot be registered while
+// the registry is started.
+func (r *ExtensionRegistry) Register(ext Extension) error {
+	if ext == nil {
+		return newError("ext cannot be nil")
+	}
+	if ext.ID() == "" {
+		return newError("extension id cannot be empty")
+	}
+	if r.isStarted {
+		return newError("registry already started")
+	}
+	if r.Extension(ext.ID()) != nil {
+		return newError("extension already registered: " + ext.ID())
+	}
+
+	r.extensions = append(r.extensions, ext)
+
+	return nil
+}
+
+// Extension returns the registered extension with id, or nil.
+func (r *ExtensionRegistry) Extension(id string) Extension {
+	for _, ext := range r.extensions {
+		if ext.ID() == id {
+			return ext
+		}
+	}
+
+	return nil
+}
+
+// Extensions returns the registered extensions, in the order they are
+// started in once the registry is started and in registration order before.
+func (r *ExtensionRegistry) Extensions() []Extension {
+	if r.isStarted {
+		return append([]Extension(nil), r.started...)
+	}
+
+	return append([]Extension(nil), r.extensions...)
+}
+
+// IsStarted returns whether the registry is started.
+func (r *ExtensionRegistry) IsStarted() bool {
+	return r.isStarted
+}
+
+// Started returns the event that is published after the registry started all
+// extensions.
+func (r *ExtensionRegistry) Started() *Event {
+	return r.startedPublisher.Event()
+}
+
+// Stopped returns the event that is published after the registry stopped all
+// extensions and removed their contributions.
+func (r *ExtensionRegistry) Stopped() *Event {
+	return r.stoppedPublisher.Event()
+}
+
+// Start starts the registered extensions, each after those it depends on.
+//
+// If an extension depends on one that is not registered, or the dependencies
+// form a cycle, no extension is started. If an extension fails to start, the
+// ones already started are stopped again and the error is returned.
+func (r *ExtensionRegistry) Start() error {
+	if r.isStarted {
+		return newError("registry already started")
+	}
+
+	order, err := r.startOrder()
+	if err != nil {
+		return err
+	}
+
+	for _, ext := range order {
+		ctx := &ExtensionContext{registry: r, extension: ext}
+
+		if err := ext.Start(ctx); err != nil {
+			r.removeContributions(ext)
+			r.Stop()
+
+			return wrapErrorNoPanic(err)
+		}
+
+		r.started = append(r.started, ext)
+	}
+
+	r.isStarted = true
+
+	r.startedPublisher.Publish()
+
+	return nil
+}
+
+// Stop stops the started extensions in reverse order and removes their
+// contributions, including the actions placed with AddActionsTo and
+// AttachTableView. The first error returned by Extension.Stop is returned,
+// after all extensions were stopped.
+func (r *ExtensionRegistry) Stop() error {
+	var firstErr error
+
+	for i := len(r.started) - 1; i >= 0; i-- {
+		ext := r.started[i]
+
+		if err := ext.Stop(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+
+		r.removeContributions(ext)
+	}
+
+	wasStarted := r.isStarted
+
+	r.started = nil
+	r.isStarted = false
+
+	if wasStarted {
+		r.stoppedPublisher.Publish()
+	}
+
+	return firstErr
+}
+
+// startOrder returns the registered extensions sorted topologically by their
+// dependencies. Independent extensions keep their registration order.
+func (r *ExtensionRegistry) startOrder() ([]Extension, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(r.extensions))
+	order := make([]Extension, 0, len(r.extensions))
+
+	var visit func(ext Extension, path []string) error
+	visit = func(ext Extension, path []string) error {
+		id := ext.ID()
+		path = append(path, id)
+
+		switch state[id] {
+		case visiting:
+			return newError("extension dependency cycle: " + strings.Join(path, " -> "))
+
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+
+		for _, depID := range ext.Dependencies() {
+			dep := r.Extension(depID)
+			if dep == nil {
+				return newError("extension " + id + " depends on unregistered extension " + depID)
+			}
+
+			if err := visit(dep, path); err != nil {
+				return err
+			}
+		}
+
+		state[id] = visited
+		order = append(order, ext)
+
+		return nil
+	}
+
+	for _, ext := range r.extensions {
+		if err := visit(ext, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+func (r *ExtensionRegistry) add(c *extensionContribution) {
+	r.contributions = append(r.contributions, c)
+
+	if item := c.tableContextMenuItem; item != nil {
+		for _, tv := range r.tableViews {
+			if tv.Name() == item.TableName {
+				r.placeTableContextMenuItem(tv, c)
+			}
+		}
+	}
+}
+
+func (r *ExtensionRegistry) removeContributions(ext Extension) {
+	contributions := r.contributions[:0]
+	for _, c := range r.contributions {
+		if c.extension != ext {
+			contributions = append(contributions, c)
+		}
+	}
+	r.contributions = contributions
+
+	placements := r.placements[:0]
+	for _, p := range r.placements {
+		if p.extension != ext {
+			placements = append(placements, p)
+			continue
+		}
+
+		if p.list.Contains(p.action) {
+			p.list.Remove(p.action)
+		}
+	}
+	r.placements = placements
+}
+
+// Actions returns the actions contributed to the extension point point, in
+// the order the extensions were started in.
+func (r *ExtensionRegistry) Actions(point string) []*Action {
+	var actions []*Action
+
+	for _, c := range r.contributions {
+		if c.action != nil && c.point == point {
+			actions = append(actions, c.action)
+		}
+	}
+
+	return actions
+}
+
+// AddActionsTo adds the actions contributed to the extension point point to
+// list. They are removed from list again when the registry is stopped.
+func (r *ExtensionRegistry) AddActionsTo(point string, list *ActionList) error {
+	if list == nil {
+		return newError("list cannot be nil")
+	}
+
+	for _, c := range r.contributions {
+		if c.action == nil || c.point != point {
+			continue
+		}
+
+		if err := r.placeAction(c.extension, list, c.action); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ExtensionRegistry) placeAction(ext Extension, list *ActionList, action *Action) error {
+	if list.Contains(action) {
+		return nil
+	}
+
+	if err := list.Add(action); err != nil {
+		return err
+	}
+
+	r.placements = append(r.placements, extensionActionPlacement{ext, list, action})
+
+	return nil
+}
+
+// SettingsPages returns the contributed settings pages, sorted by title.
+func (r *ExtensionRegistry) SettingsPages() []*SettingsPage {
+	var pages []*SettingsPage
+
+	for _, c := range r.contributions {
+		if c.settingsPage != nil {
+			pages = append(pages, c.settingsPage)
+		}
+	}
+
+	sort.SliceStable(pages, func(i, j int) bool {
+		return pages[i].Title < pages[j].Title
+	})
+
+	return pages
+}
+
+// ToolPanes returns the contributed tool panes, in the order the extensions
+// were started in.
+func (r *ExtensionRegistry) ToolPanes() []*ToolPane {
+	var panes []*ToolPane
+
+	for _, c := range r.contributions {
+		if c.toolPane != nil {
+			panes = append(panes, c.toolPane)
+		}
+	}
+
+	return panes
+}
+
+// AttachTableView adds the table context menu items contributed for the name
+// of tv to its context menu, creating one if needed. Items contributed later
+// are added as well, until tv is disposed.
+func (r *ExtensionRegistry) AttachTableView(tv *TableView) error {
+	if tv == nil {
+		return newError("tv cannot be nil")
+	}
+
+	for _, attached := range r.tableViews {
+		if attached == tv {
+			return nil
+		}
+	}
+
+	r.tableViews = append(r.tableViews, tv)
+
+	tv.Disposing().Attach(func() {
+		for i, attached := range r.tableViews {
+			if attached == tv {
+				r.tableViews = append(r.tableViews[:i], r.tableViews[i+1:]...)
+				break
+			}
+		}
+	})
+
+	for _, c := range r.contributions {
+		if item := c.tableContextMenuItem; item != nil && item.TableName == tv.Name() {
+			if err := r.placeTableContextMenuItem(tv, c); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *ExtensionRegistry) placeTableContextMenuItem(tv *TableView, c *extensionContribution) error {
+	menu := tv.ContextMenu()
+	if menu == nil {
+		var err error
+		if menu, err = NewMenu(); err != nil {
+			return err
+		}
+
+		tv.SetContextMenu(menu)
+	}
+
+	return r.placeAction(c.extension, menu.Actions(), c.tableContextMenuItem.Action)
+}
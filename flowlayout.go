@@ -7,9 +7,19 @@
 package walk
 
 import (
+	"sync"
+
 	"github.com/lxn/win"
 )
 
+// FlowLayout places the widgets of a container left to right and wraps them
+// to a new row when the width of the container is exhausted, like the words
+// of a paragraph.
+//
+// The height a FlowLayout needs depends on the available width, so it
+// reports height for width to the layout of the parent container. The
+// alignment of the layout and of each widget determines where a widget is
+// placed in its row.
 type FlowLayout struct {
 	LayoutBase
 	hwnd2StretchFactor map[win.HWND]int
@@ -61,7 +71,6 @@ func (l *FlowLayout) SetStretchFactor(widget Widget, factor int) error {
 
 func (l *FlowLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
 	li := &flowLayoutItem{
-		size2MinSize:       make(map[Size]Size),
 		hwnd2StretchFactor: make(map[win.HWND]int),
 	}
 
@@ -74,7 +83,8 @@ func (l *FlowLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
 
 type flowLayoutItem struct {
 	ContainerLayoutItemBase
-	size2MinSize       map[Size]Size // in native pixels
+	mutex              sync.Mutex
+	minSizeCache       minSizeCache // in native pixels
 	hwnd2StretchFactor map[win.HWND]int
 }
 
@@ -89,12 +99,29 @@ type flowLayoutSectionItem struct {
 	minSize Size // in native pixels
 }
 
-func (*flowLayoutItem) LayoutFlags() LayoutFlags {
-	return ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert | GreedyHorz | GreedyVert
+func (li *flowLayoutItem) LayoutFlags() LayoutFlags {
+	// Wrapping lets the items adapt to any width.
+	return boxLayoutFlags(Horizontal, li.children) | ShrinkableHorz | GrowableHorz
 }
 
+// MinSize returns the width of the widest item, since all others can be
+// wrapped, and the height that is needed at the current width.
 func (li *flowLayoutItem) MinSize() Size {
-	return li.MinSizeForSize(li.geometry.ClientSize)
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+
+	var width int
+	for _, item := range li.children {
+		if shouldLayoutItem(item) {
+			width = maxi(width, li.MinSizeEffectiveForChild(item).Width)
+		}
+	}
+	width += margins.HNear + margins.HFar
+
+	return Size{width, li.HeightForWidth(maxi(width, li.geometry.ClientSize.Width))}
+}
+
+func (*flowLayoutItem) HasHeightForWidth() bool {
+	return true
 }
 
 func (li *flowLayoutItem) HeightForWidth(width int) int {
@@ -102,11 +129,15 @@ func (li *flowLayoutItem) HeightForWidth(width int) int {
 }
 
 func (li *flowLayoutItem) MinSizeForSize(size Size) Size {
-	if min, ok := li.size2MinSize[size]; ok {
+	li.mutex.Lock()
+	defer li.mutex.Unlock()
+
+	if min, ok := li.minSizeCache.get(size); ok {
 		return min
 	}
 
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+	hSpacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi(Vertical), li.ctx.dpi)
 	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
 
 	bounds := Rectangle{Width: size.Width}
@@ -124,7 +155,7 @@ func (li *flowLayoutItem) MinSizeForSize(size Size) Size {
 
 			sectionMinWidth += sectionItem.minSize.Width
 		}
-		sectionMinWidth += (len(section.items) - 1) * spacing
+		sectionMinWidth += (len(section.items) - 1) * hSpacing
 		maxPrimary = maxi(maxPrimary, sectionMinWidth)
 
 		bounds.Height = section.secondaryMinSize
@@ -137,7 +168,7 @@ func (li *flowLayoutItem) MinSizeForSize(size Size) Size {
 			margins96dpi.VFar = 0
 		}
 
-		layoutItems := boxLayoutItems(li, items, Horizontal, li.alignment, bounds, margins96dpi, li.spacing96dpi, li.hwnd2StretchFactor)
+		layoutItems := boxLayoutItems(li, items, Horizontal, li.alignment, bounds, margins96dpi, li.spacing96dpi(Horizontal), li.hwnd2StretchFactor)
 
 		var maxSecondary int
 
@@ -160,17 +191,20 @@ func (li *flowLayoutItem) MinSizeForSize(size Size) Size {
 	s.Width = maxPrimary
 
 	s.Width += margins.HNear + margins.HFar
-	s.Height += margins.VNear + margins.VFar + (len(sections)-1)*spacing
+	s.Height += margins.VNear + margins.VFar
+	if len(sections) > 1 {
+		s.Height += (len(sections) - 1) * spacing
+	}
 
 	if s.Width > 0 && s.Height > 0 {
-		li.size2MinSize[size] = s
+		li.minSizeCache.put(size, s)
 	}
 
 	return s
 }
 
 func (li *flowLayoutItem) PerformLayout() []LayoutResultItem {
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi(Vertical), li.ctx.dpi)
 	bounds := Rectangle{Width: li.geometry.ClientSize.Width, Height: li.geometry.ClientSize.Height}
 
 	sections := li.sectionsForPrimarySize(bounds.Width)
@@ -193,7 +227,7 @@ func (li *flowLayoutItem) PerformLayout() []LayoutResultItem {
 			margins96dpi.VFar = 0
 		}
 
-		layoutItems := boxLayoutItems(li, items, Horizontal, li.alignment, bounds, margins96dpi, li.spacing96dpi, li.hwnd2StretchFactor)
+		layoutItems := boxLayoutItems(li, items, Horizontal, li.alignment, bounds, margins96dpi, li.spacing96dpi(Horizontal), li.hwnd2StretchFactor)
 
 		margins := MarginsFrom96DPI(margins96dpi, li.ctx.dpi)
 
@@ -211,7 +245,7 @@ func (li *flowLayoutItem) PerformLayout() []LayoutResultItem {
 
 		bounds.Height = maxSecondary + margins.VNear + margins.VFar
 
-		resultItems = append(resultItems, boxLayoutItems(li, items, Horizontal, li.alignment, bounds, margins96dpi, li.spacing96dpi, li.hwnd2StretchFactor)...)
+		resultItems = append(resultItems, boxLayoutItems(li, items, Horizontal, li.alignment, bounds, margins96dpi, li.spacing96dpi(Horizontal), li.hwnd2StretchFactor)...)
 
 		bounds.Y += bounds.Height + spacing
 	}
@@ -222,7 +256,7 @@ func (li *flowLayoutItem) PerformLayout() []LayoutResultItem {
 // sectionsForPrimarySize calculates sections for primary width in native pixels.
 func (li *flowLayoutItem) sectionsForPrimarySize(primarySize int) []flowLayoutSection {
 	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+	spacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
 
 	var sections []flowLayoutSection
 
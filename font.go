@@ -156,6 +156,8 @@ func (f *Font) Bold() bool {
 // The Font can no longer be used for drawing operations or with GUI widgets
 // after calling this method. It is safe to call Dispose multiple times.
 func (f *Font) Dispose() {
+	mustNotBeInUse(f)
+
 	if len(f.dpi2hFont) == 0 {
 		return
 	}
@@ -0,0 +1,224 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"syscall"
+	"unicode/utf16"
+
+	"github.com/lxn/win"
+)
+
+// FontIconTextColor can be passed as color to NewFontIcon, to draw the glyph
+// in the current system text color, so it follows the theme.
+const FontIconTextColor Color = 0xff000000
+
+// FontIcon is an Image that displays a single glyph of an icon font, e.g. of
+// "Segoe MDL2 Assets" or "Segoe Fluent Icons".
+//
+// The glyph is rendered for each DPI it is drawn at, so it stays sharp on
+// high DPI displays.
+type FontIcon struct {
+	family        string
+	glyph         rune
+	size          int
+	color         Color
+	renderedColor Color
+	dpi2Bitmap    map[int]*Bitmap
+}
+
+// NewFontIcon returns a new FontIcon, that displays glyph from the font
+// family at size in 1/96" units using color.
+//
+// If color is FontIconTextColor, the current system text color is used.
+func NewFontIcon(family string, glyph rune, size int, color Color) (*FontIcon, error) {
+	if family == "" {
+		return nil, newError("family cannot be empty")
+	}
+	if size <= 0 {
+		return nil, newError("size must be positive")
+	}
+
+	return &FontIcon{
+		family:     family,
+		glyph:      glyph,
+		size:       size,
+		color:      color,
+		dpi2Bitmap: make(map[int]*Bitmap),
+	}, nil
+}
+
+// Family returns the font family of the glyph.
+func (fi *FontIcon) Family() string {
+	return fi.family
+}
+
+// Glyph returns the displayed glyph.
+func (fi *FontIcon) Glyph() rune {
+	return fi.glyph
+}
+
+// Color returns the color of the glyph, which may be FontIconTextColor.
+func (fi *FontIcon) Color() Color {
+	return fi.color
+}
+
+// Dispose releases the bitmaps the glyph has been rendered to.
+func (fi *FontIcon) Dispose() {
+	for dpi, bmp := range fi.dpi2Bitmap {
+		bmp.Dispose()
+		delete(fi.dpi2Bitmap, dpi)
+	}
+}
+
+// Size returns the size of the icon in 1/96" units.
+func (fi *FontIcon) Size() Size {
+	return Size{fi.size, fi.size}
+}
+
+func (fi *FontIcon) draw(hdc win.HDC, location Point) error {
+	bmp, err := fi.bitmapForDPI(dpiForHDC(hdc))
+	if err != nil {
+		return err
+	}
+
+	return bmp.draw(hdc, location)
+}
+
+func (fi *FontIcon) drawStretched(hdc win.HDC, bounds Rectangle) error {
+	// Render the glyph at the requested size instead of stretching a bitmap.
+	dpi := (bounds.Width*96 + fi.size/2) / fi.size
+	if dpi <= 0 {
+		return nil
+	}
+
+	bmp, err := fi.bitmapForDPI(dpi)
+	if err != nil {
+		return err
+	}
+
+	return bmp.drawStretched(hdc, bounds)
+}
+
+func (fi *FontIcon) usesTextColor() bool {
+	return fi.color == FontIconTextColor
+}
+
+func (fi *FontIcon) resolvedColor() Color {
+	if fi.usesTextColor() {
+		return Color(win.GetSysColor(win.COLOR_WINDOWTEXT))
+	}
+
+	return fi.color
+}
+
+func (fi *FontIcon) bitmapForDPI(dpi int) (*Bitmap, error) {
+	color := fi.resolvedColor()
+	if color != fi.renderedColor {
+		fi.Dispose()
+		fi.renderedColor = color
+	}
+
+	if bmp, ok := fi.dpi2Bitmap[dpi]; ok {
+		return bmp, nil
+	}
+
+	bmp, err := fi.render(dpi, color)
+	if err != nil {
+		return nil, err
+	}
+
+	fi.dpi2Bitmap[dpi] = bmp
+
+	return bmp, nil
+}
+
+// render draws the glyph white on black and uses the resulting coverage as
+// alpha channel of a bitmap filled with color.
+func (fi *FontIcon) render(dpi int, color Color) (*Bitmap, error) {
+	sizePixels := IntFrom96DPI(fi.size, dpi)
+
+	bmp, err := NewBitmapForDPI(Size{sizePixels, sizePixels}, dpi)
+	if err != nil {
+		return nil, err
+	}
+
+	var lf win.LOGFONT
+	lf.LfHeight = -int32(sizePixels)
+	lf.LfWeight = win.FW_NORMAL
+	lf.LfCharSet = win.DEFAULT_CHARSET
+	lf.LfQuality = win.ANTIALIASED_QUALITY
+	src, err := syscall.UTF16FromString(fi.family)
+	if err != nil {
+		bmp.Dispose()
+		return nil, wrapError(err)
+	}
+	if len(src) > len(lf.LfFaceName) {
+		src = append(src[:len(lf.LfFaceName)-1], 0)
+	}
+	copy(lf.LfFaceName[:], src)
+
+	hFont := win.CreateFontIndirect(&lf)
+	if hFont == 0 {
+		bmp.Dispose()
+		return nil, newError("CreateFontIndirect failed")
+	}
+	defer win.DeleteObject(win.HGDIOBJ(hFont))
+
+	text := utf16.Encode([]rune{fi.glyph})
+
+	if err := bmp.withSelectedIntoMemDC(func(hdcMem win.HDC) error {
+		hFontOld := win.SelectObject(hdcMem, win.HGDIOBJ(hFont))
+		defer win.SelectObject(hdcMem, hFontOld)
+
+		win.SetBkMode(hdcMem, win.TRANSPARENT)
+		win.SetTextColor(hdcMem, win.RGB(0xff, 0xff, 0xff))
+
+		rc := win.RECT{Right: int32(sizePixels), Bottom: int32(sizePixels)}
+		if 0 == win.DrawTextEx(hdcMem, &text[0], int32(len(text)), &rc, win.DT_CENTER|win.DT_VCENTER|win.DT_SINGLELINE|win.DT_NOPREFIX, nil) {
+			return newError("DrawTextEx failed")
+		}
+
+		return nil
+	}); err != nil {
+		bmp.Dispose()
+		return nil, err
+	}
+
+	if err := bmp.withPixels(func(bi *win.BITMAPINFO, hdc win.HDC, pixels *[maxPixels]bgraPixel, pixelsLen int) error {
+		for i := 0; i < pixelsLen; i++ {
+			p := &pixels[i]
+
+			a := int(p.G)
+			if int(p.R) > a {
+				a = int(p.R)
+			}
+			if int(p.B) > a {
+				a = int(p.B)
+			}
+
+			// AlphaBlend expects premultiplied alpha.
+			p.R = byte(int(color.R()) * a / 255)
+			p.G = byte(int(color.G()) * a / 255)
+			p.B = byte(int(color.B()) * a / 255)
+			p.A = byte(a)
+		}
+
+		if 0 == win.SetDIBits(hdc, bmp.hBmp, 0, uint32(bi.BmiHeader.BiHeight), &pixels[0].B, bi, win.DIB_RGB_COLORS) {
+			return newError("SetDIBits")
+		}
+
+		return nil
+	}); err != nil {
+		bmp.Dispose()
+		return nil, err
+	}
+
+	bmp.transparencyStatus = transparencyTransparent
+
+	return bmp, nil
+}
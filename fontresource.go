@@ -7,14 +7,20 @@
 package walk
 
 import (
-	"github.com/lxn/win"
+	"encoding/binary"
+	"io/ioutil"
 	"syscall"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/lxn/win"
 )
 
 // FontMemResource represents a font resource loaded into memory from
 // the application's resources.
 type FontMemResource struct {
 	hFontResource win.HANDLE
+	families      []string
 }
 
 func newFontMemResource(resourceName *uint16) (*FontMemResource, error) {
@@ -43,6 +49,27 @@ func newFontMemResource(resourceName *uint16) (*FontMemResource, error) {
 		return nil, lastError("LockResource")
 	}
 
+	return addFontMemResource(ptr, size)
+}
+
+// newFontMemResourceFromBytes loads the font file contained in data, e.g. a
+// TrueType or OpenType font, into memory.
+func newFontMemResourceFromBytes(data []byte) (*FontMemResource, error) {
+	if len(data) == 0 {
+		return nil, newError("no font data")
+	}
+
+	fmr, err := addFontMemResource(uintptr(unsafe.Pointer(&data[0])), uint32(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	fmr.families = fontFamilyNames(data)
+
+	return fmr, nil
+}
+
+func addFontMemResource(ptr uintptr, size uint32) (*FontMemResource, error) {
 	numFonts := uint32(0)
 	hFontResource := win.AddFontMemResourceEx(ptr, size, nil, &numFonts)
 
@@ -53,6 +80,28 @@ func newFontMemResource(resourceName *uint16) (*FontMemResource, error) {
 	return &FontMemResource{hFontResource: hFontResource}, nil
 }
 
+// AddFontFromBytes loads the font file contained in data, e.g. a TrueType or
+// OpenType font, privately for the process.
+//
+// The font families it contains can be used with NewFont like installed ones,
+// but are not visible to other processes. The font should be loaded before
+// it is used by any Font. Call Dispose on the returned FontMemResource to
+// unload it.
+func AddFontFromBytes(data []byte) (*FontMemResource, error) {
+	return newFontMemResourceFromBytes(data)
+}
+
+// AddFontFile loads the font file at filePath privately for the process, like
+// AddFontFromBytes does.
+func AddFontFile(filePath string) (*FontMemResource, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return newFontMemResourceFromBytes(data)
+}
+
 // NewFontMemResourceByName function loads a font resource from the executable's resources
 // using the resource name.
 // The font must be embedded into resources using corresponding operator in the
@@ -74,6 +123,12 @@ func NewFontMemResourceById(id int) (*FontMemResource, error) {
 	return newFontMemResource(win.MAKEINTRESOURCE(uintptr(id)))
 }
 
+// Families returns the names of the font families contained in the font
+// resource, if they are known, e.g. for passing to NewFont.
+func (fmr *FontMemResource) Families() []string {
+	return fmr.families
+}
+
 // Dispose removes the font resource from memory
 func (fmr *FontMemResource) Dispose() {
 	if fmr.hFontResource != 0 {
@@ -81,3 +136,98 @@ func (fmr *FontMemResource) Dispose() {
 		fmr.hFontResource = 0
 	}
 }
+
+// fontFamilyNames returns the family names found in the name tables of the
+// TrueType or OpenType font file or collection contained in data.
+func fontFamilyNames(data []byte) []string {
+	be := binary.BigEndian
+
+	var offsets []uint32
+	if len(data) >= 12 && string(data[:4]) == "ttcf" {
+		count := be.Uint32(data[8:])
+		for i := uint32(0); i < count && 16+4*int(i) <= len(data); i++ {
+			offsets = append(offsets, be.Uint32(data[12+4*i:]))
+		}
+	} else {
+		offsets = []uint32{0}
+	}
+
+	var families []string
+	seen := make(map[string]bool)
+
+	for _, offset := range offsets {
+		name := fontFamilyName(data, int(offset))
+		if name != "" && !seen[name] {
+			seen[name] = true
+			families = append(families, name)
+		}
+	}
+
+	return families
+}
+
+// fontFamilyName returns the family name of the font, whose offset table
+// starts at offset in data, preferring the US English Windows name.
+func fontFamilyName(data []byte, offset int) string {
+	be := binary.BigEndian
+
+	if offset < 0 || offset+12 > len(data) {
+		return ""
+	}
+
+	numTables := int(be.Uint16(data[offset+4:]))
+
+	var table []byte
+	for i := 0; i < numTables; i++ {
+		rec := offset + 12 + 16*i
+		if rec+16 > len(data) {
+			return ""
+		}
+
+		if string(data[rec:rec+4]) == "name" {
+			start, length := int(be.Uint32(data[rec+8:])), int(be.Uint32(data[rec+12:]))
+			if start < 0 || length < 6 || start+length > len(data) {
+				return ""
+			}
+
+			table = data[start : start+length]
+			break
+		}
+	}
+	if table == nil {
+		return ""
+	}
+
+	count, stringOffset := int(be.Uint16(table[2:])), int(be.Uint16(table[4:]))
+
+	var name string
+	for i := 0; i < count; i++ {
+		rec := 6 + 12*i
+		if rec+12 > len(table) {
+			break
+		}
+
+		platformID, languageID, nameID := be.Uint16(table[rec:]), be.Uint16(table[rec+4:]), be.Uint16(table[rec+6:])
+		if platformID != 3 || nameID != 1 {
+			continue
+		}
+
+		start, length := stringOffset+int(be.Uint16(table[rec+10:])), int(be.Uint16(table[rec+8:]))
+		if start+length > len(table) {
+			continue
+		}
+
+		chars := make([]uint16, length/2)
+		for j := range chars {
+			chars[j] = be.Uint16(table[start+2*j:])
+		}
+
+		name = string(utf16.Decode(chars))
+
+		if languageID == 0x0409 {
+			break
+		}
+	}
+
+	return name
+}
@@ -69,6 +69,17 @@ type Form interface {
 	// SetRightToLeftLayout sets whether coordinates on the x axis of the
 	// Form increase from right to left.
 	SetRightToLeftLayout(rtl bool) error
+
+	// Fullscreen returns whether the Form covers a whole monitor without
+	// decorations.
+	Fullscreen() bool
+
+	// SetFullscreen makes the Form cover the whole monitor it is on, or
+	// restores its previous placement and decorations.
+	SetFullscreen(fullscreen bool) error
+
+	// SetFullscreenOnMonitor makes the Form cover the whole monitor m.
+	SetFullscreenOnMonitor(m Monitor) error
 }
 
 type FormBase struct {
@@ -76,6 +87,7 @@ type FormBase struct {
 	clientComposite             *Composite
 	owner                       Form
 	stopwatch                   *stopwatch
+	layoutRequestStack          []byte
 	inProgressEventCount        int
 	performLayout               chan ContainerLayoutItem
 	layoutResults               chan []LayoutResult
@@ -93,11 +105,26 @@ type FormBase struct {
 	prevFocusHWnd               win.HWND
 	proposedSize                Size // in native pixels
 	closeReason                 CloseReason
+	closeGeneration             int
+	inClosing                   bool
+	closeDeferrals              int
+	closeVetoed                 bool
+	overlayDialog               *OverlayDialog
 	inSizingLoop                bool
 	startingLayoutViaSizingLoop bool
 	isInRestoreState            bool
 	started                     bool
 	layoutScheduled             bool
+	windowPlacement             *win.WINDOWPLACEMENT
+	fullscreen                  bool
+	fullscreenMonitor           Monitor
+	fullscreenStyle             uint32
+	presentationMode            bool
+	monitorsChangedPublisher    EventPublisher
+	sessionChangedPublisher     SessionChangeEventPublisher
+	sessionNotificationsEnabled bool
+	autoSize                    bool
+	inAutoSize                  bool
 }
 
 func (fb *FormBase) init(form Form) error {
@@ -217,6 +244,88 @@ func (fb *FormBase) SetBoundsPixels(bounds Rectangle) error {
 	return nil
 }
 
+// AutoSize returns whether the FormBase resizes itself to fit its content.
+func (fb *FormBase) AutoSize() bool {
+	return fb.autoSize
+}
+
+// SetAutoSize sets whether the FormBase resizes itself to fit its content.
+//
+// If enabled, the FormBase takes the ideal size of its layout whenever it is
+// laid out, e.g. because the text of a widget changed or widgets were shown
+// or hidden. The size is clamped to the work area of the monitor the FormBase
+// is on and the FormBase is moved as needed to stay inside of it.
+//
+// While the FormBase is maximized, minimized or in fullscreen mode, it is not
+// resized. Sizes set by the user only last until the next layout.
+func (fb *FormBase) SetAutoSize(autoSize bool) {
+	if autoSize == fb.autoSize {
+		return
+	}
+
+	fb.autoSize = autoSize
+
+	if autoSize {
+		fb.clientComposite.RequestLayout()
+	}
+}
+
+// autoSizeToContent resizes the FormBase to the ideal size of its content, if
+// it auto sizes.
+func (fb *FormBase) autoSizeToContent() {
+	if !fb.autoSize || fb.inAutoSize || fb.inSizingLoop || fb.fullscreen ||
+		fb.clientComposite.layout == nil || win.IsZoomed(fb.hWnd) || win.IsIconic(fb.hWnd) {
+
+		return
+	}
+
+	li := CreateLayoutItemsForContainer(fb.clientComposite)
+
+	cs := li.MinSize()
+	if is, ok := li.(IdealSizer); ok {
+		cs = maxSize(cs, is.IdealSize())
+	}
+
+	size := maxSize(fb.sizeFromClientSizePixels(cs), SizeFrom96DPI(fb.minSize96dpi, fb.DPI()))
+	if max := SizeFrom96DPI(fb.maxSize96dpi, fb.DPI()); max.Width > 0 && max.Height > 0 {
+		size = Size{mini(size.Width, max.Width), mini(size.Height, max.Height)}
+	}
+
+	old := fb.BoundsPixels()
+	bounds := old
+
+	if m := MonitorForWindow(fb); m.IsValid() {
+		work := m.WorkAreaPixels()
+
+		if size.Width > work.Width {
+			size.Width = work.Width
+
+			// Wrapping content needs more height for the narrower width.
+			if li.HasHeightForWidth() {
+				height := li.HeightForWidth(fb.clientSizeFromSizePixels(size).Width)
+				size.Height = maxi(size.Height, fb.sizeFromClientSizePixels(Size{Height: height}).Height)
+			}
+		}
+		size.Height = mini(size.Height, work.Height)
+
+		bounds.X = maxi(work.X, mini(bounds.X, work.X+work.Width-size.Width))
+		bounds.Y = maxi(work.Y, mini(bounds.Y, work.Y+work.Height-size.Height))
+	}
+
+	bounds.Width, bounds.Height = size.Width, size.Height
+
+	if bounds == old {
+		return
+	}
+
+	fb.inAutoSize = true
+	defer func() {
+		fb.inAutoSize = false
+	}()
+
+	fb.SetBoundsPixels(bounds)
+}
+
 func (fb *FormBase) fixedSize() bool {
 	return !fb.hasStyleBits(win.WS_THICKFRAME)
 }
@@ -326,6 +435,22 @@ func (fb *FormBase) TitleChanged() *Event {
 	return fb.titleChangedPublisher.Event()
 }
 
+func (fb *FormBase) applyRightToLeft(rtl bool) error {
+	// The system mirrors the title bar and the client composite. Children
+	// must not inherit the mirroring, because their layouts mirror them.
+	if err := fb.ensureExtendedStyleBits(win.WS_EX_LAYOUTRTL|win.WS_EX_NOINHERITLAYOUT, rtl); err != nil {
+		return err
+	}
+
+	if fb.clientComposite != nil {
+		fb.clientComposite.rightToLeft = rtl
+	}
+
+	win.SetWindowPos(fb.hWnd, 0, 0, 0, 0, 0, win.SWP_FRAMECHANGED|win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOZORDER|win.SWP_NOACTIVATE)
+
+	return nil
+}
+
 // RightToLeftLayout returns whether coordinates on the x axis of the
 // FormBase increase from right to left.
 func (fb *FormBase) RightToLeftLayout() bool {
@@ -338,6 +463,154 @@ func (fb *FormBase) SetRightToLeftLayout(rtl bool) error {
 	return fb.ensureExtendedStyleBits(win.WS_EX_LAYOUTRTL, rtl)
 }
 
+// Fullscreen returns whether the FormBase covers a whole monitor without
+// decorations.
+func (fb *FormBase) Fullscreen() bool {
+	return fb.fullscreen
+}
+
+// SetFullscreen makes the FormBase cover the whole monitor it is on, or
+// restores its previous placement and decorations.
+func (fb *FormBase) SetFullscreen(fullscreen bool) error {
+	if fullscreen == fb.fullscreen {
+		return nil
+	}
+
+	if fullscreen {
+		return fb.SetFullscreenOnMonitor(MonitorForWindow(fb))
+	}
+
+	if err := fb.ensureStyleBits(fb.fullscreenStyle, true); err != nil {
+		return err
+	}
+
+	if !win.SetWindowPlacement(fb.hWnd, fb.windowPlacement) {
+		return lastError("SetWindowPlacement")
+	}
+
+	if !win.SetWindowPos(fb.hWnd, 0, 0, 0, 0, 0, win.SWP_FRAMECHANGED|win.SWP_NOMOVE|
+		win.SWP_NOOWNERZORDER|win.SWP_NOSIZE|win.SWP_NOZORDER) {
+
+		return lastError("SetWindowPos")
+	}
+
+	fb.fullscreen = false
+	fb.fullscreenMonitor = Monitor{}
+
+	return nil
+}
+
+// FullscreenMonitor returns the Monitor the FormBase covers while it is in
+// fullscreen mode.
+func (fb *FormBase) FullscreenMonitor() Monitor {
+	return fb.fullscreenMonitor
+}
+
+// SetFullscreenOnMonitor makes the FormBase cover the whole monitor m without
+// decorations. If the FormBase already is in fullscreen mode, it moves to m.
+//
+// Call SetFullscreen(false) to restore the previous placement and decorations.
+func (fb *FormBase) SetFullscreenOnMonitor(m Monitor) error {
+	if !m.IsValid() {
+		return newError("invalid monitor")
+	}
+
+	if !fb.fullscreen {
+		if fb.windowPlacement == nil {
+			fb.windowPlacement = new(win.WINDOWPLACEMENT)
+		}
+		fb.windowPlacement.Length = uint32(unsafe.Sizeof(*fb.windowPlacement))
+
+		if !win.GetWindowPlacement(fb.hWnd, fb.windowPlacement) {
+			return lastError("GetWindowPlacement")
+		}
+
+		fb.fullscreenStyle = uint32(win.GetWindowLong(fb.hWnd, win.GWL_STYLE)) & win.WS_OVERLAPPEDWINDOW
+
+		if err := fb.ensureStyleBits(fb.fullscreenStyle, false); err != nil {
+			return err
+		}
+	}
+
+	fb.fullscreen = true
+	fb.fullscreenMonitor = m
+
+	return fb.fitToFullscreenMonitor()
+}
+
+func (fb *FormBase) fitToFullscreenMonitor() error {
+	if r := fb.fullscreenMonitor.BoundsPixels(); !win.SetWindowPos(
+		fb.hWnd, win.HWND_TOP,
+		int32(r.X), int32(r.Y), int32(r.Width), int32(r.Height),
+		win.SWP_FRAMECHANGED|win.SWP_NOOWNERZORDER) {
+
+		return lastError("SetWindowPos")
+	}
+
+	return nil
+}
+
+// PresentationMode returns whether the FormBase is in presentation mode.
+func (fb *FormBase) PresentationMode() bool {
+	return fb.presentationMode
+}
+
+// SetPresentationMode sets whether the FormBase is in presentation mode.
+//
+// While any form is in presentation mode, neither the display nor the system
+// go to sleep and the screen saver does not start.
+func (fb *FormBase) SetPresentationMode(presentationMode bool) error {
+	if presentationMode == fb.presentationMode {
+		return nil
+	}
+
+	if presentationMode {
+		if fb.group.presentationModeForms == 0 {
+			if 0 == setThreadExecutionState(esContinuous|esDisplayRequired|esSystemRequired) {
+				return lastError("SetThreadExecutionState")
+			}
+		}
+
+		fb.group.presentationModeForms++
+	} else {
+		fb.group.presentationModeForms--
+
+		if fb.group.presentationModeForms == 0 {
+			if 0 == setThreadExecutionState(esContinuous) {
+				return lastError("SetThreadExecutionState")
+			}
+		}
+	}
+
+	fb.presentationMode = presentationMode
+
+	return nil
+}
+
+// MonitorsChanged returns the event that is published when monitors are
+// attached, detached or change their resolution.
+//
+// If the FormBase is in fullscreen mode, it has already been fit to its
+// monitor, or moved to the nearest one if its monitor was detached, when the
+// event is published.
+func (fb *FormBase) MonitorsChanged() *Event {
+	return fb.monitorsChangedPublisher.Event()
+}
+
+// SessionChanged returns the event that is published when the session the
+// FormBase runs in changes, e.g. when it is locked, unlocked or disconnected.
+func (fb *FormBase) SessionChanged() *SessionChangeEvent {
+	if !fb.sessionNotificationsEnabled && fb.hWnd != 0 {
+		if wtsRegisterSessionNotification(fb.hWnd, notifyForThisSession) {
+			fb.sessionNotificationsEnabled = true
+		} else {
+			lastError("WTSRegisterSessionNotification")
+		}
+	}
+
+	return fb.sessionChangedPublisher.Event()
+}
+
 func (fb *FormBase) Run() int {
 	if fb.owner != nil {
 		win.EnableWindow(fb.owner.Handle(), false)
@@ -431,6 +704,11 @@ func (fb *FormBase) handleKeyDown(msg *win.MSG) bool {
 		}
 	}
 
+	if isInspectorShortcut(key, mods) {
+		toggleInspector()
+		return true
+	}
+
 	// Shortcut actions
 	hwnd := msg.HWnd
 	for hwnd != 0 {
@@ -576,6 +854,60 @@ func (fb *FormBase) close() error {
 	return nil
 }
 
+// DeferClose postpones the decision whether the form closes. It may only be
+// called by a handler of the Closing event.
+//
+// The form stays open until CloseDecision has been called on every
+// CloseDeferral returned for the current close request. It closes only if
+// all of them allowed it and no handler canceled the Closing event. Further
+// close requests are ignored while a decision is pending.
+func (fb *FormBase) DeferClose() *CloseDeferral {
+	if !fb.inClosing {
+		return nil
+	}
+
+	fb.closeDeferrals++
+
+	return &CloseDeferral{form: fb, generation: fb.closeGeneration}
+}
+
+// ClosePending returns whether a close request waits for the decision of a
+// CloseDeferral.
+func (fb *FormBase) ClosePending() bool {
+	return fb.closeDeferrals > 0 && !fb.inClosing
+}
+
+func (fb *FormBase) decideClose(generation int, allow bool) {
+	if generation != fb.closeGeneration || fb.closeDeferrals == 0 {
+		return
+	}
+
+	if !allow {
+		fb.closeVetoed = true
+	}
+
+	fb.closeDeferrals--
+
+	if fb.closeDeferrals == 0 && !fb.inClosing {
+		fb.closeGeneration++
+
+		if !fb.closeVetoed {
+			fb.finishClose()
+		}
+	}
+}
+
+func (fb *FormBase) finishClose() {
+	if fb.owner != nil {
+		win.EnableWindow(fb.owner.Handle(), true)
+		if !win.SetWindowPos(fb.owner.Handle(), win.HWND_NOTOPMOST, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_SHOWWINDOW) {
+			lastError("SetWindowPos")
+		}
+	}
+
+	fb.close()
+}
+
 func (fb *FormBase) Close() error {
 	fb.SendMessage(win.WM_CLOSE, 0, 0)
 
@@ -681,6 +1013,8 @@ func (fb *FormBase) startLayout() bool {
 		return false
 	}
 
+	fb.autoSizeToContent()
+
 	cs := fb.clientSizeFromSizePixels(fb.proposedSize)
 	min := CreateLayoutItemsForContainer(fb.clientComposite).MinSizeForSize(fb.proposedSize)
 
@@ -698,6 +1032,8 @@ func (fb *FormBase) startLayout() bool {
 	cli := CreateLayoutItemsForContainer(fb)
 	cli.Geometry().ClientSize = cs
 
+	recordLayoutRequest(fb)
+
 	fb.performLayout <- cli
 
 	return true
@@ -727,18 +1063,25 @@ func (fb *FormBase) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 		return 0
 
 	case win.WM_CLOSE:
+		if fb.closeDeferrals > 0 {
+			// A decision about the previous close request is still pending.
+			return 0
+		}
+
 		fb.closeReason = CloseReasonUnknown
+		fb.closeGeneration++
+		fb.closeVetoed = false
+
 		var canceled bool
+		fb.inClosing = true
 		fb.closingPublisher.Publish(&canceled, fb.closeReason)
-		if !canceled {
-			if fb.owner != nil {
-				win.EnableWindow(fb.owner.Handle(), true)
-				if !win.SetWindowPos(fb.owner.Handle(), win.HWND_NOTOPMOST, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_SHOWWINDOW) {
-					lastError("SetWindowPos")
-				}
-			}
+		fb.inClosing = false
 
-			fb.close()
+		if canceled {
+			fb.closeDeferrals = 0
+			fb.closeGeneration++
+		} else if fb.closeDeferrals == 0 && !fb.closeVetoed {
+			fb.finishClose()
 		}
 		return 0
 
@@ -798,6 +1141,11 @@ func (fb *FormBase) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 
 		fb.proposedSize = Size{int(wp.Cx), int(wp.Cy)}
 
+		if fb.inAutoSize {
+			// The layout follows in startLayout.
+			break
+		}
+
 		const performingLayoutSubject = "*FormBase.WndProc - WM_WINDOWPOSCHANGED - full layout from sizing loop"
 
 		if fb.inSizingLoop {
@@ -821,6 +1169,8 @@ func (fb *FormBase) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 		}
 
 	case win.WM_SYSCOLORCHANGE:
+		iconCache.forgetSysColorDependent()
+
 		fb.ApplySysColors()
 
 	case win.WM_DPICHANGED:
@@ -869,8 +1219,36 @@ func (fb *FormBase) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 		})
 
 	case win.WM_SYSCOMMAND:
-		if wParam == win.SC_CLOSE {
+		switch wParam & 0xFFF0 {
+		case win.SC_CLOSE:
 			fb.closeReason = CloseReasonUser
+
+		case win.SC_SCREENSAVE, win.SC_MONITORPOWER:
+			if fb.presentationMode {
+				return 0
+			}
+		}
+
+	case win.WM_DISPLAYCHANGE:
+		if fb.fullscreen {
+			if !fb.fullscreenMonitor.IsValid() {
+				fb.fullscreenMonitor = MonitorForWindow(fb)
+			}
+
+			fb.fitToFullscreenMonitor()
+		}
+
+		fb.monitorsChangedPublisher.Publish()
+
+	case wmWTSSessionChange:
+		fb.sessionChangedPublisher.Publish(SessionChangeReason(wParam), uint32(lParam))
+
+	case win.WM_DESTROY:
+		fb.SetPresentationMode(false)
+
+		if fb.sessionNotificationsEnabled {
+			wtsUnRegisterSessionNotification(fb.hWnd)
+			fb.sessionNotificationsEnabled = false
 		}
 
 	case taskbarButtonCreatedMsgId:
@@ -0,0 +1,411 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"sync"
+
+	"github.com/lxn/win"
+)
+
+// FormLayout arranges the widgets of a container as a form of label/field
+// pairs.
+//
+// A Label followed by a widget, that is not a Label, forms a row with the
+// label in the first and the widget in the second column. Labels are aligned
+// according to LabelAlignment, which is AlignFar by default, and fields are
+// stretched to the width of the second column. Labels are vertically aligned
+// with the text of single-line fields like LineEdit or ComboBox. All other
+// widgets span both columns. With BaselineAlignment, labels are aligned with
+// the baseline of the text of their fields instead.
+type FormLayout struct {
+	LayoutBase
+	labelAlignment    Alignment1D
+	baselineAlignment bool
+}
+
+func NewFormLayout() *FormLayout {
+	l := &FormLayout{
+		LayoutBase: LayoutBase{
+			margins96dpi: Margins{9, 9, 9, 9},
+			spacing96dpi: 6,
+		},
+	}
+	l.layout = l
+
+	return l
+}
+
+// LabelAlignment returns the horizontal alignment of the labels within the
+// first column.
+func (l *FormLayout) LabelAlignment() Alignment1D {
+	if l.labelAlignment == AlignDefault {
+		return AlignFar
+	}
+
+	return l.labelAlignment
+}
+
+// SetLabelAlignment sets the horizontal alignment of the labels within the
+// first column.
+func (l *FormLayout) SetLabelAlignment(alignment Alignment1D) error {
+	if alignment < AlignDefault || alignment > AlignFar {
+		return newError("invalid Alignment value")
+	}
+
+	if alignment != l.labelAlignment {
+		l.labelAlignment = alignment
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// BaselineAlignment returns if labels and their fields are vertically aligned
+// by the baseline of their text.
+func (l *FormLayout) BaselineAlignment() bool {
+	return l.baselineAlignment
+}
+
+// SetBaselineAlignment sets if labels and their fields are vertically aligned
+// by the baseline of their text, so the text of a label lines up with that of
+// a LineEdit, ComboBox or CheckBox next to it at any DPI. Rows, whose field
+// has no baseline, like a multi-line TextEdit, keep the default alignment.
+func (l *FormLayout) SetBaselineAlignment(baselineAlignment bool) {
+	if baselineAlignment != l.baselineAlignment {
+		l.baselineAlignment = baselineAlignment
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+}
+
+func (l *FormLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	li := &formLayoutItem{
+		labelAlignment:    l.LabelAlignment(),
+		baselineAlignment: l.baselineAlignment,
+		labelHandles:      make(map[win.HWND]bool),
+	}
+
+	if l.container != nil {
+		children := l.container.Children()
+		for i := children.Len() - 1; i >= 0; i-- {
+			if label, ok := children.At(i).(*Label); ok {
+				li.labelHandles[label.Handle()] = true
+			}
+		}
+	}
+
+	return li
+}
+
+type formLayoutItem struct {
+	ContainerLayoutItemBase
+	mutex             sync.Mutex
+	minSizeCache      minSizeCache // in native pixels
+	labelAlignment    Alignment1D
+	baselineAlignment bool
+	labelHandles      map[win.HWND]bool
+}
+
+// formLayoutRow is a row of a form. If paired is false, field spans both
+// columns.
+type formLayoutRow struct {
+	label  LayoutItem
+	field  LayoutItem
+	paired bool
+}
+
+func (li *formLayoutItem) isLabel(item LayoutItem) bool {
+	return li.labelHandles[item.Handle()]
+}
+
+func (li *formLayoutItem) rows() []formLayoutRow {
+	var rows []formLayoutRow
+
+	for i := 0; i < len(li.children); i++ {
+		item := li.children[i]
+
+		var row formLayoutRow
+		if li.isLabel(item) && i+1 < len(li.children) && !li.isLabel(li.children[i+1]) {
+			row.label, row.field, row.paired = item, li.children[i+1], true
+			i++
+		} else {
+			row.field = item
+		}
+
+		if !shouldLayoutItem(row.label) {
+			row.label = nil
+		}
+		if !shouldLayoutItem(row.field) {
+			row.field = nil
+		}
+
+		if row.label != nil || row.field != nil {
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
+
+// isSingleLine returns if item displays a single line of text, so a label
+// should be vertically centered next to it.
+func isSingleLine(item LayoutItem) bool {
+	if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+		return false
+	}
+
+	return item.LayoutFlags()&(GrowableVert|GreedyVert) == 0
+}
+
+func (li *formLayoutItem) heightForWidth(item LayoutItem, width int) int {
+	if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+		return hfw.HeightForWidth(width)
+	}
+
+	return li.MinSizeEffectiveForChild(item).Height
+}
+
+// baselineAligned returns if the label and the field of row are aligned by
+// their baselines.
+func (li *formLayoutItem) baselineAligned(row formLayoutRow) bool {
+	if !li.baselineAlignment || !row.paired || row.label == nil || row.field == nil {
+		return false
+	}
+
+	_, _, labelOK := baselineOf(row.label)
+	_, _, fieldOK := baselineOf(row.field)
+
+	return labelOK && fieldOK
+}
+
+func (li *formLayoutItem) LayoutFlags() LayoutFlags {
+	// Fields are stretched to any width beyond the minimum.
+	return boxLayoutFlags(Vertical, li.children) | GrowableHorz
+}
+
+func (li *formLayoutItem) IdealSize() Size {
+	return li.MinSize()
+}
+
+func (li *formLayoutItem) MinSize() Size {
+	return li.MinSizeForSize(li.geometry.ClientSize)
+}
+
+func (li *formLayoutItem) HeightForWidth(width int) int {
+	return li.MinSizeForSize(Size{width, li.geometry.ClientSize.Height}).Height
+}
+
+func (li *formLayoutItem) MinSizeForSize(size Size) Size {
+	li.mutex.Lock()
+	defer li.mutex.Unlock()
+
+	if min, ok := li.minSizeCache.get(size); ok {
+		return min
+	}
+
+	_, s := li.layoutItems(Size{Width: size.Width})
+
+	if s.Width > 0 && s.Height > 0 {
+		li.minSizeCache.put(size, s)
+	}
+
+	return s
+}
+
+func (li *formLayoutItem) PerformLayout() []LayoutResultItem {
+	items, _ := li.layoutItems(li.geometry.ClientSize)
+
+	return items
+}
+
+// layoutItems returns the bounds of the items for the client size and the
+// minimum size the form needs at the width of size.
+func (li *formLayoutItem) layoutItems(size Size) ([]LayoutResultItem, Size) {
+	rows := li.rows()
+	if len(rows) == 0 {
+		return nil, Size{}
+	}
+
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	hSpacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
+	vSpacing := IntFrom96DPI(li.spacing96dpi(Vertical), li.ctx.dpi)
+
+	var anyPaired bool
+	var labelWidth, fieldMinWidth, spanMinWidth, lineHeight int
+	for _, row := range rows {
+		if row.label != nil {
+			labelWidth = maxi(labelWidth, li.MinSizeEffectiveForChild(row.label).Width)
+		}
+
+		if row.field == nil {
+			continue
+		}
+
+		min := li.MinSizeEffectiveForChild(row.field)
+		if row.paired {
+			anyPaired = true
+			fieldMinWidth = maxi(fieldMinWidth, min.Width)
+
+			if isSingleLine(row.field) {
+				lineHeight = maxi(lineHeight, min.Height)
+			}
+		} else {
+			spanMinWidth = maxi(spanMinWidth, min.Width)
+		}
+	}
+
+	pairedMinWidth := fieldMinWidth
+	if anyPaired {
+		pairedMinWidth += labelWidth + hSpacing
+	}
+
+	minSize := Size{Width: maxi(pairedMinWidth, spanMinWidth) + margins.HNear + margins.HFar}
+
+	width := maxi(size.Width, minSize.Width)
+	spanWidth := width - margins.HNear - margins.HFar
+	fieldX := margins.HNear + labelWidth + hSpacing
+	fieldWidth := width - margins.HFar - fieldX
+
+	heights := make([]int, len(rows))
+	var greedyCount int
+	for i, row := range rows {
+		var h int
+		if row.label != nil {
+			h = li.MinSizeEffectiveForChild(row.label).Height
+		}
+		if row.field != nil {
+			w := spanWidth
+			if row.paired {
+				w = fieldWidth
+			}
+			h = maxi(h, li.heightForWidth(row.field, w))
+
+			if li.baselineAligned(row) {
+				h = maxi(h, baselineExtent([]LayoutItem{row.label, row.field}))
+			}
+
+			if row.field.LayoutFlags()&GreedyVert != 0 {
+				greedyCount++
+			}
+		}
+
+		heights[i] = h
+		minSize.Height += h
+	}
+	minSize.Height += margins.VNear + margins.VFar + (len(rows)-1)*vSpacing
+
+	if excess := size.Height - minSize.Height; excess > 0 && greedyCount > 0 {
+		for i, row := range rows {
+			if row.field != nil && row.field.LayoutFlags()&GreedyVert != 0 {
+				share := excess / greedyCount
+				heights[i] += share
+				excess -= share
+				greedyCount--
+			}
+		}
+	}
+
+	items := make([]LayoutResultItem, 0, len(li.children))
+
+	y := margins.VNear
+	for i, row := range rows {
+		h := heights[i]
+
+		if !row.paired {
+			items = append(items, LayoutResultItem{Item: row.field, Bounds: Rectangle{margins.HNear, y, li.cappedWidth(row.field, spanWidth), h}})
+
+			y += h + vSpacing
+			continue
+		}
+
+		if li.baselineAligned(row) {
+			items = append(items, li.baselineAlignedRow(row, margins.HNear, labelWidth, fieldX, fieldWidth, y, h)...)
+
+			y += h + vSpacing
+			continue
+		}
+
+		singleLine := row.field != nil && isSingleLine(row.field)
+
+		if row.label != nil {
+			min := li.MinSizeEffectiveForChild(row.label)
+
+			x := li.labelX(margins.HNear, labelWidth, min.Width)
+
+			// Center the label on the first line of text of the field, which
+			// for multi-line fields is where a single-line field would be.
+			labelY := y
+			if singleLine {
+				labelY += (h - min.Height) / 2
+			} else if lineHeight > min.Height {
+				labelY += (lineHeight - min.Height) / 2
+			}
+
+			items = append(items, LayoutResultItem{Item: row.label, Bounds: Rectangle{x, labelY, min.Width, min.Height}})
+		}
+
+		if row.field != nil {
+			fieldY, fieldH := y, h
+			if singleLine {
+				fieldH = li.MinSizeEffectiveForChild(row.field).Height
+				fieldY += (h - fieldH) / 2
+			}
+
+			items = append(items, LayoutResultItem{Item: row.field, Bounds: Rectangle{fieldX, fieldY, li.cappedWidth(row.field, fieldWidth), fieldH}})
+		}
+
+		y += h + vSpacing
+	}
+
+	return items, minSize
+}
+
+// labelX returns the x coordinate of a label of width in the first column.
+func (li *formLayoutItem) labelX(left, labelWidth, width int) int {
+	switch li.labelAlignment {
+	case AlignNear:
+		return left
+
+	case AlignCenter:
+		return left + (labelWidth-width)/2
+	}
+
+	return left + labelWidth - width
+}
+
+// baselineAlignedRow returns the bounds of the label and the field of row,
+// which are aligned by their baselines and vertically centered in the row at
+// y with height h.
+func (li *formLayoutItem) baselineAlignedRow(row formLayoutRow, left, labelWidth, fieldX, fieldWidth, y, h int) []LayoutResultItem {
+	labelBaseline, labelHeight, _ := baselineOf(row.label)
+	fieldBaseline, fieldHeight, _ := baselineOf(row.field)
+
+	ascent := maxi(labelBaseline, fieldBaseline)
+	extent := ascent + maxi(labelHeight-labelBaseline, fieldHeight-fieldBaseline)
+	top := y + (h-extent)/2
+
+	labelMin := li.MinSizeEffectiveForChild(row.label)
+
+	return []LayoutResultItem{
+		{Item: row.label, Bounds: Rectangle{li.labelX(left, labelWidth, labelMin.Width), top + ascent - labelBaseline, labelMin.Width, labelHeight}},
+		{Item: row.field, Bounds: Rectangle{fieldX, top + ascent - fieldBaseline, li.cappedWidth(row.field, fieldWidth), fieldHeight}},
+	}
+}
+
+func (li *formLayoutItem) cappedWidth(item LayoutItem, width int) int {
+	if max := item.Geometry().MaxSize.Width; max > 0 && width > max {
+		return max
+	}
+
+	return width
+}
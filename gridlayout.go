@@ -9,6 +9,8 @@ package walk
 import (
 	"sort"
 	"sync"
+
+	"github.com/lxn/win"
 )
 
 type gridLayoutCell struct {
@@ -22,11 +24,94 @@ type gridLayoutSection struct {
 	greedySpacerCount    int
 }
 
+type gridSizeModeKind int
+
+const (
+	gridSizeAuto gridSizeModeKind = iota
+	gridSizeFixed
+	gridSizePercent
+)
+
+// GridSizeMode specifies how the size of a GridLayout row or column is
+// determined. The zero value is GridSizeAuto.
+type GridSizeMode struct {
+	kind  gridSizeModeKind
+	value int
+}
+
+// GridSizeAuto sizes a row or column by the min sizes of its widgets and
+// distributes excess space according to the stretch factors.
+var GridSizeAuto GridSizeMode
+
+// GridSizeFixed returns a GridSizeMode, that sizes a row or column to size in
+// 1/96" units, regardless of its widgets.
+func GridSizeFixed(size int) GridSizeMode {
+	return GridSizeMode{gridSizeFixed, size}
+}
+
+// GridSizePercent returns a GridSizeMode, that sizes a row or column to
+// percent of the space, that is left after margins and spacing.
+func GridSizePercent(percent int) GridSizeMode {
+	return GridSizeMode{gridSizePercent, percent}
+}
+
+// Fixed returns the size in 1/96" units and whether m is a fixed size.
+func (m GridSizeMode) Fixed() (size int, ok bool) {
+	return m.value, m.kind == gridSizeFixed
+}
+
+// Percent returns the percentage and whether m is a percentage.
+func (m GridSizeMode) Percent() (percent int, ok bool) {
+	return m.value, m.kind == gridSizePercent
+}
+
+func (m GridSizeMode) validate() error {
+	switch m.kind {
+	case gridSizeFixed:
+		if m.value < 0 {
+			return newError("fixed size must be >= 0")
+		}
+
+	case gridSizePercent:
+		if m.value < 0 || m.value > 100 {
+			return newError("percent must be between 0 and 100")
+		}
+	}
+
+	return nil
+}
+
+// gridSectionLimits constrain the size of a row or column in 1/96" units.
+// Zero means unconstrained.
+type gridSectionLimits struct {
+	min int
+	max int
+}
+
+// GridOverlapPolicy specifies what GridLayout.SetRange does, if the range
+// overlaps the range of another widget.
+type GridOverlapPolicy int
+
+const (
+	// GridOverlapEvict removes the ranges of the other widgets, so they are
+	// no longer laid out, unless they are placed automatically (see
+	// SetColumns) or get a new range.
+	GridOverlapEvict GridOverlapPolicy = iota
+
+	// GridOverlapError makes SetRange return an error and leave the ranges
+	// unchanged.
+	GridOverlapError
+)
+
 type gridLayoutWidgetInfo struct {
-	cell     *gridLayoutCell
-	spanHorz int
-	spanVert int
-	minSize  Size // in native pixels
+	cell       *gridLayoutCell
+	spanHorz   int
+	spanVert   int
+	minSize    Size // in native pixels
+	autoPlaced bool
+	layered    bool // see SetRangeLayered
+	zOrder     int
+	seq        int // orders the ranges by the time they were set
 }
 
 type GridLayout struct {
@@ -35,6 +120,16 @@ type GridLayout struct {
 	columnStretchFactors []int
 	widgetBase2Info      map[*WidgetBase]*gridLayoutWidgetInfo
 	cells                [][]gridLayoutCell
+	columns              int
+	rowSizeModes         []GridSizeMode
+	columnSizeModes      []GridSizeMode
+	rowLimits            []gridSectionLimits
+	columnLimits         []gridSectionLimits
+	rowSpacings          []int // in 1/96" units, -1 if the layout spacing applies
+	columnSpacings       []int
+	baselineAlignment    bool
+	overlapPolicy        GridOverlapPolicy
+	rangeSeq             int
 }
 
 func NewGridLayout() *GridLayout {
@@ -127,137 +222,1042 @@ func (l *GridLayout) SetRowStretchFactor(row, factor int) error {
 			return newError("factor must be >= 1")
 		}
 
-		l.ensureSufficientSize(row+1, len(l.columnStretchFactors))
+		l.ensureSufficientSize(row+1, len(l.columnStretchFactors))
+
+		l.rowStretchFactors[row] = factor
+
+		l.container.RequestLayout()
+	}
+
+	return nil
+}
+
+func (l *GridLayout) ColumnStretchFactor(column int) int {
+	if column < 0 {
+		// FIXME: Should we rather return an error?
+		return -1
+	}
+
+	if column >= len(l.columnStretchFactors) {
+		return 1
+	}
+
+	return l.columnStretchFactors[column]
+}
+
+func (l *GridLayout) SetColumnStretchFactor(column, factor int) error {
+	if column < 0 {
+		return newError("column must be >= 0")
+	}
+
+	if factor != l.ColumnStretchFactor(column) {
+		if l.container == nil {
+			return newError("container required")
+		}
+		if factor < 1 {
+			return newError("factor must be >= 1")
+		}
+
+		l.ensureSufficientSize(len(l.rowStretchFactors), column+1)
+
+		l.columnStretchFactors[column] = factor
+
+		l.container.RequestLayout()
+	}
+
+	return nil
+}
+
+// RowSizeMode returns how the size of row is determined.
+func (l *GridLayout) RowSizeMode(row int) GridSizeMode {
+	if row < 0 || row >= len(l.rowSizeModes) {
+		return GridSizeAuto
+	}
+
+	return l.rowSizeModes[row]
+}
+
+// SetRowSizeMode sets how the size of row is determined.
+//
+// Rows with GridSizeFixed or GridSizePercent get exactly that size, even if
+// this clips their widgets, and do not take part in the distribution of
+// excess space by stretch factors.
+func (l *GridLayout) SetRowSizeMode(row int, mode GridSizeMode) error {
+	if row < 0 {
+		return newError("row must be >= 0")
+	}
+	if err := mode.validate(); err != nil {
+		return err
+	}
+
+	if mode != l.RowSizeMode(row) {
+		l.ensureSufficientSize(row+1, len(l.columnStretchFactors))
+
+		l.rowSizeModes = sufficientSizeModes(l.rowSizeModes, row+1)
+		l.rowSizeModes[row] = mode
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// ColumnSizeMode returns how the size of column is determined.
+func (l *GridLayout) ColumnSizeMode(column int) GridSizeMode {
+	if column < 0 || column >= len(l.columnSizeModes) {
+		return GridSizeAuto
+	}
+
+	return l.columnSizeModes[column]
+}
+
+// SetColumnSizeMode sets how the size of column is determined.
+//
+// Columns with GridSizeFixed or GridSizePercent get exactly that size, even
+// if this clips their widgets, and do not take part in the distribution of
+// excess space by stretch factors.
+func (l *GridLayout) SetColumnSizeMode(column int, mode GridSizeMode) error {
+	if column < 0 {
+		return newError("column must be >= 0")
+	}
+	if err := mode.validate(); err != nil {
+		return err
+	}
+
+	if mode != l.ColumnSizeMode(column) {
+		l.ensureSufficientSize(len(l.rowStretchFactors), column+1)
+
+		l.columnSizeModes = sufficientSizeModes(l.columnSizeModes, column+1)
+		l.columnSizeModes[column] = mode
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// RowMinimumHeight returns the minimum height of row in 1/96" units. 0 means
+// the height is only limited by the widgets in the row.
+func (l *GridLayout) RowMinimumHeight(row int) int {
+	return sectionLimitsAt(l.rowLimits, row).min
+}
+
+// SetRowMinimumHeight sets the minimum height of row in 1/96" units, which
+// greedy widgets in other rows can not squash the row below. Pass 0 to remove
+// the constraint.
+func (l *GridLayout) SetRowMinimumHeight(row, height int) error {
+	limits := sectionLimitsAt(l.rowLimits, row)
+	limits.min = height
+
+	return l.setRowLimits(row, limits)
+}
+
+// RowMaximumHeight returns the maximum height of row in 1/96" units. 0 means
+// the row is not limited.
+func (l *GridLayout) RowMaximumHeight(row int) int {
+	return sectionLimitsAt(l.rowLimits, row).max
+}
+
+// SetRowMaximumHeight sets the maximum height of row in 1/96" units. Pass 0 to
+// remove the constraint.
+func (l *GridLayout) SetRowMaximumHeight(row, height int) error {
+	limits := sectionLimitsAt(l.rowLimits, row)
+	limits.max = height
+
+	return l.setRowLimits(row, limits)
+}
+
+// ColumnMinimumWidth returns the minimum width of column in 1/96" units. 0
+// means the width is only limited by the widgets in the column.
+func (l *GridLayout) ColumnMinimumWidth(column int) int {
+	return sectionLimitsAt(l.columnLimits, column).min
+}
+
+// SetColumnMinimumWidth sets the minimum width of column in 1/96" units,
+// which greedy widgets in other columns can not squash the column below. Pass
+// 0 to remove the constraint.
+func (l *GridLayout) SetColumnMinimumWidth(column, width int) error {
+	limits := sectionLimitsAt(l.columnLimits, column)
+	limits.min = width
+
+	return l.setColumnLimits(column, limits)
+}
+
+// ColumnMaximumWidth returns the maximum width of column in 1/96" units. 0
+// means the column is not limited.
+func (l *GridLayout) ColumnMaximumWidth(column int) int {
+	return sectionLimitsAt(l.columnLimits, column).max
+}
+
+// SetColumnMaximumWidth sets the maximum width of column in 1/96" units. Pass
+// 0 to remove the constraint.
+func (l *GridLayout) SetColumnMaximumWidth(column, width int) error {
+	limits := sectionLimitsAt(l.columnLimits, column)
+	limits.max = width
+
+	return l.setColumnLimits(column, limits)
+}
+
+func (l *GridLayout) setRowLimits(row int, limits gridSectionLimits) error {
+	if row < 0 {
+		return newError("row must be >= 0")
+	}
+	if err := limits.validate(); err != nil {
+		return err
+	}
+
+	if limits != sectionLimitsAt(l.rowLimits, row) {
+		l.ensureSufficientSize(row+1, len(l.columnStretchFactors))
+
+		if len(l.rowLimits) <= row {
+			l.rowLimits = append(l.rowLimits, make([]gridSectionLimits, row+1-len(l.rowLimits))...)
+		}
+		l.rowLimits[row] = limits
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+func (l *GridLayout) setColumnLimits(column int, limits gridSectionLimits) error {
+	if column < 0 {
+		return newError("column must be >= 0")
+	}
+	if err := limits.validate(); err != nil {
+		return err
+	}
+
+	if limits != sectionLimitsAt(l.columnLimits, column) {
+		l.ensureSufficientSize(len(l.rowStretchFactors), column+1)
+
+		if len(l.columnLimits) <= column {
+			l.columnLimits = append(l.columnLimits, make([]gridSectionLimits, column+1-len(l.columnLimits))...)
+		}
+		l.columnLimits[column] = limits
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+func (limits gridSectionLimits) validate() error {
+	if limits.min < 0 || limits.max < 0 {
+		return newError("size must be >= 0")
+	}
+	if limits.max > 0 && limits.min > limits.max {
+		return newError("minimum size must not exceed maximum size")
+	}
+
+	return nil
+}
+
+// clamp returns size, which is in native pixels, limited to limits at dpi.
+func (limits gridSectionLimits) clamp(size, dpi int) int {
+	if limits.min > 0 {
+		size = maxi(size, IntFrom96DPI(limits.min, dpi))
+	}
+	if limits.max > 0 {
+		size = mini(size, IntFrom96DPI(limits.max, dpi))
+	}
+
+	return size
+}
+
+func sectionLimitsAt(limits []gridSectionLimits, index int) gridSectionLimits {
+	if index < 0 || index >= len(limits) {
+		return gridSectionLimits{}
+	}
+
+	return limits[index]
+}
+
+func removeSectionLimits(limits []gridSectionLimits, index int) []gridSectionLimits {
+	if index >= len(limits) {
+		return limits
+	}
+
+	return append(limits[:index], limits[index+1:]...)
+}
+
+func insertSectionLimits(limits []gridSectionLimits, index int) []gridSectionLimits {
+	if index >= len(limits) {
+		return limits
+	}
+
+	limits = append(limits, gridSectionLimits{})
+	copy(limits[index+1:], limits[index:])
+	limits[index] = gridSectionLimits{}
+
+	return limits
+}
+
+func sufficientSizeModes(modes []GridSizeMode, required int) []GridSizeMode {
+	if len(modes) < required {
+		modes = append(modes, make([]GridSizeMode, required-len(modes))...)
+	}
+
+	return modes
+}
+
+func removeSizeMode(modes []GridSizeMode, index int) []GridSizeMode {
+	if index >= len(modes) {
+		return modes
+	}
+
+	return append(modes[:index], modes[index+1:]...)
+}
+
+func insertSizeMode(modes []GridSizeMode, index int) []GridSizeMode {
+	if index >= len(modes) {
+		return modes
+	}
+
+	modes = append(modes, GridSizeAuto)
+	copy(modes[index+1:], modes[index:])
+	modes[index] = GridSizeAuto
+
+	return modes
+}
+
+// RowSpacing returns the spacing in 1/96" units between row and the next
+// non-empty row below it, or -1 if the vertical spacing of the layout applies.
+func (l *GridLayout) RowSpacing(row int) int {
+	return spacingAt(l.rowSpacings, row)
+}
+
+// SetRowSpacing sets the spacing in 1/96" units between row and the next
+// non-empty row below it. Pass -1 to use the vertical spacing of the layout.
+func (l *GridLayout) SetRowSpacing(row, spacing int) error {
+	if row < 0 {
+		return newError("row must be >= 0")
+	}
+	if spacing < -1 {
+		return newError("spacing cannot be negative")
+	}
+
+	if spacing != l.RowSpacing(row) {
+		l.ensureSufficientSize(row+1, len(l.columnStretchFactors))
+
+		l.rowSpacings = sufficientSpacings(l.rowSpacings, row+1)
+		l.rowSpacings[row] = spacing
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// ColumnSpacing returns the spacing in 1/96" units between column and the
+// next non-empty column right of it, or -1 if the horizontal spacing of the
+// layout applies.
+func (l *GridLayout) ColumnSpacing(column int) int {
+	return spacingAt(l.columnSpacings, column)
+}
+
+// SetColumnSpacing sets the spacing in 1/96" units between column and the
+// next non-empty column right of it. Pass -1 to use the horizontal spacing of
+// the layout.
+func (l *GridLayout) SetColumnSpacing(column, spacing int) error {
+	if column < 0 {
+		return newError("column must be >= 0")
+	}
+	if spacing < -1 {
+		return newError("spacing cannot be negative")
+	}
+
+	if spacing != l.ColumnSpacing(column) {
+		l.ensureSufficientSize(len(l.rowStretchFactors), column+1)
+
+		l.columnSpacings = sufficientSpacings(l.columnSpacings, column+1)
+		l.columnSpacings[column] = spacing
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+func spacingAt(spacings []int, index int) int {
+	if index < 0 || index >= len(spacings) {
+		return -1
+	}
+
+	return spacings[index]
+}
+
+func sufficientSpacings(spacings []int, required int) []int {
+	for len(spacings) < required {
+		spacings = append(spacings, -1)
+	}
+
+	return spacings
+}
+
+func removeSpacing(spacings []int, index int) []int {
+	if index >= len(spacings) {
+		return spacings
+	}
+
+	return append(spacings[:index], spacings[index+1:]...)
+}
+
+func insertSpacing(spacings []int, index int) []int {
+	if index >= len(spacings) {
+		return spacings
+	}
+
+	spacings = append(spacings, -1)
+	copy(spacings[index+1:], spacings[index:])
+	spacings[index] = -1
+
+	return spacings
+}
+
+// BaselineAlignment returns if the widgets in a row are vertically aligned by
+// the baseline of their text.
+func (l *GridLayout) BaselineAlignment() bool {
+	return l.baselineAlignment
+}
+
+// SetBaselineAlignment sets if the widgets in a row are vertically aligned by
+// the baseline of their text, so e.g. the texts of a Label, a LineEdit and a
+// ComboBox in a row line up. Widgets without text or spanning multiple rows
+// keep their alignment.
+func (l *GridLayout) SetBaselineAlignment(baselineAlignment bool) {
+	if baselineAlignment != l.baselineAlignment {
+		l.baselineAlignment = baselineAlignment
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+}
+
+// Columns returns the number of columns children without a range set via
+// SetRange are placed into. 0 means such children are not displayed.
+func (l *GridLayout) Columns() int {
+	return l.columns
+}
+
+// SetColumns sets the number of columns children without a range set via
+// SetRange are placed into.
+//
+// Such children flow in the order of the container's children into the next
+// free cell, row by row, skipping cells that are occupied by ranges set via
+// SetRange. Pass 0 to turn automatic placement off.
+func (l *GridLayout) SetColumns(columns int) error {
+	if columns < 0 {
+		return newError("columns must be >= 0")
+	}
+
+	if columns != l.columns {
+		l.columns = columns
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// autoPlace places the children without a range set via SetRange into the
+// free cells.
+func (l *GridLayout) autoPlace() {
+	// Previous placements are dropped, so the placement follows the current
+	// children.
+	for wb, info := range l.widgetBase2Info {
+		if info.autoPlaced {
+			if info.cell.widgetBase == wb {
+				info.cell.widgetBase = nil
+			}
+			delete(l.widgetBase2Info, wb)
+		}
+	}
+
+	if l.columns < 1 || l.container == nil {
+		return
+	}
+
+	occupied := func(row, col int) bool {
+		return row < len(l.cells) && col < len(l.cells[row]) && l.cells[row][col].widgetBase != nil
+	}
+
+	var row, col int
+
+	children := l.container.Children()
+	for i := 0; i < children.Len(); i++ {
+		widget := children.At(i)
+		wb := widget.AsWidgetBase()
+
+		if _, ok := l.widgetBase2Info[wb]; ok {
+			continue
+		}
+
+		for occupied(row, col) {
+			if col++; col == l.columns {
+				row++
+				col = 0
+			}
+		}
+
+		l.ensureSufficientSize(row+1, col+1)
+
+		cell := &l.cells[row][col]
+		cell.row = row
+		cell.column = col
+
+		l.widgetBase2Info[wb] = &gridLayoutWidgetInfo{
+			cell:       cell,
+			spanHorz:   1,
+			spanVert:   1,
+			autoPlaced: true,
+		}
+
+		l.setWidgetOnCells(widget, Rectangle{col, row, 1, 1})
+	}
+}
+
+func rangeFromGridLayoutWidgetInfo(info *gridLayoutWidgetInfo) Rectangle {
+	return Rectangle{
+		X:      info.cell.column,
+		Y:      info.cell.row,
+		Width:  info.spanHorz,
+		Height: info.spanVert,
+	}
+}
+
+func (l *GridLayout) setWidgetOnCells(widget Widget, r Rectangle) {
+	var wb *WidgetBase
+	if widget != nil {
+		wb = widget.AsWidgetBase()
+	}
+
+	for row := r.Y; row < r.Y+r.Height; row++ {
+		for col := r.X; col < r.X+r.Width; col++ {
+			l.cells[row][col].widgetBase = wb
+		}
+	}
+}
+
+func (l *GridLayout) Range(widget Widget) (r Rectangle, ok bool) {
+	if widget == nil {
+		return Rectangle{}, false
+	}
+
+	info := l.widgetBase2Info[widget.AsWidgetBase()]
+
+	if info == nil ||
+		l.container == nil ||
+		!l.container.Children().containsHandle(widget.Handle()) {
+		return Rectangle{}, false
+	}
+
+	return rangeFromGridLayoutWidgetInfo(info), true
+}
+
+// OverlapPolicy returns what SetRange does, if the range overlaps the range
+// of another widget.
+func (l *GridLayout) OverlapPolicy() GridOverlapPolicy {
+	return l.overlapPolicy
+}
+
+// SetOverlapPolicy sets what SetRange does, if the range overlaps the range
+// of another widget. The default is GridOverlapEvict.
+func (l *GridLayout) SetOverlapPolicy(policy GridOverlapPolicy) {
+	l.overlapPolicy = policy
+}
+
+// SetRange sets the cells widget occupies. If r overlaps the range of
+// another widget, the OverlapPolicy applies.
+func (l *GridLayout) SetRange(widget Widget, r Rectangle) error {
+	return l.setRange(widget, r, false, 0)
+}
+
+// SetRangeLayered sets the cells widget occupies, like SetRange, but allows r
+// to overlap the ranges of other widgets set via SetRangeLayered, e.g. to
+// show a badge or overlay on top of another widget.
+//
+// Widgets with a higher zOrder are shown on top of those with a lower one,
+// widgets with the same zOrder in the order their ranges were set. Only the
+// topmost widget of a cell counts towards the size of its row and column.
+func (l *GridLayout) SetRangeLayered(widget Widget, r Rectangle, zOrder int) error {
+	return l.setRange(widget, r, true, zOrder)
+}
+
+// ZOrder returns the z-order of widget and whether its range was set via
+// SetRangeLayered.
+func (l *GridLayout) ZOrder(widget Widget) (zOrder int, layered bool) {
+	if widget == nil {
+		return 0, false
+	}
+
+	if info := l.widgetBase2Info[widget.AsWidgetBase()]; info != nil && info.layered {
+		return info.zOrder, true
+	}
+
+	return 0, false
+}
+
+func gridRangesOverlap(a, b Rectangle) bool {
+	return a.X < b.X+b.Width && b.X < a.X+a.Width && a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
+}
+
+// overlappingWidgets returns the widgets other than wb, whose ranges overlap
+// r, except automatically placed ones and, if layered, layered ones. Ranges
+// of widgets that are no longer children of the container are dropped.
+func (l *GridLayout) overlappingWidgets(wb *WidgetBase, r Rectangle, layered bool) []*WidgetBase {
+	children := l.container.Children()
+
+	var overlapping []*WidgetBase
+
+	for other, info := range l.widgetBase2Info {
+		if other == wb || info.autoPlaced {
+			continue
+		}
+
+		if other.hWnd == 0 || !children.containsHandle(other.hWnd) {
+			delete(l.widgetBase2Info, other)
+			continue
+		}
+
+		if layered && info.layered {
+			continue
+		}
+
+		if gridRangesOverlap(r, rangeFromGridLayoutWidgetInfo(info)) {
+			overlapping = append(overlapping, other)
+		}
+	}
+
+	return overlapping
+}
+
+func (l *GridLayout) setRange(widget Widget, r Rectangle, layered bool, zOrder int) error {
+	if widget == nil {
+		return newError("widget required")
+	}
+	if l.container == nil {
+		return newError("container required")
+	}
+	if !l.container.Children().containsHandle(widget.Handle()) {
+		return newError("widget must be child of container")
+	}
+	if r.X < 0 || r.Y < 0 {
+		return newError("range.X and range.Y must be >= 0")
+	}
+	if r.Width < 1 || r.Height < 1 {
+		return newError("range.Width and range.Height must be >= 1")
+	}
+
+	wb := widget.AsWidgetBase()
+
+	if overlapping := l.overlappingWidgets(wb, r, layered); len(overlapping) > 0 {
+		if l.overlapPolicy == GridOverlapError {
+			return newError("range overlaps the range of another widget")
+		}
+
+		for _, other := range overlapping {
+			delete(l.widgetBase2Info, other)
+		}
+	}
+
+	info := l.widgetBase2Info[wb]
+	if info == nil {
+		info = new(gridLayoutWidgetInfo)
+	}
+	info.autoPlaced = false
+	info.layered = layered
+	info.zOrder = zOrder
+	l.rangeSeq++
+	info.seq = l.rangeSeq
+
+	l.ensureSufficientSize(r.Y+r.Height, r.X+r.Width)
+
+	cell := &l.cells[r.Y][r.X]
+	cell.row = r.Y
+	cell.column = r.X
+
+	if info.cell == nil {
+		// We have to do this _after_ calling ensureSufficientSize().
+		l.widgetBase2Info[wb] = info
+	}
+
+	info.cell = cell
+	info.spanHorz = r.Width
+	info.spanVert = r.Height
+
+	l.assignCells()
+
+	if layered {
+		l.applyZOrder()
+	}
+
+	return nil
+}
+
+// sortedWidgetBases returns the widgets with a range, ordered from bottom to
+// top.
+func (l *GridLayout) sortedWidgetBases() []*WidgetBase {
+	wbs := make([]*WidgetBase, 0, len(l.widgetBase2Info))
+	for wb := range l.widgetBase2Info {
+		wbs = append(wbs, wb)
+	}
+
+	sort.Slice(wbs, func(i, j int) bool {
+		a, b := l.widgetBase2Info[wbs[i]], l.widgetBase2Info[wbs[j]]
+
+		if a.zOrder != b.zOrder {
+			return a.zOrder < b.zOrder
+		}
+
+		return a.seq < b.seq
+	})
+
+	return wbs
+}
+
+// assignCells assigns the cells to the widgets occupying them, the topmost
+// one winning.
+func (l *GridLayout) assignCells() {
+	for _, cells := range l.cells {
+		for col := range cells {
+			cells[col].widgetBase = nil
+		}
+	}
+
+	for _, wb := range l.sortedWidgetBases() {
+		r := rangeFromGridLayoutWidgetInfo(l.widgetBase2Info[wb])
+
+		for row := r.Y; row < r.Y+r.Height; row++ {
+			for col := r.X; col < r.X+r.Width; col++ {
+				l.cells[row][col].widgetBase = wb
+			}
+		}
+	}
+}
+
+// applyZOrder orders the windows of the layered widgets, so those with a
+// higher z-order are shown on top.
+func (l *GridLayout) applyZOrder() {
+	for _, wb := range l.sortedWidgetBases() {
+		if !l.widgetBase2Info[wb].layered {
+			continue
+		}
+
+		win.SetWindowPos(wb.hWnd, win.HWND_TOP, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOACTIVATE)
+	}
+}
+
+// InsertRow inserts an empty row before row. Ranges set via SetRange, that
+// start at or below row, move down by one row and ranges that span across row
+// grow by one row. Row stretch factors move along.
+func (l *GridLayout) InsertRow(row int) error {
+	if row < 0 {
+		return newError("row must be >= 0")
+	}
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	l.rowStretchFactors = insertStretchFactor(l.rowStretchFactors, row)
+	l.rowSizeModes = insertSizeMode(l.rowSizeModes, row)
+	l.rowLimits = insertSectionLimits(l.rowLimits, row)
+	l.rowSpacings = insertSpacing(l.rowSpacings, row)
+
+	l.shiftRanges(func(r Rectangle) (Rectangle, bool) {
+		if r.Y >= row {
+			r.Y++
+		} else if r.Y+r.Height > row {
+			r.Height++
+		}
+
+		return r, true
+	})
+
+	l.container.RequestLayout()
+
+	return nil
+}
+
+// InsertColumn inserts an empty column before column. Ranges set via
+// SetRange, that start at or right of column, move right by one column and
+// ranges that span across column grow by one column. Column stretch factors
+// move along.
+func (l *GridLayout) InsertColumn(column int) error {
+	if column < 0 {
+		return newError("column must be >= 0")
+	}
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	l.columnStretchFactors = insertStretchFactor(l.columnStretchFactors, column)
+	l.columnSizeModes = insertSizeMode(l.columnSizeModes, column)
+	l.columnLimits = insertSectionLimits(l.columnLimits, column)
+	l.columnSpacings = insertSpacing(l.columnSpacings, column)
+
+	l.shiftRanges(func(r Rectangle) (Rectangle, bool) {
+		if r.X >= column {
+			r.X++
+		} else if r.X+r.Width > column {
+			r.Width++
+		}
+
+		return r, true
+	})
+
+	l.container.RequestLayout()
+
+	return nil
+}
+
+// RemoveRow removes row. Ranges below row move up by one row and ranges that
+// span across row shrink by one row. Widgets that are placed in row only are
+// disposed if disposeWidgets is true, otherwise they are no longer displayed
+// until a new range is set for them.
+func (l *GridLayout) RemoveRow(row int, disposeWidgets bool) error {
+	if row < 0 {
+		return newError("row must be >= 0")
+	}
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	removed := l.widgetsInSection(func(r Rectangle) bool {
+		return r.Y == row && r.Height == 1
+	})
+
+	l.rowStretchFactors = removeStretchFactor(l.rowStretchFactors, row)
+	l.rowSizeModes = removeSizeMode(l.rowSizeModes, row)
+	l.rowLimits = removeSectionLimits(l.rowLimits, row)
+	l.rowSpacings = removeSpacing(l.rowSpacings, row)
+
+	l.shiftRanges(func(r Rectangle) (Rectangle, bool) {
+		switch {
+		case r.Y == row && r.Height == 1:
+			return r, false
+
+		case r.Y > row:
+			r.Y--
+
+		case r.Y+r.Height > row:
+			r.Height--
+		}
+
+		return r, true
+	})
+
+	l.finishRemoval(removed, disposeWidgets)
+
+	return nil
+}
+
+// RemoveColumn removes column. Ranges right of column move left by one column
+// and ranges that span across column shrink by one column. Widgets that are
+// placed in column only are disposed if disposeWidgets is true, otherwise they
+// are no longer displayed until a new range is set for them.
+func (l *GridLayout) RemoveColumn(column int, disposeWidgets bool) error {
+	if column < 0 {
+		return newError("column must be >= 0")
+	}
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	removed := l.widgetsInSection(func(r Rectangle) bool {
+		return r.X == column && r.Width == 1
+	})
+
+	l.columnStretchFactors = removeStretchFactor(l.columnStretchFactors, column)
+	l.columnSizeModes = removeSizeMode(l.columnSizeModes, column)
+	l.columnLimits = removeSectionLimits(l.columnLimits, column)
+	l.columnSpacings = removeSpacing(l.columnSpacings, column)
+
+	l.shiftRanges(func(r Rectangle) (Rectangle, bool) {
+		switch {
+		case r.X == column && r.Width == 1:
+			return r, false
+
+		case r.X > column:
+			r.X--
+
+		case r.X+r.Width > column:
+			r.Width--
+		}
 
-		l.rowStretchFactors[row] = factor
+		return r, true
+	})
 
-		l.container.RequestLayout()
-	}
+	l.finishRemoval(removed, disposeWidgets)
 
 	return nil
 }
 
-func (l *GridLayout) ColumnStretchFactor(column int) int {
-	if column < 0 {
-		// FIXME: Should we rather return an error?
-		return -1
+// Compact removes all rows and columns that contain no widget and have
+// neither a fixed or percentage size nor a minimum size, so they no longer
+// take up spacing. Ranges of widgets that are no longer children of the
+// container are dropped first.
+func (l *GridLayout) Compact() error {
+	if l.container == nil {
+		return newError("container required")
 	}
 
-	if column >= len(l.columnStretchFactors) {
-		return 1
+	children := l.container.Children()
+	for wb := range l.widgetBase2Info {
+		if wb.hWnd == 0 || !children.containsHandle(wb.hWnd) {
+			delete(l.widgetBase2Info, wb)
+		}
 	}
 
-	return l.columnStretchFactors[column]
-}
+	// Automatically placed children are placed again into the compacted grid.
+	l.shiftRanges(func(r Rectangle) (Rectangle, bool) {
+		return r, true
+	})
+
+	for row := len(l.cells) - 1; row >= 0; row-- {
+		empty := l.RowSizeMode(row) == GridSizeAuto && l.RowMinimumHeight(row) == 0
+		for _, cell := range l.cells[row] {
+			if cell.widgetBase != nil {
+				empty = false
+				break
+			}
+		}
 
-func (l *GridLayout) SetColumnStretchFactor(column, factor int) error {
-	if column < 0 {
-		return newError("column must be >= 0")
+		if empty {
+			l.RemoveRow(row, false)
+		}
 	}
 
-	if factor != l.ColumnStretchFactor(column) {
-		if l.container == nil {
-			return newError("container required")
-		}
-		if factor < 1 {
-			return newError("factor must be >= 1")
+	for col := len(l.columnStretchFactors) - 1; col >= 0; col-- {
+		empty := l.ColumnSizeMode(col) == GridSizeAuto && l.ColumnMinimumWidth(col) == 0
+		for _, cells := range l.cells {
+			if col < len(cells) && cells[col].widgetBase != nil {
+				empty = false
+				break
+			}
 		}
 
-		l.ensureSufficientSize(len(l.rowStretchFactors), column+1)
-
-		l.columnStretchFactors[column] = factor
-
-		l.container.RequestLayout()
+		if empty {
+			l.RemoveColumn(col, false)
+		}
 	}
 
+	l.container.RequestLayout()
+
 	return nil
 }
 
-func rangeFromGridLayoutWidgetInfo(info *gridLayoutWidgetInfo) Rectangle {
-	return Rectangle{
-		X:      info.cell.column,
-		Y:      info.cell.row,
-		Width:  info.spanHorz,
-		Height: info.spanVert,
+// widgetsInSection returns the widgets whose range matches inSection,
+// including automatically placed ones.
+func (l *GridLayout) widgetsInSection(inSection func(r Rectangle) bool) []Widget {
+	var widgets []Widget
+
+	for wb, info := range l.widgetBase2Info {
+		if inSection(rangeFromGridLayoutWidgetInfo(info)) {
+			widgets = append(widgets, wb.window.(Widget))
+		}
 	}
+
+	return widgets
 }
 
-func (l *GridLayout) setWidgetOnCells(widget Widget, r Rectangle) {
-	var wb *WidgetBase
-	if widget != nil {
-		wb = widget.AsWidgetBase()
-	}
+func (l *GridLayout) finishRemoval(removed []Widget, disposeWidgets bool) {
+	for _, widget := range removed {
+		delete(l.widgetBase2Info, widget.AsWidgetBase())
 
-	for row := r.Y; row < r.Y+r.Height; row++ {
-		for col := r.X; col < r.X+r.Width; col++ {
-			l.cells[row][col].widgetBase = wb
+		if disposeWidgets {
+			widget.Dispose()
 		}
 	}
+
+	l.container.RequestLayout()
 }
 
-func (l *GridLayout) Range(widget Widget) (r Rectangle, ok bool) {
-	if widget == nil {
-		return Rectangle{}, false
+func removeStretchFactor(stretchFactors []int, index int) []int {
+	if index >= len(stretchFactors) {
+		return stretchFactors
 	}
 
-	info := l.widgetBase2Info[widget.AsWidgetBase()]
+	return append(stretchFactors[:index], stretchFactors[index+1:]...)
+}
 
-	if info == nil ||
-		l.container == nil ||
-		!l.container.Children().containsHandle(widget.Handle()) {
-		return Rectangle{}, false
+func insertStretchFactor(stretchFactors []int, index int) []int {
+	if index >= len(stretchFactors) {
+		return stretchFactors
 	}
 
-	return rangeFromGridLayoutWidgetInfo(info), true
+	stretchFactors = append(stretchFactors, 0)
+	copy(stretchFactors[index+1:], stretchFactors[index:])
+	stretchFactors[index] = 1
+
+	return stretchFactors
 }
 
-func (l *GridLayout) SetRange(widget Widget, r Rectangle) error {
-	if widget == nil {
-		return newError("widget required")
-	}
-	if l.container == nil {
-		return newError("container required")
-	}
-	if !l.container.Children().containsHandle(widget.Handle()) {
-		return newError("widget must be child of container")
-	}
-	if r.X < 0 || r.Y < 0 {
-		return newError("range.X and range.Y must be >= 0")
-	}
-	if r.Width < 1 || r.Height < 1 {
-		return newError("range.Width and range.Height must be >= 1")
-	}
+// shiftRanges rebuilds the cells with the ranges set via SetRange changed by
+// shift. Ranges for which shift returns false are dropped. Automatically
+// placed children are placed again by the next layout.
+func (l *GridLayout) shiftRanges(shift func(r Rectangle) (Rectangle, bool)) {
+	wb2Range := make(map[*WidgetBase]Rectangle, len(l.widgetBase2Info))
+	wb2Info := make(map[*WidgetBase]*gridLayoutWidgetInfo, len(l.widgetBase2Info))
 
-	wb := widget.AsWidgetBase()
+	var rows, columns int
+	for wb, info := range l.widgetBase2Info {
+		if info.autoPlaced {
+			continue
+		}
 
-	info := l.widgetBase2Info[wb]
-	if info == nil {
-		info = new(gridLayoutWidgetInfo)
-	} else {
-		l.setWidgetOnCells(nil, rangeFromGridLayoutWidgetInfo(info))
+		r, ok := shift(rangeFromGridLayoutWidgetInfo(info))
+		if !ok {
+			continue
+		}
+
+		wb2Range[wb] = r
+		wb2Info[wb] = info
+
+		rows = maxi(rows, r.Y+r.Height)
+		columns = maxi(columns, r.X+r.Width)
 	}
 
-	l.ensureSufficientSize(r.Y+r.Height, r.X+r.Width)
+	l.cells = nil
+	l.widgetBase2Info = make(map[*WidgetBase]*gridLayoutWidgetInfo, len(wb2Info))
 
-	cell := &l.cells[r.Y][r.X]
-	cell.row = r.Y
-	cell.column = r.X
+	l.ensureSufficientSize(rows, columns)
 
-	if info.cell == nil {
-		// We have to do this _after_ calling ensureSufficientSize().
-		l.widgetBase2Info[wb] = info
-	}
+	for wb, r := range wb2Range {
+		info := wb2Info[wb]
 
-	info.cell = cell
-	info.spanHorz = r.Width
-	info.spanVert = r.Height
+		cell := &l.cells[r.Y][r.X]
+		cell.row = r.Y
+		cell.column = r.X
 
-	l.setWidgetOnCells(widget, r)
+		info.cell = cell
+		info.spanHorz = r.Width
+		info.spanVert = r.Height
 
-	return nil
+		l.widgetBase2Info[wb] = info
+	}
+
+	l.assignCells()
 }
 
 func (l *GridLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	l.autoPlace()
+
 	wb2Item := make(map[*WidgetBase]LayoutItem)
 
 	var children []LayoutItem
@@ -275,7 +1275,7 @@ func (l *GridLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
 			if srcCell.widgetBase != nil {
 				item, ok := wb2Item[srcCell.widgetBase]
 				if !ok {
-					item = createLayoutItemForWidgetWithContext(srcCell.widgetBase.window.(Widget), ctx)
+					item = createChildLayoutItem(l, srcCell.widgetBase.window.(Widget), ctx)
 					children = append(children, item)
 					wb2Item[srcCell.widgetBase] = item
 
@@ -285,6 +1285,15 @@ func (l *GridLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
 		}
 	}
 
+	// Layered widgets may be covered completely by others.
+	for wb := range l.widgetBase2Info {
+		if _, ok := wb2Item[wb]; !ok {
+			item := createChildLayoutItem(l, wb.window.(Widget), ctx)
+			children = append(children, item)
+			wb2Item[wb] = item
+		}
+	}
+
 	item2Info := make(map[LayoutItem]*gridLayoutItemInfo, len(l.widgetBase2Info))
 	for wb, info := range l.widgetBase2Info {
 		item := wb2Item[wb]
@@ -304,9 +1313,15 @@ func (l *GridLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
 		ContainerLayoutItemBase: ContainerLayoutItemBase{
 			children: children,
 		},
-		size2MinSize:         make(map[Size]Size),
 		rowStretchFactors:    append([]int(nil), l.rowStretchFactors...),
 		columnStretchFactors: append([]int(nil), l.columnStretchFactors...),
+		rowSizeModes:         append([]GridSizeMode(nil), l.rowSizeModes...),
+		columnSizeModes:      append([]GridSizeMode(nil), l.columnSizeModes...),
+		rowLimits:            append([]gridSectionLimits(nil), l.rowLimits...),
+		columnLimits:         append([]gridSectionLimits(nil), l.columnLimits...),
+		rowSpacings:          append([]int(nil), l.rowSpacings...),
+		columnSpacings:       append([]int(nil), l.columnSpacings...),
+		baselineAlignment:    l.baselineAlignment,
 		item2Info:            item2Info,
 		cells:                cells,
 	}
@@ -315,12 +1330,21 @@ func (l *GridLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
 type gridLayoutItem struct {
 	ContainerLayoutItemBase
 	mutex                sync.Mutex
-	size2MinSize         map[Size]Size // in native pixels
+	minSizeCache         minSizeCache // in native pixels
 	rowStretchFactors    []int
 	columnStretchFactors []int
+	rowSizeModes         []GridSizeMode
+	columnSizeModes      []GridSizeMode
+	rowLimits            []gridSectionLimits
+	columnLimits         []gridSectionLimits
+	rowSpacings          []int // in 1/96" units, -1 if the layout spacing applies
+	columnSpacings       []int
+	baselineAlignment    bool
 	item2Info            map[LayoutItem]*gridLayoutItemInfo
 	cells                [][]gridLayoutItemCell
-	minSize              Size // in native pixels
+	minSize              Size        // in native pixels
+	overlayColumns       []Rectangle // in native pixels, see SetLayoutDebugOverlay
+	overlayRows          []Rectangle
 }
 
 type gridLayoutItemInfo struct {
@@ -346,6 +1370,56 @@ func (*gridLayoutItem) stretchFactorsTotal(stretchFactors []int) int {
 	return total
 }
 
+func (li *gridLayoutItem) sizeMode(orientation Orientation, index int) GridSizeMode {
+	modes := li.rowSizeModes
+	if orientation == Horizontal {
+		modes = li.columnSizeModes
+	}
+
+	if index < len(modes) {
+		return modes[index]
+	}
+
+	return GridSizeAuto
+}
+
+func (li *gridLayoutItem) sectionLimits(orientation Orientation, index int) gridSectionLimits {
+	if orientation == Horizontal {
+		return sectionLimitsAt(li.columnLimits, index)
+	}
+
+	return sectionLimitsAt(li.rowLimits, index)
+}
+
+// rowBaselineExtent returns the height in native pixels, that the items in
+// row need, when they are aligned by their baselines.
+func (li *gridLayoutItem) rowBaselineExtent(row int) int {
+	if !li.baselineAlignment {
+		return 0
+	}
+
+	var items []LayoutItem
+	for col, cell := range li.cells[row] {
+		if cell.item == nil || !shouldLayoutItem(cell.item) {
+			continue
+		}
+
+		if info := li.item2Info[cell.item]; info.spanVert == 1 && info.cell.column == col {
+			items = append(items, cell.item)
+		}
+	}
+
+	return baselineExtent(items)
+}
+
+// fixedSize returns the fixed size in native pixels of a section, if it has
+// one.
+func (li *gridLayoutItem) fixedSize(orientation Orientation, index int) (int, bool) {
+	size, ok := li.sizeMode(orientation, index).Fixed()
+
+	return IntFrom96DPI(size, li.ctx.dpi), ok
+}
+
 func (li *gridLayoutItem) LayoutFlags() LayoutFlags {
 	var flags LayoutFlags
 
@@ -389,6 +1463,31 @@ func (li *gridLayoutItem) HeightForWidth(width int) int {
 	return li.MinSizeForSize(Size{width, li.geometry.ClientSize.Height}).Height
 }
 
+func (li *gridLayoutItem) WidthForHeight(height int) int {
+	return li.MinSizeForSize(Size{li.geometry.ClientSize.Width, height}).Width
+}
+
+// widthForHeight returns if the sections are sized for the height first,
+// which is the case if some items have a width for height, but none has a
+// height for width.
+func (li *gridLayoutItem) widthForHeight() bool {
+	return li.HasWidthForHeight() && !li.HasHeightForWidth()
+}
+
+// sectionSizes returns the column widths and row heights for size, sizing
+// the rows first if the sections are sized for the height first.
+func (li *gridLayoutItem) sectionSizes(ls *layoutScratch, size Size) (widths, heights []int) {
+	if li.widthForHeight() {
+		heights = li.sectionSizesForSpace(ls, Vertical, size.Height, nil)
+		widths = li.sectionSizesForSpace(ls, Horizontal, size.Width, heights)
+	} else {
+		widths = li.sectionSizesForSpace(ls, Horizontal, size.Width, nil)
+		heights = li.sectionSizesForSpace(ls, Vertical, size.Height, widths)
+	}
+
+	return
+}
+
 func (li *gridLayoutItem) MinSizeForSize(size Size) Size {
 	if len(li.cells) == 0 {
 		return Size{}
@@ -397,11 +1496,14 @@ func (li *gridLayoutItem) MinSizeForSize(size Size) Size {
 	li.mutex.Lock()
 	defer li.mutex.Unlock()
 
-	if min, ok := li.size2MinSize[size]; ok {
+	if min, ok := li.minSizeCache.get(size); ok {
 		return min
 	}
 
-	ws := make([]int, len(li.cells[0]))
+	ls := getLayoutScratch()
+	defer ls.release()
+
+	ws := ls.intSlice(len(li.cells[0]))
 
 	for row := 0; row < len(li.cells); row++ {
 		for col := 0; col < len(ws); col++ {
@@ -423,10 +1525,39 @@ func (li *gridLayoutItem) MinSizeForSize(size Size) Size {
 		}
 	}
 
-	widths := li.sectionSizesForSpace(Horizontal, size.Width, nil)
-	heights := li.sectionSizesForSpace(Vertical, size.Height, widths)
+	for col := range ws {
+		if w, ok := li.fixedSize(Horizontal, col); ok {
+			ws[col] = w
+		} else {
+			ws[col] = li.sectionLimits(Horizontal, col).clamp(ws[col], li.ctx.dpi)
+		}
+	}
+
+	widths, heights := li.sectionSizes(ls, size)
+
+	if li.widthForHeight() {
+		for item, info := range li.item2Info {
+			wfh, ok := item.(WidthForHeighter)
+			if !ok || !wfh.HasWidthForHeight() || info.spanHorz != 1 || !shouldLayoutItem(item) {
+				continue
+			}
+
+			col := info.cell.column
+			if _, ok := li.fixedSize(Horizontal, col); ok {
+				continue
+			}
+
+			w := wfh.WidthForHeight(li.spannedHeight(info, heights))
+			ws[col] = li.sectionLimits(Horizontal, col).clamp(maxi(ws[col], w), li.ctx.dpi)
+		}
+	}
 
 	for row := range heights {
+		if h, ok := li.fixedSize(Vertical, row); ok {
+			heights[row] = h
+			continue
+		}
+
 		var wg sync.WaitGroup
 		var mutex sync.Mutex
 		var maxHeight int
@@ -466,73 +1597,101 @@ func (li *gridLayoutItem) MinSizeForSize(size Size) Size {
 
 		wg.Wait()
 
-		heights[row] = maxHeight
+		maxHeight = maxi(maxHeight, li.rowBaselineExtent(row))
+
+		heights[row] = li.sectionLimits(Vertical, row).clamp(maxHeight, li.ctx.dpi)
 	}
 
 	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
 
-	width := margins.HNear + margins.HFar
-	height := margins.VNear + margins.VFar
+	width := margins.HNear + margins.HFar + li.spannedSize(Horizontal, ws, 0, len(ws))
+	height := margins.VNear + margins.VFar + li.spannedSize(Vertical, heights, 0, len(heights))
 
-	for i, w := range ws {
-		if w > 0 {
-			if i > 0 {
-				width += spacing
-			}
-			width += w
-		}
+	if width > 0 && height > 0 {
+		li.minSizeCache.put(size, Size{width, height})
 	}
-	for i, h := range heights {
-		if h > 0 {
-			if i > 0 {
-				height += spacing
-			}
-			height += h
-		}
+
+	return Size{width, height}
+}
+
+// sectionSpacing returns the spacing in native pixels between the section at
+// index and the next non-empty one.
+func (li *gridLayoutItem) sectionSpacing(orientation Orientation, index int) int {
+	spacings := li.rowSpacings
+	if orientation == Horizontal {
+		spacings = li.columnSpacings
 	}
 
-	if width > 0 && height > 0 {
-		li.size2MinSize[size] = Size{width, height}
+	spacing96dpi := spacingAt(spacings, index)
+	if spacing96dpi == -1 {
+		spacing96dpi = li.spacing96dpi(orientation)
 	}
 
-	return Size{width, height}
+	return IntFrom96DPI(spacing96dpi, li.ctx.dpi)
 }
 
-// spannedWidth returns spanned width in native pixels.
-func (li *gridLayoutItem) spannedWidth(info *gridLayoutItemInfo, widths []int) int {
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+// spacingTotal returns the spacing in native pixels between the non-empty
+// sections.
+func (li *gridLayoutItem) spacingTotal(orientation Orientation, sizes []int) int {
+	var total int
+
+	prev := -1
+	for i, s := range sizes {
+		if s > 0 {
+			if prev != -1 {
+				total += li.sectionSpacing(orientation, prev)
+			}
+			prev = i
+		}
+	}
 
-	var width int
+	return total
+}
 
-	for i := info.cell.column; i < info.cell.column+info.spanHorz; i++ {
-		if w := widths[i]; w > 0 {
-			width += w
-			if i > info.cell.column {
-				width += spacing
+// spannedSize returns the size in native pixels of the sections from first
+// up to end, including the spacing between the non-empty ones.
+func (li *gridLayoutItem) spannedSize(orientation Orientation, sizes []int, first, end int) int {
+	var size int
+
+	prev := -1
+	for i := first; i < end; i++ {
+		if s := sizes[i]; s > 0 {
+			if prev != -1 {
+				size += li.sectionSpacing(orientation, prev)
 			}
+			size += s
+			prev = i
 		}
 	}
 
-	return width
+	return size
 }
 
-// spannedHeight returns spanned height in native pixels.
-func (li *gridLayoutItem) spannedHeight(info *gridLayoutItemInfo, heights []int) int {
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
+// sectionPositions returns the positions in native pixels of the sections,
+// the first one starting at start.
+func (li *gridLayoutItem) sectionPositions(orientation Orientation, sizes []int, start int) []int {
+	positions := make([]int, len(sizes))
 
-	var height int
+	pos := start
+	for i, s := range sizes {
+		positions[i] = pos
 
-	for i := info.cell.row; i < info.cell.row+info.spanVert; i++ {
-		if h := heights[i]; h > 0 {
-			height += h
-			if i > info.cell.row {
-				height += spacing
-			}
+		if s > 0 {
+			pos += s + li.sectionSpacing(orientation, i)
 		}
 	}
 
-	return height
+	return positions
+}
+
+// spannedWidth returns spanned width in native pixels.
+func (li *gridLayoutItem) spannedWidth(info *gridLayoutItemInfo, widths []int) int {
+	return li.spannedSize(Horizontal, widths, info.cell.column, info.cell.column+info.spanHorz)
+}
+
+// spannedHeight returns spanned height in native pixels.
+func (li *gridLayoutItem) spannedHeight(info *gridLayoutItemInfo, heights []int) int {
+	return li.spannedSize(Vertical, heights, info.cell.row, info.cell.row+info.spanVert)
 }
 
 type gridLayoutSectionInfo struct {
@@ -573,33 +1732,38 @@ func (l gridLayoutSectionInfoList) Swap(i, j int) {
 }
 
 func (li *gridLayoutItem) PerformLayout() []LayoutResultItem {
-	widths := li.sectionSizesForSpace(Horizontal, li.geometry.ClientSize.Width, nil)
-	heights := li.sectionSizesForSpace(Vertical, li.geometry.ClientSize.Height, widths)
+	ls := getLayoutScratch()
+	defer ls.release()
 
-	items := make([]LayoutResultItem, 0, len(li.item2Info))
+	widths, heights := li.sectionSizes(ls, li.geometry.ClientSize)
 
 	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
 
-	for item, info := range li.item2Info {
-		if !shouldLayoutItem(item) {
-			continue
+	xs := li.sectionPositions(Horizontal, widths, margins.HNear)
+	ys := li.sectionPositions(Vertical, heights, margins.VNear)
+
+	if layoutDebugOverlay {
+		li.overlayColumns = make([]Rectangle, len(widths))
+		for i, w := range widths {
+			li.overlayColumns[i] = Rectangle{X: xs[i], Width: w}
 		}
 
-		x := margins.HNear
-		for i := 0; i < info.cell.column; i++ {
-			if w := widths[i]; w > 0 {
-				x += w + spacing
-			}
+		li.overlayRows = make([]Rectangle, len(heights))
+		for i, h := range heights {
+			li.overlayRows[i] = Rectangle{X: ys[i], Width: h}
 		}
+	}
 
-		y := margins.VNear
-		for i := 0; i < info.cell.row; i++ {
-			if h := heights[i]; h > 0 {
-				y += h + spacing
-			}
+	items := make([]LayoutResultItem, 0, len(li.item2Info))
+
+	for item, info := range li.item2Info {
+		if !shouldLayoutItem(item) {
+			continue
 		}
 
+		x := xs[info.cell.column]
+		y := ys[info.cell.row]
+
 		width := li.spannedWidth(info, widths)
 		height := li.spannedHeight(info, heights)
 
@@ -607,25 +1771,36 @@ func (li *gridLayoutItem) PerformLayout() []LayoutResultItem {
 		h := height
 
 		if lf := item.LayoutFlags(); lf&GrowableHorz == 0 || lf&GrowableVert == 0 {
+			hfw, hasHFW := item.(HeightForWidther)
+			hasHFW = hasHFW && hfw.HasHeightForWidth()
+			wfh, hasWFH := item.(WidthForHeighter)
+			hasWFH = hasWFH && wfh.HasWidthForHeight()
+
 			var s Size
-			if hfw, ok := item.(HeightForWidther); !ok || !hfw.HasHeightForWidth() {
+			if !hasHFW && !hasWFH {
 				if is, ok := item.(IdealSizer); ok {
 					s = is.IdealSize()
 				}
 			}
 
 			max := item.Geometry().MaxSize
-			if max.Width > 0 && s.Width > max.Width {
-				s.Width = max.Width
-			}
-			if lf&GrowableHorz == 0 {
-				w = s.Width
+			if hasWFH {
+				if lf&GrowableHorz == 0 {
+					w = wfh.WidthForHeight(height)
+				}
+			} else {
+				if max.Width > 0 && s.Width > max.Width {
+					s.Width = max.Width
+				}
+				if lf&GrowableHorz == 0 {
+					w = s.Width
+				}
 			}
 			w = mini(w, width)
 
-			if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+			if hasHFW {
 				h = hfw.HeightForWidth(w)
-			} else {
+			} else if !hasWFH {
 				if max.Height > 0 && s.Height > max.Height {
 					s.Height = max.Height
 				}
@@ -636,6 +1811,11 @@ func (li *gridLayoutItem) PerformLayout() []LayoutResultItem {
 			h = mini(h, height)
 		}
 
+		if ar := item.Geometry().AspectRatio; ar.Width > 0 {
+			s := sizeWithAspectRatio(Size{w, h}, ar)
+			w, h = s.Width, s.Height
+		}
+
 		alignment := item.Geometry().Alignment
 		if alignment == AlignHVDefault {
 			alignment = li.alignment
@@ -664,11 +1844,51 @@ func (li *gridLayoutItem) PerformLayout() []LayoutResultItem {
 		items = append(items, LayoutResultItem{Item: item, Bounds: Rectangle{X: x, Y: y, Width: w, Height: h}})
 	}
 
+	if li.baselineAlignment {
+		li.alignRowBaselines(items)
+	}
+
 	return items
 }
 
+func (li *gridLayoutItem) overlayCells() (columns, rows []Rectangle) {
+	return li.overlayColumns, li.overlayRows
+}
+
+// alignRowBaselines aligns the baselines of the items, that span a single
+// row, per row.
+func (li *gridLayoutItem) alignRowBaselines(items []LayoutResultItem) {
+	row := func(i int) int {
+		if info := li.item2Info[items[i].Item]; info.spanVert == 1 {
+			return info.cell.row
+		}
+
+		return -1
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return row(i) < row(j)
+	})
+
+	for start := 0; start < len(items); {
+		end := start + 1
+		for end < len(items) && row(end) == row(start) {
+			end++
+		}
+
+		if row(start) >= 0 {
+			alignBaselines(items[start:end])
+		}
+
+		start = end
+	}
+}
+
 // sectionSizesForSpace returns section sizes. Input and outpus is measured in native pixels.
-func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space int, widths []int) []int {
+// otherSizes are the section sizes of the other orientation, if they are known
+// already, so items with a height for width or width for height can be sized.
+// The returned slice is allocated from ls.
+func (li *gridLayoutItem) sectionSizesForSpace(ls *layoutScratch, orientation Orientation, space int, otherSizes []int) []int {
 	var stretchFactors []int
 	if orientation == Horizontal {
 		stretchFactors = li.columnStretchFactors
@@ -680,10 +1900,11 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 	var sectionCountWithGreedySpacer int
 	var stretchFactorsTotal [3]int
 	var minSizesRemaining int
-	minSizes := make([]int, len(stretchFactors))
-	maxSizes := make([]int, len(stretchFactors))
-	sizes := make([]int, len(stretchFactors))
-	sortedSections := gridLayoutSectionInfoList(make([]gridLayoutSectionInfo, len(stretchFactors)))
+	stretches := ls.intSlice(len(stretchFactors))
+	minSizes := ls.intSlice(len(stretchFactors))
+	maxSizes := ls.intSlice(len(stretchFactors))
+	sizes := ls.intSlice(len(stretchFactors))
+	sortedSections := ls.gridLayoutSectionInfos(len(stretchFactors))
 
 	for i := 0; i < len(stretchFactors); i++ {
 		var otherAxisCount int
@@ -714,8 +1935,13 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 
 			max := item.Geometry().MaxSize
 
+			hfw, hasHFW := item.(HeightForWidther)
+			hasHFW = hasHFW && hfw.HasHeightForWidth()
+			wfh, hasWFH := item.(WidthForHeighter)
+			hasWFH = hasWFH && wfh.HasWidthForHeight()
+
 			var pref Size
-			if hfw, ok := item.(HeightForWidther); !ok || !hfw.HasHeightForWidth() {
+			if !hasHFW && !hasWFH {
 				if is, ok := item.(IdealSizer); ok {
 					pref = is.IdealSize()
 				}
@@ -723,11 +1949,17 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 
 			if orientation == Horizontal {
 				if info.spanHorz == 1 {
-					minSizes[i] = maxi(minSizes[i], li.MinSizeEffectiveForChild(item).Width)
+					if hasWFH && otherSizes != nil {
+						minSizes[i] = maxi(minSizes[i], wfh.WidthForHeight(li.spannedHeight(info, otherSizes)))
+					} else {
+						minSizes[i] = maxi(minSizes[i], li.MinSizeEffectiveForChild(item).Width)
+					}
 				}
 
 				if max.Width > 0 {
 					maxSizes[i] = maxi(maxSizes[i], max.Width)
+				} else if hasWFH && otherSizes != nil && flags&GrowableHorz == 0 {
+					maxSizes[i] = minSizes[i]
 				} else if pref.Width > 0 && flags&GrowableHorz == 0 {
 					maxSizes[i] = maxi(maxSizes[i], pref.Width)
 				} else {
@@ -743,8 +1975,8 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 				}
 			} else {
 				if info.spanVert == 1 {
-					if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
-						minSizes[i] = maxi(minSizes[i], hfw.HeightForWidth(li.spannedWidth(info, widths)))
+					if hasHFW && otherSizes != nil {
+						minSizes[i] = maxi(minSizes[i], hfw.HeightForWidth(li.spannedWidth(info, otherSizes)))
 					} else {
 						minSizes[i] = maxi(minSizes[i], li.MinSizeEffectiveForChild(item).Height)
 					}
@@ -752,7 +1984,7 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 
 				if max.Height > 0 {
 					maxSizes[i] = maxi(maxSizes[i], max.Height)
-				} else if hfw, ok := item.(HeightForWidther); ok && flags&GrowableVert == 0 && hfw.HasHeightForWidth() {
+				} else if hasHFW && otherSizes != nil && flags&GrowableVert == 0 {
 					maxSizes[i] = minSizes[i]
 				} else if pref.Height > 0 && flags&GrowableVert == 0 {
 					maxSizes[i] = maxi(maxSizes[i], pref.Height)
@@ -770,6 +2002,35 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 			}
 		}
 
+		if orientation == Vertical {
+			minSizes[i] = maxi(minSizes[i], li.rowBaselineExtent(i))
+		}
+
+		if limits := li.sectionLimits(orientation, i); limits != (gridSectionLimits{}) {
+			minSizes[i] = limits.clamp(minSizes[i], li.ctx.dpi)
+			maxSizes[i] = limits.clamp(maxSizes[i], li.ctx.dpi)
+		}
+
+		// Sections with a fixed or percentage size get exactly that, so they
+		// take no share of the excess space.
+		switch mode := li.sizeMode(orientation, i); mode.kind {
+		case gridSizeFixed:
+			minSizes[i] = IntFrom96DPI(mode.value, li.ctx.dpi)
+			maxSizes[i] = minSizes[i]
+			sortedSections[i].hasGreedyNonSpacer = false
+			sortedSections[i].hasGreedySpacer = false
+
+		case gridSizePercent:
+			// Sized below, once the space left after spacing is known.
+			minSizes[i] = 0
+			maxSizes[i] = mini(1, mode.value)
+			sortedSections[i].hasGreedyNonSpacer = false
+			sortedSections[i].hasGreedySpacer = false
+
+		default:
+			stretches[i] = stretchFactors[i]
+		}
+
 		sortedSections[i].index = i
 		sortedSections[i].minSize = minSizes[i]
 		sortedSections[i].maxSize = maxSizes[i]
@@ -779,19 +2040,16 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 
 		if sortedSections[i].hasGreedyNonSpacer {
 			sectionCountWithGreedyNonSpacer++
-			stretchFactorsTotal[0] += stretchFactors[i]
+			stretchFactorsTotal[0] += stretches[i]
 		} else if sortedSections[i].hasGreedySpacer {
 			sectionCountWithGreedySpacer++
-			stretchFactorsTotal[1] += stretchFactors[i]
+			stretchFactorsTotal[1] += stretches[i]
 		} else {
-			stretchFactorsTotal[2] += stretchFactors[i]
+			stretchFactorsTotal[2] += stretches[i]
 		}
 	}
 
-	sort.Stable(sortedSections)
-
 	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
-	spacing := IntFrom96DPI(li.spacing96dpi, li.ctx.dpi)
 
 	if orientation == Horizontal {
 		space -= margins.HNear + margins.HFar
@@ -799,15 +2057,21 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 		space -= margins.VNear + margins.VFar
 	}
 
-	var spacingRemaining int
-	for _, max := range maxSizes {
-		if max > 0 {
-			spacingRemaining += spacing
+	spacingRemaining := li.spacingTotal(orientation, maxSizes)
+
+	for i := range sortedSections {
+		if percent, ok := li.sizeMode(orientation, i).Percent(); ok {
+			size := maxi(0, space-spacingRemaining) * percent / 100
+			size = li.sectionLimits(orientation, i).clamp(size, li.ctx.dpi)
+
+			minSizes[i], maxSizes[i] = size, size
+			sortedSections[i].minSize, sortedSections[i].maxSize = size, size
+
+			minSizesRemaining += size
 		}
 	}
-	if spacingRemaining > 0 {
-		spacingRemaining -= spacing
-	}
+
+	sort.Stable(sortedSections)
 
 	offsets := [3]int{0, sectionCountWithGreedyNonSpacer, sectionCountWithGreedyNonSpacer + sectionCountWithGreedySpacer}
 	counts := [3]int{sectionCountWithGreedyNonSpacer, sectionCountWithGreedySpacer, len(stretchFactors) - sectionCountWithGreedyNonSpacer - sectionCountWithGreedySpacer}
@@ -819,7 +2083,7 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 			info := sortedSections[offsets[i]+j]
 			k := info.index
 
-			stretch := stretchFactors[k]
+			stretch := stretches[k]
 			min := info.minSize
 			max := info.maxSize
 			size := min
@@ -840,6 +2104,7 @@ func (li *gridLayoutItem) sectionSizesForSpace(orientation Orientation, space in
 			minSizesRemaining -= min
 			stretchFactorsRemaining -= stretch
 
+			spacing := li.sectionSpacing(orientation, k)
 			space -= (size + spacing)
 			spacingRemaining -= spacing
 		}
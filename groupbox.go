@@ -21,17 +21,28 @@ func init() {
 	})
 }
 
+const groupBoxCollapseTimerId = 1
+
+const (
+	groupBoxExpandedGlyph  = "\u25BE" // ▾
+	groupBoxCollapsedGlyph = "\u25B8" // ▸
+)
+
 type GroupBox struct {
 	WidgetBase
-	hWndGroupBox          win.HWND
-	checkBox              *CheckBox
-	composite             *Composite
-	headerHeight          int // in native pixels
-	titleChangedPublisher EventPublisher
+	hWndGroupBox              win.HWND
+	checkBox                  *CheckBox
+	collapseButton            *PushButton
+	composite                 *Composite
+	headerHeight              int // in native pixels
+	collapsed                 bool
+	expansion                 float64 // 1 when expanded, 0 when collapsed
+	titleChangedPublisher     EventPublisher
+	collapsedChangedPublisher EventPublisher
 }
 
 func NewGroupBox(parent Container) (*GroupBox, error) {
-	gb := new(GroupBox)
+	gb := &GroupBox{expansion: 1}
 
 	if err := InitWidget(
 		gb,
@@ -78,6 +89,19 @@ func NewGroupBox(parent Container) (*GroupBox, error) {
 
 	setWindowVisible(gb.checkBox.hWnd, false)
 
+	gb.collapseButton, err = NewPushButton(gb)
+	if err != nil {
+		return nil, err
+	}
+	win.SetWindowLong(gb.collapseButton.hWnd, win.GWL_ID, 4)
+
+	gb.collapseButton.SetText(groupBoxExpandedGlyph)
+	gb.collapseButton.Clicked().Attach(func() {
+		gb.SetCollapsed(!gb.collapsed)
+	})
+
+	setWindowVisible(gb.collapseButton.hWnd, false)
+
 	gb.composite, err = NewComposite(gb)
 	if err != nil {
 		return nil, err
@@ -86,6 +110,7 @@ func NewGroupBox(parent Container) (*GroupBox, error) {
 	gb.composite.name = "composite"
 
 	win.SetWindowPos(gb.checkBox.hWnd, win.HWND_TOP, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE)
+	win.SetWindowPos(gb.collapseButton.hWnd, win.HWND_TOP, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE)
 
 	gb.SetBackground(NullBrush())
 
@@ -108,6 +133,16 @@ func NewGroupBox(parent Container) (*GroupBox, error) {
 		},
 		gb.CheckedChanged()))
 
+	gb.MustRegisterProperty("Collapsed", NewBoolProperty(
+		func() bool {
+			return gb.Collapsed()
+		},
+		func(v bool) error {
+			gb.SetCollapsed(v)
+			return nil
+		},
+		gb.CollapsedChanged()))
+
 	succeeded = true
 
 	return gb, nil
@@ -170,6 +205,10 @@ func (gb *GroupBox) applyEnabled(enabled bool) {
 		gb.checkBox.applyEnabled(enabled)
 	}
 
+	if gb.collapseButton != nil {
+		gb.collapseButton.applyEnabled(enabled)
+	}
+
 	if gb.composite != nil {
 		gb.composite.applyEnabled(enabled)
 	}
@@ -192,6 +231,10 @@ func (gb *GroupBox) applyFont(font *Font) {
 		gb.checkBox.applyFont(font)
 	}
 
+	if gb.collapseButton != nil {
+		gb.collapseButton.applyFont(font)
+	}
+
 	if gb.hWndGroupBox != 0 {
 		SetWindowFont(gb.hWndGroupBox, font)
 	}
@@ -263,16 +306,166 @@ func (gb *GroupBox) CheckedChanged() *Event {
 	return gb.checkBox.CheckedChanged()
 }
 
+// Collapsible returns if the GroupBox has a toggle in its header, that
+// collapses it to its header and expands it again.
+func (gb *GroupBox) Collapsible() bool {
+	return gb.collapseButton.visible
+}
+
+// SetCollapsible sets if the GroupBox has a toggle in its header, that
+// collapses it to its header and expands it again. A collapsed GroupBox is
+// expanded when it becomes non-collapsible.
+func (gb *GroupBox) SetCollapsible(collapsible bool) {
+	if !collapsible {
+		gb.SetCollapsed(false)
+	}
+
+	gb.collapseButton.SetVisible(collapsible)
+
+	gb.updateHeaderControlBounds()
+
+	gb.RequestLayout()
+}
+
+// Collapsed returns if the content area of the GroupBox is collapsed.
+func (gb *GroupBox) Collapsed() bool {
+	return gb.collapsed
+}
+
+// SetCollapsed collapses the content area of the GroupBox, so only its header
+// remains, or expands it again. If the GroupBox is visible, the height of the
+// content area is animated.
+func (gb *GroupBox) SetCollapsed(collapsed bool) {
+	if collapsed == gb.collapsed {
+		return
+	}
+
+	gb.collapsed = collapsed
+
+	if collapsed {
+		gb.collapseButton.SetText(groupBoxCollapsedGlyph)
+
+		focus := win.GetFocus()
+		if focus == gb.composite.hWnd || win.IsChild(gb.composite.hWnd, focus) {
+			gb.collapseButton.SetFocus()
+		}
+	} else {
+		gb.collapseButton.SetText(groupBoxExpandedGlyph)
+
+		gb.composite.SetVisible(true)
+	}
+
+	if win.IsWindowVisible(gb.hWnd) {
+		win.SetTimer(gb.hWnd, groupBoxCollapseTimerId, 15, 0)
+	} else {
+		gb.finishCollapseAnimation()
+	}
+
+	gb.collapsedChangedPublisher.Publish()
+}
+
+// CollapsedChanged returns the event that is published when the GroupBox is
+// collapsed or expanded.
+func (gb *GroupBox) CollapsedChanged() *Event {
+	return gb.collapsedChangedPublisher.Event()
+}
+
+func (gb *GroupBox) animateCollapse() {
+	const step = 0.15
+
+	if gb.collapsed {
+		gb.expansion -= step
+	} else {
+		gb.expansion += step
+	}
+
+	if gb.expansion <= 0 || gb.expansion >= 1 {
+		gb.finishCollapseAnimation()
+		return
+	}
+
+	gb.RequestLayout()
+}
+
+func (gb *GroupBox) finishCollapseAnimation() {
+	win.KillTimer(gb.hWnd, groupBoxCollapseTimerId)
+
+	if gb.collapsed {
+		gb.expansion = 0
+		gb.composite.SetVisible(false)
+	} else {
+		gb.expansion = 1
+	}
+
+	gb.RequestLayout()
+}
+
+// updateHeaderControlBounds moves the check box and the collapse toggle to
+// their places in the header.
+func (gb *GroupBox) updateHeaderControlBounds() {
+	wbcb := gb.WidgetBase.ClientBoundsPixels()
+
+	if gb.Checkable() {
+		s := createLayoutItemForWidget(gb.checkBox).(MinSizer).MinSize()
+		var x int
+		if l := gb.Layout(); l != nil {
+			x = gb.IntFrom96DPI(l.Margins().HNear)
+		} else {
+			x = gb.headerHeight * 2 / 3
+		}
+		if gb.rightToLeft {
+			x = wbcb.Width - x - s.Width
+		}
+		gb.checkBox.SetBoundsPixels(Rectangle{x, gb.headerHeight, s.Width, s.Height})
+	}
+
+	if gb.Collapsible() {
+		size := gb.headerHeight
+		x := wbcb.Width - gb.headerHeight*2/3 - size
+		if gb.rightToLeft {
+			x = gb.headerHeight * 2 / 3
+		}
+		gb.collapseButton.SetBoundsPixels(Rectangle{x, 0, size, size})
+	}
+}
+
 func (gb *GroupBox) ApplyDPI(dpi int) {
 	gb.WidgetBase.ApplyDPI(dpi)
 	if gb.checkBox != nil {
 		gb.checkBox.ApplyDPI(dpi)
 	}
+	if gb.collapseButton != nil {
+		gb.collapseButton.ApplyDPI(dpi)
+	}
 	if gb.composite != nil {
 		gb.composite.ApplyDPI(dpi)
 	}
 }
 
+func (gb *GroupBox) applyRightToLeft(rtl bool) error {
+	if err := ensureWindowLongBits(gb.hWndGroupBox, win.GWL_EXSTYLE, win.WS_EX_LAYOUTRTL, rtl); err != nil {
+		return err
+	}
+
+	if gb.checkBox != nil {
+		if err := gb.checkBox.SetRightToLeft(rtl); err != nil {
+			return err
+		}
+	}
+
+	if gb.collapseButton != nil {
+		if err := gb.collapseButton.SetRightToLeft(rtl); err != nil {
+			return err
+		}
+	}
+
+	if gb.composite != nil {
+		gb.composite.rightToLeft = rtl
+	}
+
+	return nil
+}
+
 func (gb *GroupBox) Children() *WidgetList {
 	if gb.composite == nil {
 		// Without this we would get into trouble in NewComposite.
@@ -331,6 +524,7 @@ func (gb *GroupBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 
 		case win.WM_PAINT:
 			win.UpdateWindow(gb.checkBox.hWnd)
+			win.UpdateWindow(gb.collapseButton.hWnd)
 
 		case win.WM_WINDOWPOSCHANGED:
 			wp := (*win.WINDOWPOS)(unsafe.Pointer(lParam))
@@ -352,15 +546,11 @@ func (gb *GroupBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 				break
 			}
 
-			if gb.Checkable() {
-				s := createLayoutItemForWidget(gb.checkBox).(MinSizer).MinSize()
-				var x int
-				if l := gb.Layout(); l != nil {
-					x = gb.IntFrom96DPI(l.Margins().HNear)
-				} else {
-					x = gb.headerHeight * 2 / 3
-				}
-				gb.checkBox.SetBoundsPixels(Rectangle{x, gb.headerHeight, s.Width, s.Height})
+			gb.updateHeaderControlBounds()
+
+		case win.WM_TIMER:
+			if wParam == groupBoxCollapseTimerId {
+				gb.animateCollapse()
 			}
 		}
 	}
@@ -378,6 +568,7 @@ func (gb *GroupBox) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 
 	li := &groupBoxLayoutItem{
 		compositePos: compositePos,
+		expansion:    gb.expansion,
 	}
 
 	gbli := CreateLayoutItemsForContainerWithContext(gb.composite, ctx)
@@ -390,17 +581,34 @@ func (gb *GroupBox) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 
 type groupBoxLayoutItem struct {
 	ContainerLayoutItemBase
-	compositePos Point // in native pixels
+	compositePos Point   // in native pixels
+	expansion    float64 // 1 when expanded, 0 when collapsed
+}
+
+// contentHeight returns the part of height, that is shown of the content area
+// while it is collapsed or expanded.
+func (li *groupBoxLayoutItem) contentHeight(height int) int {
+	if li.expansion >= 1 {
+		return height
+	}
+
+	return int(float64(height) * li.expansion)
 }
 
 func (li *groupBoxLayoutItem) LayoutFlags() LayoutFlags {
-	return li.children[0].LayoutFlags()
+	flags := li.children[0].LayoutFlags()
+
+	if li.expansion < 1 {
+		flags &^= GrowableVert | GreedyVert
+	}
+
+	return flags
 }
 
 func (li *groupBoxLayoutItem) MinSize() Size {
 	min := li.children[0].(MinSizer).MinSize()
 	min.Width += li.compositePos.X * 2
-	min.Height += li.compositePos.Y + 2
+	min.Height = li.contentHeight(min.Height) + li.compositePos.Y + 2
 
 	return min
 }
@@ -414,20 +622,29 @@ func (li *groupBoxLayoutItem) HasHeightForWidth() bool {
 }
 
 func (li *groupBoxLayoutItem) HeightForWidth(width int) int {
-	return li.children[0].(HeightForWidther).HeightForWidth(width-li.compositePos.X*2) + li.compositePos.Y
+	return li.contentHeight(li.children[0].(HeightForWidther).HeightForWidth(width-li.compositePos.X*2)) + li.compositePos.Y
 }
 
 func (li *groupBoxLayoutItem) IdealSize() Size {
 	size := li.children[0].(IdealSizer).IdealSize()
-	size.Height += li.compositePos.Y
+	size.Height = li.contentHeight(size.Height) + li.compositePos.Y
 	return size
 }
 
 func (li *groupBoxLayoutItem) PerformLayout() []LayoutResultItem {
+	width := li.geometry.Size.Width - li.compositePos.X*2
+	height := li.geometry.Size.Height - li.compositePos.Y - 4
+
+	if li.expansion < 1 {
+		// Keep the content at its minimum height while the content area is
+		// animated, so it is clipped instead of squeezed.
+		height = maxi(height, li.children[0].(MinSizer).MinSize().Height)
+	}
+
 	return []LayoutResultItem{
 		{
 			Item:   li.children[0],
-			Bounds: Rectangle{X: li.compositePos.X, Y: li.compositePos.Y, Width: li.geometry.Size.Width - li.compositePos.X*2, Height: li.geometry.Size.Height - li.compositePos.Y - 4},
+			Bounds: Rectangle{X: li.compositePos.X, Y: li.compositePos.Y, Width: width, Height: height},
 		},
 	}
 }
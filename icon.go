@@ -302,6 +302,8 @@ func (i *Icon) handleForDPIWithError(dpi int) (win.HICON, error) {
 
 // Dispose releases the operating system resources associated with the Icon.
 func (i *Icon) Dispose() {
+	mustNotBeInUse(i)
+
 	if i.isStock || len(i.dpi2hIcon) == 0 {
 		return
 	}
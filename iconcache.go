@@ -88,3 +88,20 @@ func (ic *IconCache) Icon(image Image, dpi int) (*Icon, error) {
 
 	return ico, nil
 }
+
+// forgetSysColorDependent removes the entries of images, whose appearance
+// depends on the system colors, so they are rendered again when requested.
+// The removed bitmaps and icons are not disposed, because they may still be
+// in use.
+func (ic *IconCache) forgetSysColorDependent() {
+	for key := range ic.imageAndDPI2Bitmap {
+		if fi, ok := key.image.(*FontIcon); ok && fi.usesTextColor() {
+			delete(ic.imageAndDPI2Bitmap, key)
+		}
+	}
+	for key := range ic.imageAndDPI2Icon {
+		if fi, ok := key.image.(*FontIcon); ok && fi.usesTextColor() {
+			delete(ic.imageAndDPI2Icon, key)
+		}
+	}
+}
@@ -120,6 +120,8 @@ func (iv *ImageView) SetImage(image Image) error {
 		newSize = image.Size()
 	}
 
+	iv.replaceResource(iv.image, image)
+
 	iv.image = image
 
 	_, isMetafile := image.(*Metafile)
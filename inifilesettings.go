@@ -22,6 +22,7 @@ type IniFileSettings struct {
 	key2Record     map[string]iniFileRecord
 	expireDuration time.Duration
 	portable       bool
+	shared         bool
 }
 
 type iniFileRecord struct {
@@ -97,6 +98,22 @@ func (ifs *IniFileSettings) SetPortable(portable bool) {
 	ifs.portable = portable
 }
 
+// Shared returns whether the settings file is stored in the common
+// application data folder, where it is shared by all users and services.
+func (ifs *IniFileSettings) Shared() bool {
+	return ifs.shared
+}
+
+// SetShared sets whether the settings file is stored in the common
+// application data folder, where it is shared by all users and services.
+//
+// This allows e.g. a service and the UI of an application to use the same
+// settings. Note that by default, files created there by a service can only
+// be read by regular users.
+func (ifs *IniFileSettings) SetShared(shared bool) {
+	ifs.shared = shared
+}
+
 func (ifs *IniFileSettings) FilePath() string {
 	if ifs.portable {
 		absPath, err := filepath.Abs(ifs.fileName)
@@ -108,6 +125,9 @@ func (ifs *IniFileSettings) FilePath() string {
 	}
 
 	appDataPath, err := AppDataPath()
+	if ifs.shared {
+		appDataPath, err = CommonAppDataPath()
+	}
 	if err != nil {
 		return ""
 	}
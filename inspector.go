@@ -0,0 +1,733 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/lxn/win"
+)
+
+const inspectorOverlayWindowClass = `\o/ Walk_InspectorOverlay_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClass(inspectorOverlayWindowClass)
+	})
+}
+
+var (
+	inspectorShortcut Shortcut
+	theInspector      *inspector
+)
+
+// InspectorShortcut returns the shortcut that toggles the inspector.
+func InspectorShortcut() Shortcut {
+	return inspectorShortcut
+}
+
+// SetInspectorShortcut sets the shortcut that toggles the inspector in all
+// forms of the application. Pass the zero Shortcut to disable it.
+//
+// Builds with the walk_debug tag use Ctrl+Shift+I by default.
+func SetInspectorShortcut(shortcut Shortcut) {
+	inspectorShortcut = shortcut
+}
+
+// ShowInspector shows the inspector, a window for debugging the user
+// interface of the application.
+//
+// The inspector displays the tree of forms and widgets, the geometry, layout
+// flags, properties and the number of event handlers of the selected window
+// and highlights the bounds and layout margins of the selected window on
+// screen. Using "Pick", a widget can be selected by clicking it.
+func ShowInspector() error {
+	if theInspector == nil {
+		ins, err := newInspector()
+		if err != nil {
+			return err
+		}
+
+		theInspector = ins
+	}
+
+	theInspector.refresh()
+
+	theInspector.mw.Show()
+
+	return theInspector.mw.BringToTop()
+}
+
+func toggleInspector() {
+	if theInspector != nil && theInspector.mw.Visible() {
+		theInspector.mw.Close()
+		return
+	}
+
+	ShowInspector()
+}
+
+// isInspectorShortcut returns if key and modifiers match the inspector
+// shortcut.
+func isInspectorShortcut(key Key, modifiers Modifiers) bool {
+	return inspectorShortcut.Key != 0 && key == inspectorShortcut.Key && modifiers == inspectorShortcut.Modifiers
+}
+
+type inspector struct {
+	mw         *MainWindow
+	treeView   *TreeView
+	tableView  *TableView
+	treeModel  *inspectorTreeModel
+	tableModel *inspectorPropertyModel
+	overlay    *inspectorOverlay
+}
+
+func newInspector() (*inspector, error) {
+	ins := &inspector{
+		treeModel:  new(inspectorTreeModel),
+		tableModel: new(inspectorPropertyModel),
+	}
+
+	var err error
+	if ins.mw, err = NewMainWindow(); err != nil {
+		return nil, err
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			ins.dispose()
+		}
+	}()
+
+	if err := ins.mw.SetTitle(tr("Walk Inspector", "walk")); err != nil {
+		return nil, err
+	}
+	if err := ins.mw.SetLayout(NewVBoxLayout()); err != nil {
+		return nil, err
+	}
+
+	if ins.overlay, err = newInspectorOverlay(); err != nil {
+		return nil, err
+	}
+	ins.overlay.picked = ins.selectWindow
+
+	buttons, err := NewComposite(ins.mw)
+	if err != nil {
+		return nil, err
+	}
+	buttonsLayout := NewHBoxLayout()
+	buttonsLayout.SetMargins(Margins{})
+	if err := buttons.SetLayout(buttonsLayout); err != nil {
+		return nil, err
+	}
+
+	pickPB, err := NewPushButton(buttons)
+	if err != nil {
+		return nil, err
+	}
+	if err := pickPB.SetText(tr("Pick", "walk")); err != nil {
+		return nil, err
+	}
+	pickPB.Clicked().Attach(func() {
+		ins.overlay.startPicking()
+	})
+
+	refreshPB, err := NewPushButton(buttons)
+	if err != nil {
+		return nil, err
+	}
+	if err := refreshPB.SetText(tr("Refresh", "walk")); err != nil {
+		return nil, err
+	}
+	refreshPB.Clicked().Attach(ins.refresh)
+
+	if _, err := NewHSpacer(buttons); err != nil {
+		return nil, err
+	}
+
+	splitter, err := NewHSplitter(ins.mw)
+	if err != nil {
+		return nil, err
+	}
+
+	if ins.treeView, err = NewTreeView(splitter); err != nil {
+		return nil, err
+	}
+	if err := ins.treeView.SetModel(ins.treeModel); err != nil {
+		return nil, err
+	}
+	ins.treeView.CurrentItemChanged().Attach(ins.updateSelection)
+
+	if ins.tableView, err = NewTableView(splitter); err != nil {
+		return nil, err
+	}
+	for _, title := range []string{tr("Name", "walk"), tr("Value", "walk")} {
+		col := NewTableViewColumn()
+		if err := col.SetTitle(title); err != nil {
+			return nil, err
+		}
+		if err := col.SetWidth(200); err != nil {
+			return nil, err
+		}
+		if err := ins.tableView.Columns().Add(col); err != nil {
+			return nil, err
+		}
+	}
+	if err := ins.tableView.SetModel(ins.tableModel); err != nil {
+		return nil, err
+	}
+
+	ins.mw.Closing().Attach(func(canceled *bool, reason CloseReason) {
+		ins.overlay.stopPicking()
+		ins.overlay.SetVisible(false)
+	})
+	ins.mw.Disposing().Attach(func() {
+		ins.overlay.Dispose()
+
+		if theInspector == ins {
+			theInspector = nil
+		}
+	})
+
+	if err := ins.mw.SetSize(Size{800, 600}); err != nil {
+		return nil, err
+	}
+
+	succeeded = true
+
+	return ins, nil
+}
+
+func (ins *inspector) dispose() {
+	if ins.overlay != nil {
+		ins.overlay.Dispose()
+	}
+
+	ins.mw.Dispose()
+}
+
+// refresh rebuilds the widget tree, keeping the selection if possible.
+func (ins *inspector) refresh() {
+	var selected Window
+	if item, ok := ins.treeView.CurrentItem().(*inspectorTreeItem); ok {
+		selected = item.window
+	}
+
+	ins.treeModel.reset(ins.mw)
+
+	if selected != nil {
+		ins.selectWindow(selected)
+	} else {
+		ins.updateSelection()
+	}
+}
+
+// selectWindow selects window in the tree.
+func (ins *inspector) selectWindow(window Window) {
+	item := ins.treeModel.itemForWindow(window)
+	if item == nil {
+		ins.treeModel.reset(ins.mw)
+
+		if item = ins.treeModel.itemForWindow(window); item == nil {
+			return
+		}
+	}
+
+	ins.treeView.SetCurrentItem(item)
+	ins.treeView.EnsureVisible(item)
+
+	ins.updateSelection()
+}
+
+func (ins *inspector) updateSelection() {
+	item, _ := ins.treeView.CurrentItem().(*inspectorTreeItem)
+	if item == nil || item.window.IsDisposed() {
+		ins.tableModel.reset(nil)
+		ins.overlay.SetVisible(false)
+		return
+	}
+
+	ins.tableModel.reset(item.window)
+
+	if !ins.overlay.picking {
+		ins.overlay.highlight(item.window)
+	}
+}
+
+type inspectorTreeItem struct {
+	window   Window
+	parent   *inspectorTreeItem
+	children []*inspectorTreeItem
+}
+
+func newInspectorTreeItem(window Window, parent *inspectorTreeItem) *inspectorTreeItem {
+	item := &inspectorTreeItem{window: window, parent: parent}
+
+	if container, ok := window.(Container); ok {
+		if children := container.Children(); children != nil {
+			for i := 0; i < children.Len(); i++ {
+				item.children = append(item.children, newInspectorTreeItem(children.At(i), item))
+			}
+		}
+	}
+
+	return item
+}
+
+func (item *inspectorTreeItem) Text() string {
+	return describeWindow(item.window)
+}
+
+func (item *inspectorTreeItem) Parent() TreeItem {
+	if item.parent == nil {
+		return nil
+	}
+
+	return item.parent
+}
+
+func (item *inspectorTreeItem) ChildCount() int {
+	return len(item.children)
+}
+
+func (item *inspectorTreeItem) ChildAt(index int) TreeItem {
+	return item.children[index]
+}
+
+type inspectorTreeModel struct {
+	TreeModelBase
+	roots []*inspectorTreeItem
+}
+
+func (m *inspectorTreeModel) RootCount() int {
+	return len(m.roots)
+}
+
+func (m *inspectorTreeModel) RootAt(index int) TreeItem {
+	return m.roots[index]
+}
+
+// reset builds the tree from all forms except the inspector itself.
+func (m *inspectorTreeModel) reset(exclude Form) {
+	var forms []Form
+	for _, wb := range hwnd2WindowBase {
+		if form, ok := wb.window.(Form); ok && form != exclude {
+			forms = append(forms, form)
+		}
+	}
+
+	sort.Slice(forms, func(i, j int) bool {
+		return forms[i].Handle() < forms[j].Handle()
+	})
+
+	m.roots = m.roots[:0]
+	for _, form := range forms {
+		m.roots = append(m.roots, newInspectorTreeItem(form, nil))
+	}
+
+	m.PublishItemsReset(nil)
+}
+
+func (m *inspectorTreeModel) itemForWindow(window Window) *inspectorTreeItem {
+	var find func(items []*inspectorTreeItem) *inspectorTreeItem
+	find = func(items []*inspectorTreeItem) *inspectorTreeItem {
+		for _, item := range items {
+			if item.window == window {
+				return item
+			}
+
+			if found := find(item.children); found != nil {
+				return found
+			}
+		}
+
+		return nil
+	}
+
+	return find(m.roots)
+}
+
+type inspectorProperty struct {
+	name  string
+	value string
+}
+
+type inspectorPropertyModel struct {
+	TableModelBase
+	properties []inspectorProperty
+}
+
+func (m *inspectorPropertyModel) RowCount() int {
+	return len(m.properties)
+}
+
+func (m *inspectorPropertyModel) Value(row, col int) interface{} {
+	if col == 0 {
+		return m.properties[row].name
+	}
+
+	return m.properties[row].value
+}
+
+func (m *inspectorPropertyModel) add(name string, value interface{}) {
+	m.properties = append(m.properties, inspectorProperty{name, fmt.Sprint(value)})
+}
+
+// reset collects the properties of window.
+func (m *inspectorPropertyModel) reset(window Window) {
+	m.properties = m.properties[:0]
+
+	defer m.PublishRowsReset()
+
+	if window == nil {
+		return
+	}
+
+	wb := window.AsWindowBase()
+
+	m.add("Type", reflect.TypeOf(window))
+	m.add("Name", window.Name())
+	m.add("Handle", fmt.Sprintf("0x%X", window.Handle()))
+	m.add("Visible", window.Visible())
+	m.add("Enabled", window.Enabled())
+	m.add("DPI", window.DPI())
+	m.add("Bounds", window.Bounds())
+	m.add("Bounds (pixels)", window.BoundsPixels())
+	m.add("Client Bounds (pixels)", window.ClientBoundsPixels())
+
+	if widget, ok := window.(Widget); ok {
+		m.add("Alignment", widget.Alignment())
+		m.add("Min Size", widget.MinSize())
+		m.add("Max Size", widget.MaxSize())
+		m.add("Always Consume Space", widget.AlwaysConsumeSpace())
+
+		if item := createLayoutItemForWidget(widget); item != nil {
+			m.add("Layout Flags", layoutFlagsString(item.LayoutFlags()))
+
+			if is, ok := item.(IdealSizer); ok {
+				m.add("Ideal Size (pixels)", is.IdealSize())
+			}
+			if ms, ok := item.(MinSizer); ok {
+				m.add("Min Size (pixels)", ms.MinSize())
+			}
+		}
+	}
+
+	if container, ok := window.(Container); ok {
+		if layout := container.Layout(); layout != nil {
+			m.add("Layout", reflect.TypeOf(layout))
+			m.add("Layout Margins", layout.Margins())
+			m.add("Layout Spacing", layout.Spacing())
+		}
+	}
+
+	names := make([]string, 0, len(wb.name2Property))
+	for name := range wb.name2Property {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		m.add("Property "+name, wb.name2Property[name].Get())
+	}
+
+	for _, ec := range eventHandlerCounts(window) {
+		m.add("Event "+ec.name, ec.count)
+	}
+}
+
+var layoutFlagNames = []struct {
+	flag LayoutFlags
+	name string
+}{
+	{ShrinkableHorz, "ShrinkableHorz"},
+	{ShrinkableVert, "ShrinkableVert"},
+	{GrowableHorz, "GrowableHorz"},
+	{GrowableVert, "GrowableVert"},
+	{GreedyHorz, "GreedyHorz"},
+	{GreedyVert, "GreedyVert"},
+}
+
+func layoutFlagsString(flags LayoutFlags) string {
+	var names []string
+	for _, fn := range layoutFlagNames {
+		if flags&fn.flag != 0 {
+			names = append(names, fn.name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "0"
+	}
+
+	return strings.Join(names, " | ")
+}
+
+type eventHandlerCount struct {
+	name  string
+	count int
+}
+
+// eventHandlerCounts returns the number of attached handlers of the event
+// publishers of window, that have any.
+func eventHandlerCounts(window Window) []eventHandlerCount {
+	var counts []eventHandlerCount
+
+	var collect func(v reflect.Value)
+	collect = func(v reflect.Value) {
+		t := v.Type()
+
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			fv := v.Field(i)
+
+			if fv.Kind() == reflect.Ptr && sf.Anonymous {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() != reflect.Struct {
+				continue
+			}
+
+			if strings.HasSuffix(fv.Type().Name(), "EventPublisher") {
+				if handlers := fv.FieldByName("event").FieldByName("handlers"); handlers.IsValid() {
+					var count int
+					for j := 0; j < handlers.Len(); j++ {
+						if !handlers.Index(j).FieldByName("handler").IsNil() {
+							count++
+						}
+					}
+
+					if count > 0 {
+						counts = append(counts, eventHandlerCount{strings.TrimSuffix(sf.Name, "Publisher"), count})
+					}
+				}
+				continue
+			}
+
+			if sf.Anonymous {
+				collect(fv)
+			}
+		}
+	}
+
+	if v := reflect.ValueOf(window); v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct {
+		collect(v.Elem())
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].name < counts[j].name
+	})
+
+	return counts
+}
+
+// inspectorOverlay is a click-through, top-most window that highlights the
+// bounds and layout margins of a window and implements picking a widget by
+// clicking it.
+type inspectorOverlay struct {
+	WindowBase
+	margins Margins // in native pixels
+	picking bool
+	hovered Window
+	picked  func(window Window)
+}
+
+var inspectorOverlayColorKey = RGB(255, 0, 254)
+
+func newInspectorOverlay() (*inspectorOverlay, error) {
+	ov := new(inspectorOverlay)
+
+	if err := InitWindow(
+		ov,
+		nil,
+		inspectorOverlayWindowClass,
+		win.WS_POPUP,
+		win.WS_EX_LAYERED|win.WS_EX_TRANSPARENT|win.WS_EX_TOOLWINDOW|win.WS_EX_TOPMOST|win.WS_EX_NOACTIVATE); err != nil {
+		return nil, err
+	}
+
+	if !setLayeredWindowAttributes(ov.hWnd, win.COLORREF(inspectorOverlayColorKey), 192, lwaColorKey|lwaAlpha) {
+		ov.Dispose()
+		return nil, lastError("SetLayeredWindowAttributes")
+	}
+
+	return ov, nil
+}
+
+// highlight moves the overlay over window.
+func (ov *inspectorOverlay) highlight(window Window) {
+	if window == nil || window.IsDisposed() || !window.Visible() {
+		ov.SetVisible(false)
+		return
+	}
+
+	var rc win.RECT
+	if !win.GetWindowRect(window.Handle(), &rc) {
+		ov.SetVisible(false)
+		return
+	}
+
+	ov.margins = Margins{}
+	if container, ok := window.(Container); ok {
+		if layout := container.Layout(); layout != nil {
+			ov.margins = MarginsFrom96DPI(layout.Margins(), window.DPI())
+		}
+	}
+
+	win.SetWindowPos(ov.hWnd, win.HWND_TOPMOST, rc.Left, rc.Top, rc.Right-rc.Left, rc.Bottom-rc.Top, win.SWP_NOACTIVATE|win.SWP_SHOWWINDOW)
+	ov.Invalidate()
+}
+
+func (ov *inspectorOverlay) startPicking() {
+	ov.picking = true
+	ov.hovered = nil
+
+	win.SetCapture(ov.hWnd)
+	win.SetCursor(CursorCross().handle())
+}
+
+func (ov *inspectorOverlay) stopPicking() {
+	if !ov.picking {
+		return
+	}
+
+	ov.picking = false
+
+	win.ReleaseCapture()
+}
+
+// windowAtCursor returns the innermost window of the application under the
+// mouse cursor.
+func (ov *inspectorOverlay) windowAtCursor() Window {
+	var pt win.POINT
+	if !win.GetCursorPos(&pt) {
+		return nil
+	}
+
+	for hwnd := win.WindowFromPoint(pt); hwnd != 0; hwnd = win.GetParent(hwnd) {
+		if window := windowFromHandle(hwnd); window != nil {
+			if theInspector != nil {
+				if hwndInspector := theInspector.mw.Handle(); hwnd == hwndInspector || win.IsChild(hwndInspector, hwnd) {
+					return nil
+				}
+			}
+
+			return window
+		}
+	}
+
+	return nil
+}
+
+func (ov *inspectorOverlay) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_ERASEBKGND:
+		return 1
+
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+
+		hdc := win.BeginPaint(hwnd, &ps)
+		defer win.EndPaint(hwnd, &ps)
+
+		ov.paint(hdc)
+
+		return 0
+
+	case win.WM_MOUSEMOVE:
+		if !ov.picking {
+			break
+		}
+
+		win.SetCursor(CursorCross().handle())
+
+		if window := ov.windowAtCursor(); window != nil && window != ov.hovered {
+			ov.hovered = window
+			ov.highlight(window)
+		}
+
+		return 0
+
+	case win.WM_LBUTTONDOWN:
+		if !ov.picking {
+			break
+		}
+
+		hovered := ov.hovered
+		ov.stopPicking()
+
+		if hovered != nil && ov.picked != nil {
+			ov.picked(hovered)
+		}
+
+		return 0
+
+	case win.WM_RBUTTONDOWN:
+		ov.stopPicking()
+
+		return 0
+
+	case win.WM_CAPTURECHANGED:
+		ov.picking = false
+	}
+
+	return ov.WindowBase.WndProc(hwnd, msg, wParam, lParam)
+}
+
+// paint draws the layout margins and the outline of the highlighted window.
+// Everything else has the color key, so it is transparent.
+func (ov *inspectorOverlay) paint(hdc win.HDC) {
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	bounds := ov.ClientBoundsPixels()
+
+	keyBrush, err := NewSolidColorBrush(inspectorOverlayColorKey)
+	if err != nil {
+		return
+	}
+	defer keyBrush.Dispose()
+
+	canvas.FillRectanglePixels(keyBrush, bounds)
+
+	if m := ov.margins; !m.isZero() {
+		marginBrush, err := NewSolidColorBrush(RGB(246, 178, 107))
+		if err != nil {
+			return
+		}
+		defer marginBrush.Dispose()
+
+		canvas.FillRectanglePixels(marginBrush, Rectangle{0, 0, bounds.Width, m.VNear})
+		canvas.FillRectanglePixels(marginBrush, Rectangle{0, bounds.Height - m.VFar, bounds.Width, m.VFar})
+		canvas.FillRectanglePixels(marginBrush, Rectangle{0, m.VNear, m.HNear, bounds.Height - m.VNear - m.VFar})
+		canvas.FillRectanglePixels(marginBrush, Rectangle{bounds.Width - m.HFar, m.VNear, m.HFar, bounds.Height - m.VNear - m.VFar})
+	}
+
+	outlineBrush, err := NewSolidColorBrush(RGB(220, 0, 0))
+	if err != nil {
+		return
+	}
+	defer outlineBrush.Dispose()
+
+	pen, err := NewGeometricPen(PenSolid|PenInsideFrame, ov.IntFrom96DPI(2), outlineBrush)
+	if err != nil {
+		return
+	}
+	defer pen.Dispose()
+
+	canvas.DrawRectanglePixels(pen, bounds)
+}
@@ -9,6 +9,7 @@ package walk
 type intEventHandlerInfo struct {
 	handler IntEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type IntEventHandler func(n int)
@@ -18,7 +19,7 @@ type IntEvent struct {
 }
 
 func (e *IntEvent) Attach(handler IntEventHandler) int {
-	handlerInfo := intEventHandlerInfo{handler, false}
+	handlerInfo := intEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *IntEvent) Attach(handler IntEventHandler) int {
 }
 
 func (e *IntEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -41,6 +43,17 @@ func (e *IntEvent) Once(handler IntEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *IntEvent) AttachScoped(owner Window, handler IntEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type IntEventPublisher struct {
 	event IntEvent
 }
@@ -9,6 +9,7 @@ package walk
 type intRangeEventHandlerInfo struct {
 	handler IntRangeEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type IntRangeEventHandler func(from, to int)
@@ -18,7 +19,7 @@ type IntRangeEvent struct {
 }
 
 func (e *IntRangeEvent) Attach(handler IntRangeEventHandler) int {
-	handlerInfo := intRangeEventHandlerInfo{handler, false}
+	handlerInfo := intRangeEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *IntRangeEvent) Attach(handler IntRangeEventHandler) int {
 }
 
 func (e *IntRangeEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -41,6 +43,17 @@ func (e *IntRangeEvent) Once(handler IntRangeEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *IntRangeEvent) AttachScoped(owner Window, handler IntRangeEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type IntRangeEventPublisher struct {
 	event IntRangeEvent
 }
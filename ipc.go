@@ -0,0 +1,667 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+const (
+	ipcPipePrefix     = `\\.\pipe\`
+	ipcBufferSize     = 64 * 1024
+	ipcMaxPayloadSize = 16 * 1024 * 1024
+)
+
+type ipcConnEventHandlerInfo struct {
+	handler IPCConnEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+// IPCConnEventHandler is called with the connection an event relates to.
+type IPCConnEventHandler func(conn *IPCConn)
+
+type IPCConnEvent struct {
+	handlers []ipcConnEventHandlerInfo
+}
+
+func (e *IPCConnEvent) Attach(handler IPCConnEventHandler) int {
+	handlerInfo := ipcConnEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *IPCConnEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *IPCConnEvent) Once(handler IPCConnEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *IPCConnEvent) AttachScoped(owner Window, handler IPCConnEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type IPCConnEventPublisher struct {
+	event IPCConnEvent
+}
+
+func (p *IPCConnEventPublisher) Event() *IPCConnEvent {
+	return &p.event
+}
+
+func (p *IPCConnEventPublisher) Publish(conn *IPCConn) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(conn)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
+
+type ipcMessageEventHandlerInfo struct {
+	handler IPCMessageEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+// IPCMessageEventHandler is called with a payload received over conn.
+type IPCMessageEventHandler func(conn *IPCConn, payload []byte)
+
+type IPCMessageEvent struct {
+	handlers []ipcMessageEventHandlerInfo
+}
+
+func (e *IPCMessageEvent) Attach(handler IPCMessageEventHandler) int {
+	handlerInfo := ipcMessageEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *IPCMessageEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *IPCMessageEvent) Once(handler IPCMessageEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *IPCMessageEvent) AttachScoped(owner Window, handler IPCMessageEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type IPCMessageEventPublisher struct {
+	event IPCMessageEvent
+}
+
+func (p *IPCMessageEventPublisher) Event() *IPCMessageEvent {
+	return &p.event
+}
+
+func (p *IPCMessageEventPublisher) Publish(conn *IPCConn, payload []byte) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(conn, payload)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
+
+func ipcPipeName(name string) string {
+	if strings.HasPrefix(name, ipcPipePrefix) {
+		return name
+	}
+
+	return ipcPipePrefix + name
+}
+
+// overlappedIO runs op with a fresh OVERLAPPED structure and waits for it to
+// complete. We use overlapped I/O, because synchronous I/O on a pipe handle
+// would serialize reading and writing.
+func overlappedIO(h syscall.Handle, op func(o *syscall.Overlapped) error) (uint32, error) {
+	event, err := createEvent(true, false)
+	if err != nil {
+		return 0, err
+	}
+	defer syscall.CloseHandle(event)
+
+	o := &syscall.Overlapped{HEvent: event}
+
+	if err := op(o); err != nil && err != syscall.ERROR_IO_PENDING {
+		return 0, err
+	}
+
+	var n uint32
+	if err := getOverlappedResult(h, o, &n, true); err != nil {
+		return n, err
+	}
+
+	return n, nil
+}
+
+// IPCServer accepts connections on a named pipe.
+//
+// All events of an IPCServer and the connections it accepted are published on
+// the UI thread of its owner window.
+type IPCServer struct {
+	owner                 Window
+	name                  string
+	mutex                 sync.Mutex
+	pending               syscall.Handle
+	closed                bool
+	conns                 map[*IPCConn]bool
+	maxInstances          uint32
+	securityDescriptor    []byte
+	connectedPublisher    IPCConnEventPublisher
+	disconnectedPublisher IPCConnEventPublisher
+	receivedPublisher     IPCMessageEventPublisher
+	errorPublisher        ErrorEventPublisher
+}
+
+// NewIPCServer creates a new IPCServer that listens on the named pipe with
+// the given name and starts accepting connections from the local machine.
+//
+// If name does not start with `\\.\pipe\`, it is prepended.
+func NewIPCServer(owner Window, name string) (*IPCServer, error) {
+	return newIPCServer(owner, name, pipeUnlimitedInstances, nil, 0)
+}
+
+// newIPCServer is like NewIPCServer, but allows to limit the number of pipe
+// instances and to protect the pipe by a security descriptor in
+// self-relative format. firstInstanceFlags are added to the open mode of the
+// first instance, e.g. fileFlagFirstPipeInstance.
+func newIPCServer(owner Window, name string, maxInstances uint32, securityDescriptor []byte, firstInstanceFlags uint32) (*IPCServer, error) {
+	s := &IPCServer{
+		owner:              owner,
+		name:               ipcPipeName(name),
+		conns:              make(map[*IPCConn]bool),
+		maxInstances:       maxInstances,
+		securityDescriptor: securityDescriptor,
+	}
+
+	// We create the first instance here, so that creation errors are reported
+	// to the caller and clients may connect as soon as we return.
+	h, err := s.createInstance(firstInstanceFlags)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	go s.accept(h)
+
+	return s, nil
+}
+
+// Name returns the full name of the pipe the IPCServer listens on.
+func (s *IPCServer) Name() string {
+	return s.name
+}
+
+// Connected returns the event that is published when a client connected.
+func (s *IPCServer) Connected() *IPCConnEvent {
+	return s.connectedPublisher.Event()
+}
+
+// Disconnected returns the event that is published when a connection was
+// closed by either side.
+func (s *IPCServer) Disconnected() *IPCConnEvent {
+	return s.disconnectedPublisher.Event()
+}
+
+// Received returns the event that is published when a payload was received
+// on any connection.
+func (s *IPCServer) Received() *IPCMessageEvent {
+	return s.receivedPublisher.Event()
+}
+
+// Error returns the event that is published when accepting connections
+// fails.
+func (s *IPCServer) Error() *ErrorEvent {
+	return s.errorPublisher.Event()
+}
+
+// Conns returns the currently open connections.
+func (s *IPCServer) Conns() []*IPCConn {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	conns := make([]*IPCConn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+
+	return conns
+}
+
+// Broadcast sends v, encoded as JSON, to all open connections.
+func (s *IPCServer) Broadcast(v interface{}) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	var firstErr error
+	for _, c := range s.Conns() {
+		if err := c.SendBytes(payload); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// Close stops accepting connections and closes all open connections.
+func (s *IPCServer) Close() error {
+	s.mutex.Lock()
+	s.closed = true
+	pending := s.pending
+	s.pending = 0
+	s.mutex.Unlock()
+
+	if pending != 0 {
+		syscall.CancelIoEx(pending, nil)
+		syscall.CloseHandle(pending)
+	}
+
+	for _, c := range s.Conns() {
+		c.Close()
+	}
+
+	return nil
+}
+
+func (s *IPCServer) createInstance(flags uint32) (syscall.Handle, error) {
+	name, err := syscall.UTF16PtrFromString(s.name)
+	if err != nil {
+		return 0, err
+	}
+
+	var sa *syscall.SecurityAttributes
+	if s.securityDescriptor != nil {
+		sa = &syscall.SecurityAttributes{
+			Length:             uint32(unsafe.Sizeof(syscall.SecurityAttributes{})),
+			SecurityDescriptor: uintptr(unsafe.Pointer(&s.securityDescriptor[0])),
+		}
+	}
+
+	return createNamedPipe(
+		name,
+		pipeAccessDuplex|syscall.FILE_FLAG_OVERLAPPED|flags,
+		pipeTypeByte|pipeReadModeByte|pipeWait|pipeRejectRemoteClients,
+		s.maxInstances,
+		ipcBufferSize,
+		ipcBufferSize,
+		0,
+		sa)
+}
+
+func (s *IPCServer) accept(h syscall.Handle) {
+	for {
+		s.mutex.Lock()
+		if s.closed {
+			s.mutex.Unlock()
+			syscall.CloseHandle(h)
+			return
+		}
+		s.pending = h
+		s.mutex.Unlock()
+
+		_, err := overlappedIO(h, func(o *syscall.Overlapped) error {
+			return connectNamedPipe(h, o)
+		})
+		if err == errorPipeConnected {
+			err = nil
+		}
+
+		s.mutex.Lock()
+		closed := s.closed
+		s.pending = 0
+		s.mutex.Unlock()
+
+		if closed {
+			return
+		}
+
+		if err != nil {
+			syscall.CloseHandle(h)
+
+			s.owner.Synchronize(func() {
+				s.errorPublisher.Publish(wrapErrorNoPanic(err))
+			})
+			return
+		}
+
+		c := newIPCConn(s.owner, h, s)
+
+		// Close may have taken its snapshot of the connections in the meantime,
+		// so we must not add c after it.
+		s.mutex.Lock()
+		if s.closed {
+			s.mutex.Unlock()
+			c.Close()
+			return
+		}
+		s.conns[c] = true
+		s.mutex.Unlock()
+
+		s.owner.Synchronize(func() {
+			s.connectedPublisher.Publish(c)
+		})
+
+		go c.read()
+
+		if s.maxInstances == 1 {
+			// The only instance is in use, so there is nothing left to accept.
+			return
+		}
+
+		if h, err = s.createInstance(0); err != nil {
+			s.owner.Synchronize(func() {
+				s.errorPublisher.Publish(wrapErrorNoPanic(err))
+			})
+			return
+		}
+	}
+}
+
+// IPCConn is a connection over a named pipe that exchanges length-prefixed
+// payloads.
+//
+// The payload format is up to the application. Send encodes values as JSON,
+// which Received handlers may decode via json.Unmarshal. SendBytes allows to
+// transfer any other encoding, e.g. protocol buffers.
+type IPCConn struct {
+	owner                 Window
+	handle                syscall.Handle
+	server                *IPCServer
+	writeMutex            sync.Mutex
+	ioMutex               sync.Mutex
+	closed                bool
+	inFlight              sync.WaitGroup
+	closeOnce             sync.Once
+	receivedPublisher     IPCMessageEventPublisher
+	disconnectedPublisher IPCConnEventPublisher
+}
+
+func newIPCConn(owner Window, handle syscall.Handle, server *IPCServer) *IPCConn {
+	return &IPCConn{
+		owner:  owner,
+		handle: handle,
+		server: server,
+	}
+}
+
+// DialIPC connects to the named pipe with the given name, waiting up to
+// timeout for the server to become available.
+//
+// Events of the connection are published on the UI thread of owner.
+func DialIPC(owner Window, name string, timeout time.Duration) (*IPCConn, error) {
+	pipeName, err := syscall.UTF16PtrFromString(ipcPipeName(name))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		h, err := syscall.CreateFile(
+			pipeName,
+			syscall.GENERIC_READ|syscall.GENERIC_WRITE,
+			0,
+			nil,
+			syscall.OPEN_EXISTING,
+			syscall.FILE_FLAG_OVERLAPPED,
+			0)
+		if err == nil {
+			c := newIPCConn(owner, h, nil)
+
+			go c.read()
+
+			return c, nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, wrapError(err)
+		}
+
+		switch err {
+		case errorPipeBusy:
+			waitNamedPipe(pipeName, uint32(remaining/time.Millisecond))
+
+		case syscall.ERROR_FILE_NOT_FOUND:
+			time.Sleep(50 * time.Millisecond)
+
+		default:
+			return nil, wrapError(err)
+		}
+	}
+}
+
+// Received returns the event that is published when a payload was received.
+func (c *IPCConn) Received() *IPCMessageEvent {
+	return c.receivedPublisher.Event()
+}
+
+// Disconnected returns the event that is published when the connection was
+// closed by either side.
+func (c *IPCConn) Disconnected() *IPCConnEvent {
+	return c.disconnectedPublisher.Event()
+}
+
+// Send sends v, encoded as JSON. It may be called from any goroutine.
+func (c *IPCConn) Send(v interface{}) error {
+	if err := c.send(v); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+// SendBytes sends payload as is. It may be called from any goroutine.
+func (c *IPCConn) SendBytes(payload []byte) error {
+	if err := c.sendBytes(payload); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+// send is like Send, but leaves reporting errors to the caller, e.g. for
+// responses to clients, that may have disconnected in the meantime.
+func (c *IPCConn) send(v interface{}) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return c.sendBytes(payload)
+}
+
+func (c *IPCConn) sendBytes(payload []byte) error {
+	if len(payload) > ipcMaxPayloadSize {
+		return newErr("payload too large")
+	}
+
+	buf := make([]byte, 4+len(payload))
+	binary.LittleEndian.PutUint32(buf, uint32(len(payload)))
+	copy(buf[4:], payload)
+
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	for len(buf) > 0 {
+		n, err := c.io(func(o *syscall.Overlapped) error {
+			var done uint32
+			return syscall.WriteFile(c.handle, buf, &done, o)
+		})
+		if err != nil {
+			return err
+		}
+
+		buf = buf[n:]
+	}
+
+	return nil
+}
+
+// Close closes the connection. Pending sends fail.
+func (c *IPCConn) Close() error {
+	var err error
+
+	c.closeOnce.Do(func() {
+		c.ioMutex.Lock()
+		c.closed = true
+		syscall.CancelIoEx(c.handle, nil)
+		c.ioMutex.Unlock()
+
+		// The operations we cancelled still use the handle until they
+		// completed.
+		c.inFlight.Wait()
+
+		err = syscall.CloseHandle(c.handle)
+	})
+
+	return err
+}
+
+// io runs op on the handle of c like overlappedIO. op is started while
+// holding ioMutex, so Close either cancels it or it is not started at all.
+func (c *IPCConn) io(op func(o *syscall.Overlapped) error) (uint32, error) {
+	var started bool
+
+	n, err := overlappedIO(c.handle, func(o *syscall.Overlapped) error {
+		c.ioMutex.Lock()
+		defer c.ioMutex.Unlock()
+
+		if c.closed {
+			return syscall.ERROR_BROKEN_PIPE
+		}
+
+		c.inFlight.Add(1)
+		started = true
+
+		return op(o)
+	})
+
+	if started {
+		c.inFlight.Done()
+	}
+
+	return n, err
+}
+
+func (c *IPCConn) readFull(buf []byte) error {
+	for len(buf) > 0 {
+		n, err := c.io(func(o *syscall.Overlapped) error {
+			var done uint32
+			return syscall.ReadFile(c.handle, buf, &done, o)
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return syscall.ERROR_BROKEN_PIPE
+		}
+
+		buf = buf[n:]
+	}
+
+	return nil
+}
+
+func (c *IPCConn) read() {
+	var header [4]byte
+
+	for {
+		if err := c.readFull(header[:]); err != nil {
+			break
+		}
+
+		size := binary.LittleEndian.Uint32(header[:])
+		if size > ipcMaxPayloadSize {
+			break
+		}
+
+		payload := make([]byte, size)
+		if err := c.readFull(payload); err != nil {
+			break
+		}
+
+		c.owner.Synchronize(func() {
+			c.receivedPublisher.Publish(c, payload)
+
+			if c.server != nil {
+				c.server.receivedPublisher.Publish(c, payload)
+			}
+		})
+	}
+
+	c.Close()
+
+	if s := c.server; s != nil {
+		s.mutex.Lock()
+		delete(s.conns, c)
+		s.mutex.Unlock()
+	}
+
+	c.owner.Synchronize(func() {
+		c.disconnectedPublisher.Publish(c)
+
+		if c.server != nil {
+			c.server.disconnectedPublisher.Publish(c)
+		}
+	})
+}
@@ -0,0 +1,362 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// SearchMode specifies how TableViews and TreeViews match the text the user
+// types, for type-ahead search and in their find bar, against the texts of
+// their items. Matching ignores case.
+type SearchMode int
+
+const (
+	// SearchPrefix matches items whose text starts with the search text.
+	SearchPrefix SearchMode = iota
+
+	// SearchContains matches items whose text contains the search text.
+	SearchContains
+
+	// SearchDisabled turns type-ahead search and the find bar off.
+	SearchDisabled
+)
+
+// typeAheadTimeout is the time after which the next typed character starts a
+// new type-ahead search.
+const typeAheadTimeout = time.Second
+
+var (
+	findBarEditWndProcPtr uintptr
+	hwnd2FindBar          = make(map[win.HWND]*findBar)
+)
+
+func init() {
+	AppendToWalkInit(func() {
+		findBarEditWndProcPtr = syscall.NewCallback(findBarEditWndProc)
+	})
+}
+
+// searchMatches returns if text matches search in mode.
+func searchMatches(text, search string, mode SearchMode) bool {
+	text, search = strings.ToLower(text), strings.ToLower(search)
+
+	switch mode {
+	case SearchPrefix:
+		return strings.HasPrefix(text, search)
+
+	case SearchContains:
+		return strings.Contains(text, search)
+	}
+
+	return false
+}
+
+// searchIndexes returns the first index from start on, moving backward or
+// forward and wrapping around, for which matches returns true, or -1.
+func searchIndexes(count, start int, backward bool, matches func(index int) bool) int {
+	step := 1
+	if backward {
+		step = -1
+	}
+
+	for i := 0; i < count; i++ {
+		index := ((start+i*step)%count + count) % count
+
+		if matches(index) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// findHighlightColor returns the background color of the items matching the
+// text of a find bar, in a view with background color bg.
+func findHighlightColor(bg Color) Color {
+	if isDarkColor(bg) {
+		return blendColor(bg, RGB(255, 192, 0), 96)
+	}
+
+	return RGB(255, 236, 140)
+}
+
+// typeAhead collects the characters the user types in quick succession into
+// the text of a type-ahead search.
+type typeAhead struct {
+	text     string
+	lastTime time.Time
+}
+
+// add adds r to the search text and returns it, or "" if there is nothing to
+// search for. If next is true, the search should start after the current
+// item, otherwise at it.
+func (ta *typeAhead) add(r rune) (text string, next bool) {
+	now := time.Now()
+	if now.Sub(ta.lastTime) > typeAheadTimeout {
+		ta.text = ""
+	}
+	ta.lastTime = now
+
+	switch {
+	case ta.text == "" && r == ' ':
+		// Space toggles check boxes, it starts no search.
+		return "", false
+
+	case ta.text == string(r):
+		// Typing the same character again cycles through the items starting
+		// with it.
+		return ta.text, true
+	}
+
+	ta.text += string(r)
+
+	return ta.text, ta.text == string(r)
+}
+
+// findBar is the edit control TableViews and TreeViews show at their top
+// right corner for incremental search.
+//
+// While the user types, the view searches from its current item on. Return,
+// Down and F3 search for the next item, together with Shift or Up for the
+// previous one. Escape hides the find bar.
+type findBar struct {
+	hwnd           win.HWND
+	origWndProcPtr uintptr
+	text           string
+	notFound       bool
+	notFoundBrush  *SolidColorBrush
+	find           func(text string, next, backward bool) bool
+	changed        func()
+}
+
+// newFindBar returns a new, hidden findBar as a child of parent. find is
+// called to search for text, see typeAhead.add for next. changed is called
+// after the text changed or the find bar was hidden, so the view can update
+// the highlighted items.
+func newFindBar(parent win.HWND, find func(text string, next, backward bool) bool, changed func()) (*findBar, error) {
+	fb := &findBar{find: find, changed: changed}
+
+	// Keep the parent from painting over the find bar.
+	if err := ensureWindowLongBits(parent, win.GWL_STYLE, win.WS_CLIPCHILDREN, true); err != nil {
+		return nil, err
+	}
+
+	if fb.hwnd = win.CreateWindowEx(
+		win.WS_EX_CLIENTEDGE,
+		syscall.StringToUTF16Ptr("EDIT"),
+		nil,
+		win.WS_CHILD|win.WS_CLIPSIBLINGS|win.ES_AUTOHSCROLL,
+		0,
+		0,
+		0,
+		0,
+		parent,
+		0,
+		0,
+		nil,
+	); fb.hwnd == 0 {
+		return nil, lastError("CreateWindowEx(EDIT)")
+	}
+
+	fb.origWndProcPtr = win.SetWindowLongPtr(fb.hwnd, win.GWLP_WNDPROC, findBarEditWndProcPtr)
+	if fb.origWndProcPtr == 0 {
+		err := lastError("SetWindowLongPtr")
+		win.DestroyWindow(fb.hwnd)
+		return nil, err
+	}
+
+	hwnd2FindBar[fb.hwnd] = fb
+
+	win.SendMessage(fb.hwnd, win.EM_SETCUEBANNER, win.TRUE, uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(tr("Find", "walk")))))
+
+	return fb, nil
+}
+
+// dispose destroys the edit control of the find bar.
+func (fb *findBar) dispose() {
+	if fb.hwnd != 0 {
+		win.DestroyWindow(fb.hwnd)
+		fb.hwnd = 0
+	}
+
+	if fb.notFoundBrush != nil {
+		fb.notFoundBrush.Dispose()
+		fb.notFoundBrush = nil
+	}
+}
+
+// visible returns if the find bar is shown.
+func (fb *findBar) visible() bool {
+	return fb != nil && fb.hwnd != 0 && win.IsWindowVisible(fb.hwnd)
+}
+
+// searchText returns the text the find bar searches for, "" while it is hidden.
+func (fb *findBar) searchText() string {
+	if !fb.visible() {
+		return ""
+	}
+
+	return fb.text
+}
+
+// show shows the find bar in font, selects its text and focuses it.
+func (fb *findBar) show(font *Font, dpi int) {
+	fb.applyFont(font, dpi)
+
+	win.ShowWindow(fb.hwnd, win.SW_SHOW)
+	win.SendMessage(fb.hwnd, win.EM_SETSEL, 0, ^uintptr(0))
+	win.SetFocus(fb.hwnd)
+}
+
+// hide hides the find bar. If it has the focus, its parent gets it.
+func (fb *findBar) hide() {
+	if !fb.visible() {
+		return
+	}
+
+	if win.GetFocus() == fb.hwnd {
+		win.SetFocus(win.GetParent(fb.hwnd))
+	}
+
+	win.ShowWindow(fb.hwnd, win.SW_HIDE)
+
+	fb.changed()
+}
+
+// applyFont sets the font of the find bar, which is sized to fit it.
+func (fb *findBar) applyFont(font *Font, dpi int) {
+	setWindowFont(fb.hwnd, font.handleForDPI(dpi))
+
+	fb.layout(dpi)
+}
+
+// layout places the find bar at the top right corner of its parent.
+func (fb *findBar) layout(dpi int) {
+	hdc := win.GetDC(fb.hwnd)
+	defer win.ReleaseDC(fb.hwnd, hdc)
+
+	hFontOld := win.SelectObject(hdc, win.HGDIOBJ(win.SendMessage(fb.hwnd, win.WM_GETFONT, 0, 0)))
+	var tm win.TEXTMETRIC
+	win.GetTextMetrics(hdc, &tm)
+	win.SelectObject(hdc, hFontOld)
+
+	var rc win.RECT
+	win.GetClientRect(win.GetParent(fb.hwnd), &rc)
+
+	margin := IntFrom96DPI(4, dpi)
+	width := mini(IntFrom96DPI(200, dpi), int(rc.Right)-2*margin)
+	height := int(tm.TmHeight) + IntFrom96DPI(8, dpi)
+
+	win.SetWindowPos(fb.hwnd, win.HWND_TOP, int32(int(rc.Right)-margin-width), int32(margin), int32(width), int32(height), win.SWP_NOACTIVATE)
+}
+
+// findNext searches for the next or previous item matching the text and
+// returns if there is one.
+func (fb *findBar) findNext(backward bool) bool {
+	if fb.text == "" {
+		return false
+	}
+
+	found := fb.find(fb.text, true, backward)
+
+	fb.setNotFound(!found)
+
+	return found
+}
+
+// handleParentMessage handles the messages the edit control sends to its
+// parent. It returns if msg was handled.
+func (fb *findBar) handleParentMessage(msg uint32, wp, lp uintptr) (uintptr, bool) {
+	if fb == nil || fb.hwnd == 0 || win.HWND(lp) != fb.hwnd {
+		return 0, false
+	}
+
+	switch msg {
+	case win.WM_COMMAND:
+		if win.HIWORD(uint32(wp)) == win.EN_CHANGE {
+			fb.text = windowText(fb.hwnd)
+
+			fb.setNotFound(fb.text != "" && !fb.find(fb.text, false, false))
+
+			fb.changed()
+
+			return 0, true
+		}
+
+	case win.WM_CTLCOLOREDIT:
+		if !fb.notFound {
+			break
+		}
+
+		if fb.notFoundBrush == nil {
+			var err error
+			if fb.notFoundBrush, err = NewSolidColorBrush(findBarNotFoundColor); err != nil {
+				break
+			}
+		}
+
+		win.SetTextColor(win.HDC(wp), win.COLORREF(RGB(0, 0, 0)))
+		win.SetBkColor(win.HDC(wp), win.COLORREF(findBarNotFoundColor))
+
+		return uintptr(fb.notFoundBrush.handle()), true
+	}
+
+	return 0, false
+}
+
+// findBarNotFoundColor is the background color of the find bar while no item
+// matches its text.
+var findBarNotFoundColor = RGB(255, 204, 204)
+
+func (fb *findBar) setNotFound(notFound bool) {
+	if notFound != fb.notFound {
+		fb.notFound = notFound
+
+		win.InvalidateRect(fb.hwnd, nil, true)
+	}
+}
+
+func findBarEditWndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr {
+	fb := hwnd2FindBar[hwnd]
+
+	switch msg {
+	case win.WM_GETDLGCODE:
+		return win.CallWindowProc(fb.origWndProcPtr, hwnd, msg, wp, lp) | win.DLGC_WANTALLKEYS
+
+	case win.WM_KEYDOWN:
+		switch Key(wp) {
+		case KeyReturn, KeyDown, KeyF3:
+			fb.findNext(ShiftDown())
+			return 0
+
+		case KeyUp:
+			fb.findNext(true)
+			return 0
+
+		case KeyEscape:
+			fb.hide()
+			return 0
+		}
+
+	case win.WM_CHAR:
+		// Keep the edit control from beeping.
+		if wp == '\r' || wp == 0x1b {
+			return 0
+		}
+
+	case win.WM_NCDESTROY:
+		delete(hwnd2FindBar, hwnd)
+	}
+
+	return win.CallWindowProc(fb.origWndProcPtr, hwnd, msg, wp, lp)
+}
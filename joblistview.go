@@ -0,0 +1,260 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+)
+
+// JobRow is a composite that displays the progress of a Job and lets the
+// user pause, resume, cancel or remove it.
+type JobRow struct {
+	*Composite
+	job           *Job
+	titleLabel    *Label
+	statusLabel   *Label
+	progressBar   *ProgressBar
+	pauseButton   *PushButton
+	cancelButton  *PushButton
+	changedHandle int
+}
+
+// NewJobRow creates and initializes a new JobRow for job.
+func NewJobRow(parent Container, job *Job) (*JobRow, error) {
+	composite, err := NewCompositeWithStyle(parent, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	jr := &JobRow{Composite: composite, job: job}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			jr.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(jr); err != nil {
+		return nil, err
+	}
+
+	layout := NewHBoxLayout()
+	layout.SetMargins(Margins{})
+	if err := jr.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	info, err := NewComposite(jr)
+	if err != nil {
+		return nil, err
+	}
+
+	infoLayout := NewVBoxLayout()
+	infoLayout.SetMargins(Margins{})
+	infoLayout.SetSpacing(2)
+	if err := info.SetLayout(infoLayout); err != nil {
+		return nil, err
+	}
+
+	if jr.titleLabel, err = NewLabel(info); err != nil {
+		return nil, err
+	}
+	jr.titleLabel.SetEllipsisMode(EllipsisEnd)
+	jr.titleLabel.SetText(job.Title())
+
+	if jr.progressBar, err = NewProgressBar(info); err != nil {
+		return nil, err
+	}
+	jr.progressBar.SetRange(0, jobProgressMax)
+
+	if jr.statusLabel, err = NewLabel(info); err != nil {
+		return nil, err
+	}
+	jr.statusLabel.SetEllipsisMode(EllipsisEnd)
+
+	if jr.pauseButton, err = NewPushButton(jr); err != nil {
+		return nil, err
+	}
+	jr.pauseButton.Clicked().Attach(func() {
+		if jr.job.State() == JobQueued || jr.job.State() == JobRunning {
+			jr.job.Pause()
+		} else {
+			jr.job.Resume()
+		}
+	})
+
+	if jr.cancelButton, err = NewPushButton(jr); err != nil {
+		return nil, err
+	}
+	jr.cancelButton.Clicked().Attach(func() {
+		if state := jr.job.State(); state == JobCompleted || state == JobCanceled {
+			jr.job.manager.Remove(jr.job)
+		} else {
+			jr.job.Cancel()
+		}
+	})
+
+	jr.changedHandle = job.Changed().Attach(jr.update)
+	jr.Disposing().Attach(func() {
+		jr.job.Changed().Detach(jr.changedHandle)
+	})
+
+	jr.update()
+
+	succeeded = true
+
+	return jr, nil
+}
+
+// Job returns the Job displayed by the JobRow.
+func (jr *JobRow) Job() *Job {
+	return jr.job
+}
+
+func (jr *JobRow) update() {
+	j := jr.job
+	state := j.State()
+
+	jr.progressBar.SetMarqueeMode(state == JobRunning && j.progress < 0)
+	if j.progress >= 0 {
+		jr.progressBar.SetValue(j.progress)
+	} else {
+		jr.progressBar.SetValue(0)
+	}
+
+	status := state.String()
+
+	switch state {
+	case JobRunning, JobQueued:
+		if text := j.StatusText(); text != "" {
+			status = text
+		} else if state == JobRunning && j.progress >= 0 {
+			status = fmt.Sprintf("%s - %d%%", state, j.progress*100/jobProgressMax)
+		}
+
+	case JobFailed:
+		if err := j.Err(); err != nil {
+			status += ": " + err.Error()
+		}
+	}
+
+	if attempts := j.Attempts(); attempts > 1 && !state.Finished() {
+		status = fmt.Sprintf(tr("%s (attempt %d)", "walk"), status, attempts)
+	}
+
+	jr.statusLabel.SetText(status)
+
+	switch state {
+	case JobQueued, JobRunning:
+		jr.pauseButton.SetText(tr("Pause", "walk"))
+		jr.pauseButton.SetEnabled(true)
+
+	case JobPaused, JobFailed:
+		jr.pauseButton.SetText(tr("Resume", "walk"))
+		jr.pauseButton.SetEnabled(true)
+
+	default:
+		jr.pauseButton.SetEnabled(false)
+	}
+
+	if state == JobCompleted || state == JobCanceled {
+		jr.cancelButton.SetText(tr("Remove", "walk"))
+	} else {
+		jr.cancelButton.SetText(tr("Cancel", "walk"))
+	}
+}
+
+// JobListView is a scrollable list of JobRows, that is kept in sync with the
+// jobs of a JobManager.
+type JobListView struct {
+	*ScrollView
+	manager       *JobManager
+	rows          map[*Job]*JobRow
+	addedHandle   int
+	removedHandle int
+}
+
+// NewJobListView creates and initializes a new JobListView for manager.
+func NewJobListView(parent Container, manager *JobManager) (*JobListView, error) {
+	if manager == nil {
+		return nil, newError("manager must not be nil")
+	}
+
+	sv, err := NewScrollView(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	jlv := &JobListView{
+		ScrollView: sv,
+		manager:    manager,
+		rows:       make(map[*Job]*JobRow),
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			jlv.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(jlv); err != nil {
+		return nil, err
+	}
+
+	sv.SetScrollbars(false, true)
+
+	layout := NewVBoxLayout()
+	layout.SetAlignment(AlignHNearVNear)
+	if err := jlv.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	for _, j := range manager.Jobs() {
+		if err := jlv.addRow(j); err != nil {
+			return nil, err
+		}
+	}
+
+	jlv.addedHandle = manager.JobAdded().Attach(func(j *Job) {
+		jlv.addRow(j)
+	})
+	jlv.removedHandle = manager.JobRemoved().Attach(jlv.removeRow)
+
+	jlv.Disposing().Attach(func() {
+		manager.JobAdded().Detach(jlv.addedHandle)
+		manager.JobRemoved().Detach(jlv.removedHandle)
+	})
+
+	succeeded = true
+
+	return jlv, nil
+}
+
+// JobManager returns the JobManager displayed by the JobListView.
+func (jlv *JobListView) JobManager() *JobManager {
+	return jlv.manager
+}
+
+func (jlv *JobListView) addRow(j *Job) error {
+	row, err := NewJobRow(jlv, j)
+	if err != nil {
+		return err
+	}
+
+	jlv.rows[j] = row
+
+	return nil
+}
+
+func (jlv *JobListView) removeRow(j *Job) {
+	if row, ok := jlv.rows[j]; ok {
+		delete(jlv.rows, j)
+		row.Dispose()
+	}
+}
@@ -0,0 +1,804 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const jobProgressMax = 1000
+
+// JobState is the state of a Job.
+type JobState int
+
+const (
+	JobQueued JobState = iota
+	JobRunning
+	JobPaused
+	JobCompleted
+	JobFailed
+	JobCanceled
+)
+
+func (s JobState) String() string {
+	switch s {
+	case JobQueued:
+		return tr("Queued", "walk")
+
+	case JobRunning:
+		return tr("Running", "walk")
+
+	case JobPaused:
+		return tr("Paused", "walk")
+
+	case JobCompleted:
+		return tr("Completed", "walk")
+
+	case JobFailed:
+		return tr("Failed", "walk")
+
+	case JobCanceled:
+		return tr("Canceled", "walk")
+	}
+
+	return ""
+}
+
+// Finished returns whether the state is final, i.e. JobCompleted, JobFailed
+// or JobCanceled.
+func (s JobState) Finished() bool {
+	return s >= JobCompleted
+}
+
+type jobEventHandlerInfo struct {
+	handler JobEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+type JobEventHandler func(job *Job)
+
+type JobEvent struct {
+	handlers []jobEventHandlerInfo
+}
+
+func (e *JobEvent) Attach(handler JobEventHandler) int {
+	handlerInfo := jobEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *JobEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *JobEvent) Once(handler JobEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *JobEvent) AttachScoped(owner Window, handler JobEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type JobEventPublisher struct {
+	event JobEvent
+}
+
+func (p *JobEventPublisher) Event() *JobEvent {
+	return &p.event
+}
+
+func (p *JobEventPublisher) Publish(job *Job) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(job)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
+
+// JobFunc performs the work of a Job. It runs on its own goroutine and should
+// return soon after ctx.Context() is done.
+type JobFunc func(ctx *JobContext) error
+
+// RetryPolicy describes how often and when a failed Job is run again.
+type RetryPolicy struct {
+	MaxAttempts int           // The maximum number of runs, values < 2 mean no retries.
+	Delay       time.Duration // The delay before the first retry.
+	Backoff     float64       // The factor the delay grows by for each further retry, values < 1 mean 1.
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := float64(p.Delay)
+
+	if p.Backoff > 1 {
+		for i := 1; i < attempt; i++ {
+			d *= p.Backoff
+		}
+	}
+
+	return time.Duration(d)
+}
+
+// JobRequest describes a Job to run.
+type JobRequest struct {
+	Title    string
+	Priority int // Queued jobs with higher priority are started first.
+	Retry    RetryPolicy
+	Run      JobFunc
+}
+
+// JobContext is passed to the JobFunc of a Job, to report progress and to
+// learn about cancellation or pausing.
+//
+// Its methods may be called from any goroutine.
+type JobContext struct {
+	ctx        context.Context
+	job        *Job
+	generation int
+}
+
+// Context returns the context.Context of the run, that is done when the Job
+// is canceled.
+func (jc *JobContext) Context() context.Context {
+	return jc.ctx
+}
+
+// Attempt returns the number of the current run, starting at 1.
+func (jc *JobContext) Attempt() int {
+	jc.job.mutex.Lock()
+	defer jc.job.mutex.Unlock()
+
+	return jc.job.attemptsRun
+}
+
+// SetProgress reports the progress of the Job as completed of total units.
+// If total is < 1, the progress is indeterminate.
+func (jc *JobContext) SetProgress(completed, total int64) {
+	progress := -1
+	if total > 0 {
+		progress = int(completed * jobProgressMax / total)
+		progress = maxi(0, mini(progress, jobProgressMax))
+	}
+
+	jc.report(func(j *Job) {
+		j.progress = progress
+	})
+}
+
+// SetStatusText reports a short description of what the Job currently does.
+func (jc *JobContext) SetStatusText(text string) {
+	jc.report(func(j *Job) {
+		j.statusText = text
+	})
+}
+
+// Checkpoint blocks while the Job is paused. It returns the error of
+// Context(), if the Job was canceled.
+//
+// Pausing is cooperative, a JobFunc should call Checkpoint regularly.
+func (jc *JobContext) Checkpoint() error {
+	jc.job.mutex.Lock()
+	gate := jc.job.gate
+	jc.job.mutex.Unlock()
+
+	if gate != nil {
+		select {
+		case <-gate:
+
+		case <-jc.ctx.Done():
+		}
+	}
+
+	return jc.ctx.Err()
+}
+
+func (jc *JobContext) report(f func(j *Job)) {
+	j := jc.job
+
+	j.manager.owner.Synchronize(func() {
+		if jc.generation == j.generation {
+			f(j)
+			j.changedPublisher.Publish()
+			j.manager.updateProgress()
+		}
+	})
+}
+
+// Job is a unit of work managed by a JobManager.
+//
+// All methods must be called on the UI thread of the owner window of the
+// JobManager and all events are published there.
+type Job struct {
+	manager          *JobManager
+	request          JobRequest
+	seq              int
+	state            JobState
+	err              error
+	progress         int // 0 - jobProgressMax, -1 means indeterminate
+	statusText       string
+	started          bool
+	inBatch          bool
+	notBefore        time.Time
+	retryTimer       *time.Timer
+	cancel           context.CancelFunc
+	generation       int
+	mutex            sync.Mutex    // guards gate and attemptsRun
+	gate             chan struct{} // non-nil while paused, closed on resume
+	attemptsRun      int
+	changedPublisher EventPublisher
+}
+
+// Title returns the title of the job.
+func (j *Job) Title() string {
+	return j.request.Title
+}
+
+// Priority returns the priority of the job.
+func (j *Job) Priority() int {
+	return j.request.Priority
+}
+
+// SetPriority sets the priority of the job. It only has an effect while the
+// job is queued.
+func (j *Job) SetPriority(priority int) {
+	if priority == j.request.Priority {
+		return
+	}
+
+	j.request.Priority = priority
+
+	j.changedPublisher.Publish()
+
+	j.manager.schedule()
+}
+
+// State returns the state of the job.
+func (j *Job) State() JobState {
+	return j.state
+}
+
+// Err returns why the job failed, if it did.
+func (j *Job) Err() error {
+	return j.err
+}
+
+// Attempts returns how often the job has been started.
+func (j *Job) Attempts() int {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	return j.attemptsRun
+}
+
+// Progress returns the progress of the job in the range from 0 to 1, or -1 if
+// it is indeterminate.
+func (j *Job) Progress() float64 {
+	if j.progress < 0 {
+		return -1
+	}
+
+	return float64(j.progress) / jobProgressMax
+}
+
+// StatusText returns the status text last reported by the job.
+func (j *Job) StatusText() string {
+	return j.statusText
+}
+
+// Changed returns the event that is published when the state or progress of
+// the job changed.
+func (j *Job) Changed() *Event {
+	return j.changedPublisher.Event()
+}
+
+// Pause holds a queued job back or, if it is running, makes its next
+// JobContext.Checkpoint block until the job is resumed.
+func (j *Job) Pause() {
+	if j.state != JobQueued && j.state != JobRunning {
+		return
+	}
+
+	if j.state == JobRunning {
+		j.mutex.Lock()
+		j.gate = make(chan struct{})
+		j.mutex.Unlock()
+	}
+
+	j.setState(JobPaused, nil)
+
+	j.manager.schedule()
+}
+
+// Resume queues a paused or failed job again. A paused job that had been
+// running continues where it stopped, once it gets a slot again.
+func (j *Job) Resume() {
+	if j.state != JobPaused && j.state != JobFailed {
+		return
+	}
+
+	if j.state == JobFailed {
+		j.started = false
+		j.progress = 0
+		j.mutex.Lock()
+		j.attemptsRun = 0
+		j.mutex.Unlock()
+	}
+
+	j.notBefore = time.Time{}
+	j.inBatch = true
+	j.setState(JobQueued, nil)
+
+	j.manager.schedule()
+}
+
+// Cancel stops the job.
+func (j *Job) Cancel() {
+	if j.state.Finished() && j.state != JobFailed {
+		return
+	}
+
+	j.stop()
+	j.setState(JobCanceled, nil)
+
+	j.manager.schedule()
+}
+
+func (j *Job) stop() {
+	j.generation++
+	j.started = false
+
+	if j.retryTimer != nil {
+		j.retryTimer.Stop()
+		j.retryTimer = nil
+	}
+
+	if j.cancel != nil {
+		j.cancel()
+		j.cancel = nil
+	}
+
+	j.openGate()
+}
+
+func (j *Job) openGate() {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.gate != nil {
+		close(j.gate)
+		j.gate = nil
+	}
+}
+
+func (j *Job) setState(state JobState, err error) {
+	j.state = state
+	j.err = err
+
+	j.changedPublisher.Publish()
+	j.manager.updateProgress()
+}
+
+func (j *Job) start() {
+	if j.started {
+		// The job was paused while running, so let it continue.
+		j.openGate()
+		j.setState(JobRunning, nil)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	j.generation++
+	j.cancel = cancel
+	j.started = true
+	j.statusText = ""
+
+	j.mutex.Lock()
+	j.attemptsRun++
+	j.mutex.Unlock()
+
+	j.setState(JobRunning, nil)
+
+	jc := &JobContext{ctx: ctx, job: j, generation: j.generation}
+
+	go j.run(jc)
+}
+
+// run calls the JobFunc on its own goroutine. The result is applied on the UI
+// thread, as long as the run is still current.
+func (j *Job) run(jc *JobContext) {
+	err := j.call(jc)
+	if jc.ctx.Err() != nil {
+		return
+	}
+
+	j.manager.owner.Synchronize(func() {
+		if jc.generation != j.generation {
+			return
+		}
+
+		j.cancel = nil
+		j.started = false
+
+		if err == nil {
+			j.progress = jobProgressMax
+			j.setState(JobCompleted, nil)
+		} else if attempts := j.Attempts(); attempts < j.request.Retry.MaxAttempts {
+			j.scheduleRetry(attempts, err)
+		} else {
+			j.setState(JobFailed, err)
+		}
+
+		j.manager.schedule()
+	})
+}
+
+func (j *Job) call(jc *JobContext) (err error) {
+	defer func() {
+		if x := recover(); x != nil {
+			err = newErrorNoPanic(fmt.Sprint(x))
+		}
+	}()
+
+	if j.request.Run == nil {
+		return nil
+	}
+
+	return j.request.Run(jc)
+}
+
+// scheduleRetry queues the job again, after the delay of its RetryPolicy.
+func (j *Job) scheduleRetry(attempt int, err error) {
+	delay := j.request.Retry.delay(attempt)
+
+	j.progress = 0
+	j.statusText = fmt.Sprintf(tr("Retrying after error: %s", "walk"), err.Error())
+	j.notBefore = time.Now().Add(delay)
+
+	generation := j.generation
+	j.retryTimer = time.AfterFunc(delay, func() {
+		j.manager.owner.Synchronize(func() {
+			if generation == j.generation {
+				j.retryTimer = nil
+				j.manager.schedule()
+			}
+		})
+	})
+
+	j.setState(JobQueued, nil)
+}
+
+// JobManager runs a queue of jobs on goroutines, with a limited number of
+// jobs running at the same time.
+//
+// Queued jobs are started in order of descending priority and, for equal
+// priorities, in the order they were added. Failed jobs are retried as
+// configured by their RetryPolicy.
+//
+// All methods must be called on the UI thread of the owner window and all
+// events are published there.
+type JobManager struct {
+	owner                    Window
+	maxConcurrent            int
+	jobs                     []*Job
+	nextSeq                  int
+	progress                 float64
+	statusBarItem            *StatusBarItem
+	progressIndicator        *ProgressIndicator
+	addedPublisher           JobEventPublisher
+	removedPublisher         JobEventPublisher
+	progressChangedPublisher EventPublisher
+}
+
+// NewJobManager creates a new JobManager, that publishes its events on the UI
+// thread of owner.
+func NewJobManager(owner Window) *JobManager {
+	return &JobManager{
+		owner:         owner,
+		maxConcurrent: 2,
+	}
+}
+
+// MaxConcurrent returns the maximum number of jobs that run at the same time.
+func (jm *JobManager) MaxConcurrent() int {
+	return jm.maxConcurrent
+}
+
+// SetMaxConcurrent sets the maximum number of jobs that run at the same time.
+func (jm *JobManager) SetMaxConcurrent(maxConcurrent int) {
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+
+	jm.maxConcurrent = maxConcurrent
+
+	jm.schedule()
+}
+
+// Jobs returns all jobs of the JobManager, in the order they were added.
+func (jm *JobManager) Jobs() []*Job {
+	return append([]*Job(nil), jm.jobs...)
+}
+
+// JobAdded returns the event that is published when a job was added.
+func (jm *JobManager) JobAdded() *JobEvent {
+	return jm.addedPublisher.Event()
+}
+
+// JobRemoved returns the event that is published when a job was removed.
+func (jm *JobManager) JobRemoved() *JobEvent {
+	return jm.removedPublisher.Event()
+}
+
+// Add queues a new job.
+func (jm *JobManager) Add(request JobRequest) *Job {
+	jm.nextSeq++
+
+	j := &Job{
+		manager: jm,
+		request: request,
+		seq:     jm.nextSeq,
+		inBatch: true,
+	}
+
+	jm.jobs = append(jm.jobs, j)
+	jm.addedPublisher.Publish(j)
+
+	jm.schedule()
+	jm.updateProgress()
+
+	return j
+}
+
+// Remove cancels the job, if it is not finished yet, and removes it from the
+// JobManager.
+func (jm *JobManager) Remove(j *Job) {
+	for i, job := range jm.jobs {
+		if job == j {
+			if !j.state.Finished() {
+				j.Cancel()
+			}
+
+			jm.jobs = append(jm.jobs[:i], jm.jobs[i+1:]...)
+			jm.removedPublisher.Publish(j)
+
+			jm.updateProgress()
+			return
+		}
+	}
+}
+
+// RemoveFinished removes all completed and canceled jobs.
+func (jm *JobManager) RemoveFinished() {
+	for _, j := range jm.Jobs() {
+		if j.state == JobCompleted || j.state == JobCanceled {
+			jm.Remove(j)
+		}
+	}
+}
+
+// CancelAll cancels all jobs that are not finished.
+func (jm *JobManager) CancelAll() {
+	for _, j := range jm.Jobs() {
+		if !j.state.Finished() {
+			j.Cancel()
+		}
+	}
+}
+
+// Progress returns the combined progress of the current batch of jobs in the
+// range from 0 to 1.
+//
+// A batch starts when a job is added to an idle JobManager and ends when no
+// job is queued, running or paused anymore.
+func (jm *JobManager) Progress() float64 {
+	return jm.progress
+}
+
+// ProgressChanged returns the event that is published when the state or
+// progress of any job changed.
+func (jm *JobManager) ProgressChanged() *Event {
+	return jm.progressChangedPublisher.Event()
+}
+
+// StatusBarItem returns the *StatusBarItem that displays the combined
+// progress, if any.
+func (jm *JobManager) StatusBarItem() *StatusBarItem {
+	return jm.statusBarItem
+}
+
+// SetStatusBarItem sets a *StatusBarItem that displays the combined progress.
+func (jm *JobManager) SetStatusBarItem(item *StatusBarItem) {
+	if jm.statusBarItem != nil && jm.statusBarItem != item {
+		jm.statusBarItem.SetText("")
+	}
+
+	jm.statusBarItem = item
+
+	jm.updateProgress()
+}
+
+// ProgressIndicator returns the *ProgressIndicator that displays the combined
+// progress in the taskbar, if any.
+func (jm *JobManager) ProgressIndicator() *ProgressIndicator {
+	return jm.progressIndicator
+}
+
+// SetProgressIndicator sets a *ProgressIndicator, usually the one of a Form,
+// that displays the combined progress in the taskbar.
+func (jm *JobManager) SetProgressIndicator(pi *ProgressIndicator) {
+	if jm.progressIndicator != nil && jm.progressIndicator != pi {
+		jm.progressIndicator.SetState(PINoProgress)
+	}
+
+	jm.progressIndicator = pi
+
+	jm.updateProgress()
+}
+
+// Close cancels all jobs and removes the progress from the status bar and
+// taskbar.
+func (jm *JobManager) Close() {
+	jm.CancelAll()
+
+	jm.SetStatusBarItem(nil)
+	jm.SetProgressIndicator(nil)
+}
+
+// schedule starts queued jobs by priority, as long as there are less than
+// maxConcurrent running.
+func (jm *JobManager) schedule() {
+	running := 0
+	for _, j := range jm.jobs {
+		if j.state == JobRunning {
+			running++
+		}
+	}
+
+	now := time.Now()
+
+	for running < jm.maxConcurrent {
+		var next *Job
+
+		for _, j := range jm.jobs {
+			if j.state != JobQueued || j.notBefore.After(now) {
+				continue
+			}
+
+			if next == nil || j.request.Priority > next.request.Priority ||
+				j.request.Priority == next.request.Priority && j.seq < next.seq {
+				next = j
+			}
+		}
+
+		if next == nil {
+			return
+		}
+
+		next.notBefore = time.Time{}
+		next.start()
+		running++
+	}
+}
+
+// updateProgress recomputes the combined progress of the current batch and
+// updates the status bar item and progress indicator.
+func (jm *JobManager) updateProgress() {
+	var active, total, done, running, paused, failed int
+	var sum float64
+	indeterminate := true
+
+	for _, j := range jm.jobs {
+		if !j.inBatch || j.state == JobCanceled {
+			continue
+		}
+
+		total++
+
+		switch j.state {
+		case JobCompleted:
+			done++
+			sum++
+
+		case JobFailed:
+			failed++
+			sum++
+
+		default:
+			active++
+
+			switch j.state {
+			case JobRunning:
+				running++
+
+			case JobPaused:
+				paused++
+			}
+
+			if j.progress >= 0 {
+				sum += float64(j.progress) / jobProgressMax
+				indeterminate = false
+			}
+		}
+	}
+
+	if active == 0 {
+		// The batch is over.
+		for _, j := range jm.jobs {
+			j.inBatch = false
+		}
+
+		total = 0
+	}
+
+	progress := 0.0
+	if total > 0 {
+		progress = sum / float64(total)
+	}
+
+	jm.progress = progress
+
+	if item := jm.statusBarItem; item != nil {
+		var text string
+		if total > 0 {
+			text = fmt.Sprintf(tr("%d of %d jobs done (%d%%)", "walk"), done+failed, total, int(progress*100))
+		}
+
+		item.SetText(text)
+	}
+
+	if pi := jm.progressIndicator; pi != nil {
+		switch {
+		case total == 0:
+			pi.SetState(PINoProgress)
+
+		case failed > 0:
+			pi.SetState(PIError)
+
+		case running == 0 && paused > 0:
+			pi.SetState(PIPaused)
+
+		case indeterminate && done == 0:
+			pi.SetState(PIIndeterminate)
+
+		default:
+			pi.SetState(PINormal)
+		}
+
+		if total > 0 {
+			pi.SetTotal(jobProgressMax)
+			pi.SetCompleted(uint32(progress * jobProgressMax))
+		}
+	}
+
+	jm.progressChangedPublisher.Publish()
+}
@@ -9,6 +9,7 @@ package walk
 type keyEventHandlerInfo struct {
 	handler KeyEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type KeyEventHandler func(key Key)
@@ -18,7 +19,7 @@ type KeyEvent struct {
 }
 
 func (e *KeyEvent) Attach(handler KeyEventHandler) int {
-	handlerInfo := keyEventHandlerInfo{handler, false}
+	handlerInfo := keyEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *KeyEvent) Attach(handler KeyEventHandler) int {
 }
 
 func (e *KeyEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -41,6 +43,17 @@ func (e *KeyEvent) Once(handler KeyEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *KeyEvent) AttachScoped(owner Window, handler KeyEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type KeyEventPublisher struct {
 	event KeyEvent
 }
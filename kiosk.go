@@ -0,0 +1,316 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+const mainWindowIdleTimerId = 1
+
+var (
+	kioskKeyboardHook      uintptr
+	kioskKeyboardHookProc  uintptr
+	kioskKeyboardHookUsers int
+)
+
+// KioskMode returns if the *MainWindow is in kiosk mode.
+func (mw *MainWindow) KioskMode() bool {
+	return mw.kioskMode
+}
+
+// SetKioskMode enters or leaves kiosk mode.
+//
+// In kiosk mode the *MainWindow covers the whole monitor including the taskbar
+// and stays on top of other windows. While it is the foreground window, the
+// Windows keys, the application key, Alt+Tab, Alt+Esc and Ctrl+Esc are
+// swallowed. Key combinations reserved by the system, like Ctrl+Alt+Del, can
+// not be blocked. Attempts by the user to close the window, e.g. by pressing
+// Alt+F4, are reported via the KioskClosing event and canceled by default.
+//
+// Leaving kiosk mode restores whether the *MainWindow was fullscreen and
+// topmost before.
+func (mw *MainWindow) SetKioskMode(kioskMode bool) error {
+	if kioskMode == mw.kioskMode {
+		return nil
+	}
+
+	if kioskMode {
+		// If a step fails, the completed ones are undone, so the window is
+		// not left fullscreen or topmost without the keyboard hook.
+		wasFullscreen := mw.Fullscreen()
+		wasTopmost := win.GetWindowLong(mw.hWnd, win.GWL_EXSTYLE)&win.WS_EX_TOPMOST != 0
+
+		if err := mw.SetFullscreen(true); err != nil {
+			return err
+		}
+
+		succeeded := false
+		defer func() {
+			if succeeded {
+				return
+			}
+
+			if !wasTopmost {
+				win.SetWindowPos(mw.hWnd, win.HWND_NOTOPMOST, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE)
+			}
+			if !wasFullscreen {
+				mw.SetFullscreen(false)
+			}
+		}()
+
+		if !win.SetWindowPos(mw.hWnd, win.HWND_TOPMOST, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE) {
+			return lastError("SetWindowPos")
+		}
+
+		if err := acquireKioskKeyboardHook(); err != nil {
+			return err
+		}
+
+		succeeded = true
+
+		mw.kioskWasFullscreen = wasFullscreen
+		mw.kioskWasTopmost = wasTopmost
+	} else {
+		// We restore the state from before entering kiosk mode.
+		releaseKioskKeyboardHook()
+
+		if !mw.kioskWasTopmost {
+			if !win.SetWindowPos(mw.hWnd, win.HWND_NOTOPMOST, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE) {
+				return lastError("SetWindowPos")
+			}
+		}
+
+		if !mw.kioskWasFullscreen {
+			if err := mw.SetFullscreen(false); err != nil {
+				return err
+			}
+		}
+	}
+
+	mw.kioskMode = kioskMode
+
+	return nil
+}
+
+// KioskClosing returns the event that is published when the user tries to
+// close the *MainWindow while it is in kiosk mode.
+//
+// The canceled argument is initially true. Handlers may set it to false to
+// let the window close. Calling Close programmatically is not affected.
+func (mw *MainWindow) KioskClosing() *CloseEvent {
+	return mw.kioskClosingPublisher.Event()
+}
+
+// CursorHidden returns if the mouse cursor is hidden while it is over the
+// *MainWindow.
+func (mw *MainWindow) CursorHidden() bool {
+	return mw.cursorHidden
+}
+
+// SetCursorHidden sets if the mouse cursor is hidden while it is over the
+// *MainWindow.
+func (mw *MainWindow) SetCursorHidden(hidden bool) {
+	if hidden == mw.cursorHidden {
+		return
+	}
+
+	mw.cursorHidden = hidden
+
+	if hidden {
+		if win.GetForegroundWindow() == mw.hWnd {
+			win.SetCursor(0)
+		}
+	} else {
+		var pt win.POINT
+		if win.GetCursorPos(&pt) {
+			win.SetCursorPos(pt.X, pt.Y)
+		}
+	}
+}
+
+// IdleTimeout returns the duration without user input, after which the
+// IdleTimedOut event is published.
+func (mw *MainWindow) IdleTimeout() time.Duration {
+	return mw.idleTimeout
+}
+
+// SetIdleTimeout sets the duration without user input, after which the
+// IdleTimedOut event is published.
+//
+// User input is tracked system-wide. A value of 0 disables idle tracking.
+func (mw *MainWindow) SetIdleTimeout(timeout time.Duration) error {
+	if timeout < 0 {
+		return newError("timeout must be >= 0")
+	}
+
+	if timeout == mw.idleTimeout {
+		return nil
+	}
+
+	mw.idleTimeout = timeout
+	mw.idle = false
+
+	if timeout == 0 {
+		if !win.KillTimer(mw.hWnd, mainWindowIdleTimerId) {
+			return lastError("KillTimer")
+		}
+
+		return nil
+	}
+
+	interval := timeout / 4
+	if interval > time.Second {
+		interval = time.Second
+	} else if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+
+	if 0 == win.SetTimer(mw.hWnd, mainWindowIdleTimerId, uint32(interval/time.Millisecond), 0) {
+		return lastError("SetTimer")
+	}
+
+	return nil
+}
+
+// IdleTimedOut returns the event that is published when there was no user
+// input for the duration set via SetIdleTimeout.
+func (mw *MainWindow) IdleTimedOut() *Event {
+	return mw.idleTimedOutPublisher.Event()
+}
+
+// IdleEnded returns the event that is published when user input occurs after
+// the IdleTimedOut event was published.
+func (mw *MainWindow) IdleEnded() *Event {
+	return mw.idleEndedPublisher.Event()
+}
+
+func (mw *MainWindow) checkIdle() {
+	lii := lastInputInfo{CbSize: uint32(unsafe.Sizeof(lastInputInfo{}))}
+	if !getLastInputInfo(&lii) {
+		return
+	}
+
+	idleFor := time.Duration(getTickCount()-lii.DwTime) * time.Millisecond
+
+	if !mw.idle && idleFor >= mw.idleTimeout {
+		mw.idle = true
+		mw.idleTimedOutPublisher.Publish()
+	} else if mw.idle && idleFor < mw.idleTimeout {
+		mw.idle = false
+		mw.idleEndedPublisher.Publish()
+	}
+}
+
+// handleKioskMessage handles messages that need special treatment in kiosk
+// mode or while the cursor is hidden. It returns true if the message was
+// handled.
+func (mw *MainWindow) handleKioskMessage(msg uint32, wParam, lParam uintptr) (result uintptr, handled bool) {
+	switch msg {
+	case win.WM_SETCURSOR:
+		if mw.cursorHidden {
+			win.SetCursor(0)
+			return 1, true
+		}
+
+	case win.WM_TIMER:
+		if wParam == mainWindowIdleTimerId {
+			mw.checkIdle()
+			return 0, true
+		}
+
+	case win.WM_SYSCOMMAND:
+		if !mw.kioskMode {
+			break
+		}
+
+		switch wParam & 0xFFF0 {
+		case win.SC_CLOSE:
+			canceled := true
+			mw.kioskClosingPublisher.Publish(&canceled, CloseReasonUser)
+			if canceled {
+				return 0, true
+			}
+
+		case win.SC_MINIMIZE, win.SC_MAXIMIZE, win.SC_RESTORE, win.SC_MOVE, win.SC_SIZE:
+			return 0, true
+		}
+
+	case win.WM_DESTROY:
+		if mw.kioskMode {
+			mw.kioskMode = false
+			releaseKioskKeyboardHook()
+		}
+	}
+
+	return 0, false
+}
+
+func acquireKioskKeyboardHook() error {
+	if kioskKeyboardHookUsers == 0 {
+		if kioskKeyboardHookProc == 0 {
+			kioskKeyboardHookProc = syscall.NewCallback(kioskLowLevelKeyboardProc)
+		}
+
+		kioskKeyboardHook = setWindowsHookEx(whKeyboardLL, kioskKeyboardHookProc, win.GetModuleHandle(nil), 0)
+		if kioskKeyboardHook == 0 {
+			return lastError("SetWindowsHookEx")
+		}
+	}
+
+	kioskKeyboardHookUsers++
+
+	return nil
+}
+
+func releaseKioskKeyboardHook() {
+	if kioskKeyboardHookUsers == 0 {
+		return
+	}
+
+	kioskKeyboardHookUsers--
+
+	if kioskKeyboardHookUsers == 0 {
+		if !unhookWindowsHookEx(kioskKeyboardHook) {
+			lastError("UnhookWindowsHookEx")
+		}
+
+		kioskKeyboardHook = 0
+	}
+}
+
+func kioskLowLevelKeyboardProc(nCode int32, wParam, lParam uintptr) uintptr {
+	if nCode == hcAction {
+		if mw, ok := windowFromHandle(win.GetForegroundWindow()).(*MainWindow); ok && mw.kioskMode {
+			kb := (*kbdllHookStruct)(unsafe.Pointer(lParam))
+
+			altDown := kb.Flags&llkhfAltDown != 0
+			ctrlDown := win.GetKeyState(win.VK_CONTROL) < 0
+
+			switch kb.VkCode {
+			case win.VK_LWIN, win.VK_RWIN, win.VK_APPS:
+				return 1
+
+			case win.VK_TAB:
+				if altDown {
+					return 1
+				}
+
+			case win.VK_ESCAPE:
+				if altDown || ctrlDown {
+					return 1
+				}
+			}
+		}
+	}
+
+	return callNextHookEx(kioskKeyboardHook, nCode, wParam, lParam)
+}
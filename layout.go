@@ -8,6 +8,7 @@ package walk
 
 import (
 	"sync"
+	"time"
 
 	"github.com/lxn/win"
 )
@@ -37,6 +38,7 @@ func createLayoutItemForWidgetWithContext(widget Widget, ctx *LayoutContext) Lay
 	lib.visible = widget.AsWidgetBase().visible
 	lib.geometry = widget.AsWidgetBase().geometry
 	lib.geometry.Alignment = widget.Alignment()
+	lib.geometry.AspectRatio = widget.AsWidgetBase().aspectRatio
 	lib.geometry.MinSize = widget.MinSizePixels()
 	lib.geometry.MaxSize = widget.MaxSizePixels()
 	lib.geometry.ConsumingSpaceWhenInvisible = widget.AlwaysConsumeSpace()
@@ -77,7 +79,8 @@ func CreateLayoutItemsForContainerWithContext(container Container, ctx *LayoutCo
 	if lb := layout.asLayoutBase(); lb != nil {
 		clib.alignment = lb.alignment
 		clib.margins96dpi = lb.margins96dpi
-		clib.spacing96dpi = lb.spacing96dpi
+		clib.hSpacing96dpi = lb.HorizontalSpacing()
+		clib.vSpacing96dpi = lb.VerticalSpacing()
 	}
 
 	if len(clib.children) == 0 {
@@ -85,11 +88,11 @@ func CreateLayoutItemsForContainerWithContext(container Container, ctx *LayoutCo
 		count := children.Len()
 
 		for i := 0; i < count; i++ {
-			item := createLayoutItemForWidgetWithContext(children.At(i), ctx)
+			widget := children.At(i)
+
+			item := createChildLayoutItem(layout, widget, ctx)
 			if item != nil {
-				lib := item.AsLayoutItemBase()
-				lib.ctx = ctx
-				lib.parent = containerItem
+				item.AsLayoutItemBase().parent = containerItem
 
 				clib.children = append(clib.children, item)
 			}
@@ -99,6 +102,29 @@ func CreateLayoutItemsForContainerWithContext(container Container, ctx *LayoutCo
 	return containerItem
 }
 
+// createChildLayoutItem creates the layout item for widget, a child of the
+// container laid out by layout. Layouts, that create the items of their
+// children themselves, must use it as well, so the size limits and layout
+// margins set for the child are applied.
+func createChildLayoutItem(layout Layout, widget Widget, ctx *LayoutContext) LayoutItem {
+	item := createLayoutItemForWidgetWithContext(widget, ctx)
+	if item == nil {
+		return nil
+	}
+
+	item.AsLayoutItemBase().ctx = ctx
+
+	if lb := layout.asLayoutBase(); lb != nil {
+		lb.applyChildSizeLimits(widget.Handle(), item.Geometry(), ctx.dpi)
+	}
+
+	if margins := widget.AsWidgetBase().layoutMargins96dpi; !margins.isZero() {
+		item = withLayoutMargins(item, MarginsFrom96DPI(margins, ctx.dpi))
+	}
+
+	return item
+}
+
 func startLayoutPerformer(form Form) (performLayout chan ContainerLayoutItem, layoutResults chan []LayoutResult, inSizeLoop chan bool, updateStopwatch chan *stopwatch, quit chan struct{}) {
 	performLayout = make(chan ContainerLayoutItem)
 	layoutResults = make(chan []LayoutResult)
@@ -165,6 +191,11 @@ func startLayoutPerformer(form Form) (performLayout chan ContainerLayoutItem, la
 func layoutTree(root ContainerLayoutItem, size Size, cancel chan struct{}, done chan []LayoutResult, stopwatch *stopwatch) {
 	const minSizeCacheSubject = "layoutTree - populating min size cache"
 
+	var started time.Time
+	if metricsEnabled {
+		started = time.Now()
+	}
+
 	if stopwatch != nil {
 		stopwatch.Start(minSizeCacheSubject)
 	}
@@ -225,6 +256,8 @@ func layoutTree(root ContainerLayoutItem, size Size, cancel chan struct{}, done
 
 				items := container.PerformLayout()
 
+				applyLayoutMargins(items)
+
 				select {
 				case <-cancel:
 					return
@@ -273,6 +306,10 @@ func layoutTree(root ContainerLayoutItem, size Size, cancel chan struct{}, done
 				stopwatch.Stop(layoutSubject)
 			}
 
+			if !started.IsZero() {
+				recordLayoutComputation(time.Since(started))
+			}
+
 			done <- layoutResults
 			return
 
@@ -286,12 +323,26 @@ func layoutTree(root ContainerLayoutItem, size Size, cancel chan struct{}, done
 }
 
 func applyLayoutResults(results []LayoutResult, stopwatch *stopwatch) error {
+	if metricsEnabled {
+		started := time.Now()
+		defer func() {
+			recordLayoutApplication(time.Since(started))
+		}()
+	}
+
 	if stopwatch != nil {
 		const subject = "applyLayoutResults"
 		stopwatch.Start(subject)
 		defer stopwatch.Stop(subject)
 	}
 
+	if layoutDiagnosticsMode != LayoutDiagnosticsOff {
+		defer diagnoseLayoutResults(results)
+	}
+	if layoutDebugOverlay {
+		defer updateLayoutOverlays(results)
+	}
+
 	var form Form
 
 	for _, result := range results {
@@ -304,14 +355,17 @@ func applyLayoutResults(results []LayoutResult, stopwatch *stopwatch) error {
 			return lastError("BeginDeferWindowPos")
 		}
 
-		var maybeInvalidate bool
+		var maybeInvalidate, rightToLeft bool
 		if wnd := windowFromHandle(result.container.Handle()); wnd != nil {
 			if ctr, ok := wnd.(Container); ok {
 				if cb := ctr.AsContainerBase(); cb != nil {
 					maybeInvalidate = cb.hasComplexBackground()
 				}
 			}
+
+			rightToLeft = wnd.RightToLeft()
 		}
+		clientWidth := result.container.Geometry().ClientSize.Width
 
 		for _, ri := range result.items {
 			if ri.Item.Handle() != 0 {
@@ -334,6 +388,10 @@ func applyLayoutResults(results []LayoutResult, stopwatch *stopwatch) error {
 					}
 				}
 
+				if rightToLeft && isMirroredByLayout(ri.Item.Handle()) {
+					ri.Bounds.X = clientWidth - ri.Bounds.X - ri.Bounds.Width
+				}
+
 				widget := window.(Widget)
 
 				oldBounds := widget.BoundsPixels()
@@ -408,15 +466,20 @@ type Layout interface {
 }
 
 type LayoutBase struct {
-	layout       Layout
-	container    Container
-	margins96dpi Margins
-	margins      Margins // in native pixels
-	spacing96dpi int
-	spacing      int // in native pixels
-	alignment    Alignment2D
-	resetNeeded  bool
-	dirty        bool
+	layout                 Layout
+	container              Container
+	margins96dpi           Margins
+	margins                Margins // in native pixels
+	spacing96dpi           int
+	spacing                int // in native pixels
+	horizontalSpacing96dpi int // only if horizontalSpacingSet
+	verticalSpacing96dpi   int // only if verticalSpacingSet
+	horizontalSpacingSet   bool
+	verticalSpacingSet     bool
+	alignment              Alignment2D
+	hwnd2ChildSizeLimits   map[win.HWND]childSizeLimits
+	resetNeeded            bool
+	dirty                  bool
 }
 
 func (l *LayoutBase) asLayoutBase() *LayoutBase {
@@ -478,8 +541,11 @@ func (l *LayoutBase) Spacing() int {
 	return l.spacing96dpi
 }
 
+// SetSpacing sets the spacing in 1/96" units between the items, both
+// horizontally and vertically. It replaces the values set by
+// SetHorizontalSpacing and SetVerticalSpacing.
 func (l *LayoutBase) SetSpacing(value int) error {
-	if value == l.spacing96dpi {
+	if value == l.spacing96dpi && !l.horizontalSpacingSet && !l.verticalSpacingSet {
 		return nil
 	}
 
@@ -488,6 +554,8 @@ func (l *LayoutBase) SetSpacing(value int) error {
 	}
 
 	l.spacing96dpi = value
+	l.horizontalSpacingSet = false
+	l.verticalSpacingSet = false
 
 	l.updateSpacing()
 
@@ -498,6 +566,70 @@ func (l *LayoutBase) SetSpacing(value int) error {
 	return nil
 }
 
+// HorizontalSpacing returns the spacing in 1/96" units between items that are
+// next to each other.
+func (l *LayoutBase) HorizontalSpacing() int {
+	if l.horizontalSpacingSet {
+		return l.horizontalSpacing96dpi
+	}
+
+	return l.spacing96dpi
+}
+
+// SetHorizontalSpacing sets the spacing in 1/96" units between items that are
+// next to each other, e.g. between the columns of a GridLayout or the items
+// of an HBoxLayout.
+func (l *LayoutBase) SetHorizontalSpacing(value int) error {
+	if l.horizontalSpacingSet && value == l.horizontalSpacing96dpi {
+		return nil
+	}
+
+	if value < 0 {
+		return newError("spacing cannot be negative")
+	}
+
+	l.horizontalSpacing96dpi = value
+	l.horizontalSpacingSet = true
+
+	if l.container != nil {
+		l.container.RequestLayout()
+	}
+
+	return nil
+}
+
+// VerticalSpacing returns the spacing in 1/96" units between items that are
+// above each other.
+func (l *LayoutBase) VerticalSpacing() int {
+	if l.verticalSpacingSet {
+		return l.verticalSpacing96dpi
+	}
+
+	return l.spacing96dpi
+}
+
+// SetVerticalSpacing sets the spacing in 1/96" units between items that are
+// above each other, e.g. between the rows of a GridLayout or the items of a
+// VBoxLayout.
+func (l *LayoutBase) SetVerticalSpacing(value int) error {
+	if l.verticalSpacingSet && value == l.verticalSpacing96dpi {
+		return nil
+	}
+
+	if value < 0 {
+		return newError("spacing cannot be negative")
+	}
+
+	l.verticalSpacing96dpi = value
+	l.verticalSpacingSet = true
+
+	if l.container != nil {
+		l.container.RequestLayout()
+	}
+
+	return nil
+}
+
 func (l *LayoutBase) updateMargins() {
 	if l.container != nil {
 		l.margins = MarginsFrom96DPI(l.margins96dpi, l.container.AsWindowBase().DPI())
@@ -530,6 +662,110 @@ func (l *LayoutBase) SetAlignment(alignment Alignment2D) error {
 	return nil
 }
 
+// childSizeLimits holds the sizes in 1/96" units set with SetChildMinSize and
+// SetChildMaxSize. A zero dimension is not limited.
+type childSizeLimits struct {
+	min Size
+	max Size
+}
+
+// ChildMinSize returns the minimum size in 1/96" units set for widget with
+// SetChildMinSize.
+func (l *LayoutBase) ChildMinSize(widget Widget) Size {
+	return l.hwnd2ChildSizeLimits[widget.Handle()].min
+}
+
+// SetChildMinSize sets the minimum size in 1/96" units, that widget gets in the
+// layout, overriding the one set on or reported by the widget. A zero width
+// or height leaves that dimension to the widget.
+//
+// This helps with native controls that report a wrong ideal or minimum size.
+// Use SetChildMaxSize to give them less space than they report.
+func (l *LayoutBase) SetChildMinSize(widget Widget, size Size) error {
+	return l.setChildSizeLimit(widget, size, false)
+}
+
+// ChildMaxSize returns the maximum size in 1/96" units set for widget with
+// SetChildMaxSize.
+func (l *LayoutBase) ChildMaxSize(widget Widget) Size {
+	return l.hwnd2ChildSizeLimits[widget.Handle()].max
+}
+
+// SetChildMaxSize sets the maximum size in 1/96" units, that widget gets in the
+// layout, overriding the one set on the widget. The size reported by the
+// widget is capped at it. A zero width or height leaves that dimension to the
+// widget.
+func (l *LayoutBase) SetChildMaxSize(widget Widget, size Size) error {
+	return l.setChildSizeLimit(widget, size, true)
+}
+
+func (l *LayoutBase) setChildSizeLimit(widget Widget, size Size, max bool) error {
+	if l.container == nil {
+		return newError("container required")
+	}
+
+	handle := widget.Handle()
+
+	if !l.container.Children().containsHandle(handle) {
+		return newError("unknown widget")
+	}
+	if size.Width < 0 || size.Height < 0 {
+		return newError("size must not be negative")
+	}
+
+	limits := l.hwnd2ChildSizeLimits[handle]
+	if max {
+		limits.max = size
+	} else {
+		limits.min = size
+	}
+
+	if limits == (childSizeLimits{}) {
+		delete(l.hwnd2ChildSizeLimits, handle)
+	} else {
+		if l.hwnd2ChildSizeLimits == nil {
+			l.hwnd2ChildSizeLimits = make(map[win.HWND]childSizeLimits)
+		}
+		l.hwnd2ChildSizeLimits[handle] = limits
+	}
+
+	l.container.RequestLayout()
+
+	return nil
+}
+
+// removeChildSizeLimits drops the size limits set for the child with handle,
+// once it was removed from the container, so they are neither kept nor
+// applied to a later window, that gets the same handle.
+func (l *LayoutBase) removeChildSizeLimits(handle win.HWND) {
+	delete(l.hwnd2ChildSizeLimits, handle)
+}
+
+// applyChildSizeLimits replaces the min and max sizes in geometry with those
+// set for the child with handle.
+func (l *LayoutBase) applyChildSizeLimits(handle win.HWND, geometry *Geometry, dpi int) {
+	limits, ok := l.hwnd2ChildSizeLimits[handle]
+	if !ok {
+		return
+	}
+
+	min := SizeFrom96DPI(limits.min, dpi)
+	if min.Width > 0 {
+		geometry.MinSize.Width = min.Width
+	}
+	if min.Height > 0 {
+		geometry.MinSize.Height = min.Height
+	}
+
+	max := SizeFrom96DPI(limits.max, dpi)
+	if max.Width > 0 {
+		geometry.MaxSize.Width = max.Width
+	}
+	if max.Height > 0 {
+		geometry.MaxSize.Height = max.Height
+	}
+}
+
 type IdealSizer interface {
 	// IdealSize returns ideal window size in native pixels.
 	IdealSize() Size
@@ -553,6 +789,231 @@ type HeightForWidther interface {
 	HeightForWidth(width int) int
 }
 
+// WidthForHeighter is the counterpart of HeightForWidther for layout items,
+// whose width depends on the height they get, like vertical toolbars that
+// wrap into columns or rotated labels.
+type WidthForHeighter interface {
+	HasWidthForHeight() bool
+
+	// WidthForHeight returns the width the item needs at height. Both are in
+	// native pixels.
+	WidthForHeight(height int) int
+}
+
+// Baseliner is implemented by layout items, that display text, so layouts
+// can align them by the baseline of their first line of text.
+type Baseliner interface {
+	// BaselineOffset returns the distance in native pixels from the top of
+	// the item at its ideal height to the baseline of its first line of
+	// text, or -1 if it has no baseline.
+	BaselineOffset() int
+}
+
+// baselineOf returns the baseline offset and ideal height of item, if it can
+// be aligned by its baseline. Items, that grow vertically or have a height
+// for width, are not aligned.
+func baselineOf(item LayoutItem) (baseline, height int, ok bool) {
+	b, isBaseliner := item.(Baseliner)
+	if !isBaseliner || item.LayoutFlags()&GrowableVert != 0 {
+		return 0, 0, false
+	}
+	if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+		return 0, 0, false
+	}
+
+	is, isIdealSizer := item.(IdealSizer)
+	if baseline = b.BaselineOffset(); baseline < 0 || !isIdealSizer {
+		return 0, 0, false
+	}
+
+	return baseline, is.IdealSize().Height, true
+}
+
+// baselineExtent returns the height in native pixels, that the items with a
+// baseline need, when they are aligned by it.
+func baselineExtent(items []LayoutItem) int {
+	var ascent, descent int
+	for _, item := range items {
+		if baseline, height, ok := baselineOf(item); ok {
+			ascent = maxi(ascent, baseline)
+			descent = maxi(descent, height-baseline)
+		}
+	}
+
+	return ascent + descent
+}
+
+// alignBaselines moves the results, whose items have a baseline, vertically,
+// so their baselines line up. The tallest of them keeps its position.
+func alignBaselines(results []LayoutResultItem) {
+	maxBaseline, tallest, tallestBaseline := 0, -1, 0
+	for i, result := range results {
+		baseline, _, ok := baselineOf(result.Item)
+		if !ok {
+			continue
+		}
+
+		maxBaseline = maxi(maxBaseline, baseline)
+
+		if tallest == -1 || result.Bounds.Height > results[tallest].Bounds.Height {
+			tallest, tallestBaseline = i, baseline
+		}
+	}
+
+	if tallest == -1 {
+		return
+	}
+
+	top := results[tallest].Bounds.Y - (maxBaseline - tallestBaseline)
+
+	for i := range results {
+		if baseline, height, ok := baselineOf(results[i].Item); ok {
+			results[i].Bounds.Y = top + maxBaseline - baseline
+			results[i].Bounds.Height = height
+		}
+	}
+}
+
+// minSizeCache caches the minimum sizes a container layout item computed for
+// the requested sizes. Layout items are recreated for each layout and most
+// are asked for a few sizes only, so the first ones are stored without
+// allocating. Further sizes, like the many widths nested height-for-width
+// items are measured for, go to a map, so they are not computed again.
+type minSizeCache struct {
+	entries [4]struct {
+		size Size
+		min  Size
+	}
+	count    int
+	overflow map[Size]Size
+}
+
+func (c *minSizeCache) get(size Size) (Size, bool) {
+	for i := 0; i < c.count; i++ {
+		if c.entries[i].size == size {
+			return c.entries[i].min, true
+		}
+	}
+
+	min, ok := c.overflow[size]
+
+	return min, ok
+}
+
+func (c *minSizeCache) put(size, min Size) {
+	if c.count < len(c.entries) {
+		c.entries[c.count].size = size
+		c.entries[c.count].min = min
+		c.count++
+		return
+	}
+
+	if c.overflow == nil {
+		c.overflow = make(map[Size]Size)
+	}
+	c.overflow[size] = min
+}
+
+// layoutScratch is reusable memory for the intermediate results of layout
+// computations, which run many times per second while resizing a form. Get
+// one from getLayoutScratch and release it, once the slices it handed out
+// are no longer used.
+type layoutScratch struct {
+	ints      []int
+	bools     []bool
+	boxInfos  boxLayoutItemInfoList
+	gridInfos gridLayoutSectionInfoList
+}
+
+var layoutScratchPool = sync.Pool{
+	New: func() interface{} {
+		return new(layoutScratch)
+	},
+}
+
+func getLayoutScratch() *layoutScratch {
+	return layoutScratchPool.Get().(*layoutScratch)
+}
+
+func (ls *layoutScratch) release() {
+	// Don't keep layout items alive.
+	for i := range ls.boxInfos {
+		ls.boxInfos[i].item = nil
+	}
+
+	ls.ints = ls.ints[:0]
+	ls.bools = ls.bools[:0]
+
+	layoutScratchPool.Put(ls)
+}
+
+// intSlice returns a zeroed slice of n ints, that stays valid until ls is
+// released.
+func (ls *layoutScratch) intSlice(n int) []int {
+	if len(ls.ints)+n > cap(ls.ints) {
+		// Slices handed out before keep the old array.
+		ls.ints = make([]int, 0, maxi(2*cap(ls.ints), n))
+	}
+
+	start := len(ls.ints)
+	ls.ints = ls.ints[:start+n]
+
+	s := ls.ints[start : start+n : start+n]
+	for i := range s {
+		s[i] = 0
+	}
+
+	return s
+}
+
+// boolSlice returns a zeroed slice of n bools, that stays valid until ls is
+// released.
+func (ls *layoutScratch) boolSlice(n int) []bool {
+	if len(ls.bools)+n > cap(ls.bools) {
+		ls.bools = make([]bool, 0, maxi(2*cap(ls.bools), n))
+	}
+
+	start := len(ls.bools)
+	ls.bools = ls.bools[:start+n]
+
+	s := ls.bools[start : start+n : start+n]
+	for i := range s {
+		s[i] = false
+	}
+
+	return s
+}
+
+// boxLayoutItemInfos returns a zeroed boxLayoutItemInfoList of length n. It
+// is reused by the next call.
+func (ls *layoutScratch) boxLayoutItemInfos(n int) boxLayoutItemInfoList {
+	if n > cap(ls.boxInfos) {
+		ls.boxInfos = make(boxLayoutItemInfoList, n)
+	}
+
+	ls.boxInfos = ls.boxInfos[:n]
+	for i := range ls.boxInfos {
+		ls.boxInfos[i] = boxLayoutItemInfo{}
+	}
+
+	return ls.boxInfos
+}
+
+// gridLayoutSectionInfos returns a zeroed gridLayoutSectionInfoList of length
+// n. It is reused by the next call.
+func (ls *layoutScratch) gridLayoutSectionInfos(n int) gridLayoutSectionInfoList {
+	if n > cap(ls.gridInfos) {
+		ls.gridInfos = make(gridLayoutSectionInfoList, n)
+	}
+
+	ls.gridInfos = ls.gridInfos[:n]
+	for i := range ls.gridInfos {
+		ls.gridInfos[i] = gridLayoutSectionInfo{}
+	}
+
+	return ls.gridInfos
+}
+
 type LayoutContext struct {
 	layoutItem2MinSizeEffective map[LayoutItem]Size // in native pixels
 	dpi                         int
@@ -628,10 +1089,21 @@ func (lib *LayoutItemBase) Visible() bool {
 
 type ContainerLayoutItemBase struct {
 	LayoutItemBase
-	children     []LayoutItem
-	margins96dpi Margins
-	spacing96dpi int
-	alignment    Alignment2D
+	children      []LayoutItem
+	margins96dpi  Margins
+	hSpacing96dpi int
+	vSpacing96dpi int
+	alignment     Alignment2D
+}
+
+// spacing96dpi returns the spacing in 1/96" units between items in
+// orientation.
+func (clib *ContainerLayoutItemBase) spacing96dpi(orientation Orientation) int {
+	if orientation == Horizontal {
+		return clib.hSpacing96dpi
+	}
+
+	return clib.vSpacing96dpi
 }
 
 func (clib *ContainerLayoutItemBase) AsContainerLayoutItemBase() *ContainerLayoutItemBase {
@@ -710,6 +1182,16 @@ func (clib *ContainerLayoutItemBase) HasHeightForWidth() bool {
 	return false
 }
 
+func (clib *ContainerLayoutItemBase) HasWidthForHeight() bool {
+	for _, child := range clib.children {
+		if wfh, ok := child.(WidthForHeighter); ok && wfh.HasWidthForHeight() {
+			return true
+		}
+	}
+
+	return false
+}
+
 type greedyLayoutItem struct {
 	LayoutItemBase
 }
@@ -738,6 +1220,23 @@ type Geometry struct {
 	Size                        Size // in native pixels
 	ClientSize                  Size // in native pixels
 	ConsumingSpaceWhenInvisible bool
+	AspectRatio                 Size // width to height, zero if free
+}
+
+// sizeWithAspectRatio returns the largest size with aspectRatio, that fits
+// into size. Without an aspect ratio, size is returned.
+func sizeWithAspectRatio(size, aspectRatio Size) Size {
+	if aspectRatio.Width <= 0 || aspectRatio.Height <= 0 {
+		return size
+	}
+
+	if size.Width*aspectRatio.Height > size.Height*aspectRatio.Width {
+		size.Width = size.Height * aspectRatio.Width / aspectRatio.Height
+	} else {
+		size.Height = size.Width * aspectRatio.Height / aspectRatio.Width
+	}
+
+	return size
 }
 
 type formLayoutResult struct {
@@ -812,6 +1311,11 @@ func anyVisibleItemInHierarchy(item LayoutItem) bool {
 
 // minSizeEffective returns minimum effective size in native pixels
 func minSizeEffective(item LayoutItem) Size {
+	var margins Margins
+	if mi, ok := item.(layoutMarginsItem); ok {
+		item, margins = mi.unwrap()
+	}
+
 	geometry := item.Geometry()
 
 	var s Size
@@ -831,5 +1335,5 @@ func minSizeEffective(item LayoutItem) Size {
 		size.Height = max.Height
 	}
 
-	return size
+	return addMargins(size, margins)
 }
@@ -0,0 +1,273 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"testing"
+
+	"github.com/lxn/win"
+)
+
+// The benchmarks lay out items, that do not need a window, so they measure
+// the layout computations only. Run them with
+//
+//	go test -run ^$ -bench Layout -benchmem
+
+// benchmarkLayoutItem is a leaf with a fixed minimum size. If hfw is set, it
+// keeps its area when it gets narrower, like wrapped text.
+type benchmarkLayoutItem struct {
+	LayoutItemBase
+	flags   LayoutFlags
+	minSize Size
+	hfw     bool
+}
+
+func (li *benchmarkLayoutItem) LayoutFlags() LayoutFlags {
+	return li.flags
+}
+
+func (li *benchmarkLayoutItem) IdealSize() Size {
+	return li.minSize
+}
+
+func (li *benchmarkLayoutItem) MinSize() Size {
+	return li.minSize
+}
+
+func (li *benchmarkLayoutItem) HasHeightForWidth() bool {
+	return li.hfw
+}
+
+func (li *benchmarkLayoutItem) HeightForWidth(width int) int {
+	return li.minSize.Width * li.minSize.Height / maxi(1, width)
+}
+
+func newBenchmarkLayoutContext() *LayoutContext {
+	return &LayoutContext{
+		layoutItem2MinSizeEffective: make(map[LayoutItem]Size),
+		dpi:                         96,
+	}
+}
+
+func newBenchmarkLeaf(ctx *LayoutContext, parent ContainerLayoutItem, i int, hfw bool) LayoutItem {
+	li := &benchmarkLayoutItem{
+		flags:   ShrinkableHorz | GrowableHorz | ShrinkableVert,
+		minSize: Size{40 + i%7*10, 20 + i%3*5},
+		hfw:     hfw,
+	}
+	if i%5 == 0 {
+		li.flags |= GrowableVert | GreedyHorz
+	}
+
+	li.ctx = ctx
+	li.parent = parent
+	li.visible = true
+
+	return li
+}
+
+// newBenchmarkGridLayoutItem returns a gridLayoutItem with rows * columns
+// children, that are created by newChild.
+func newBenchmarkGridLayoutItem(ctx *LayoutContext, parent ContainerLayoutItem, rows, columns int, newChild func(parent ContainerLayoutItem, i int) LayoutItem) *gridLayoutItem {
+	li := &gridLayoutItem{
+		rowStretchFactors:    make([]int, rows),
+		columnStretchFactors: make([]int, columns),
+		item2Info:            make(map[LayoutItem]*gridLayoutItemInfo, rows*columns),
+		cells:                make([][]gridLayoutItemCell, rows),
+	}
+	li.ctx = ctx
+	li.parent = parent
+	li.visible = true
+	li.hSpacing96dpi = 6
+	li.vSpacing96dpi = 6
+	li.geometry.ClientSize = Size{columns * 80, rows * 30}
+
+	for row := range li.cells {
+		li.cells[row] = make([]gridLayoutItemCell, columns)
+
+		for col := range li.cells[row] {
+			cell := &li.cells[row][col]
+			cell.row = row
+			cell.column = col
+			cell.item = newChild(li, row*columns+col)
+
+			li.children = append(li.children, cell.item)
+			li.item2Info[cell.item] = &gridLayoutItemInfo{cell: cell, spanHorz: 1, spanVert: 1}
+		}
+	}
+
+	return li
+}
+
+func newBenchmarkBoxLayoutItem(ctx *LayoutContext, count int) *boxLayoutItem {
+	li := &boxLayoutItem{
+		orientation:        Vertical,
+		hwnd2StretchFactor: make(map[win.HWND]int),
+	}
+	li.ctx = ctx
+	li.visible = true
+	li.vSpacing96dpi = 6
+	li.geometry.ClientSize = Size{400, count * 30}
+
+	for i := 0; i < count; i++ {
+		li.children = append(li.children, newBenchmarkLeaf(ctx, li, i, false))
+	}
+
+	return li
+}
+
+// resetMinSizeCaches forgets the minimum sizes computed for the items of the
+// tree, like a layout with freshly created layout items would.
+func resetMinSizeCaches(ctx *LayoutContext, li *gridLayoutItem) {
+	for item := range ctx.layoutItem2MinSizeEffective {
+		delete(ctx.layoutItem2MinSizeEffective, item)
+	}
+
+	var reset func(li *gridLayoutItem)
+	reset = func(li *gridLayoutItem) {
+		li.minSizeCache = minSizeCache{}
+
+		for _, child := range li.children {
+			if grid, ok := child.(*gridLayoutItem); ok {
+				reset(grid)
+			}
+		}
+	}
+	reset(li)
+}
+
+func BenchmarkGridLayoutSectionSizesForSpace(b *testing.B) {
+	ctx := newBenchmarkLayoutContext()
+	li := newBenchmarkGridLayoutItem(ctx, nil, 100, 10, func(parent ContainerLayoutItem, i int) LayoutItem {
+		return newBenchmarkLeaf(ctx, parent, i, false)
+	})
+	size := li.geometry.ClientSize
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ls := getLayoutScratch()
+		widths := li.sectionSizesForSpace(ls, Horizontal, size.Width, nil)
+		li.sectionSizesForSpace(ls, Vertical, size.Height, widths)
+		ls.release()
+	}
+}
+
+func BenchmarkGridLayoutMinSizeForSize(b *testing.B) {
+	ctx := newBenchmarkLayoutContext()
+	li := newBenchmarkGridLayoutItem(ctx, nil, 100, 10, func(parent ContainerLayoutItem, i int) LayoutItem {
+		return newBenchmarkLeaf(ctx, parent, i, false)
+	})
+	size := li.geometry.ClientSize
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		li.minSizeCache = minSizeCache{}
+		li.MinSizeForSize(size)
+	}
+}
+
+// BenchmarkGridLayoutMinSizeForSizeNested measures a deep grid of height for
+// width items, whose inner grids are asked for their height at many widths.
+func BenchmarkGridLayoutMinSizeForSizeNested(b *testing.B) {
+	ctx := newBenchmarkLayoutContext()
+
+	var newGrid func(parent ContainerLayoutItem, depth int) *gridLayoutItem
+	newGrid = func(parent ContainerLayoutItem, depth int) *gridLayoutItem {
+		return newBenchmarkGridLayoutItem(ctx, parent, 3, 3, func(parent ContainerLayoutItem, i int) LayoutItem {
+			if depth > 0 && i%2 == 0 {
+				return newGrid(parent, depth-1)
+			}
+
+			return newBenchmarkLeaf(ctx, parent, i, true)
+		})
+	}
+	li := newGrid(nil, 3)
+	size := li.geometry.ClientSize
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		resetMinSizeCaches(ctx, li)
+		li.MinSizeForSize(size)
+	}
+}
+
+func BenchmarkBoxLayoutItems(b *testing.B) {
+	ctx := newBenchmarkLayoutContext()
+	li := newBenchmarkBoxLayoutItem(ctx, 1000)
+	items := itemsToLayout(li.children)
+	bounds := Rectangle{Width: li.geometry.ClientSize.Width, Height: li.geometry.ClientSize.Height}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		boxLayoutItems(li, items, li.orientation, li.alignment, bounds, li.margins96dpi, li.vSpacing96dpi, li.hwnd2StretchFactor)
+	}
+}
+
+func BenchmarkBoxLayoutMinSizeForSize(b *testing.B) {
+	ctx := newBenchmarkLayoutContext()
+	li := newBenchmarkBoxLayoutItem(ctx, 1000)
+	size := li.geometry.ClientSize
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		li.minSizeCache = minSizeCache{}
+		li.MinSizeForSize(size)
+	}
+}
+
+func TestChildSizeLimitsInGridLayout(t *testing.T) {
+	mw, layout, first, second := newTestGrid(t)
+	defer mw.Dispose()
+
+	if err := layout.SetChildMinSize(first, Size{150, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if err := layout.SetChildMaxSize(second, Size{20, 0}); err != nil {
+		t.Fatal(err)
+	}
+
+	item, results := layoutTestGrid(layout, Size{400, 200})
+	dpi := item.Context().DPI()
+
+	if result, ok := resultFor(results, first); !ok {
+		t.Error("no result for first label")
+	} else if min := IntFrom96DPI(150, dpi); result.Bounds.Width < min {
+		t.Errorf("first label is %d pixels wide, want at least %d", result.Bounds.Width, min)
+	}
+
+	if result, ok := resultFor(results, second); !ok {
+		t.Error("no result for second label")
+	} else if max := IntFrom96DPI(20, dpi); result.Bounds.Width > max {
+		t.Errorf("second label is %d pixels wide, want at most %d", result.Bounds.Width, max)
+	}
+}
+
+func TestChildSizeLimitsRemovedWithChild(t *testing.T) {
+	mw, layout, first, _ := newTestGrid(t)
+	defer mw.Dispose()
+
+	if err := layout.SetChildMinSize(first, Size{150, 0}); err != nil {
+		t.Fatal(err)
+	}
+
+	handle := first.Handle()
+	first.Dispose()
+
+	if _, ok := layout.hwnd2ChildSizeLimits[handle]; ok {
+		t.Error("size limits of disposed child are kept")
+	}
+}
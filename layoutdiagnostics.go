@@ -0,0 +1,357 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"sync"
+
+	"github.com/lxn/win"
+)
+
+// LayoutDiagnosticsMode specifies how problems found while applying layouts
+// are reported.
+type LayoutDiagnosticsMode int
+
+const (
+	// LayoutDiagnosticsOff disables layout diagnostics.
+	LayoutDiagnosticsOff LayoutDiagnosticsMode = iota
+
+	// LayoutDiagnosticsLog reports layout problems to the problem handler,
+	// which logs them by default.
+	LayoutDiagnosticsLog
+
+	// LayoutDiagnosticsHighlight additionally flags affected widgets with a
+	// red glow.
+	LayoutDiagnosticsHighlight
+)
+
+// LayoutProblemKind identifies a layout pathology.
+type LayoutProblemKind int
+
+const (
+	// LayoutProblemClipped means a widget got less than its minimum size or
+	// was placed outside the client area of its container.
+	LayoutProblemClipped LayoutProblemKind = iota
+
+	// LayoutProblemGreedyConflict means a widget is greedy in a direction it
+	// can not grow in.
+	LayoutProblemGreedyConflict
+
+	// LayoutProblemMinExceedsMax means the minimum size of a widget is larger
+	// than its maximum size.
+	LayoutProblemMinExceedsMax
+
+	// LayoutProblemHeightForWidthOscillation means the height for the width
+	// of a widget changes between calls or flips back and forth between
+	// layouts.
+	LayoutProblemHeightForWidthOscillation
+)
+
+func (kind LayoutProblemKind) String() string {
+	switch kind {
+	case LayoutProblemClipped:
+		return "clipped"
+
+	case LayoutProblemGreedyConflict:
+		return "greedy conflict"
+
+	case LayoutProblemMinExceedsMax:
+		return "min exceeds max"
+
+	case LayoutProblemHeightForWidthOscillation:
+		return "height for width oscillation"
+	}
+
+	return fmt.Sprintf("LayoutProblemKind(%d)", int(kind))
+}
+
+// LayoutProblem describes a problem found while applying a layout.
+type LayoutProblem struct {
+	Kind    LayoutProblemKind
+	Widget  Widget
+	Path    string // describes the widget and its ancestors, e.g. `*walk.MainWindow > *walk.LineEdit "name"`
+	Message string
+	Stack   []byte // stack of the most recent call that started a layout of the form
+}
+
+func (p *LayoutProblem) String() string {
+	return fmt.Sprintf("walk: layout problem (%s): %s: %s\n\nLayout requested at:\n%s", p.Kind, p.Path, p.Message, p.Stack)
+}
+
+type layoutProblemKey struct {
+	hwnd win.HWND
+	kind LayoutProblemKind
+}
+
+type heightForWidthHistory struct {
+	width   int
+	heights [2]int
+}
+
+var (
+	layoutDiagnosticsMutex      sync.Mutex
+	layoutDiagnosticsMode       LayoutDiagnosticsMode
+	layoutProblemHandler        func(problem *LayoutProblem)
+	layoutProblemEffect         WidgetGraphicsEffect
+	layoutProblemsReported      = make(map[layoutProblemKey]string)
+	layoutProblemWidgets        = make(map[*WidgetBase]bool)
+	layoutHeightForWidthHistory = make(map[win.HWND]*heightForWidthHistory)
+)
+
+// LayoutDiagnostics returns how layout problems are reported.
+func LayoutDiagnostics() LayoutDiagnosticsMode {
+	return layoutDiagnosticsMode
+}
+
+// SetLayoutDiagnostics sets how layout problems are reported.
+//
+// While diagnostics are enabled, each applied layout is checked for widgets
+// that are clipped, greedy in a direction they can not grow in, have a
+// minimum size exceeding their maximum size or a height for width that
+// oscillates. Each problem is reported once, until it goes away.
+func SetLayoutDiagnostics(mode LayoutDiagnosticsMode) error {
+	if mode < LayoutDiagnosticsOff || mode > LayoutDiagnosticsHighlight {
+		return newError("invalid LayoutDiagnosticsMode value")
+	}
+
+	if mode == LayoutDiagnosticsHighlight && layoutProblemEffect == nil {
+		effect, err := NewBorderGlowEffect(RGB(255, 0, 0))
+		if err != nil {
+			return err
+		}
+
+		layoutProblemEffect = effect
+	}
+
+	layoutDiagnosticsMutex.Lock()
+	defer layoutDiagnosticsMutex.Unlock()
+
+	layoutDiagnosticsMode = mode
+
+	if mode != LayoutDiagnosticsHighlight {
+		for wb := range layoutProblemWidgets {
+			wb.GraphicsEffects().Remove(layoutProblemEffect)
+		}
+		layoutProblemWidgets = make(map[*WidgetBase]bool)
+	}
+
+	if mode == LayoutDiagnosticsOff {
+		layoutProblemsReported = make(map[layoutProblemKey]string)
+		layoutHeightForWidthHistory = make(map[win.HWND]*heightForWidthHistory)
+	}
+
+	return nil
+}
+
+// SetLayoutProblemHandler sets the function layout problems are reported to
+// instead of the log. Passing nil restores logging.
+func SetLayoutProblemHandler(handler func(problem *LayoutProblem)) {
+	layoutDiagnosticsMutex.Lock()
+	defer layoutDiagnosticsMutex.Unlock()
+
+	layoutProblemHandler = handler
+}
+
+// recordLayoutRequest remembers the current stack as the origin of the next
+// layout of fb, if layout diagnostics are enabled.
+func recordLayoutRequest(fb *FormBase) {
+	if layoutDiagnosticsMode == LayoutDiagnosticsOff {
+		return
+	}
+
+	fb.layoutRequestStack = debug.Stack()
+}
+
+// diagnoseLayoutResults checks the applied results for layout problems and
+// reports the ones that are new.
+func diagnoseLayoutResults(results []LayoutResult) {
+	layoutDiagnosticsMutex.Lock()
+
+	seen := make(map[win.HWND]Widget)
+	var problems []*LayoutProblem
+
+	for _, result := range results {
+		var clientSize Size
+		var scrolling bool
+		if result.container != nil {
+			clientSize = result.container.Geometry().ClientSize
+			_, scrolling = windowFromHandle(result.container.Handle()).(*ScrollView)
+		}
+
+		for _, ri := range result.items {
+			widget, ok := windowFromHandle(ri.Item.Handle()).(Widget)
+			if !ok {
+				continue
+			}
+
+			seen[widget.Handle()] = widget
+
+			for _, p := range diagnoseLayoutResultItem(ri, clientSize, scrolling) {
+				p.Widget = widget
+				problems = append(problems, p)
+			}
+		}
+	}
+
+	current := make(map[layoutProblemKey]bool)
+	var report []*LayoutProblem
+
+	for _, p := range problems {
+		key := layoutProblemKey{p.Widget.Handle(), p.Kind}
+		current[key] = true
+
+		if layoutProblemsReported[key] == p.Message {
+			continue
+		}
+		layoutProblemsReported[key] = p.Message
+
+		p.Path = widgetPath(p.Widget)
+		if form := p.Widget.Form(); form != nil {
+			p.Stack = form.AsFormBase().layoutRequestStack
+		}
+
+		report = append(report, p)
+	}
+
+	for key := range layoutProblemsReported {
+		if _, ok := seen[key.hwnd]; ok && !current[key] {
+			delete(layoutProblemsReported, key)
+		}
+	}
+
+	var flag, unflag []*WidgetBase
+	if layoutDiagnosticsMode == LayoutDiagnosticsHighlight {
+		flagged := make(map[win.HWND]bool)
+		for key := range current {
+			flagged[key.hwnd] = true
+		}
+
+		for hwnd, widget := range seen {
+			wb := widget.AsWidgetBase()
+
+			if flagged[hwnd] && !layoutProblemWidgets[wb] {
+				layoutProblemWidgets[wb] = true
+				flag = append(flag, wb)
+			} else if !flagged[hwnd] && layoutProblemWidgets[wb] {
+				delete(layoutProblemWidgets, wb)
+				unflag = append(unflag, wb)
+			}
+		}
+	}
+
+	handler := layoutProblemHandler
+
+	layoutDiagnosticsMutex.Unlock()
+
+	for _, wb := range flag {
+		wb.GraphicsEffects().Add(layoutProblemEffect)
+	}
+	for _, wb := range unflag {
+		wb.GraphicsEffects().Remove(layoutProblemEffect)
+	}
+
+	for _, p := range report {
+		if handler != nil {
+			handler(p)
+		} else {
+			log.Print(p)
+		}
+	}
+}
+
+func diagnoseLayoutResultItem(ri LayoutResultItem, clientSize Size, scrolling bool) []*LayoutProblem {
+	item := ri.Item
+	if !item.Visible() {
+		return nil
+	}
+
+	var problems []*LayoutProblem
+	add := func(kind LayoutProblemKind, format string, args ...interface{}) {
+		problems = append(problems, &LayoutProblem{Kind: kind, Message: fmt.Sprintf(format, args...)})
+	}
+
+	geometry := item.Geometry()
+	b := ri.Bounds
+
+	if max := geometry.MaxSize; max.Width > 0 && geometry.MinSize.Width > max.Width || max.Height > 0 && geometry.MinSize.Height > max.Height {
+		add(LayoutProblemMinExceedsMax, "min size %v exceeds max size %v", geometry.MinSize, max)
+	}
+
+	flags := item.LayoutFlags()
+	if flags&GreedyHorz != 0 && flags&GrowableHorz == 0 {
+		add(LayoutProblemGreedyConflict, "GreedyHorz is set, but GrowableHorz is not")
+	}
+	if flags&GreedyVert != 0 && flags&GrowableVert == 0 {
+		add(LayoutProblemGreedyConflict, "GreedyVert is set, but GrowableVert is not")
+	}
+
+	min := minSizeEffective(item)
+
+	hfw, ok := item.(HeightForWidther)
+	if ok && hfw.HasHeightForWidth() {
+		height := hfw.HeightForWidth(b.Width)
+		min.Height = height
+
+		if again := hfw.HeightForWidth(b.Width); again != height {
+			add(LayoutProblemHeightForWidthOscillation, "height for width %d is %d and %d in consecutive calls", b.Width, height, again)
+		} else if history := layoutHeightForWidthHistory[item.Handle()]; history == nil || history.width != b.Width {
+			layoutHeightForWidthHistory[item.Handle()] = &heightForWidthHistory{width: b.Width, heights: [2]int{height, height}}
+		} else {
+			if height != history.heights[1] && height == history.heights[0] {
+				add(LayoutProblemHeightForWidthOscillation, "height for width %d flips between %d and %d", b.Width, history.heights[1], height)
+			}
+
+			history.heights[0], history.heights[1] = history.heights[1], height
+		}
+	}
+
+	if b.Width < min.Width || b.Height < min.Height {
+		add(LayoutProblemClipped, "needs at least %dx%d pixels, but got %dx%d", min.Width, min.Height, b.Width, b.Height)
+	} else if !scrolling && (b.X < 0 || b.Y < 0 || b.X+b.Width > clientSize.Width || b.Y+b.Height > clientSize.Height) {
+		add(LayoutProblemClipped, "bounds %v exceed the %dx%d client area of the container", b, clientSize.Width, clientSize.Height)
+	}
+
+	return problems
+}
+
+// widgetPath describes widget and its ancestors up to the form.
+func widgetPath(widget Widget) string {
+	var parts []string
+
+	var window Window = widget
+	for window != nil {
+		parts = append(parts, describeWindow(window))
+
+		if w, ok := window.(Widget); ok && w.Parent() != nil {
+			window = w.Parent()
+		} else {
+			window = nil
+		}
+	}
+
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+
+	return strings.Join(parts, " > ")
+}
+
+// describeWindow returns the type and, if set, the name of window.
+func describeWindow(window Window) string {
+	text := reflect.TypeOf(window).String()
+
+	if name := window.Name(); name != "" {
+		text += fmt.Sprintf(" %q", name)
+	}
+
+	return text
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"github.com/lxn/win"
+)
+
+// layoutMarginsItem is implemented by the layout items, that add the layout
+// margins of a widget around its own layout item, see
+// WidgetBase.SetLayoutMargins.
+//
+// Layouts see the margins as part of the item: its sizes include them and it
+// gets bounds including them, which are reduced by applyLayoutMargins, before
+// they are applied to the widget.
+type layoutMarginsItem interface {
+	unwrap() (item LayoutItem, margins Margins)
+}
+
+// withLayoutMargins returns item wrapped in a layout item, that adds margins
+// in native pixels around it.
+func withLayoutMargins(item LayoutItem, margins Margins) LayoutItem {
+	if _, ok := item.(*spacerLayoutItem); ok {
+		return item
+	}
+
+	mi := &marginsLayoutItem{LayoutItem: item, margins: margins}
+
+	if cli, ok := item.(ContainerLayoutItem); ok {
+		return &marginsContainerLayoutItem{marginsLayoutItem: mi, ContainerLayoutItem: cli}
+	}
+
+	return mi
+}
+
+// applyLayoutMargins reduces the bounds of the results, whose items have
+// layout margins, by the margins.
+func applyLayoutMargins(results []LayoutResultItem) {
+	for i, result := range results {
+		mi, ok := result.Item.(layoutMarginsItem)
+		if !ok {
+			continue
+		}
+
+		_, margins := mi.unwrap()
+
+		b := &results[i].Bounds
+		b.X += margins.HNear
+		b.Y += margins.VNear
+		b.Width = maxi(0, b.Width-margins.HNear-margins.HFar)
+		b.Height = maxi(0, b.Height-margins.VNear-margins.VFar)
+	}
+}
+
+// addMargins returns size grown by margins.
+func addMargins(size Size, margins Margins) Size {
+	return Size{
+		size.Width + margins.HNear + margins.HFar,
+		size.Height + margins.VNear + margins.VFar,
+	}
+}
+
+type marginsLayoutItem struct {
+	LayoutItem
+	margins Margins // in native pixels
+}
+
+func (li *marginsLayoutItem) unwrap() (LayoutItem, Margins) {
+	return li.LayoutItem, li.margins
+}
+
+func (li *marginsLayoutItem) MinSize() Size {
+	var s Size
+	if ms, ok := li.LayoutItem.(MinSizer); ok {
+		s = ms.MinSize()
+	} else if is, ok := li.LayoutItem.(IdealSizer); ok {
+		s = is.IdealSize()
+	}
+
+	return addMargins(s, li.margins)
+}
+
+func (li *marginsLayoutItem) IdealSize() Size {
+	if is, ok := li.LayoutItem.(IdealSizer); ok {
+		return addMargins(is.IdealSize(), li.margins)
+	}
+
+	return li.MinSize()
+}
+
+func (li *marginsLayoutItem) HasHeightForWidth() bool {
+	hfw, ok := li.LayoutItem.(HeightForWidther)
+
+	return ok && hfw.HasHeightForWidth()
+}
+
+func (li *marginsLayoutItem) HeightForWidth(width int) int {
+	hfw := li.LayoutItem.(HeightForWidther)
+
+	return hfw.HeightForWidth(maxi(0, width-li.margins.HNear-li.margins.HFar)) + li.margins.VNear + li.margins.VFar
+}
+
+func (li *marginsLayoutItem) HasWidthForHeight() bool {
+	wfh, ok := li.LayoutItem.(WidthForHeighter)
+
+	return ok && wfh.HasWidthForHeight()
+}
+
+func (li *marginsLayoutItem) WidthForHeight(height int) int {
+	wfh := li.LayoutItem.(WidthForHeighter)
+
+	return wfh.WidthForHeight(maxi(0, height-li.margins.VNear-li.margins.VFar)) + li.margins.HNear + li.margins.HFar
+}
+
+func (li *marginsLayoutItem) BaselineOffset() int {
+	if b, ok := li.LayoutItem.(Baseliner); ok {
+		if offset := b.BaselineOffset(); offset >= 0 {
+			return offset + li.margins.VNear
+		}
+	}
+
+	return -1
+}
+
+// marginsContainerLayoutItem is the marginsLayoutItem of a container, that
+// still is a ContainerLayoutItem, so its children are laid out.
+type marginsContainerLayoutItem struct {
+	*marginsLayoutItem
+	ContainerLayoutItem
+}
+
+func (li *marginsContainerLayoutItem) AsLayoutItemBase() *LayoutItemBase {
+	return li.ContainerLayoutItem.AsLayoutItemBase()
+}
+
+func (li *marginsContainerLayoutItem) Context() *LayoutContext {
+	return li.ContainerLayoutItem.Context()
+}
+
+func (li *marginsContainerLayoutItem) Handle() win.HWND {
+	return li.ContainerLayoutItem.Handle()
+}
+
+func (li *marginsContainerLayoutItem) Geometry() *Geometry {
+	return li.ContainerLayoutItem.Geometry()
+}
+
+func (li *marginsContainerLayoutItem) Parent() ContainerLayoutItem {
+	return li.ContainerLayoutItem.Parent()
+}
+
+func (li *marginsContainerLayoutItem) Visible() bool {
+	return li.ContainerLayoutItem.Visible()
+}
+
+func (li *marginsContainerLayoutItem) LayoutFlags() LayoutFlags {
+	return li.ContainerLayoutItem.LayoutFlags()
+}
+
+func (li *marginsContainerLayoutItem) MinSize() Size {
+	return li.marginsLayoutItem.MinSize()
+}
+
+func (li *marginsContainerLayoutItem) MinSizeForSize(size Size) Size {
+	inner := Size{
+		maxi(0, size.Width-li.margins.HNear-li.margins.HFar),
+		maxi(0, size.Height-li.margins.VNear-li.margins.VFar),
+	}
+
+	return addMargins(li.ContainerLayoutItem.MinSizeForSize(inner), li.margins)
+}
+
+func (li *marginsContainerLayoutItem) HasHeightForWidth() bool {
+	return li.marginsLayoutItem.HasHeightForWidth()
+}
+
+func (li *marginsContainerLayoutItem) HeightForWidth(width int) int {
+	return li.marginsLayoutItem.HeightForWidth(width)
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"testing"
+)
+
+// newTestGrid returns a Composite in a MainWindow, that lays out two labels
+// side by side with a GridLayout without margins and spacing. Dispose of the
+// MainWindow when done.
+func newTestGrid(t *testing.T) (mw *MainWindow, layout *GridLayout, first, second *Label) {
+	t.Helper()
+
+	mw, err := NewMainWindow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fail := func(err error) {
+		mw.Dispose()
+		t.Fatal(err)
+	}
+
+	c, err := NewComposite(mw)
+	if err != nil {
+		fail(err)
+	}
+
+	layout = NewGridLayout()
+	if err := layout.SetMargins(Margins{}); err != nil {
+		fail(err)
+	}
+	if err := layout.SetSpacing(0); err != nil {
+		fail(err)
+	}
+	if err := c.SetLayout(layout); err != nil {
+		fail(err)
+	}
+
+	for i, label := range []**Label{&first, &second} {
+		if *label, err = NewLabel(c); err != nil {
+			fail(err)
+		}
+		if err := (*label).SetText("Label"); err != nil {
+			fail(err)
+		}
+		if err := layout.SetRange(*label, Rectangle{i, 0, 1, 1}); err != nil {
+			fail(err)
+		}
+	}
+
+	return mw, layout, first, second
+}
+
+// layoutTestGrid lays out the container of layout at size, which is in native
+// pixels.
+func layoutTestGrid(layout *GridLayout, size Size) (item ContainerLayoutItem, results []LayoutResultItem) {
+	item = CreateLayoutItemsForContainer(layout.Container())
+	item.Geometry().ClientSize = size
+
+	return item, item.PerformLayout()
+}
+
+func resultFor(results []LayoutResultItem, widget Widget) (LayoutResultItem, bool) {
+	for _, result := range results {
+		if result.Item.Handle() == widget.Handle() {
+			return result, true
+		}
+	}
+
+	return LayoutResultItem{}, false
+}
+
+func TestLayoutMarginsInGridLayout(t *testing.T) {
+	mw, layout, first, _ := newTestGrid(t)
+	defer mw.Dispose()
+
+	margins96dpi := Margins{4, 5, 6, 7}
+	if err := first.SetLayoutMargins(margins96dpi); err != nil {
+		t.Fatal(err)
+	}
+
+	item, results := layoutTestGrid(layout, Size{400, 200})
+
+	result, ok := resultFor(results, first)
+	if !ok {
+		t.Fatal("no result for label")
+	}
+	if _, ok := result.Item.(layoutMarginsItem); !ok {
+		t.Fatalf("grid child item is %T, want it wrapped with its layout margins", result.Item)
+	}
+
+	applyLayoutMargins(results)
+
+	result, _ = resultFor(results, first)
+	margins := MarginsFrom96DPI(margins96dpi, item.Context().DPI())
+	if result.Bounds.X < margins.HNear || result.Bounds.Y < margins.VNear {
+		t.Errorf("bounds %+v do not keep margins %+v", result.Bounds, margins)
+	}
+}
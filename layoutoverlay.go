@@ -0,0 +1,266 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/lxn/win"
+)
+
+var (
+	layoutDebugOverlay   bool
+	layoutOverlays       = make(map[win.HWND]*layoutOverlay)
+	layoutOverlayBitmaps = make(map[Color]*Bitmap)
+)
+
+const (
+	layoutOverlayMarginColor    Color = 0xFF8000 // blue
+	layoutOverlaySpaceColor     Color = 0x00C000 // green
+	layoutOverlayCellColor      Color = 0x808080 // gray
+	layoutOverlayViolationColor Color = 0x0000FF // red
+
+	layoutOverlayOpacity = 64
+)
+
+// layoutOverlay holds what the layout debug overlay shows for a container,
+// in native pixels.
+type layoutOverlay struct {
+	clientSize Size
+	margins    Margins
+	columns    []Rectangle // sections, if the layout has cells
+	rows       []Rectangle
+	items      []layoutOverlayItem
+}
+
+type layoutOverlayItem struct {
+	bounds  Rectangle
+	minSize Size
+}
+
+func (item layoutOverlayItem) violatesMinSize() bool {
+	return item.bounds.Width < item.minSize.Width || item.bounds.Height < item.minSize.Height
+}
+
+// layoutOverlayCeller is implemented by container layout items that arrange
+// their items in cells, so the overlay can show the cell boundaries.
+type layoutOverlayCeller interface {
+	// overlayCells returns the columns and rows of the last layout as
+	// Rectangles with X as position and Width as size in native pixels.
+	overlayCells() (columns, rows []Rectangle)
+}
+
+// LayoutDebugOverlay returns if the layout debug overlay is shown.
+func LayoutDebugOverlay() bool {
+	return layoutDebugOverlay
+}
+
+// SetLayoutDebugOverlay sets if the layout debug overlay is shown.
+//
+// The overlay paints the margins of containers in translucent blue and the
+// space inside them, that is not covered by widgets, like the spacing between
+// them, in translucent green. The cell boundaries of a GridLayout are outlined
+// in gray and widgets, that got less than their minimum size, are outlined in
+// red, with their minimum size shaded in translucent red.
+//
+// The overlay is updated with each layout. It is meant for debugging only.
+func SetLayoutDebugOverlay(enabled bool) {
+	if enabled == layoutDebugOverlay {
+		return
+	}
+
+	layoutDebugOverlay = enabled
+
+	if !enabled {
+		for hwnd := range layoutOverlays {
+			win.InvalidateRect(hwnd, nil, true)
+		}
+
+		layoutOverlays = make(map[win.HWND]*layoutOverlay)
+
+		for _, bmp := range layoutOverlayBitmaps {
+			bmp.Dispose()
+		}
+		layoutOverlayBitmaps = make(map[Color]*Bitmap)
+
+		return
+	}
+
+	for _, wb := range hwnd2WindowBase {
+		if form, ok := wb.window.(Form); ok {
+			form.AsFormBase().clientComposite.RequestLayout()
+		}
+	}
+}
+
+// updateLayoutOverlays records the overlays of the containers in results and
+// invalidates them.
+func updateLayoutOverlays(results []LayoutResult) {
+	for hwnd := range layoutOverlays {
+		if windowFromHandle(hwnd) == nil {
+			delete(layoutOverlays, hwnd)
+		}
+	}
+
+	for _, result := range results {
+		if result.container == nil {
+			continue
+		}
+
+		clib := result.container.AsContainerLayoutItemBase()
+
+		overlay := &layoutOverlay{
+			clientSize: clib.geometry.ClientSize,
+			margins:    MarginsFrom96DPI(clib.margins96dpi, clib.ctx.dpi),
+		}
+
+		if celler, ok := result.container.(layoutOverlayCeller); ok {
+			overlay.columns, overlay.rows = celler.overlayCells()
+		}
+
+		for _, ri := range result.items {
+			if !ri.Item.Visible() {
+				continue
+			}
+
+			min := minSizeEffective(ri.Item)
+			if hfw, ok := ri.Item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+				min.Height = hfw.HeightForWidth(ri.Bounds.Width)
+			}
+			if wfh, ok := ri.Item.(WidthForHeighter); ok && wfh.HasWidthForHeight() {
+				min.Width = wfh.WidthForHeight(ri.Bounds.Height)
+			}
+
+			overlay.items = append(overlay.items, layoutOverlayItem{ri.Bounds, min})
+		}
+
+		hwnd := result.container.Handle()
+
+		layoutOverlays[hwnd] = overlay
+
+		win.InvalidateRect(hwnd, nil, true)
+	}
+}
+
+// layoutOverlayBitmap returns a bitmap with a single pixel of color, that is
+// stretched to paint translucent areas.
+func layoutOverlayBitmap(c Color) (*Bitmap, error) {
+	if bmp, ok := layoutOverlayBitmaps[c]; ok {
+		return bmp, nil
+	}
+
+	im := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	im.Set(0, 0, color.RGBA{c.R(), c.G(), c.B(), 0xFF})
+
+	bmp, err := NewBitmapFromImageForDPI(im, 96)
+	if err != nil {
+		return nil, err
+	}
+
+	layoutOverlayBitmaps[c] = bmp
+
+	return bmp, nil
+}
+
+func fillLayoutOverlayRectangle(canvas *Canvas, c Color, bounds Rectangle) error {
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return nil
+	}
+
+	bmp, err := layoutOverlayBitmap(c)
+	if err != nil {
+		return err
+	}
+
+	return canvas.DrawBitmapWithOpacityPixels(bmp, bounds, layoutOverlayOpacity)
+}
+
+// paintLayoutOverlay paints the layout debug overlay of the container with
+// handle hwnd. Only the parts not covered by child windows are visible.
+func paintLayoutOverlay(hwnd win.HWND, canvas *Canvas) error {
+	overlay, ok := layoutOverlays[hwnd]
+	if !ok {
+		return nil
+	}
+
+	size := overlay.clientSize
+	m := overlay.margins
+
+	inner := Rectangle{m.HNear, m.VNear, size.Width - m.HNear - m.HFar, size.Height - m.VNear - m.VFar}
+
+	for _, band := range []Rectangle{
+		{0, 0, size.Width, m.VNear},
+		{0, size.Height - m.VFar, size.Width, m.VFar},
+		{0, m.VNear, m.HNear, inner.Height},
+		{size.Width - m.HFar, m.VNear, m.HFar, inner.Height},
+	} {
+		if err := fillLayoutOverlayRectangle(canvas, layoutOverlayMarginColor, band); err != nil {
+			return err
+		}
+	}
+
+	if err := fillLayoutOverlayRectangle(canvas, layoutOverlaySpaceColor, inner); err != nil {
+		return err
+	}
+
+	if len(overlay.columns) > 0 && len(overlay.rows) > 0 {
+		pen, err := NewCosmeticPen(PenDot, layoutOverlayCellColor)
+		if err != nil {
+			return err
+		}
+		defer pen.Dispose()
+
+		for _, row := range overlay.rows {
+			if row.Width <= 0 {
+				continue
+			}
+
+			for _, column := range overlay.columns {
+				if column.Width <= 0 {
+					continue
+				}
+
+				if err := canvas.DrawRectanglePixels(pen, Rectangle{column.X, row.X, column.Width, row.Width}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	var violations []layoutOverlayItem
+	for _, item := range overlay.items {
+		if item.violatesMinSize() {
+			violations = append(violations, item)
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	pen, err := NewCosmeticPen(PenSolid, layoutOverlayViolationColor)
+	if err != nil {
+		return err
+	}
+	defer pen.Dispose()
+
+	for _, item := range violations {
+		b := item.bounds
+
+		min := Rectangle{b.X, b.Y, maxi(b.Width, item.minSize.Width), maxi(b.Height, item.minSize.Height)}
+		if err := fillLayoutOverlayRectangle(canvas, layoutOverlayViolationColor, min); err != nil {
+			return err
+		}
+
+		if err := canvas.DrawRectanglePixels(pen, Rectangle{b.X - 1, b.Y - 1, b.Width + 2, b.Height + 2}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,666 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LayoutDocumentVersion is the version of the schema written by
+// SerializeLayout.
+const LayoutDocumentVersion = 1
+
+// LayoutDocument is the root of the schema written by SerializeLayout.
+type LayoutDocument struct {
+	Version int
+	Root    *LayoutNode
+}
+
+// LayoutNode describes a widget, its place in the layout of its parent and,
+// for containers, its layout and children.
+type LayoutNode struct {
+	// Type is the name of the widget type, as registered with
+	// RegisterLayoutWidgetType.
+	Type string
+
+	Name string `json:",omitempty"`
+
+	// Properties holds the values of the writable properties of simple types,
+	// like Text, Checked or Enabled.
+	Properties map[string]interface{} `json:",omitempty"`
+
+	// MinSize and MaxSize are in 1/96" units.
+	MinSize            Size        `json:",omitempty"`
+	MaxSize            Size        `json:",omitempty"`
+	Alignment          Alignment2D `json:",omitempty"`
+	LayoutMargins      Margins     `json:",omitempty"`
+	AlwaysConsumeSpace bool        `json:",omitempty"`
+
+	// Placement describes how the widget is placed in the layout of its
+	// parent.
+	Placement *LayoutPlacement `json:",omitempty"`
+
+	// Spacer holds the configuration of a Spacer.
+	Spacer *SpacerCfg `json:",omitempty"`
+
+	Layout   *LayoutSpec   `json:",omitempty"`
+	Children []*LayoutNode `json:",omitempty"`
+}
+
+// LayoutPlacement holds the parameters of a widget in the layout of its
+// parent. Sizes are in 1/96" units.
+type LayoutPlacement struct {
+	StretchFactor int        `json:",omitempty"` // BoxLayout and FlowLayout
+	Range         *Rectangle `json:",omitempty"` // GridLayout
+	Dock          Dock       `json:",omitempty"` // DockLayout
+	ChildMinSize  Size       `json:",omitempty"`
+	ChildMaxSize  Size       `json:",omitempty"`
+}
+
+// LayoutSpec describes the layout of a container. Type is one of "HBox",
+// "VBox", "Grid", "Form", "Flow" and "Dock". Sizes are in 1/96" units.
+type LayoutSpec struct {
+	Type              string
+	Margins           Margins
+	Spacing           int
+	HorizontalSpacing int         `json:",omitempty"` // if set separately
+	VerticalSpacing   int         `json:",omitempty"` // if set separately
+	Alignment         Alignment2D `json:",omitempty"`
+	BaselineAlignment bool        `json:",omitempty"`
+
+	// FormLayout
+	LabelAlignment Alignment1D `json:",omitempty"`
+
+	// GridLayout; size modes are "auto", "fixed:<size>" or "percent:<value>".
+	RowStretchFactors    []int    `json:",omitempty"`
+	ColumnStretchFactors []int    `json:",omitempty"`
+	RowSizeModes         []string `json:",omitempty"`
+	ColumnSizeModes      []string `json:",omitempty"`
+}
+
+// LayoutWidgetType describes a widget type for SerializeLayout and
+// DeserializeLayout.
+type LayoutWidgetType struct {
+	// Create creates a widget of the type in parent.
+	Create func(parent Container) (Widget, error)
+
+	// Container specifies if the layout and children of widgets of the type
+	// are serialized. It must be false for widgets, that create their
+	// children themselves.
+	Container bool
+}
+
+var layoutWidgetTypes = map[string]LayoutWidgetType{
+	"CheckBox":    {Create: func(p Container) (Widget, error) { return NewCheckBox(p) }},
+	"ComboBox":    {Create: func(p Container) (Widget, error) { return NewComboBox(p) }},
+	"Composite":   {Create: func(p Container) (Widget, error) { return NewComposite(p) }, Container: true},
+	"DateEdit":    {Create: func(p Container) (Widget, error) { return NewDateEdit(p) }},
+	"GroupBox":    {Create: func(p Container) (Widget, error) { return NewGroupBox(p) }, Container: true},
+	"ImageView":   {Create: func(p Container) (Widget, error) { return NewImageView(p) }},
+	"Label":       {Create: func(p Container) (Widget, error) { return NewLabel(p) }},
+	"LineEdit":    {Create: func(p Container) (Widget, error) { return NewLineEdit(p) }},
+	"LinkLabel":   {Create: func(p Container) (Widget, error) { return NewLinkLabel(p) }},
+	"ListBox":     {Create: func(p Container) (Widget, error) { return NewListBox(p) }},
+	"NumberEdit":  {Create: func(p Container) (Widget, error) { return NewNumberEdit(p) }},
+	"ProgressBar": {Create: func(p Container) (Widget, error) { return NewProgressBar(p) }},
+	"PushButton":  {Create: func(p Container) (Widget, error) { return NewPushButton(p) }},
+	"RadioButton": {Create: func(p Container) (Widget, error) { return NewRadioButton(p) }},
+	"ScrollView":  {Create: func(p Container) (Widget, error) { return NewScrollView(p) }, Container: true},
+	"Slider":      {Create: func(p Container) (Widget, error) { return NewSlider(p) }},
+	"TextEdit":    {Create: func(p Container) (Widget, error) { return NewTextEdit(p) }},
+	"TextLabel":   {Create: func(p Container) (Widget, error) { return NewTextLabel(p) }},
+}
+
+// RegisterLayoutWidgetType registers a widget type under name, which is the
+// name of the Go type without package, like "LineEdit", so SerializeLayout
+// can write and DeserializeLayout can create widgets of it.
+func RegisterLayoutWidgetType(name string, t LayoutWidgetType) {
+	layoutWidgetTypes[name] = t
+}
+
+// SerializeLayout returns the hierarchy of widgets in container, their layout
+// parameters and the values of their simple properties as JSON in the schema
+// of LayoutDocument.
+//
+// The widget types must be registered with RegisterLayoutWidgetType and the
+// layouts must be BoxLayout, GridLayout, FormLayout, FlowLayout or
+// DockLayout. Models, event handlers and data bindings are not serialized.
+func SerializeLayout(container Container) ([]byte, error) {
+	root, err := serializeLayoutContainer(container, &LayoutNode{Type: layoutWidgetTypeName(container), Name: container.Name()})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(&LayoutDocument{Version: LayoutDocumentVersion, Root: root}, "", "\t")
+}
+
+// DeserializeLayout is the inverse of SerializeLayout. It sets the layout of
+// container and creates the widgets described by data in it. The type and
+// properties of the root node, which describes container itself, are
+// ignored.
+func DeserializeLayout(container Container, data []byte) error {
+	var doc LayoutDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return wrapError(err)
+	}
+
+	if doc.Version < 1 || doc.Version > LayoutDocumentVersion {
+		return newError(fmt.Sprintf("unsupported layout document version: %d", doc.Version))
+	}
+	if doc.Root == nil {
+		return newError("layout document has no root")
+	}
+
+	container.SetSuspended(true)
+	defer container.SetSuspended(false)
+
+	return deserializeLayoutContainer(container, doc.Root)
+}
+
+func layoutWidgetTypeName(widget Window) string {
+	t := reflect.TypeOf(widget)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+func serializeLayoutContainer(container Container, node *LayoutNode) (*LayoutNode, error) {
+	layout := container.Layout()
+	if layout == nil {
+		return node, nil
+	}
+
+	spec, err := layoutSpecFor(layout)
+	if err != nil {
+		return nil, err
+	}
+	node.Layout = spec
+
+	children := container.Children()
+	for i := 0; i < children.Len(); i++ {
+		child, err := serializeLayoutWidget(children.At(i), layout)
+		if err != nil {
+			return nil, err
+		}
+
+		node.Children = append(node.Children, child)
+	}
+
+	return node, nil
+}
+
+func serializeLayoutWidget(widget Widget, layout Layout) (*LayoutNode, error) {
+	wb := widget.AsWidgetBase()
+
+	node := &LayoutNode{
+		Type:               layoutWidgetTypeName(widget),
+		Name:               widget.Name(),
+		MinSize:            widget.MinSize(),
+		MaxSize:            widget.MaxSize(),
+		Alignment:          widget.Alignment(),
+		LayoutMargins:      wb.LayoutMargins(),
+		AlwaysConsumeSpace: widget.AlwaysConsumeSpace(),
+		Placement:          layoutPlacementFor(widget, layout),
+	}
+
+	if s, ok := widget.(*Spacer); ok {
+		node.Spacer = &SpacerCfg{
+			LayoutFlags:       s.layoutFlags,
+			SizeHint:          s.sizeHint96dpi,
+			GreedyLocallyOnly: s.greedyLocallyOnly,
+			StretchFactor:     s.stretchFactor,
+		}
+
+		return node, nil
+	}
+
+	t, ok := layoutWidgetTypes[node.Type]
+	if !ok {
+		return nil, newError(fmt.Sprintf("unsupported widget type: %s", node.Type))
+	}
+
+	for name, p := range wb.name2Property {
+		if p.ReadOnly() || p.Source() != nil {
+			continue
+		}
+
+		if value, ok := serializablePropertyValue(p.Get()); ok {
+			if node.Properties == nil {
+				node.Properties = make(map[string]interface{})
+			}
+			node.Properties[name] = value
+		}
+	}
+
+	if container, ok := widget.(Container); ok && t.Container {
+		return serializeLayoutContainer(container, node)
+	}
+
+	return node, nil
+}
+
+func serializablePropertyValue(value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return value, true
+	}
+
+	if _, ok := value.(time.Time); ok {
+		return value, true
+	}
+
+	return nil, false
+}
+
+func layoutSpecFor(layout Layout) (*LayoutSpec, error) {
+	spec := &LayoutSpec{
+		Margins: layout.Margins(),
+		Spacing: layout.Spacing(),
+	}
+
+	if lb := layout.asLayoutBase(); lb != nil {
+		if lb.horizontalSpacingSet {
+			spec.HorizontalSpacing = lb.horizontalSpacing96dpi
+		}
+		if lb.verticalSpacingSet {
+			spec.VerticalSpacing = lb.verticalSpacing96dpi
+		}
+		spec.Alignment = lb.alignment
+	}
+
+	switch l := layout.(type) {
+	case *BoxLayout:
+		if l.Orientation() == Horizontal {
+			spec.Type = "HBox"
+		} else {
+			spec.Type = "VBox"
+		}
+		spec.BaselineAlignment = l.BaselineAlignment()
+
+	case *GridLayout:
+		spec.Type = "Grid"
+		spec.BaselineAlignment = l.BaselineAlignment()
+		spec.RowStretchFactors = append([]int(nil), l.rowStretchFactors...)
+		spec.ColumnStretchFactors = append([]int(nil), l.columnStretchFactors...)
+		for _, mode := range l.rowSizeModes {
+			spec.RowSizeModes = append(spec.RowSizeModes, mode.String())
+		}
+		for _, mode := range l.columnSizeModes {
+			spec.ColumnSizeModes = append(spec.ColumnSizeModes, mode.String())
+		}
+
+	case *FormLayout:
+		spec.Type = "Form"
+		spec.BaselineAlignment = l.BaselineAlignment()
+		spec.LabelAlignment = l.labelAlignment
+
+	case *FlowLayout:
+		spec.Type = "Flow"
+
+	case *DockLayout:
+		spec.Type = "Dock"
+
+	default:
+		return nil, newError(fmt.Sprintf("unsupported layout type: %T", layout))
+	}
+
+	return spec, nil
+}
+
+func layoutPlacementFor(widget Widget, layout Layout) *LayoutPlacement {
+	var p LayoutPlacement
+
+	switch l := layout.(type) {
+	case *BoxLayout:
+		if sf := l.StretchFactor(widget); sf != 1 {
+			p.StretchFactor = sf
+		}
+
+	case *FlowLayout:
+		if sf := l.StretchFactor(widget); sf != 1 {
+			p.StretchFactor = sf
+		}
+
+	case *GridLayout:
+		if r, ok := l.Range(widget); ok {
+			p.Range = &r
+		}
+
+	case *DockLayout:
+		p.Dock = l.Dock(widget)
+	}
+
+	if lb := layout.asLayoutBase(); lb != nil {
+		p.ChildMinSize = lb.ChildMinSize(widget)
+		p.ChildMaxSize = lb.ChildMaxSize(widget)
+	}
+
+	if reflect.DeepEqual(p, LayoutPlacement{}) {
+		return nil
+	}
+
+	return &p
+}
+
+func deserializeLayoutContainer(container Container, node *LayoutNode) error {
+	if node.Layout == nil {
+		return nil
+	}
+
+	layout, err := layoutFromSpec(node.Layout)
+	if err != nil {
+		return err
+	}
+
+	if err := container.SetLayout(layout); err != nil {
+		return err
+	}
+
+	for _, child := range node.Children {
+		if err := deserializeLayoutWidget(container, layout, child); err != nil {
+			return err
+		}
+	}
+
+	// GridLayout grows its stretch factors with the ranges set above.
+	if l, ok := layout.(*GridLayout); ok {
+		if err := applyGridLayoutSections(l, node.Layout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeLayoutWidget(parent Container, layout Layout, node *LayoutNode) error {
+	var widget Widget
+	var t LayoutWidgetType
+
+	if node.Spacer != nil {
+		s, err := NewSpacerWithCfg(parent, node.Spacer)
+		if err != nil {
+			return err
+		}
+		widget = s
+	} else {
+		var ok bool
+		if t, ok = layoutWidgetTypes[node.Type]; !ok {
+			return newError(fmt.Sprintf("unsupported widget type: %s", node.Type))
+		}
+
+		var err error
+		if widget, err = t.Create(parent); err != nil {
+			return err
+		}
+	}
+
+	widget.SetName(node.Name)
+
+	if err := widget.SetMinMaxSize(node.MinSize, node.MaxSize); err != nil {
+		return err
+	}
+	if err := widget.SetAlignment(node.Alignment); err != nil {
+		return err
+	}
+	if err := widget.AsWidgetBase().SetLayoutMargins(node.LayoutMargins); err != nil {
+		return err
+	}
+	if err := widget.SetAlwaysConsumeSpace(node.AlwaysConsumeSpace); err != nil {
+		return err
+	}
+
+	if err := applyLayoutPlacement(widget, layout, node.Placement); err != nil {
+		return err
+	}
+
+	for name, value := range node.Properties {
+		p := widget.AsWindowBase().Property(name)
+		if p == nil || p.ReadOnly() {
+			continue
+		}
+
+		v, err := propertyValueFromJSON(value, p.Get())
+		if err != nil {
+			return err
+		}
+
+		if err := p.Set(v); err != nil {
+			return err
+		}
+	}
+
+	if container, ok := widget.(Container); ok && t.Container {
+		return deserializeLayoutContainer(container, node)
+	}
+
+	return nil
+}
+
+// propertyValueFromJSON converts value as decoded from JSON to the type of
+// the current value of the property.
+func propertyValueFromJSON(value, current interface{}) (interface{}, error) {
+	if value == nil || current == nil {
+		return value, nil
+	}
+
+	if _, ok := current.(time.Time); ok {
+		s, _ := value.(string)
+
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, wrapError(err)
+		}
+
+		return t, nil
+	}
+
+	v := reflect.ValueOf(value)
+	ct := reflect.TypeOf(current)
+
+	if !v.Type().ConvertibleTo(ct) || (v.Kind() == reflect.String) != (ct.Kind() == reflect.String) {
+		return nil, newError(fmt.Sprintf("cannot convert %T to %T", value, current))
+	}
+
+	return v.Convert(ct).Interface(), nil
+}
+
+func layoutFromSpec(spec *LayoutSpec) (Layout, error) {
+	var layout Layout
+
+	switch spec.Type {
+	case "HBox":
+		l := NewHBoxLayout()
+		l.SetBaselineAlignment(spec.BaselineAlignment)
+		layout = l
+
+	case "VBox":
+		l := NewVBoxLayout()
+		l.SetBaselineAlignment(spec.BaselineAlignment)
+		layout = l
+
+	case "Grid":
+		l := NewGridLayout()
+		l.SetBaselineAlignment(spec.BaselineAlignment)
+		layout = l
+
+	case "Form":
+		l := NewFormLayout()
+		l.SetBaselineAlignment(spec.BaselineAlignment)
+		if err := l.SetLabelAlignment(spec.LabelAlignment); err != nil {
+			return nil, err
+		}
+		layout = l
+
+	case "Flow":
+		layout = NewFlowLayout()
+
+	case "Dock":
+		layout = NewDockLayout()
+
+	default:
+		return nil, newError(fmt.Sprintf("unsupported layout type: %s", spec.Type))
+	}
+
+	if err := layout.SetMargins(spec.Margins); err != nil {
+		return nil, err
+	}
+	if err := layout.SetSpacing(spec.Spacing); err != nil {
+		return nil, err
+	}
+
+	lb := layout.asLayoutBase()
+	if spec.HorizontalSpacing > 0 {
+		if err := lb.SetHorizontalSpacing(spec.HorizontalSpacing); err != nil {
+			return nil, err
+		}
+	}
+	if spec.VerticalSpacing > 0 {
+		if err := lb.SetVerticalSpacing(spec.VerticalSpacing); err != nil {
+			return nil, err
+		}
+	}
+	if err := lb.SetAlignment(spec.Alignment); err != nil {
+		return nil, err
+	}
+
+	return layout, nil
+}
+
+func applyLayoutPlacement(widget Widget, layout Layout, p *LayoutPlacement) error {
+	if p == nil {
+		return nil
+	}
+
+	switch l := layout.(type) {
+	case *BoxLayout:
+		if p.StretchFactor > 0 {
+			if err := l.SetStretchFactor(widget, p.StretchFactor); err != nil {
+				return err
+			}
+		}
+
+	case *FlowLayout:
+		if p.StretchFactor > 0 {
+			if err := l.SetStretchFactor(widget, p.StretchFactor); err != nil {
+				return err
+			}
+		}
+
+	case *GridLayout:
+		if p.Range != nil {
+			if err := l.SetRange(widget, *p.Range); err != nil {
+				return err
+			}
+		}
+
+	case *DockLayout:
+		if err := l.SetDock(widget, p.Dock); err != nil {
+			return err
+		}
+	}
+
+	lb := layout.asLayoutBase()
+	if p.ChildMinSize != (Size{}) {
+		if err := lb.SetChildMinSize(widget, p.ChildMinSize); err != nil {
+			return err
+		}
+	}
+	if p.ChildMaxSize != (Size{}) {
+		if err := lb.SetChildMaxSize(widget, p.ChildMaxSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyGridLayoutSections(l *GridLayout, spec *LayoutSpec) error {
+	for row, factor := range spec.RowStretchFactors {
+		if err := l.SetRowStretchFactor(row, factor); err != nil {
+			return err
+		}
+	}
+	for column, factor := range spec.ColumnStretchFactors {
+		if err := l.SetColumnStretchFactor(column, factor); err != nil {
+			return err
+		}
+	}
+
+	for row, s := range spec.RowSizeModes {
+		mode, err := parseGridSizeMode(s)
+		if err != nil {
+			return err
+		}
+		if err := l.SetRowSizeMode(row, mode); err != nil {
+			return err
+		}
+	}
+	for column, s := range spec.ColumnSizeModes {
+		mode, err := parseGridSizeMode(s)
+		if err != nil {
+			return err
+		}
+		if err := l.SetColumnSizeMode(column, mode); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns m as "auto", "fixed:<size>" or "percent:<value>".
+func (m GridSizeMode) String() string {
+	switch m.kind {
+	case gridSizeFixed:
+		return "fixed:" + strconv.Itoa(m.value)
+
+	case gridSizePercent:
+		return "percent:" + strconv.Itoa(m.value)
+	}
+
+	return "auto"
+}
+
+func parseGridSizeMode(s string) (GridSizeMode, error) {
+	if s == "auto" || s == "" {
+		return GridSizeAuto, nil
+	}
+
+	i := strings.IndexByte(s, ':')
+	if i == -1 {
+		return GridSizeAuto, newError("invalid grid size mode: " + s)
+	}
+
+	value, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return GridSizeAuto, newError("invalid grid size mode: " + s)
+	}
+
+	switch s[:i] {
+	case "fixed":
+		return GridSizeFixed(value), nil
+
+	case "percent":
+		return GridSizePercent(value), nil
+	}
+
+	return GridSizeAuto, newError("invalid grid size mode: " + s)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"math"
+)
+
+// Length is a physical length in 1/96" units, also known as device
+// independent pixels (DIPs), with fractions.
+//
+// Lengths let forms and drawing code be specified in the units of the
+// domain, e.g. a form field that must match a paper form in millimeters or a
+// ruler in points:
+//
+//	le.SetMinMaxSize(walk.SizeFromLengths(walk.MM(40), 0), walk.Size{})
+//	canvas.DrawLinePixels(pen, p, walk.Point{p.X + walk.Pt(12).Pixels(canvas.DPI()), p.Y})
+//
+// Layouts and the APIs taking 1/96" units scale to the DPI of the monitor
+// themselves, so the Int value is used for them. For native pixels, like with
+// a Canvas, Pixels converts exactly for the DPI.
+type Length float64
+
+// DIP returns a Length of dips 1/96" units.
+func DIP(dips float64) Length {
+	return Length(dips)
+}
+
+// Pt returns a Length of points typographic points, which are 1/72".
+func Pt(points float64) Length {
+	return Length(points * 96 / 72)
+}
+
+// MM returns a Length of mm millimeters.
+func MM(mm float64) Length {
+	return Length(mm * 96 / 25.4)
+}
+
+// CM returns a Length of cm centimeters.
+func CM(cm float64) Length {
+	return MM(cm * 10)
+}
+
+// Inch returns a Length of inches inches.
+func Inch(inches float64) Length {
+	return Length(inches * 96)
+}
+
+// DIPs returns l in 1/96" units.
+func (l Length) DIPs() float64 {
+	return float64(l)
+}
+
+// Points returns l in typographic points.
+func (l Length) Points() float64 {
+	return float64(l) * 72 / 96
+}
+
+// MM returns l in millimeters.
+func (l Length) MM() float64 {
+	return float64(l) * 25.4 / 96
+}
+
+// Inches returns l in inches.
+func (l Length) Inches() float64 {
+	return float64(l) / 96
+}
+
+// Int returns l in 1/96" units, rounded to the nearest integer.
+func (l Length) Int() int {
+	return int(math.Round(float64(l)))
+}
+
+// Pixels returns l in native pixels for dpi, rounded to the nearest integer.
+func (l Length) Pixels(dpi int) int {
+	return int(math.Round(float64(l) * float64(dpi) / 96))
+}
+
+// SizeFromLengths returns a Size in 1/96" units.
+func SizeFromLengths(width, height Length) Size {
+	return Size{width.Int(), height.Int()}
+}
+
+// SizeFromLengthsForDPI returns a Size in native pixels for dpi.
+func SizeFromLengthsForDPI(width, height Length, dpi int) Size {
+	return Size{width.Pixels(dpi), height.Pixels(dpi)}
+}
+
+// MarginsFromLengths returns Margins in 1/96" units.
+func MarginsFromLengths(hNear, vNear, hFar, vFar Length) Margins {
+	return Margins{hNear.Int(), vNear.Int(), hFar.Int(), vFar.Int()}
+}
+
+// MarginsFromLengthsForDPI returns Margins in native pixels for dpi.
+func MarginsFromLengthsForDPI(hNear, vNear, hFar, vFar Length, dpi int) Margins {
+	return Margins{hNear.Pixels(dpi), vNear.Pixels(dpi), hFar.Pixels(dpi), vFar.Pixels(dpi)}
+}
+
+// LengthFromPixels returns the Length of pixels native pixels at dpi.
+func LengthFromPixels(pixels, dpi int) Length {
+	return Length(float64(pixels) * 96 / float64(dpi))
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+)
+
+// checkDisposedAccess makes methods of windows panic, if they are called
+// after the window was disposed of, and resources panic, if they are disposed
+// of while windows still use them. It is set in builds with the walk_debug
+// tag.
+var checkDisposedAccess bool
+
+// DisposedAccessChecks returns whether use-after-dispose checks are enabled.
+func DisposedAccessChecks() bool {
+	return checkDisposedAccess
+}
+
+// SetDisposedAccessChecks sets whether use-after-dispose checks are enabled.
+//
+// If enabled, common methods like SetVisible, SetBounds or Invalidate panic
+// with the type and name of the window, if they are called after the window
+// was disposed of, instead of silently acting on an invalid handle. Disposing
+// of a Bitmap, Icon or Font, that is still used by a window, panics as well.
+//
+// The checks are meant for debugging only. They are enabled by default in
+// builds with the walk_debug tag.
+func SetDisposedAccessChecks(enabled bool) {
+	checkDisposedAccess = enabled
+}
+
+// mustNotBeDisposed panics, if use-after-dispose checks are enabled and the
+// window was disposed of.
+func (wb *WindowBase) mustNotBeDisposed(method string) {
+	if checkDisposedAccess && wb.disposed {
+		panic(fmt.Sprintf("walk: %T.%s called after window %q was disposed of", wb.window, method, wb.name))
+	}
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+//
+// Windows should use it to attach to events of longer-lived publishers, like
+// models, application-level events or other windows, so their handlers do not
+// leak and are not called after they were disposed of. All event types of
+// this package provide AttachScoped.
+func (e *Event) AttachScoped(owner Window, handler EventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+// eventHandlerScope tracks the Disposing handler of the owner of a handler,
+// that was attached with AttachScoped. Detach releases it, so handlers of
+// owners, that outlive many attach and detach cycles, do not pile up.
+type eventHandlerScope struct {
+	owner           *WindowBase
+	disposingHandle int
+}
+
+// newEventHandlerScope arranges for detach to be called when owner is
+// disposed of, or calls it immediately if it was already.
+func newEventHandlerScope(owner Window, detach func()) eventHandlerScope {
+	wb := owner.AsWindowBase()
+	if wb.disposed {
+		detach()
+		return eventHandlerScope{}
+	}
+
+	return eventHandlerScope{
+		owner:           wb,
+		disposingHandle: wb.Disposing().Attach(detach),
+	}
+}
+
+// release detaches the Disposing handler of the owner, if any.
+func (s *eventHandlerScope) release() {
+	if s.owner == nil {
+		return
+	}
+
+	s.owner.Disposing().Detach(s.disposingHandle)
+	s.owner = nil
+}
+
+// resourceRef counts the windows using a resource.
+type resourceRef struct {
+	count             int
+	disposeWhenUnused bool
+}
+
+var resourceRefs = make(map[Disposable]*resourceRef)
+
+// ResourceUseCount returns the number of times resource, e.g. an Image or
+// Font, is used by windows that were not disposed of yet.
+//
+// Uses are counted for the images of buttons and image views and the fonts
+// set with SetFont.
+func ResourceUseCount(resource Disposable) int {
+	if ref, ok := resourceRefs[resource]; ok {
+		return ref.count
+	}
+
+	return 0
+}
+
+// DisposeWhenUnused disposes of resource, e.g. an Image or Font shared
+// between widgets, as soon as no window uses it anymore, or immediately if
+// none does.
+func DisposeWhenUnused(resource Disposable) {
+	ref, ok := resourceRefs[resource]
+	if !ok || ref.count == 0 {
+		delete(resourceRefs, resource)
+		resource.Dispose()
+		return
+	}
+
+	ref.disposeWhenUnused = true
+}
+
+// replaceResource records that the window stops using prev and starts using
+// next. Both may be nil.
+func (wb *WindowBase) replaceResource(prev, next Disposable) {
+	if prev == next {
+		return
+	}
+
+	if next != nil {
+		ref, ok := resourceRefs[next]
+		if !ok {
+			ref = new(resourceRef)
+			resourceRefs[next] = ref
+		}
+		ref.count++
+
+		if wb.resources == nil {
+			wb.resources = make(map[Disposable]int)
+		}
+		wb.resources[next]++
+	}
+
+	if prev != nil && wb.resources[prev] > 0 {
+		if wb.resources[prev]--; wb.resources[prev] == 0 {
+			delete(wb.resources, prev)
+		}
+
+		releaseResource(prev, 1)
+	}
+}
+
+// releaseResources releases all resources used by the window.
+func (wb *WindowBase) releaseResources() {
+	for resource, count := range wb.resources {
+		releaseResource(resource, count)
+	}
+
+	wb.resources = nil
+}
+
+func releaseResource(resource Disposable, count int) {
+	ref, ok := resourceRefs[resource]
+	if !ok {
+		return
+	}
+
+	if ref.count -= count; ref.count > 0 {
+		return
+	}
+
+	delete(resourceRefs, resource)
+
+	if ref.disposeWhenUnused {
+		resource.Dispose()
+	}
+}
+
+// mustNotBeInUse panics, if use-after-dispose checks are enabled and resource
+// is still used by a window.
+func mustNotBeInUse(resource Disposable) {
+	if !checkDisposedAccess {
+		return
+	}
+
+	if count := ResourceUseCount(resource); count > 0 {
+		panic(fmt.Sprintf("walk: %T disposed of while still used by %d window(s), use DisposeWhenUnused instead", resource, count))
+	}
+}
@@ -352,10 +352,13 @@ func (le *LineEdit) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 		lf |= GreedyHorz
 	}
 
+	idealSize := le.sizeHintForLimit(lineEditGreedyLimit)
+
 	return &lineEditLayoutItem{
 		layoutFlags: lf,
-		idealSize:   le.sizeHintForLimit(lineEditGreedyLimit),
+		idealSize:   idealSize,
 		minSize:     le.sizeHintForLimit(lineEditMinChars),
+		baseline:    le.centeredTextBaseline(idealSize.Height),
 	}
 }
 
@@ -364,6 +367,11 @@ type lineEditLayoutItem struct {
 	layoutFlags LayoutFlags
 	idealSize   Size // in native pixels
 	minSize     Size // in native pixels
+	baseline    int  // in native pixels
+}
+
+func (li *lineEditLayoutItem) BaselineOffset() int {
+	return li.baseline
 }
 
 func (li *lineEditLayoutItem) LayoutFlags() LayoutFlags {
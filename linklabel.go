@@ -105,24 +105,43 @@ func (ll *LinkLabel) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr)
 
 type LinkLabelLinkEventHandler func(link *LinkLabelLink)
 
+type linkLabelLinkEventHandlerInfo struct {
+	handler LinkLabelLinkEventHandler
+	scope   eventHandlerScope // see AttachScoped
+}
+
 type LinkLabelLinkEvent struct {
-	handlers []LinkLabelLinkEventHandler
+	handlers []linkLabelLinkEventHandlerInfo
 }
 
 func (e *LinkLabelLinkEvent) Attach(handler LinkLabelLinkEventHandler) int {
+	handlerInfo := linkLabelLinkEventHandlerInfo{handler: handler}
+
 	for i, h := range e.handlers {
-		if h == nil {
-			e.handlers[i] = handler
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
 			return i
 		}
 	}
 
-	e.handlers = append(e.handlers, handler)
+	e.handlers = append(e.handlers, handlerInfo)
 	return len(e.handlers) - 1
 }
 
 func (e *LinkLabelLinkEvent) Detach(handle int) {
-	e.handlers[handle] = nil
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *LinkLabelLinkEvent) AttachScoped(owner Window, handler LinkLabelLinkEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
 }
 
 type LinkLabelLinkEventPublisher struct {
@@ -134,9 +153,9 @@ func (p *LinkLabelLinkEventPublisher) Event() *LinkLabelLinkEvent {
 }
 
 func (p *LinkLabelLinkEventPublisher) Publish(link *LinkLabelLink) {
-	for _, handler := range p.event.handlers {
-		if handler != nil {
-			handler(link)
+	for _, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(link)
 		}
 	}
 }
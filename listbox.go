@@ -46,6 +46,19 @@ type ListBox struct {
 	themeSelectedTextColor          Color
 	themeSelectedNotFocusedBGColor  Color
 	trackingMouseEvent              bool
+	reorderable                     bool
+	reorderDrag                     listBoxReorderDrag
+	itemsReorderedPublisher         IntRangeEventPublisher
+}
+
+// listBoxReorderDrag tracks an item dragged to a new position in a
+// reorderable ListBox.
+type listBoxReorderDrag struct {
+	tracking    bool
+	active      bool
+	index       int
+	start       Point // in native pixels
+	insertIndex int
 }
 
 func NewListBox(parent Container) (*ListBox, error) {
@@ -55,20 +68,30 @@ func NewListBox(parent Container) (*ListBox, error) {
 func NewListBoxWithStyle(parent Container, style uint32) (*ListBox, error) {
 	lb := new(ListBox)
 
+	if err := lb.init(lb, parent, style); err != nil {
+		return nil, err
+	}
+
+	return lb, nil
+}
+
+// init initializes lb as the ListBox of widget, which is either lb itself or
+// a widget embedding it.
+func (lb *ListBox) init(widget Widget, parent Container, style uint32) error {
 	err := InitWidget(
-		lb,
+		widget,
 		parent,
 		"LISTBOX",
 		win.WS_BORDER|win.WS_TABSTOP|win.WS_VISIBLE|win.WS_VSCROLL|win.WS_HSCROLL|win.LBS_NOINTEGRALHEIGHT|win.LBS_NOTIFY|style,
 		0)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	succeeded := false
 	defer func() {
 		if !succeeded {
-			lb.Dispose()
+			widget.Dispose()
 		}
 	}()
 
@@ -143,7 +166,7 @@ func NewListBoxWithStyle(parent Container, style uint32) (*ListBox, error) {
 
 	succeeded = true
 
-	return lb, nil
+	return nil
 }
 
 func (*ListBox) LayoutFlags() LayoutFlags {
@@ -204,10 +227,10 @@ func (lb *ListBox) itemString(index int) string {
 	}
 }
 
-//insert one item from list model
+// insert one item from list model
 func (lb *ListBox) insertItemAt(index int) error {
 	str := lb.itemString(index)
-	lp := uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(str)))
+	lp := uintptr(unsafe.Pointer(stringToUTF16Ptr(str)))
 	ret := int(lb.SendMessage(win.LB_INSERTSTRING, uintptr(index), lp))
 	if ret == win.LB_ERRSPACE || ret == win.LB_ERR {
 		return newError("SendMessage(LB_INSERTSTRING)")
@@ -538,7 +561,7 @@ func (lb *ListBox) calculateMaxItemTextWidth() int {
 	for i := 0; i < count; i++ {
 		item := lb.itemString(i)
 		var s win.SIZE
-		str := syscall.StringToUTF16(item)
+		str := stringToUTF16(item)
 
 		if !win.GetTextExtentPoint32(hdc, &str[0], int32(len(str)-1), &s) {
 			newError("GetTextExtentPoint32 failed")
@@ -639,8 +662,248 @@ func (lb *ListBox) ItemActivated() *Event {
 	return lb.itemActivatedPublisher.Event()
 }
 
+// Reorderable returns if the user can reorder the items of the ListBox.
+func (lb *ListBox) Reorderable() bool {
+	return lb.reorderable
+}
+
+// SetReorderable sets if the user can reorder the items of the ListBox, by
+// dragging them to a new position or by moving the current item with
+// Alt+Up and Alt+Down.
+//
+// The model must implement ListItemMover, which applies the moves. Only
+// single-selection list boxes can be reorderable.
+func (lb *ListBox) SetReorderable(reorderable bool) error {
+	if reorderable && win.GetWindowLong(lb.hWnd, win.GWL_STYLE)&(win.LBS_MULTIPLESEL|win.LBS_EXTENDEDSEL) != 0 {
+		return newError("invalid for multiple-selection ListBox")
+	}
+
+	lb.reorderable = reorderable
+
+	if !reorderable {
+		lb.endReorderDrag(false)
+	}
+
+	return nil
+}
+
+// ItemsReordered returns the event that is published after the user moved an
+// item from index from to index to.
+func (lb *ListBox) ItemsReordered() *IntRangeEvent {
+	return lb.itemsReorderedPublisher.Event()
+}
+
+// moveItem lets the model move the item at from to to and makes it current.
+func (lb *ListBox) moveItem(from, to int) error {
+	mover, ok := lb.model.(ListItemMover)
+	if !ok {
+		return newError("model does not implement ListItemMover")
+	}
+
+	if err := mover.MoveItem(from, to); err != nil {
+		return err
+	}
+
+	if err := lb.SetCurrentIndex(to); err != nil {
+		return err
+	}
+
+	lb.itemsReorderedPublisher.Publish(from, to)
+
+	return nil
+}
+
+func (lb *ListBox) itemCount() int {
+	return int(int32(lb.SendMessage(win.LB_GETCOUNT, 0, 0)))
+}
+
+// itemIndexFromPoint returns the index of the item nearest to the point in
+// native pixels and whether the point is inside the client area.
+func (lb *ListBox) itemIndexFromPoint(x, y int) (int, bool) {
+	ret := uint32(lb.SendMessage(win.LB_ITEMFROMPOINT, 0, uintptr(uint16(int16(x)))|uintptr(uint16(int16(y)))<<16))
+
+	return int(win.LOWORD(ret)), win.HIWORD(ret) == 0
+}
+
+func (lb *ListBox) itemRect(index int) win.RECT {
+	var rc win.RECT
+	lb.SendMessage(win.LB_GETITEMRECT, uintptr(index), uintptr(unsafe.Pointer(&rc)))
+
+	return rc
+}
+
+// reorderInsertIndex returns the position between the items, that the point
+// with the y coordinate in native pixels is nearest to.
+func (lb *ListBox) reorderInsertIndex(y int) int {
+	if lb.itemCount() == 0 {
+		return 0
+	}
+
+	index, _ := lb.itemIndexFromPoint(0, y)
+
+	if rc := lb.itemRect(index); int32(y) >= (rc.Top+rc.Bottom)/2 {
+		index++
+	}
+
+	return index
+}
+
+func (lb *ListBox) beginReorderTracking(lParam uintptr) {
+	x, y := int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))
+
+	index, inside := lb.itemIndexFromPoint(x, y)
+	if !inside || index >= lb.itemCount() {
+		return
+	}
+
+	if _, ok := lb.model.(ListItemMover); !ok {
+		return
+	}
+
+	lb.reorderDrag = listBoxReorderDrag{tracking: true, index: index, start: Point{x, y}, insertIndex: -1}
+}
+
+// trackReorderDrag updates the drag for a mouse move and returns true, if
+// the move must not reach the list box, which would change the selection.
+func (lb *ListBox) trackReorderDrag(wParam, lParam uintptr) bool {
+	if wParam&win.MK_LBUTTON == 0 {
+		lb.endReorderDrag(false)
+		return false
+	}
+
+	x, y := int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))
+	d := &lb.reorderDrag
+
+	if !d.active {
+		dx, dy := x-d.start.X, y-d.start.Y
+		if dx < 0 {
+			dx = -dx
+		}
+		if dy < 0 {
+			dy = -dy
+		}
+
+		if dx <= int(win.GetSystemMetrics(win.SM_CXDRAG)) && dy <= int(win.GetSystemMetrics(win.SM_CYDRAG)) {
+			return false
+		}
+
+		d.active = true
+	}
+
+	// Scroll when the mouse is dragged beyond the top or bottom.
+	if top := int(lb.SendMessage(win.LB_GETTOPINDEX, 0, 0)); y < 0 && top > 0 {
+		lb.SendMessage(win.LB_SETTOPINDEX, uintptr(top-1), 0)
+	} else if y >= lb.ClientBoundsPixels().Height {
+		lb.SendMessage(win.LB_SETTOPINDEX, uintptr(top+1), 0)
+	}
+
+	if insertIndex := lb.reorderInsertIndex(y); insertIndex != d.insertIndex {
+		d.insertIndex = insertIndex
+		lb.Invalidate()
+	}
+
+	return true
+}
+
+// endReorderDrag ends the drag and, if apply is true, moves the dragged item.
+func (lb *ListBox) endReorderDrag(apply bool) {
+	d := lb.reorderDrag
+	lb.reorderDrag = listBoxReorderDrag{}
+
+	if !d.active {
+		return
+	}
+
+	lb.Invalidate()
+
+	if !apply || d.insertIndex < 0 {
+		return
+	}
+
+	to := d.insertIndex
+	if to > d.index {
+		to--
+	}
+
+	if to != d.index {
+		lb.moveItem(d.index, to)
+	}
+}
+
+// drawInsertIndicator draws a line at the position the dragged item would be
+// inserted at.
+func (lb *ListBox) drawInsertIndicator() {
+	d := lb.reorderDrag
+	if !d.active || d.insertIndex < 0 {
+		return
+	}
+
+	var y int32
+	if count := lb.itemCount(); d.insertIndex < count {
+		y = lb.itemRect(d.insertIndex).Top
+	} else if count > 0 {
+		y = lb.itemRect(count - 1).Bottom
+	}
+
+	hdc := win.GetDC(lb.hWnd)
+	defer win.ReleaseDC(lb.hWnd, hdc)
+
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	brush, err := NewSystemColorBrush(SysColorHighlight)
+	if err != nil {
+		return
+	}
+	defer brush.Dispose()
+
+	thickness := IntFrom96DPI(2, lb.DPI())
+
+	canvas.FillRectanglePixels(brush, Rectangle{0, int(y) - thickness/2, lb.ClientBoundsPixels().Width, thickness})
+}
+
 func (lb *ListBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
 	switch msg {
+	case win.WM_PAINT:
+		if lb.reorderDrag.active {
+			ret := lb.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
+			lb.drawInsertIndicator()
+			return ret
+		}
+
+	case win.WM_LBUTTONUP:
+		if lb.reorderDrag.tracking {
+			lb.endReorderDrag(true)
+		}
+
+	case win.WM_CAPTURECHANGED:
+		if lb.reorderDrag.tracking {
+			lb.endReorderDrag(false)
+		}
+
+	case win.WM_SYSKEYDOWN:
+		if lb.reorderable {
+			var delta int
+			switch Key(wParam) {
+			case KeyUp:
+				delta = -1
+
+			case KeyDown:
+				delta = 1
+			}
+
+			if index := lb.CurrentIndex(); delta != 0 && index > -1 {
+				if to := index + delta; to >= 0 && to < lb.itemCount() {
+					lb.moveItem(index, to)
+				}
+
+				return 0
+			}
+		}
+
 	case win.WM_MEASUREITEM:
 		if lb.styler == nil {
 			break
@@ -749,7 +1012,15 @@ func (lb *ListBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) ui
 	case win.WM_LBUTTONDOWN:
 		lb.Invalidate()
 
+		if lb.reorderable {
+			lb.beginReorderTracking(lParam)
+		}
+
 	case win.WM_MOUSEMOVE:
+		if lb.reorderDrag.tracking && lb.trackReorderDrag(wParam, lParam) {
+			return 0
+		}
+
 		if lb.styler == nil {
 			break
 		}
@@ -834,6 +1105,11 @@ func (lb *ListBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) ui
 		}
 
 	case win.WM_KEYDOWN:
+		if lb.reorderDrag.active && Key(wParam) == KeyEscape {
+			lb.endReorderDrag(false)
+			return 0
+		}
+
 		if uint32(lParam)>>30 == 0 && Key(wParam) == KeyReturn && lb.CurrentIndex() > -1 {
 			lb.itemActivatedPublisher.Publish()
 		}
@@ -15,7 +15,22 @@ import (
 // #include <windows.h>
 //
 // extern void shimRunSynchronized(uintptr_t fb);
+// extern void shimMessageRetrieved(uint32_t time);
 // extern unsigned char shimHandleKeyDown(uintptr_t fb, uintptr_t m);
+// extern unsigned char shimHandleDesignModeMessage(uintptr_t m);
+//
+// static int metrics_enabled;
+// static int design_mode_active;
+//
+// static void set_metrics_enabled(int enabled)
+// {
+//     metrics_enabled = enabled;
+// }
+//
+// static void set_design_mode_active(int active)
+// {
+//     design_mode_active = active;
+// }
 //
 // static int mainloop(uintptr_t handle_ptr, uintptr_t fb_ptr)
 // {
@@ -29,6 +44,14 @@ import (
 //             return m.wParam;
 //         else if (r < 0)
 //             return -1;
+//         if (metrics_enabled)
+//             shimMessageRetrieved(m.time);
+//         if (design_mode_active &&
+//             ((m.message >= WM_MOUSEFIRST && m.message <= WM_MOUSELAST) ||
+//              (m.message >= WM_NCMOUSEMOVE && m.message <= WM_NCXBUTTONDBLCLK) ||
+//              (m.message >= WM_KEYFIRST && m.message <= WM_KEYLAST)) &&
+//             shimHandleDesignModeMessage((uintptr_t)&m))
+//             continue;
 //         if (m.message == WM_KEYDOWN && shimHandleKeyDown(fb_ptr, (uintptr_t)&m))
 //             continue;
 //         if (!IsDialogMessage(*hwnd, &m)) {
@@ -46,11 +69,44 @@ func shimHandleKeyDown(fb uintptr, msg uintptr) bool {
 	return (*FormBase)(unsafe.Pointer(fb)).handleKeyDown((*win.MSG)(unsafe.Pointer(msg)))
 }
 
+//export shimHandleDesignModeMessage
+func shimHandleDesignModeMessage(msg uintptr) bool {
+	return handleDesignModeMessage((*win.MSG)(unsafe.Pointer(msg)))
+}
+
+//export shimMessageRetrieved
+func shimMessageRetrieved(time uint32) {
+	recordMessageLatency(time)
+}
+
 //export shimRunSynchronized
 func shimRunSynchronized(fb uintptr) {
 	(*FormBase)(unsafe.Pointer(fb)).group.RunSynchronized()
 }
 
+// setMainLoopMetricsEnabled makes the C main loop call into Go for each
+// message only while metrics are enabled, so it does not pay for a cgo
+// callback otherwise.
+func setMainLoopMetricsEnabled(enabled bool) {
+	var v C.int
+	if enabled {
+		v = 1
+	}
+
+	C.set_metrics_enabled(v)
+}
+
+// setMainLoopDesignModeActive makes the C main loop pass input messages to
+// handleDesignModeMessage only while a Designer is enabled.
+func setMainLoopDesignModeActive(active bool) {
+	var v C.int
+	if active {
+		v = 1
+	}
+
+	C.set_design_mode_active(v)
+}
+
 func (fb *FormBase) mainLoop() int {
 	return int(C.mainloop(C.uintptr_t(uintptr(unsafe.Pointer(&fb.hWnd))), C.uintptr_t(uintptr(unsafe.Pointer(fb)))))
 }
@@ -12,6 +12,16 @@ import (
 	"github.com/lxn/win"
 )
 
+// setMainLoopMetricsEnabled is a no-op, because the Go main loop checks
+// metricsEnabled itself.
+func setMainLoopMetricsEnabled(enabled bool) {
+}
+
+// setMainLoopDesignModeActive is a no-op, because the Go main loop checks
+// designers itself.
+func setMainLoopDesignModeActive(active bool) {
+}
+
 func (fb *FormBase) mainLoop() int {
 	msg := (*win.MSG)(unsafe.Pointer(win.GlobalAlloc(0, unsafe.Sizeof(win.MSG{}))))
 	defer win.GlobalFree(win.HGLOBAL(unsafe.Pointer(msg)))
@@ -25,6 +35,14 @@ func (fb *FormBase) mainLoop() int {
 			return -1
 		}
 
+		if metricsEnabled {
+			recordMessageLatency(msg.Time)
+		}
+
+		if len(designers) > 0 && handleDesignModeMessage(msg) {
+			continue
+		}
+
 		switch msg.Message {
 		case win.WM_KEYDOWN:
 			if fb.handleKeyDown(msg) {
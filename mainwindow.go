@@ -7,6 +7,7 @@
 package walk
 
 import (
+	"time"
 	"unsafe"
 
 	"github.com/lxn/win"
@@ -27,10 +28,19 @@ type MainWindowCfg struct {
 
 type MainWindow struct {
 	FormBase
-	windowPlacement *win.WINDOWPLACEMENT
-	menu            *Menu
-	toolBar         *ToolBar
-	statusBar       *StatusBar
+	menu      *Menu
+	toolBar   *ToolBar
+	statusBar *StatusBar
+
+	kioskMode             bool
+	kioskWasFullscreen    bool
+	kioskWasTopmost       bool
+	kioskClosingPublisher CloseEventPublisher
+	cursorHidden          bool
+	idleTimeout           time.Duration
+	idle                  bool
+	idleTimedOutPublisher EventPublisher
+	idleEndedPublisher    EventPublisher
 }
 
 func NewMainWindow() (*MainWindow, error) {
@@ -162,63 +172,11 @@ func (mw *MainWindow) applyFont(font *Font) {
 	}
 }
 
-func (mw *MainWindow) Fullscreen() bool {
-	return win.GetWindowLong(mw.hWnd, win.GWL_STYLE)&win.WS_OVERLAPPEDWINDOW == 0
-}
-
-func (mw *MainWindow) SetFullscreen(fullscreen bool) error {
-	if fullscreen == mw.Fullscreen() {
-		return nil
-	}
-
-	if fullscreen {
-		var mi win.MONITORINFO
-		mi.CbSize = uint32(unsafe.Sizeof(mi))
-
-		if mw.windowPlacement == nil {
-			mw.windowPlacement = new(win.WINDOWPLACEMENT)
-		}
-
-		if !win.GetWindowPlacement(mw.hWnd, mw.windowPlacement) {
-			return lastError("GetWindowPlacement")
-		}
-		if !win.GetMonitorInfo(win.MonitorFromWindow(
-			mw.hWnd, win.MONITOR_DEFAULTTOPRIMARY), &mi) {
-
-			return newError("GetMonitorInfo")
-		}
-
-		if err := mw.ensureStyleBits(win.WS_OVERLAPPEDWINDOW, false); err != nil {
-			return err
-		}
-
-		if r := mi.RcMonitor; !win.SetWindowPos(
-			mw.hWnd, win.HWND_TOP,
-			r.Left, r.Top, r.Right-r.Left, r.Bottom-r.Top,
-			win.SWP_FRAMECHANGED|win.SWP_NOOWNERZORDER) {
-
-			return lastError("SetWindowPos")
-		}
-	} else {
-		if err := mw.ensureStyleBits(win.WS_OVERLAPPEDWINDOW, true); err != nil {
-			return err
-		}
-
-		if !win.SetWindowPlacement(mw.hWnd, mw.windowPlacement) {
-			return lastError("SetWindowPlacement")
-		}
-
-		if !win.SetWindowPos(mw.hWnd, 0, 0, 0, 0, 0, win.SWP_FRAMECHANGED|win.SWP_NOMOVE|
-			win.SWP_NOOWNERZORDER|win.SWP_NOSIZE|win.SWP_NOZORDER) {
-
-			return lastError("SetWindowPos")
-		}
+func (mw *MainWindow) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	if result, handled := mw.handleKioskMessage(msg, wParam, lParam); handled {
+		return result
 	}
 
-	return nil
-}
-
-func (mw *MainWindow) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
 	switch msg {
 	case win.WM_WINDOWPOSCHANGED:
 		wp := (*win.WINDOWPOS)(unsafe.Pointer(lParam))
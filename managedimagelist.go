@@ -0,0 +1,274 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"github.com/lxn/win"
+)
+
+// ManagedImageList is a list of Images, e.g. Bitmaps, Icons or FontIcons,
+// that can be shared by TableViews and TreeViews, see their SetImageList
+// methods.
+//
+// Unlike ImageList, it keeps the source images and renders them for each DPI
+// a view using it is displayed at, so the images stay sharp on high DPI
+// displays and after moving a view to another monitor. Images that depend on
+// the system colors, like FontIcons drawn in FontIconTextColor, are rendered
+// again when the theme changes.
+//
+// The index of an image stays the same for all DPIs and for the lifetime of
+// the list, so views sharing it can use the same indexes.
+//
+// Views using the list count as its users, see DisposeWhenUnused.
+type ManagedImageList struct {
+	imageSize96dpi   Size
+	images           []Image
+	image2Index      map[Image]int32
+	dpi2List         map[int]*managedImageListDPI
+	textColor        Color
+	changedPublisher EventPublisher
+}
+
+// managedImageListDPI is the native image list of a ManagedImageList for a
+// DPI, together with the number of views using it.
+type managedImageListDPI struct {
+	hIml  win.HIMAGELIST
+	users int
+}
+
+// NewManagedImageList returns a new, empty ManagedImageList for images of
+// imageSize in 1/96" units. A zero imageSize means the size of small icons.
+func NewManagedImageList(imageSize Size) (*ManagedImageList, error) {
+	if imageSize.Width < 0 || imageSize.Height < 0 {
+		return nil, newError("invalid imageSize")
+	}
+
+	if imageSize == (Size{}) {
+		imageSize = Size{
+			int(win.GetSystemMetricsForDpi(win.SM_CXSMICON, 96)),
+			int(win.GetSystemMetricsForDpi(win.SM_CYSMICON, 96)),
+		}
+	}
+
+	return &ManagedImageList{
+		imageSize96dpi: imageSize,
+		image2Index:    make(map[Image]int32),
+		dpi2List:       make(map[int]*managedImageListDPI),
+		textColor:      Color(win.GetSysColor(win.COLOR_WINDOWTEXT)),
+	}, nil
+}
+
+// ImageSize returns the size of the images in 1/96" units.
+func (l *ManagedImageList) ImageSize() Size {
+	return l.imageSize96dpi
+}
+
+// Len returns the number of images in the list.
+func (l *ManagedImageList) Len() int {
+	return len(l.images)
+}
+
+// At returns the image at index.
+func (l *ManagedImageList) At(index int) Image {
+	return l.images[index]
+}
+
+// Index returns the index of image, or -1 if it is not in the list.
+func (l *ManagedImageList) Index(image Image) int {
+	if index, ok := l.image2Index[image]; ok {
+		return int(index)
+	}
+
+	return -1
+}
+
+// Add adds image to the list and returns its index. If image already is in
+// the list, its index is returned.
+func (l *ManagedImageList) Add(image Image) (int, error) {
+	if image == nil {
+		return -1, newError("image cannot be nil")
+	}
+
+	if index, ok := l.image2Index[image]; ok {
+		return int(index), nil
+	}
+
+	index := int32(len(l.images))
+
+	for dpi, list := range l.dpi2List {
+		if err := l.render(list.hIml, -1, image, dpi); err != nil {
+			return -1, err
+		}
+	}
+
+	l.images = append(l.images, image)
+	l.image2Index[image] = index
+
+	return int(index), nil
+}
+
+// Replace replaces the image at index with image. Views showing the old image
+// are updated.
+func (l *ManagedImageList) Replace(index int, image Image) error {
+	if index < 0 || index >= len(l.images) {
+		return newError("index out of range")
+	}
+	if image == nil {
+		return newError("image cannot be nil")
+	}
+
+	for dpi, list := range l.dpi2List {
+		if err := l.render(list.hIml, int32(index), image, dpi); err != nil {
+			return err
+		}
+	}
+
+	if old := l.images[index]; l.image2Index[old] == int32(index) {
+		delete(l.image2Index, old)
+	}
+	if _, ok := l.image2Index[image]; !ok {
+		l.image2Index[image] = int32(index)
+	}
+	l.images[index] = image
+
+	l.changedPublisher.Publish()
+
+	return nil
+}
+
+// Changed returns the event that is published when images were replaced or
+// rendered again, e.g. after a theme change, so views should repaint.
+func (l *ManagedImageList) Changed() *Event {
+	return l.changedPublisher.Event()
+}
+
+// Dispose releases the native image lists. The list must not be used by any
+// view anymore, see DisposeWhenUnused.
+func (l *ManagedImageList) Dispose() {
+	mustNotBeInUse(l)
+
+	for dpi, list := range l.dpi2List {
+		win.ImageList_Destroy(list.hIml)
+		delete(l.dpi2List, dpi)
+	}
+}
+
+// indexMaybeAdd returns the index of src, which may be anything ImageFrom
+// accepts, adding it first if needed. It returns -1 for invalid images.
+func (l *ManagedImageList) indexMaybeAdd(src interface{}) int32 {
+	image, err := ImageFrom(src)
+	if err != nil || image == nil {
+		return -1
+	}
+
+	index, err := l.Add(image)
+	if err != nil {
+		wrapError(err)
+		return -1
+	}
+
+	return int32(index)
+}
+
+// acquire returns the native image list for dpi, creating it if needed, and
+// counts the calling view as its user until it calls release.
+func (l *ManagedImageList) acquire(dpi int) (win.HIMAGELIST, error) {
+	l.updateSysColorDependent()
+
+	if list, ok := l.dpi2List[dpi]; ok {
+		list.users++
+		return list.hIml, nil
+	}
+
+	size := SizeFrom96DPI(l.imageSize96dpi, dpi)
+
+	hIml := win.ImageList_Create(int32(size.Width), int32(size.Height), win.ILC_MASK|win.ILC_COLOR32, int32(maxi(len(l.images), 8)), 8)
+	if hIml == 0 {
+		return 0, newError("ImageList_Create failed")
+	}
+
+	for _, image := range l.images {
+		if err := l.render(hIml, -1, image, dpi); err != nil {
+			win.ImageList_Destroy(hIml)
+			return 0, err
+		}
+	}
+
+	l.dpi2List[dpi] = &managedImageListDPI{hIml: hIml, users: 1}
+
+	return hIml, nil
+}
+
+// release stops counting the calling view as a user of the native image list
+// for dpi and destroys it, once no view uses it anymore. Views must stop
+// using the handle before calling it.
+func (l *ManagedImageList) release(dpi int) {
+	list, ok := l.dpi2List[dpi]
+	if !ok {
+		return
+	}
+
+	if list.users--; list.users > 0 {
+		return
+	}
+
+	win.ImageList_Destroy(list.hIml)
+	delete(l.dpi2List, dpi)
+}
+
+// updateSysColorDependent renders the images that depend on the system colors
+// again, if the text color changed since they were rendered. Views call it
+// when the system colors change, so the first of them does the work.
+func (l *ManagedImageList) updateSysColorDependent() {
+	textColor := Color(win.GetSysColor(win.COLOR_WINDOWTEXT))
+	if textColor == l.textColor {
+		return
+	}
+	l.textColor = textColor
+
+	var changed bool
+
+	for i, image := range l.images {
+		if fi, ok := image.(*FontIcon); !ok || !fi.usesTextColor() {
+			continue
+		}
+
+		for dpi, list := range l.dpi2List {
+			if err := l.render(list.hIml, int32(i), image, dpi); err != nil {
+				wrapError(err)
+			}
+		}
+
+		changed = true
+	}
+
+	if changed {
+		l.changedPublisher.Publish()
+	}
+}
+
+// render draws image at the image size for dpi into hIml, replacing the image
+// at index or appending it if index is -1.
+func (l *ManagedImageList) render(hIml win.HIMAGELIST, index int32, image Image, dpi int) error {
+	bmp, err := NewBitmapFromImageWithSize(image, SizeFrom96DPI(l.imageSize96dpi, dpi))
+	if err != nil {
+		return err
+	}
+	defer bmp.Dispose()
+
+	hIcon, err := createAlphaCursorOrIconFromBitmap(bmp, Point{}, true)
+	if err != nil {
+		return err
+	}
+	defer win.DestroyIcon(hIcon)
+
+	if win.ImageList_ReplaceIcon(hIml, index, hIcon) == -1 {
+		return newError("ImageList_ReplaceIcon failed")
+	}
+
+	return nil
+}
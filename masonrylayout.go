@@ -0,0 +1,300 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+// MasonryLayout arranges the widgets in columns of equal width, like the
+// tiles of a photo gallery or a pin board. The widgets keep their heights and
+// are placed in their order, each into the column that is the shortest at
+// that point, so columns end up about equally long.
+//
+// Widgets that implement HeightForWidther, like word wrapping labels, get the
+// height they need for the column width.
+//
+// If a minimum column width is set, the number of columns is reduced as far
+// as needed for the columns to be at least that wide.
+type MasonryLayout struct {
+	LayoutBase
+	columns             int
+	minColumnWidth96dpi int
+}
+
+func NewMasonryLayout() *MasonryLayout {
+	l := &MasonryLayout{
+		LayoutBase: LayoutBase{
+			margins96dpi: Margins{9, 9, 9, 9},
+			spacing96dpi: 6,
+		},
+		columns: 3,
+	}
+	l.layout = l
+
+	return l
+}
+
+// Columns returns the number of columns, or the maximum number of columns if
+// a minimum column width is set.
+func (l *MasonryLayout) Columns() int {
+	return l.columns
+}
+
+// SetColumns sets the number of columns, or the maximum number of columns if
+// a minimum column width is set.
+func (l *MasonryLayout) SetColumns(columns int) error {
+	if columns < 1 {
+		return newError("columns must be >= 1")
+	}
+
+	if columns != l.columns {
+		l.columns = columns
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// MinColumnWidth returns the minimum width of the columns in 1/96" units, 0
+// if the number of columns is fixed.
+func (l *MasonryLayout) MinColumnWidth() int {
+	return l.minColumnWidth96dpi
+}
+
+// SetMinColumnWidth sets the minimum width of the columns in 1/96" units,
+// 0 to have a fixed number of columns.
+func (l *MasonryLayout) SetMinColumnWidth(width int) error {
+	if width < 0 {
+		return newError("width must be >= 0")
+	}
+
+	if width != l.minColumnWidth96dpi {
+		l.minColumnWidth96dpi = width
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+func (l *MasonryLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	return &masonryLayoutItem{
+		columns:        l.columns,
+		minColumnWidth: IntFrom96DPI(l.minColumnWidth96dpi, ctx.dpi),
+	}
+}
+
+type masonryLayoutItem struct {
+	ContainerLayoutItemBase
+	columns        int
+	minColumnWidth int         // in native pixels
+	overlayColumns []Rectangle // in native pixels, see SetLayoutDebugOverlay
+	overlayRows    []Rectangle
+}
+
+func (li *masonryLayoutItem) items() []LayoutItem {
+	items := make([]LayoutItem, 0, len(li.children))
+
+	for _, item := range li.children {
+		if shouldLayoutItem(item) {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// maxItemWidth returns the largest minimum width of the items.
+func (li *masonryLayoutItem) maxItemWidth(items []LayoutItem) int {
+	var width int
+
+	for _, item := range items {
+		width = maxi(width, li.MinSizeEffectiveForChild(item).Width)
+	}
+
+	return width
+}
+
+// columnCount returns the number of columns for the width available to them.
+func (li *masonryLayoutItem) columnCount(space, spacing int) int {
+	if li.minColumnWidth <= 0 {
+		return li.columns
+	}
+
+	return maxi(1, mini(li.columns, (space+spacing)/(li.minColumnWidth+spacing)))
+}
+
+// itemHeight returns the height of item in a column of width.
+func (li *masonryLayoutItem) itemHeight(item LayoutItem, width int) int {
+	if hfw, ok := item.(HeightForWidther); ok && hfw.HasHeightForWidth() {
+		return hfw.HeightForWidth(width)
+	}
+
+	height := li.MinSizeEffectiveForChild(item).Height
+
+	if item.LayoutFlags()&GrowableVert == 0 {
+		if is, ok := item.(IdealSizer); ok {
+			height = maxi(height, is.IdealSize().Height)
+		}
+	}
+
+	return height
+}
+
+// arrange places items into the columns for a layout of width. It returns
+// the bounds of the items, the x positions of the columns, the column width
+// and the height of the layout.
+func (li *masonryLayoutItem) arrange(items []LayoutItem, width int) (bounds []Rectangle, xs []int, columnWidth, height int) {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	hSpacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
+	vSpacing := IntFrom96DPI(li.spacing96dpi(Vertical), li.ctx.dpi)
+
+	space := maxi(0, width-margins.HNear-margins.HFar)
+	columns := li.columnCount(space, hSpacing)
+	columnWidth = maxi(li.maxItemWidth(items), (space-(columns-1)*hSpacing)/columns)
+
+	xs = make([]int, columns)
+	ys := make([]int, columns)
+	for i := range xs {
+		xs[i] = margins.HNear + i*(columnWidth+hSpacing)
+		ys[i] = margins.VNear
+	}
+
+	bounds = make([]Rectangle, len(items))
+
+	for i, item := range items {
+		shortest := 0
+		for col := 1; col < columns; col++ {
+			if ys[col] < ys[shortest] {
+				shortest = col
+			}
+		}
+
+		h := li.itemHeight(item, columnWidth)
+
+		bounds[i] = Rectangle{xs[shortest], ys[shortest], columnWidth, h}
+
+		ys[shortest] += h + vSpacing
+	}
+
+	var bottom int
+	for _, y := range ys {
+		bottom = maxi(bottom, y)
+	}
+	if len(items) > 0 {
+		// The spacing after the last item of the longest column.
+		bottom -= vSpacing
+	}
+
+	return bounds, xs, columnWidth, bottom + margins.VFar
+}
+
+// minWidth returns the width of the layout, at which the columns are as wide
+// as the widest widget.
+func (li *masonryLayoutItem) minWidth(items []LayoutItem, itemWidth int) int {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	hSpacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
+
+	columns := li.columns
+	if li.minColumnWidth > 0 {
+		// As narrow as a single column.
+		columns = 1
+		itemWidth = maxi(itemWidth, li.minColumnWidth)
+	}
+
+	return margins.HNear + margins.HFar + columns*itemWidth + (columns-1)*hSpacing
+}
+
+func (li *masonryLayoutItem) LayoutFlags() LayoutFlags {
+	flags := ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert
+
+	for _, item := range li.children {
+		if shouldLayoutItem(item) {
+			flags |= item.LayoutFlags() & (GreedyHorz | GreedyVert)
+		}
+	}
+
+	return flags
+}
+
+// IdealSize returns the size at which the columns are as wide as the widest
+// ideal width of the widgets.
+func (li *masonryLayoutItem) IdealSize() Size {
+	items := li.items()
+
+	var itemWidth int
+	for _, item := range items {
+		if is, ok := item.(IdealSizer); ok {
+			itemWidth = maxi(itemWidth, is.IdealSize().Width)
+		}
+	}
+	itemWidth = maxi(itemWidth, li.maxItemWidth(items))
+
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	hSpacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
+
+	width := margins.HNear + margins.HFar + li.columns*maxi(itemWidth, li.minColumnWidth) + (li.columns-1)*hSpacing
+
+	return Size{width, li.HeightForWidth(width)}
+}
+
+func (li *masonryLayoutItem) MinSize() Size {
+	return li.MinSizeForSize(li.geometry.ClientSize)
+}
+
+func (li *masonryLayoutItem) MinSizeForSize(size Size) Size {
+	items := li.items()
+
+	width := li.minWidth(items, li.maxItemWidth(items))
+
+	return Size{width, li.HeightForWidth(maxi(width, size.Width))}
+}
+
+func (li *masonryLayoutItem) HasHeightForWidth() bool {
+	return true
+}
+
+func (li *masonryLayoutItem) HeightForWidth(width int) int {
+	_, _, _, height := li.arrange(li.items(), width)
+
+	return height
+}
+
+func (li *masonryLayoutItem) PerformLayout() []LayoutResultItem {
+	items := li.items()
+	if len(items) == 0 {
+		return nil
+	}
+
+	clientSize := li.geometry.ClientSize
+
+	bounds, xs, columnWidth, _ := li.arrange(items, clientSize.Width)
+
+	if layoutDebugOverlay {
+		li.overlayColumns = make([]Rectangle, len(xs))
+		for i, x := range xs {
+			li.overlayColumns[i] = Rectangle{X: x, Width: columnWidth}
+		}
+
+		margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+		li.overlayRows = []Rectangle{{X: margins.VNear, Width: clientSize.Height - margins.VNear - margins.VFar}}
+	}
+
+	results := make([]LayoutResultItem, len(items))
+
+	for i, item := range items {
+		results[i] = LayoutResultItem{Item: item, Bounds: bounds[i]}
+	}
+
+	return results
+}
+
+func (li *masonryLayoutItem) overlayCells() (columns, rows []Rectangle) {
+	return li.overlayColumns, li.overlayRows
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+// MenuItemStater is the interface that the items of a TreeModel bound to a
+// menu by a MenuModelBinding may implement to provide the state of their menu
+// items.
+type MenuItemStater interface {
+	// Enabled returns if the menu item can be triggered.
+	Enabled() bool
+
+	// Checkable returns if the menu item shows a check mark when checked.
+	Checkable() bool
+
+	// Checked returns if the menu item is checked.
+	Checked() bool
+}
+
+// MenuModelBinding keeps a section of the actions of a Menu in sync with the
+// items of a TreeModel, e.g. for a list of open windows, recently used files
+// or commands contributed by plugins.
+//
+// Each root item of the model becomes an action in the section. Items with
+// children become submenus. Items with the text "-" become separators. Items
+// may implement Imager to have an image and MenuItemStater to be disabled or
+// checked.
+//
+// The section is rebuilt when the model publishes ItemsReset, ItemInserted or
+// ItemRemoved. ItemChanged updates the action of the item in place.
+type MenuModelBinding struct {
+	menu                      *Menu
+	after                     *Action
+	model                     TreeModel
+	actions                   []*Action
+	item2Action               map[TreeItem]*Action
+	itemsResetHandlerHandle   int
+	itemChangedHandlerHandle  int
+	itemInsertedHandlerHandle int
+	itemRemovedHandlerHandle  int
+	itemTriggeredPublisher    TreeItemEventPublisher
+}
+
+// NewMenuModelBinding fills a section of menu with actions for the items of
+// model and keeps it in sync with it. The section starts after the action
+// after, or at the beginning of menu if after is nil, so a menu can have
+// static actions around the section and multiple sections.
+func NewMenuModelBinding(menu *Menu, after *Action, model TreeModel) (*MenuModelBinding, error) {
+	if menu == nil {
+		return nil, newError("menu cannot be nil")
+	}
+	if model == nil {
+		return nil, newError("model cannot be nil")
+	}
+	if after != nil && !menu.Actions().Contains(after) {
+		return nil, newError("after must be an action of menu")
+	}
+
+	b := &MenuModelBinding{
+		menu:  menu,
+		after: after,
+		model: model,
+	}
+
+	if err := b.rebuild(); err != nil {
+		b.removeActions()
+		return nil, err
+	}
+
+	b.itemsResetHandlerHandle = model.ItemsReset().Attach(func(parent TreeItem) {
+		b.onStructureChanged()
+	})
+	b.itemChangedHandlerHandle = model.ItemChanged().Attach(b.onItemChanged)
+	b.itemInsertedHandlerHandle = model.ItemInserted().Attach(func(item TreeItem) {
+		b.onStructureChanged()
+	})
+	b.itemRemovedHandlerHandle = model.ItemRemoved().Attach(func(item TreeItem) {
+		b.onStructureChanged()
+	})
+
+	return b, nil
+}
+
+// Dispose detaches the binding from its model and removes its actions from
+// the menu.
+func (b *MenuModelBinding) Dispose() {
+	if b.model == nil {
+		return
+	}
+
+	b.detach()
+
+	if !b.menu.IsDisposed() {
+		b.removeActions()
+	}
+}
+
+func (b *MenuModelBinding) detach() {
+	b.model.ItemsReset().Detach(b.itemsResetHandlerHandle)
+	b.model.ItemChanged().Detach(b.itemChangedHandlerHandle)
+	b.model.ItemInserted().Detach(b.itemInsertedHandlerHandle)
+	b.model.ItemRemoved().Detach(b.itemRemovedHandlerHandle)
+
+	b.model = nil
+}
+
+// Model returns the model of the binding, or nil if it was disposed.
+func (b *MenuModelBinding) Model() TreeModel {
+	return b.model
+}
+
+// ItemTriggered returns the event that is published when the action of an
+// item is triggered.
+//
+// The state of a checkable action is restored from the item afterwards, so
+// handlers should update the item and publish ItemChanged to toggle it.
+func (b *MenuModelBinding) ItemTriggered() *TreeItemEvent {
+	return b.itemTriggeredPublisher.Event()
+}
+
+func (b *MenuModelBinding) onStructureChanged() {
+	if b.menu.IsDisposed() {
+		b.detach()
+		return
+	}
+
+	if err := b.rebuild(); err != nil {
+		wrapError(err)
+	}
+}
+
+func (b *MenuModelBinding) onItemChanged(item TreeItem) {
+	if b.menu.IsDisposed() {
+		b.detach()
+		return
+	}
+
+	action, ok := b.item2Action[item]
+	if !ok {
+		return
+	}
+
+	if action.IsSeparator() != (item.Text() == "-") || (action.menu != nil) != (item.ChildCount() > 0) {
+		b.onStructureChanged()
+		return
+	}
+
+	if err := b.updateAction(action, item); err != nil {
+		wrapError(err)
+	}
+}
+
+// rebuild replaces the actions of the section.
+func (b *MenuModelBinding) rebuild() error {
+	b.removeActions()
+
+	b.item2Action = make(map[TreeItem]*Action)
+
+	index := 0
+	if b.after != nil {
+		if index = b.menu.Actions().Index(b.after) + 1; index == 0 {
+			return newError("the action the section starts after was removed")
+		}
+	}
+
+	count := b.model.RootCount()
+	for i := 0; i < count; i++ {
+		action, err := b.newAction(b.model.RootAt(i))
+		if err != nil {
+			return err
+		}
+
+		if err := b.menu.Actions().Insert(index+i, action); err != nil {
+			return err
+		}
+
+		b.actions = append(b.actions, action)
+	}
+
+	return nil
+}
+
+func (b *MenuModelBinding) removeActions() {
+	for _, action := range b.actions {
+		b.menu.Actions().Remove(action)
+	}
+
+	b.actions = nil
+}
+
+// newAction returns a new action for item, with a submenu for its children.
+func (b *MenuModelBinding) newAction(item TreeItem) (*Action, error) {
+	if item.Text() == "-" {
+		return NewSeparatorAction(), nil
+	}
+
+	var action *Action
+
+	if count := item.ChildCount(); count > 0 {
+		menu, err := NewMenu()
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < count; i++ {
+			childAction, err := b.newAction(item.ChildAt(i))
+			if err != nil {
+				menu.Dispose()
+				return nil, err
+			}
+
+			if err := menu.Actions().Add(childAction); err != nil {
+				menu.Dispose()
+				return nil, err
+			}
+		}
+
+		action = NewMenuAction(menu)
+	} else {
+		action = NewAction()
+
+		action.Triggered().Attach(func() {
+			b.itemTriggeredPublisher.Publish(item)
+
+			if b.model != nil {
+				b.updateAction(action, item)
+			}
+		})
+	}
+
+	if err := b.updateAction(action, item); err != nil {
+		return nil, err
+	}
+
+	b.item2Action[item] = action
+
+	return action, nil
+}
+
+// updateAction applies the text, image and state of item to action.
+func (b *MenuModelBinding) updateAction(action *Action, item TreeItem) error {
+	if err := action.SetText(item.Text()); err != nil {
+		return err
+	}
+
+	if imager, ok := item.(Imager); ok {
+		var img Image
+		if src := imager.Image(); src != nil {
+			img, _ = ImageFrom(src)
+		}
+
+		if err := action.SetImage(img); err != nil {
+			return err
+		}
+	}
+
+	if stater, ok := item.(MenuItemStater); ok {
+		if err := action.SetEnabled(stater.Enabled()); err != nil {
+			return err
+		}
+		if err := action.SetCheckable(stater.Checkable()); err != nil {
+			return err
+		}
+		if err := action.SetChecked(stater.Checked()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,441 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lxn/win"
+)
+
+const metricsHUDWindowClass = `\o/ Walk_MetricsHUD_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClass(metricsHUDWindowClass)
+	})
+}
+
+// maxMessageLatencySamples is the number of most recent message latencies
+// percentiles are computed from.
+const maxMessageLatencySamples = 1024
+
+// DurationStats summarizes a series of measured durations.
+type DurationStats struct {
+	Count int64
+	Total time.Duration
+	Min   time.Duration
+	Max   time.Duration
+	Last  time.Duration
+}
+
+// Average returns the average duration, or 0 if nothing was measured.
+func (ds DurationStats) Average() time.Duration {
+	if ds.Count == 0 {
+		return 0
+	}
+
+	return ds.Total / time.Duration(ds.Count)
+}
+
+func (ds *DurationStats) add(d time.Duration) {
+	ds.Count++
+	ds.Total += d
+	if d < ds.Min || ds.Count == 1 {
+		ds.Min = d
+	}
+	if d > ds.Max {
+		ds.Max = d
+	}
+	ds.Last = d
+}
+
+// WidgetPaintMetrics holds the time a widget spent painting.
+type WidgetPaintMetrics struct {
+	DurationStats
+	Widget Widget
+	Path   string // describes the widget and its ancestors, e.g. `*walk.MainWindow > *walk.TableView "items"`
+}
+
+// LatencyMetrics holds percentiles of the time messages waited in the
+// message queue before being retrieved by the message loop. Message times
+// have the resolution of the system timer, typically 10 to 16 milliseconds.
+type LatencyMetrics struct {
+	Samples int
+	P50     time.Duration
+	P90     time.Duration
+	P99     time.Duration
+	Max     time.Duration
+}
+
+// PerformanceMetrics is a snapshot of the performance counters, see Metrics.
+type PerformanceMetrics struct {
+	// Paint holds the paint times of widgets, sorted by total time in
+	// descending order.
+	Paint []WidgetPaintMetrics
+
+	// LayoutComputation holds the durations of computing layouts of forms,
+	// which happens in the background.
+	LayoutComputation DurationStats
+
+	// LayoutApplication holds the durations of applying computed layouts to
+	// the widgets of forms.
+	LayoutApplication DurationStats
+
+	// MessageLatency holds the latencies of the most recent messages.
+	MessageLatency LatencyMetrics
+}
+
+func (pm *PerformanceMetrics) String() string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Layout    computed %5dx  avg %8s  max %8s\n", pm.LayoutComputation.Count, formatMetricsDuration(pm.LayoutComputation.Average()), formatMetricsDuration(pm.LayoutComputation.Max))
+	fmt.Fprintf(&buf, "          applied  %5dx  avg %8s  max %8s\n", pm.LayoutApplication.Count, formatMetricsDuration(pm.LayoutApplication.Average()), formatMetricsDuration(pm.LayoutApplication.Max))
+
+	ml := pm.MessageLatency
+	fmt.Fprintf(&buf, "Messages  p50 %s  p90 %s  p99 %s  max %s  (%d samples)\n", formatMetricsDuration(ml.P50), formatMetricsDuration(ml.P90), formatMetricsDuration(ml.P99), formatMetricsDuration(ml.Max), ml.Samples)
+
+	fmt.Fprintf(&buf, "Paint     %8s  %8s  %8s  %6s  widget\n", "total", "avg", "max", "count")
+	for i, p := range pm.Paint {
+		if i == 10 {
+			fmt.Fprintf(&buf, "          ... %d more\n", len(pm.Paint)-i)
+			break
+		}
+
+		fmt.Fprintf(&buf, "          %8s  %8s  %8s  %6d  %s\n", formatMetricsDuration(p.Total), formatMetricsDuration(p.Average()), formatMetricsDuration(p.Max), p.Count, describeWindow(p.Widget))
+	}
+
+	return buf.String()
+}
+
+func formatMetricsDuration(d time.Duration) string {
+	return fmt.Sprintf("%.2fms", float64(d)/float64(time.Millisecond))
+}
+
+var (
+	metricsMutex         sync.Mutex
+	metricsEnabled       bool
+	metricsPaint         = make(map[win.HWND]*DurationStats)
+	metricsLayoutCompute DurationStats
+	metricsLayoutApply   DurationStats
+	metricsLatencies     []time.Duration
+	metricsLatencyNext   int
+	theMetricsHUD        *metricsHUD
+)
+
+// MetricsEnabled returns if the performance counters are collected.
+func MetricsEnabled() bool {
+	return metricsEnabled
+}
+
+// SetMetricsEnabled sets if the performance counters are collected. They
+// are disabled by default, because measuring adds a little overhead to each
+// message and paint.
+//
+// Disabling the counters keeps the values collected so far, use
+// ResetMetrics to clear them.
+func SetMetricsEnabled(enabled bool) {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	metricsEnabled = enabled
+
+	setMainLoopMetricsEnabled(enabled)
+}
+
+// ResetMetrics clears the performance counters.
+func ResetMetrics() {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	metricsPaint = make(map[win.HWND]*DurationStats)
+	metricsLayoutCompute = DurationStats{}
+	metricsLayoutApply = DurationStats{}
+	metricsLatencies = nil
+	metricsLatencyNext = 0
+}
+
+// Metrics returns a snapshot of the performance counters, which are
+// collected while enabled via SetMetricsEnabled. They help finding the
+// widgets that are slow to paint, forms that are slow to lay out and code
+// that blocks the message loop.
+func Metrics() *PerformanceMetrics {
+	metricsMutex.Lock()
+
+	pm := &PerformanceMetrics{
+		LayoutComputation: metricsLayoutCompute,
+		LayoutApplication: metricsLayoutApply,
+	}
+
+	for hwnd, stats := range metricsPaint {
+		widget, ok := windowFromHandle(hwnd).(Widget)
+		if !ok {
+			delete(metricsPaint, hwnd)
+			continue
+		}
+
+		pm.Paint = append(pm.Paint, WidgetPaintMetrics{DurationStats: *stats, Widget: widget})
+	}
+
+	latencies := append([]time.Duration(nil), metricsLatencies...)
+
+	metricsMutex.Unlock()
+
+	for i := range pm.Paint {
+		pm.Paint[i].Path = widgetPath(pm.Paint[i].Widget)
+	}
+
+	sort.Slice(pm.Paint, func(i, j int) bool {
+		return pm.Paint[i].Total > pm.Paint[j].Total
+	})
+
+	if n := len(latencies); n > 0 {
+		sort.Slice(latencies, func(i, j int) bool {
+			return latencies[i] < latencies[j]
+		})
+
+		percentile := func(p int) time.Duration {
+			return latencies[(n-1)*p/100]
+		}
+
+		pm.MessageLatency = LatencyMetrics{
+			Samples: n,
+			P50:     percentile(50),
+			P90:     percentile(90),
+			P99:     percentile(99),
+			Max:     latencies[n-1],
+		}
+	}
+
+	return pm
+}
+
+func recordPaint(hwnd win.HWND, d time.Duration) {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	stats, ok := metricsPaint[hwnd]
+	if !ok {
+		stats = new(DurationStats)
+		metricsPaint[hwnd] = stats
+	}
+
+	stats.add(d)
+}
+
+func recordLayoutComputation(d time.Duration) {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	metricsLayoutCompute.add(d)
+}
+
+func recordLayoutApplication(d time.Duration) {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	metricsLayoutApply.add(d)
+}
+
+// recordMessageLatency records the latency of a message that was posted at
+// msgTime, in milliseconds since system start like GetTickCount.
+func recordMessageLatency(msgTime uint32) {
+	// Unsigned subtraction handles the wrap around after 49.7 days.
+	latency := time.Duration(getTickCount()-msgTime) * time.Millisecond
+
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	if len(metricsLatencies) < maxMessageLatencySamples {
+		metricsLatencies = append(metricsLatencies, latency)
+	} else {
+		metricsLatencies[metricsLatencyNext] = latency
+		metricsLatencyNext = (metricsLatencyNext + 1) % maxMessageLatencySamples
+	}
+}
+
+// ShowMetricsHUD enables the performance counters and shows them live in a
+// small click-through window in the top right corner of the primary
+// monitor.
+func ShowMetricsHUD() error {
+	SetMetricsEnabled(true)
+
+	if theMetricsHUD == nil {
+		hud, err := newMetricsHUD()
+		if err != nil {
+			return err
+		}
+
+		theMetricsHUD = hud
+	}
+
+	theMetricsHUD.refresh()
+
+	if 0 == win.SetTimer(theMetricsHUD.hWnd, metricsHUDTimerId, uint32(metricsHUDRefreshPeriod/time.Millisecond), 0) {
+		return lastError("SetTimer")
+	}
+
+	return nil
+}
+
+// HideMetricsHUD hides the window shown by ShowMetricsHUD. The performance
+// counters stay enabled.
+func HideMetricsHUD() {
+	if theMetricsHUD == nil {
+		return
+	}
+
+	theMetricsHUD.Dispose()
+	theMetricsHUD = nil
+}
+
+// MetricsHUDVisible returns if the window shown by ShowMetricsHUD is
+// visible.
+func MetricsHUDVisible() bool {
+	return theMetricsHUD != nil
+}
+
+const (
+	metricsHUDTimerId       = 1
+	metricsHUDRefreshPeriod = 500 * time.Millisecond
+)
+
+// metricsHUD is a click-through, top-most window that displays the
+// performance counters.
+type metricsHUD struct {
+	WindowBase
+	text string
+	font *Font
+}
+
+func newMetricsHUD() (*metricsHUD, error) {
+	hud := new(metricsHUD)
+
+	if err := InitWindow(
+		hud,
+		nil,
+		metricsHUDWindowClass,
+		win.WS_POPUP,
+		win.WS_EX_LAYERED|win.WS_EX_TRANSPARENT|win.WS_EX_TOOLWINDOW|win.WS_EX_TOPMOST|win.WS_EX_NOACTIVATE); err != nil {
+		return nil, err
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			hud.Dispose()
+		}
+	}()
+
+	if !setLayeredWindowAttributes(hud.hWnd, 0, 208, lwaAlpha) {
+		return nil, lastError("SetLayeredWindowAttributes")
+	}
+
+	var err error
+	if hud.font, err = NewFont("Consolas", 9, 0); err != nil {
+		return nil, err
+	}
+
+	succeeded = true
+
+	return hud, nil
+}
+
+func (hud *metricsHUD) Dispose() {
+	if hud.hWnd != 0 {
+		win.KillTimer(hud.hWnd, metricsHUDTimerId)
+	}
+
+	hud.WindowBase.Dispose()
+
+	if hud.font != nil {
+		hud.font.Dispose()
+		hud.font = nil
+	}
+}
+
+// refresh takes a new snapshot of the counters and resizes the window to fit
+// it.
+func (hud *metricsHUD) refresh() {
+	hud.text = Metrics().String()
+
+	canvas, err := hud.CreateCanvas()
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	bounds, _, err := canvas.MeasureTextPixels(hud.text, hud.font, Rectangle{Width: 10000, Height: 10000}, TextLeft|TextNoPrefix)
+	if err != nil {
+		return
+	}
+
+	padding := hud.IntFrom96DPI(6)
+	width, height := bounds.Width+2*padding, bounds.Height+2*padding
+
+	workArea := PrimaryMonitor().WorkAreaPixels()
+	x := workArea.X + workArea.Width - width - padding
+	y := workArea.Y + padding
+
+	win.SetWindowPos(hud.hWnd, win.HWND_TOPMOST, int32(x), int32(y), int32(width), int32(height), win.SWP_NOACTIVATE|win.SWP_SHOWWINDOW)
+	hud.Invalidate()
+}
+
+func (hud *metricsHUD) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_ERASEBKGND:
+		return 1
+
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+
+		hdc := win.BeginPaint(hwnd, &ps)
+		defer win.EndPaint(hwnd, &ps)
+
+		hud.paint(hdc)
+
+		return 0
+
+	case win.WM_TIMER:
+		if wParam == metricsHUDTimerId {
+			hud.refresh()
+			return 0
+		}
+	}
+
+	return hud.WindowBase.WndProc(hwnd, msg, wParam, lParam)
+}
+
+func (hud *metricsHUD) paint(hdc win.HDC) {
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	bounds := hud.ClientBoundsPixels()
+
+	bgBrush, err := NewSolidColorBrush(RGB(32, 32, 32))
+	if err != nil {
+		return
+	}
+	defer bgBrush.Dispose()
+
+	canvas.FillRectanglePixels(bgBrush, bounds)
+
+	padding := hud.IntFrom96DPI(6)
+	bounds.X += padding
+	bounds.Y += padding
+	bounds.Width -= 2 * padding
+	bounds.Height -= 2 * padding
+
+	canvas.DrawTextPixels(hud.text, hud.font, RGB(144, 238, 144), bounds, TextLeft|TextNoPrefix)
+}
@@ -306,6 +306,50 @@ type ImageProvider interface {
 	Image(index int) interface{}
 }
 
+// RowHeighter is the interface that a model may implement to make the rows of
+// a TableView taller than a single line of text, e.g. for multi-line columns.
+//
+// The list view control the TableView is built on requires all rows to have
+// the same height, so the rows get the largest height returned. To keep this
+// affordable for large and virtual models, only the first 1000 rows are asked
+// for their height, whenever rows were changed.
+type RowHeighter interface {
+	// RowHeight returns the height in 1/96" units, that the row at index
+	// needs.
+	RowHeight(index int) int
+}
+
+// ListItemKind specifies how a widget like ComboBox presents an item.
+type ListItemKind int
+
+const (
+	// ListItemNormal is a regular item, that can be selected.
+	ListItemNormal ListItemKind = iota
+
+	// ListItemSeparator is drawn as a horizontal line and cannot be selected.
+	ListItemSeparator
+
+	// ListItemGroupHeader is drawn in bold and cannot be selected. It titles
+	// the items following it.
+	ListItemGroupHeader
+)
+
+// ListItemKindProvider is the interface that a list model may implement to
+// have separators and group headers among its items.
+type ListItemKindProvider interface {
+	// ItemKind returns the kind of the item at index index.
+	ItemKind(index int) ListItemKind
+}
+
+// ListItemMover is the interface that a list model must implement to have its
+// items reordered by the user, e.g. in a reorderable ListBox.
+type ListItemMover interface {
+	// MoveItem moves the item at index from to index to, shifting the items
+	// in between, and publishes the ItemsReset event or the ItemsRemoved and
+	// ItemsInserted events.
+	MoveItem(from, to int) error
+}
+
 // CellStyler is the interface that must be implemented to provide a tabular
 // widget like TableView with cell display style information.
 type CellStyler interface {
@@ -526,6 +570,41 @@ type ItemChecker interface {
 	SetChecked(index int, checked bool) error
 }
 
+// ItemCheckStater is the interface that a model may implement to provide the
+// check states of the items of a widget like CheckedListBox, including the
+// indeterminate state. It takes precedence over ItemChecker.
+type ItemCheckStater interface {
+	// CheckState returns the check state of the specified item.
+	CheckState(index int) CheckState
+
+	// SetCheckState sets the check state of the specified item.
+	SetCheckState(index int, state CheckState) error
+}
+
+// CellValueSetter is the interface that a model must implement to support
+// pasting into a widget like TableView, see TableView.Paste.
+type CellValueSetter interface {
+	// SetValue sets the value of the specified cell from text. It returns an
+	// error, if text is not valid for the cell. The model should publish
+	// RowChanged for changed rows.
+	SetValue(row, col int, text string) error
+}
+
+// RowAppender is the interface that a model may implement to let a widget like
+// TableView add rows to it, e.g. for pasted rows that do not fit in.
+type RowAppender interface {
+	// AppendRows appends count empty rows and publishes RowsInserted.
+	AppendRows(count int) error
+}
+
+// CellToolTipProvider is the interface that a model may implement to provide
+// the tool tips of the cells of a TableView.
+type CellToolTipProvider interface {
+	// CellToolTip returns the tool tip text of the cell at row and col. If it
+	// returns "", the TableView shows the text of the cell, if it is clipped.
+	CellToolTip(row, col int) string
+}
+
 // SortOrder specifies the order by which items are sorted.
 type SortOrder int
 
@@ -660,16 +739,30 @@ type TreeModel interface {
 	ItemRemoved() *TreeItemEvent
 }
 
+// TreeModelItemsInserter is an optional interface of a TreeModel, that
+// publishes the insertion of a range of children at once.
+//
+// For models with many items, publishing ItemsInserted instead of
+// ItemInserted for each item is much faster, because a TreeView inserts the
+// whole range in one go.
+type TreeModelItemsInserter interface {
+	// ItemsInserted returns the event that the model should publish when the
+	// children of an item, or the roots if no item is specified, in the
+	// specified closed range were inserted into the model.
+	ItemsInserted() *TreeItemRangeEvent
+}
+
 // TreeModelBase partially implements the TreeModel interface.
 //
 // You still need to provide your own implementation of at least the
 // RootCount and RootAt methods. If your model needs lazy population,
 // you will also have to implement LazyPopulation.
 type TreeModelBase struct {
-	itemsResetPublisher   TreeItemEventPublisher
-	itemChangedPublisher  TreeItemEventPublisher
-	itemInsertedPublisher TreeItemEventPublisher
-	itemRemovedPublisher  TreeItemEventPublisher
+	itemsResetPublisher    TreeItemEventPublisher
+	itemChangedPublisher   TreeItemEventPublisher
+	itemInsertedPublisher  TreeItemEventPublisher
+	itemsInsertedPublisher TreeItemRangeEventPublisher
+	itemRemovedPublisher   TreeItemEventPublisher
 }
 
 func (tmb *TreeModelBase) LazyPopulation() bool {
@@ -688,6 +781,10 @@ func (tmb *TreeModelBase) ItemInserted() *TreeItemEvent {
 	return tmb.itemInsertedPublisher.Event()
 }
 
+func (tmb *TreeModelBase) ItemsInserted() *TreeItemRangeEvent {
+	return tmb.itemsInsertedPublisher.Event()
+}
+
 func (tmb *TreeModelBase) ItemRemoved() *TreeItemEvent {
 	return tmb.itemRemovedPublisher.Event()
 }
@@ -704,6 +801,12 @@ func (tmb *TreeModelBase) PublishItemInserted(item TreeItem) {
 	tmb.itemInsertedPublisher.Publish(item)
 }
 
+// PublishItemsInserted publishes the insertion of the children from index
+// from to index to of parent, or of the roots if parent is nil.
+func (tmb *TreeModelBase) PublishItemsInserted(parent TreeItem, from, to int) {
+	tmb.itemsInsertedPublisher.Publish(parent, from, to)
+}
+
 func (tmb *TreeModelBase) PublishItemRemoved(item TreeItem) {
 	tmb.itemRemovedPublisher.Publish(item)
 }
@@ -0,0 +1,444 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validateModels makes TableView and TreeView validate their models. It is
+// set in builds with the walk_debug tag.
+var validateModels bool
+
+// maxValidatedTreeDepth limits the depth to which tree models are validated,
+// so cycles between Parent and ChildAt are reported instead of recursing
+// forever.
+const maxValidatedTreeDepth = 256
+
+// ModelValidator checks the invariants of a TableModel or TreeModel each
+// time the model publishes an event, so model bugs are reported where they
+// are made, instead of crashing views deep inside their notification
+// handling.
+//
+// For a TableModel, the row count must match the published insertions and
+// removals, published indexes must be in bounds and, if the model is a
+// Sorter, rows must be in order after sorting and insertions. For a
+// TreeModel, published items must be children of their parents and the
+// Parent of each child must be the item it is a child of.
+//
+// In builds with the walk_debug tag, TableView and TreeView validate their
+// models automatically.
+type ModelValidator struct {
+	tableModel       TableModel
+	treeModel        TreeModel
+	rowCount         int
+	columnCount      int
+	lessFuncs        []func(i, j int) bool
+	violationHandler func(err error)
+	detachers        []func()
+}
+
+// NewTableModelValidator returns a ModelValidator, that validates model.
+func NewTableModelValidator(model TableModel) *ModelValidator {
+	mv := &ModelValidator{tableModel: model, rowCount: model.RowCount()}
+
+	mv.attach(model.RowsReset(), mv.onRowsReset)
+	mv.attachInt(model.RowChanged(), mv.onRowChanged)
+	mv.attachIntRange(model.RowsChanged(), mv.onRowsChanged)
+	mv.attachIntRange(model.RowsInserted(), mv.onRowsInserted)
+	mv.attachIntRange(model.RowsRemoved(), mv.onRowsRemoved)
+
+	if sorter, ok := model.(Sorter); ok {
+		mv.attach(sorter.SortChanged(), mv.onSortChanged)
+	}
+
+	return mv
+}
+
+// NewTreeModelValidator returns a ModelValidator, that validates model.
+func NewTreeModelValidator(model TreeModel) *ModelValidator {
+	mv := &ModelValidator{treeModel: model}
+
+	for _, binding := range []struct {
+		event   *TreeItemEvent
+		handler TreeItemEventHandler
+	}{
+		{model.ItemsReset(), mv.onItemsReset},
+		{model.ItemChanged(), mv.onItemChanged},
+		{model.ItemInserted(), mv.onItemInserted},
+		{model.ItemRemoved(), mv.onItemRemoved},
+	} {
+		event := binding.event
+		handle := event.Attach(binding.handler)
+		mv.detachers = append(mv.detachers, func() {
+			event.Detach(handle)
+		})
+	}
+
+	if inserter, ok := model.(TreeModelItemsInserter); ok {
+		event := inserter.ItemsInserted()
+		handle := event.Attach(mv.onItemsInserted)
+		mv.detachers = append(mv.detachers, func() {
+			event.Detach(handle)
+		})
+	}
+
+	return mv
+}
+
+// Dispose stops the validation.
+func (mv *ModelValidator) Dispose() {
+	for _, detach := range mv.detachers {
+		detach()
+	}
+	mv.detachers = nil
+}
+
+// SetViolationHandler sets the function violations are reported to. By
+// default, violations cause a panic.
+func (mv *ModelValidator) SetViolationHandler(handler func(err error)) {
+	mv.violationHandler = handler
+}
+
+// SetColumnCount sets the number of columns of a TableModel, for which Check
+// requests all values.
+func (mv *ModelValidator) SetColumnCount(count int) {
+	mv.columnCount = count
+}
+
+// setLessFuncs sets the functions rows of a sorted TableModel are compared
+// with. Columns without one are compared by value.
+func (mv *ModelValidator) setLessFuncs(lessFuncs []func(i, j int) bool) {
+	mv.lessFuncs = lessFuncs
+}
+
+// Check validates the whole model and returns the first violation found. It
+// is handy after each mutation of a model in tests and fuzzers.
+func (mv *ModelValidator) Check() (err error) {
+	handler := mv.violationHandler
+	defer func() {
+		mv.violationHandler = handler
+	}()
+
+	mv.violationHandler = func(e error) {
+		if err == nil {
+			err = e
+		}
+	}
+
+	if mv.tableModel != nil {
+		mv.checkTable()
+	} else {
+		mv.checkChildren(nil, 0)
+	}
+
+	return
+}
+
+func (mv *ModelValidator) violation(format string, args ...interface{}) {
+	err := newError("model validation: " + fmt.Sprintf(format, args...))
+
+	if mv.violationHandler != nil {
+		mv.violationHandler(err)
+	} else {
+		panic(err)
+	}
+}
+
+func (mv *ModelValidator) attach(event *Event, handler EventHandler) {
+	handle := event.Attach(handler)
+	mv.detachers = append(mv.detachers, func() {
+		event.Detach(handle)
+	})
+}
+
+func (mv *ModelValidator) attachInt(event *IntEvent, handler IntEventHandler) {
+	handle := event.Attach(handler)
+	mv.detachers = append(mv.detachers, func() {
+		event.Detach(handle)
+	})
+}
+
+func (mv *ModelValidator) attachIntRange(event *IntRangeEvent, handler IntRangeEventHandler) {
+	handle := event.Attach(handler)
+	mv.detachers = append(mv.detachers, func() {
+		event.Detach(handle)
+	})
+}
+
+func (mv *ModelValidator) onRowsReset() {
+	if mv.rowCount = mv.tableModel.RowCount(); mv.rowCount < 0 {
+		mv.violation("RowsReset: RowCount is %d", mv.rowCount)
+	}
+}
+
+func (mv *ModelValidator) onRowChanged(row int) {
+	mv.checkUnchangedRowCount("RowChanged")
+
+	if row < 0 || row >= mv.rowCount {
+		mv.violation("RowChanged(%d): row out of bounds for %d rows", row, mv.rowCount)
+	}
+}
+
+func (mv *ModelValidator) onRowsChanged(from, to int) {
+	mv.checkUnchangedRowCount("RowsChanged")
+
+	if from < 0 || from > to || to >= mv.rowCount {
+		mv.violation("RowsChanged(%d, %d): range out of bounds for %d rows", from, to, mv.rowCount)
+	}
+}
+
+func (mv *ModelValidator) onRowsInserted(from, to int) {
+	oldCount := mv.rowCount
+	mv.rowCount = mv.tableModel.RowCount()
+
+	switch {
+	case from < 0 || from > to || from > oldCount:
+		mv.violation("RowsInserted(%d, %d): invalid range for %d rows", from, to, oldCount)
+
+	case mv.rowCount != oldCount+to-from+1:
+		mv.violation("RowsInserted(%d, %d): RowCount is %d, expected %d", from, to, mv.rowCount, oldCount+to-from+1)
+
+	default:
+		mv.checkSorted("RowsInserted")
+	}
+}
+
+func (mv *ModelValidator) onRowsRemoved(from, to int) {
+	oldCount := mv.rowCount
+	mv.rowCount = mv.tableModel.RowCount()
+
+	switch {
+	case from < 0 || from > to || to >= oldCount:
+		mv.violation("RowsRemoved(%d, %d): invalid range for %d rows", from, to, oldCount)
+
+	case mv.rowCount != oldCount-(to-from+1):
+		mv.violation("RowsRemoved(%d, %d): RowCount is %d, expected %d", from, to, mv.rowCount, oldCount-(to-from+1))
+	}
+}
+
+func (mv *ModelValidator) onSortChanged() {
+	mv.checkUnchangedRowCount("SortChanged")
+
+	mv.checkSorted("SortChanged")
+}
+
+func (mv *ModelValidator) checkUnchangedRowCount(context string) {
+	if count := mv.tableModel.RowCount(); count != mv.rowCount {
+		mv.violation("%s: RowCount changed from %d to %d without RowsReset, RowsInserted or RowsRemoved", context, mv.rowCount, count)
+		mv.rowCount = count
+	}
+}
+
+func (mv *ModelValidator) checkTable() {
+	mv.checkUnchangedRowCount("Check")
+
+	if mv.rowCount < 0 {
+		mv.violation("Check: RowCount is %d", mv.rowCount)
+		return
+	}
+
+	for row := 0; row < mv.rowCount; row++ {
+		for col := 0; col < mv.columnCount; col++ {
+			mv.value("Check", row, col)
+		}
+	}
+
+	mv.checkSorted("Check")
+}
+
+// value returns the value of a cell, reporting a panic of the model as
+// violation.
+func (mv *ModelValidator) value(context string, row, col int) (value interface{}, ok bool) {
+	defer func() {
+		if x := recover(); x != nil {
+			mv.violation("%s: Value(%d, %d) panics with %v", context, row, col, x)
+			ok = false
+		}
+	}()
+
+	return mv.tableModel.Value(row, col), true
+}
+
+func (mv *ModelValidator) checkSorted(context string) {
+	sorter, ok := mv.tableModel.(Sorter)
+	if !ok {
+		return
+	}
+
+	col := sorter.SortedColumn()
+	if col < 0 {
+		return
+	}
+
+	order := sorter.SortOrder()
+
+	var lessFunc func(i, j int) bool
+	if col < len(mv.lessFuncs) {
+		lessFunc = mv.lessFuncs[col]
+	}
+
+	for row := 1; row < mv.rowCount; row++ {
+		var outOfOrder bool
+		if lessFunc != nil {
+			if order == SortAscending {
+				outOfOrder = lessFunc(row, row-1)
+			} else {
+				outOfOrder = lessFunc(row-1, row)
+			}
+		} else {
+			prev, ok := mv.value(context, row-1, col)
+			if !ok {
+				return
+			}
+			cur, ok := mv.value(context, row, col)
+			if !ok {
+				return
+			}
+
+			outOfOrder = less(cur, prev, order)
+		}
+
+		if outOfOrder {
+			mv.violation("%s: rows %d and %d are out of order for column %d", context, row-1, row, col)
+			return
+		}
+	}
+}
+
+func (mv *ModelValidator) onItemsReset(parent TreeItem) {
+	mv.checkChildren(parent, 0)
+}
+
+func (mv *ModelValidator) onItemChanged(item TreeItem) {
+	if item == nil {
+		mv.violation("ItemChanged: item is nil")
+	} else if !mv.isChildOfParent(item) {
+		mv.violation("ItemChanged(%s): item is not a child of its parent", treeItemPath(item))
+	}
+}
+
+func (mv *ModelValidator) onItemInserted(item TreeItem) {
+	if item == nil {
+		mv.violation("ItemInserted: item is nil")
+	} else if !mv.isChildOfParent(item) {
+		mv.violation("ItemInserted(%s): item is not a child of its parent", treeItemPath(item))
+	} else {
+		mv.checkChildren(item, 0)
+	}
+}
+
+func (mv *ModelValidator) onItemsInserted(parent TreeItem, from, to int) {
+	if count := mv.childCount(parent); from < 0 || to < from || to >= count {
+		mv.violation("ItemsInserted(%s, %d, %d): invalid range for %d children", treeItemPath(parent), from, to, count)
+		return
+	}
+
+	for i := from; i <= to; i++ {
+		mv.checkChildren(mv.childAt(parent, i), 0)
+	}
+}
+
+func (mv *ModelValidator) onItemRemoved(item TreeItem) {
+	if item == nil {
+		mv.violation("ItemRemoved: item is nil")
+	} else if mv.isChildOfParent(item) {
+		mv.violation("ItemRemoved(%s): item is still a child of its parent", treeItemPath(item))
+	}
+}
+
+func (mv *ModelValidator) childCount(parent TreeItem) int {
+	if parent == nil {
+		return mv.treeModel.RootCount()
+	}
+
+	return parent.ChildCount()
+}
+
+func (mv *ModelValidator) childAt(parent TreeItem, index int) TreeItem {
+	if parent == nil {
+		return mv.treeModel.RootAt(index)
+	}
+
+	return parent.ChildAt(index)
+}
+
+func (mv *ModelValidator) isChildOfParent(item TreeItem) bool {
+	parent := item.Parent()
+
+	count := mv.childCount(parent)
+	for i := 0; i < count; i++ {
+		if sameTreeItem(mv.childAt(parent, i), item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkChildren checks the children of parent, or the roots if parent is
+// nil, and unless the model is lazily populated, their descendants.
+func (mv *ModelValidator) checkChildren(parent TreeItem, depth int) {
+	if depth == maxValidatedTreeDepth {
+		mv.violation("%s: tree is deeper than %d levels, Parent and ChildAt may form a cycle", treeItemPath(parent), maxValidatedTreeDepth)
+		return
+	}
+
+	count := mv.childCount(parent)
+	if count < 0 {
+		mv.violation("%s: child count is %d", treeItemPath(parent), count)
+		return
+	}
+
+	for i := 0; i < count; i++ {
+		child := mv.childAt(parent, i)
+		if child == nil {
+			mv.violation("%s: child %d of %d is nil", treeItemPath(parent), i, count)
+			continue
+		}
+
+		if p := child.Parent(); !sameTreeItem(p, parent) {
+			mv.violation("%s: Parent of child %d is %s", treeItemPath(parent), i, treeItemPath(p))
+			continue
+		}
+
+		if !mv.treeModel.LazyPopulation() {
+			mv.checkChildren(child, depth+1)
+		}
+	}
+}
+
+// sameTreeItem returns whether a and b are the same item, without panicking
+// for items of uncomparable types.
+func sameTreeItem(a, b TreeItem) (same bool) {
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+
+	return a == b
+}
+
+// treeItemPath describes item by the texts of it and its ancestors.
+func treeItemPath(item TreeItem) string {
+	if item == nil {
+		return "<root>"
+	}
+
+	var texts []string
+	for ; item != nil && len(texts) < 16; item = item.Parent() {
+		texts = append(texts, fmt.Sprintf("%q", item.Text()))
+	}
+
+	for i, j := 0, len(texts)-1; i < j; i, j = i+1, j-1 {
+		texts[i], texts[j] = texts[j], texts[i]
+	}
+
+	return strings.Join(texts, "/")
+}
@@ -0,0 +1,11 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows,walk_debug
+
+package walk
+
+func init() {
+	validateModels = true
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+var (
+	enumDisplayMonitorsCallbackPtr uintptr
+	enumDisplayMonitorsResult      []Monitor
+)
+
+// Monitor represents a display monitor.
+type Monitor struct {
+	hMonitor win.HMONITOR
+}
+
+// Monitors returns all display monitors currently attached to the desktop.
+func Monitors() []Monitor {
+	if enumDisplayMonitorsCallbackPtr == 0 {
+		enumDisplayMonitorsCallbackPtr = syscall.NewCallback(enumDisplayMonitorsCallback)
+	}
+
+	enumDisplayMonitorsResult = nil
+	defer func() {
+		enumDisplayMonitorsResult = nil
+	}()
+
+	if !enumDisplayMonitors(0, nil, enumDisplayMonitorsCallbackPtr, 0) {
+		return nil
+	}
+
+	return enumDisplayMonitorsResult
+}
+
+func enumDisplayMonitorsCallback(hMonitor, hdc, lprcMonitor, lParam uintptr) uintptr {
+	enumDisplayMonitorsResult = append(enumDisplayMonitorsResult, Monitor{win.HMONITOR(hMonitor)})
+
+	return 1
+}
+
+// PrimaryMonitor returns the primary display monitor.
+func PrimaryMonitor() Monitor {
+	for _, m := range Monitors() {
+		if m.Primary() {
+			return m
+		}
+	}
+
+	return Monitor{}
+}
+
+// MonitorForWindow returns the display monitor that has the largest area of
+// intersection with the window, or the nearest one if there is none.
+func MonitorForWindow(window Window) Monitor {
+	return Monitor{win.MonitorFromWindow(window.Handle(), win.MONITOR_DEFAULTTONEAREST)}
+}
+
+// Handle returns the HMONITOR of the Monitor.
+func (m Monitor) Handle() win.HMONITOR {
+	return m.hMonitor
+}
+
+// IsValid returns if the Monitor is still attached to the desktop.
+func (m Monitor) IsValid() bool {
+	if m.hMonitor == 0 {
+		return false
+	}
+
+	_, ok := m.info()
+	return ok
+}
+
+// Primary returns if the Monitor is the primary display monitor.
+func (m Monitor) Primary() bool {
+	mi, _ := m.info()
+
+	return mi.DwFlags&win.MONITORINFOF_PRIMARY != 0
+}
+
+// BoundsPixels returns the bounds of the Monitor in virtual screen
+// coordinates, in native pixels.
+func (m Monitor) BoundsPixels() Rectangle {
+	mi, _ := m.info()
+
+	return rectangleFromRECT(mi.RcMonitor)
+}
+
+// WorkAreaPixels returns the bounds of the Monitor in virtual screen
+// coordinates excluding the taskbar and docked toolbars, in native pixels.
+func (m Monitor) WorkAreaPixels() Rectangle {
+	mi, _ := m.info()
+
+	return rectangleFromRECT(mi.RcWork)
+}
+
+func (m Monitor) info() (win.MONITORINFO, bool) {
+	var mi win.MONITORINFO
+	mi.CbSize = uint32(unsafe.Sizeof(mi))
+
+	ok := win.GetMonitorInfo(m.hMonitor, &mi)
+
+	return mi, ok
+}
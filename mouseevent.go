@@ -21,6 +21,7 @@ const (
 type mouseEventHandlerInfo struct {
 	handler MouseEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 // MouseEventHandler is called for mouse events. x and y are measured in native pixels.
@@ -31,7 +32,7 @@ type MouseEvent struct {
 }
 
 func (e *MouseEvent) Attach(handler MouseEventHandler) int {
-	handlerInfo := mouseEventHandlerInfo{handler, false}
+	handlerInfo := mouseEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -46,6 +47,7 @@ func (e *MouseEvent) Attach(handler MouseEventHandler) int {
 }
 
 func (e *MouseEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -54,6 +56,17 @@ func (e *MouseEvent) Once(handler MouseEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *MouseEvent) AttachScoped(owner Window, handler MouseEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type MouseEventPublisher struct {
 	event MouseEvent
 }
@@ -0,0 +1,363 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// Connectivity describes the network connectivity of the system, as reported
+// by the Network List Manager.
+type Connectivity uint32
+
+const (
+	ConnectivityDisconnected     Connectivity = 0x0000
+	ConnectivityIPv4NoTraffic    Connectivity = 0x0001
+	ConnectivityIPv6NoTraffic    Connectivity = 0x0002
+	ConnectivityIPv4Subnet       Connectivity = 0x0010
+	ConnectivityIPv4LocalNetwork Connectivity = 0x0020
+	ConnectivityIPv4Internet     Connectivity = 0x0040
+	ConnectivityIPv6Subnet       Connectivity = 0x0100
+	ConnectivityIPv6LocalNetwork Connectivity = 0x0200
+	ConnectivityIPv6Internet     Connectivity = 0x0400
+)
+
+// Internet returns whether the system has access to the internet.
+func (c Connectivity) Internet() bool {
+	return c&(ConnectivityIPv4Internet|ConnectivityIPv6Internet) != 0
+}
+
+// Connected returns whether the system has access to at least a local
+// network.
+func (c Connectivity) Connected() bool {
+	return c&(ConnectivityIPv4Subnet|ConnectivityIPv4LocalNetwork|ConnectivityIPv4Internet|
+		ConnectivityIPv6Subnet|ConnectivityIPv6LocalNetwork|ConnectivityIPv6Internet) != 0
+}
+
+type connectivityEventHandlerInfo struct {
+	handler ConnectivityEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+type ConnectivityEventHandler func(connectivity Connectivity)
+
+type ConnectivityEvent struct {
+	handlers []connectivityEventHandlerInfo
+}
+
+func (e *ConnectivityEvent) Attach(handler ConnectivityEventHandler) int {
+	handlerInfo := connectivityEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *ConnectivityEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *ConnectivityEvent) Once(handler ConnectivityEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *ConnectivityEvent) AttachScoped(owner Window, handler ConnectivityEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type ConnectivityEventPublisher struct {
+	event ConnectivityEvent
+}
+
+func (p *ConnectivityEventPublisher) Event() *ConnectivityEvent {
+	return &p.event
+}
+
+func (p *ConnectivityEventPublisher) Publish(connectivity Connectivity) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(connectivity)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
+
+var (
+	clsidNetworkListManager      = win.CLSID{Data1: 0xDCB00C01, Data2: 0x570F, Data3: 0x4A9B, Data4: [8]byte{0x8D, 0x69, 0x19, 0x9F, 0xDB, 0xA5, 0x72, 0x3B}}
+	iidINetworkListManager       = win.IID{Data1: 0xDCB00000, Data2: 0x570F, Data3: 0x4A9B, Data4: [8]byte{0x8D, 0x69, 0x19, 0x9F, 0xDB, 0xA5, 0x72, 0x3B}}
+	iidINetworkListManagerEvents = win.IID{Data1: 0xDCB00001, Data2: 0x570F, Data3: 0x4A9B, Data4: [8]byte{0x8D, 0x69, 0x19, 0x9F, 0xDB, 0xA5, 0x72, 0x3B}}
+	networkListManagerEventsVtbl *iNetworkListManagerEventsVtbl
+)
+
+func init() {
+	AppendToWalkInit(func() {
+		networkListManagerEventsVtbl = &iNetworkListManagerEventsVtbl{
+			syscall.NewCallback(networkListManagerEvents_QueryInterface),
+			syscall.NewCallback(networkListManagerEvents_AddRef),
+			syscall.NewCallback(networkListManagerEvents_Release),
+			syscall.NewCallback(networkListManagerEvents_ConnectivityChanged),
+		}
+	})
+}
+
+type iNetworkListManagerVtbl struct {
+	QueryInterface            uintptr
+	AddRef                    uintptr
+	Release                   uintptr
+	GetTypeInfoCount          uintptr
+	GetTypeInfo               uintptr
+	GetIDsOfNames             uintptr
+	Invoke                    uintptr
+	GetNetworks               uintptr
+	GetNetwork                uintptr
+	GetNetworkConnections     uintptr
+	GetNetworkConnection      uintptr
+	IsConnectedToInternet     uintptr
+	IsConnected               uintptr
+	GetConnectivity           uintptr
+	SetSimulatedProfileInfo   uintptr
+	ClearSimulatedProfileInfo uintptr
+}
+
+type iNetworkListManager struct {
+	LpVtbl *iNetworkListManagerVtbl
+}
+
+func (nlm *iNetworkListManager) QueryInterface(riid win.REFIID, ppvObject *unsafe.Pointer) win.HRESULT {
+	ret, _, _ := syscall.Syscall(nlm.LpVtbl.QueryInterface, 3,
+		uintptr(unsafe.Pointer(nlm)),
+		uintptr(unsafe.Pointer(riid)),
+		uintptr(unsafe.Pointer(ppvObject)))
+
+	return win.HRESULT(ret)
+}
+
+func (nlm *iNetworkListManager) Release() uint32 {
+	ret, _, _ := syscall.Syscall(nlm.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(nlm)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+func (nlm *iNetworkListManager) GetConnectivity(pConnectivity *Connectivity) win.HRESULT {
+	ret, _, _ := syscall.Syscall(nlm.LpVtbl.GetConnectivity, 2,
+		uintptr(unsafe.Pointer(nlm)),
+		uintptr(unsafe.Pointer(pConnectivity)),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+type iNetworkListManagerEventsVtbl struct {
+	QueryInterface      uintptr
+	AddRef              uintptr
+	Release             uintptr
+	ConnectivityChanged uintptr
+}
+
+type networkListManagerEvents struct {
+	LpVtbl  *iNetworkListManagerEventsVtbl
+	monitor *NetworkMonitor
+}
+
+func networkListManagerEvents_QueryInterface(events *networkListManagerEvents, riid win.REFIID, ppvObject *unsafe.Pointer) uintptr {
+	if win.EqualREFIID(riid, &win.IID_IUnknown) || win.EqualREFIID(riid, &iidINetworkListManagerEvents) {
+		*ppvObject = unsafe.Pointer(events)
+		return win.S_OK
+	}
+
+	*ppvObject = nil
+	return win.E_NOINTERFACE
+}
+
+func networkListManagerEvents_AddRef(events *networkListManagerEvents) uintptr {
+	return 1
+}
+
+func networkListManagerEvents_Release(events *networkListManagerEvents) uintptr {
+	return 1
+}
+
+func networkListManagerEvents_ConnectivityChanged(events *networkListManagerEvents, newConnectivity uintptr) uintptr {
+	if nm := events.monitor; nm != nil {
+		nm.setConnectivity(Connectivity(newConnectivity))
+	}
+
+	return win.S_OK
+}
+
+// NetworkMonitor publishes changes of the network connectivity of the system,
+// using the Network List Manager, so there is no need for polling.
+//
+// A NetworkMonitor must be created and used on the UI thread. Its events are
+// published on that thread.
+type NetworkMonitor struct {
+	nlm                          *iNetworkListManager
+	cp                           *win.IConnectionPoint
+	cookie                       uint32
+	events                       networkListManagerEvents
+	connectivity                 Connectivity
+	connectivityChangedPublisher ConnectivityEventPublisher
+	onlineChangedPublisher       EventPublisher
+	onlineCondition              Condition
+}
+
+// NewNetworkMonitor creates a new NetworkMonitor.
+func NewNetworkMonitor() (*NetworkMonitor, error) {
+	nm := new(NetworkMonitor)
+	nm.events.LpVtbl = networkListManagerEventsVtbl
+	nm.events.monitor = nm
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			nm.Dispose()
+		}
+	}()
+
+	if hr := win.CoCreateInstance(
+		&clsidNetworkListManager,
+		nil,
+		win.CLSCTX_ALL,
+		&iidINetworkListManager,
+		(*unsafe.Pointer)(unsafe.Pointer(&nm.nlm))); win.FAILED(hr) {
+
+		return nil, errorFromHRESULT("CoCreateInstance(CLSID_NetworkListManager)", hr)
+	}
+
+	if hr := nm.nlm.GetConnectivity(&nm.connectivity); win.FAILED(hr) {
+		return nil, errorFromHRESULT("INetworkListManager.GetConnectivity", hr)
+	}
+
+	var cpcPtr unsafe.Pointer
+	if hr := nm.nlm.QueryInterface(&win.IID_IConnectionPointContainer, &cpcPtr); win.FAILED(hr) {
+		return nil, errorFromHRESULT("INetworkListManager.QueryInterface(IID_IConnectionPointContainer)", hr)
+	}
+	cpc := (*win.IConnectionPointContainer)(cpcPtr)
+	defer cpc.Release()
+
+	if hr := cpc.FindConnectionPoint(&iidINetworkListManagerEvents, &nm.cp); win.FAILED(hr) {
+		return nil, errorFromHRESULT("IConnectionPointContainer.FindConnectionPoint(IID_INetworkListManagerEvents)", hr)
+	}
+
+	if hr := nm.cp.Advise(unsafe.Pointer(&nm.events), &nm.cookie); win.FAILED(hr) {
+		return nil, errorFromHRESULT("IConnectionPoint.Advise", hr)
+	}
+
+	nm.onlineCondition = NewDelegateCondition(nm.Online, nm.onlineChangedPublisher.Event())
+
+	succeeded = true
+
+	return nm, nil
+}
+
+// Dispose stops monitoring and releases the resources of the NetworkMonitor.
+func (nm *NetworkMonitor) Dispose() {
+	if nm.cp != nil {
+		if nm.cookie != 0 {
+			syscall.Syscall(nm.cp.LpVtbl.Unadvise, 2,
+				uintptr(unsafe.Pointer(nm.cp)),
+				uintptr(nm.cookie),
+				0)
+			nm.cookie = 0
+		}
+
+		nm.cp.Release()
+		nm.cp = nil
+	}
+
+	if nm.nlm != nil {
+		nm.nlm.Release()
+		nm.nlm = nil
+	}
+
+	nm.events.monitor = nil
+}
+
+// Connectivity returns the current network connectivity.
+func (nm *NetworkMonitor) Connectivity() Connectivity {
+	return nm.connectivity
+}
+
+// Online returns whether the system currently has access to the internet.
+func (nm *NetworkMonitor) Online() bool {
+	return nm.connectivity.Internet()
+}
+
+// ConnectivityChanged returns the event that is published when the network
+// connectivity changed.
+func (nm *NetworkMonitor) ConnectivityChanged() *ConnectivityEvent {
+	return nm.connectivityChangedPublisher.Event()
+}
+
+// OnlineChanged returns the event that is published when the system gained
+// or lost access to the internet.
+func (nm *NetworkMonitor) OnlineChanged() *Event {
+	return nm.onlineChangedPublisher.Event()
+}
+
+// OnlineCondition returns a Condition that is satisfied while the system has
+// access to the internet.
+func (nm *NetworkMonitor) OnlineCondition() Condition {
+	return nm.onlineCondition
+}
+
+// DisableActionsWhenOffline makes the actions disabled while the system has
+// no access to the internet.
+//
+// An enabled condition the actions already have is kept and combined with
+// OnlineCondition.
+func (nm *NetworkMonitor) DisableActionsWhenOffline(actions ...*Action) {
+	for _, action := range actions {
+		if cond := action.EnabledCondition(); cond != nil {
+			action.SetEnabledCondition(NewAllCondition(cond, nm.onlineCondition))
+		} else {
+			action.SetEnabledCondition(nm.onlineCondition)
+		}
+	}
+}
+
+func (nm *NetworkMonitor) setConnectivity(connectivity Connectivity) {
+	if connectivity == nm.connectivity {
+		return
+	}
+
+	wasOnline := nm.Online()
+
+	nm.connectivity = connectivity
+
+	nm.connectivityChangedPublisher.Publish(connectivity)
+
+	if nm.Online() != wasOnline {
+		nm.onlineChangedPublisher.Publish()
+	}
+}
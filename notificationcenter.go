@@ -0,0 +1,811 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"encoding/json"
+	"time"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// NotificationSeverity is the severity of a Notification.
+type NotificationSeverity int
+
+const (
+	NotificationInfo NotificationSeverity = iota
+	NotificationWarning
+	NotificationError
+)
+
+func (s NotificationSeverity) icon() *Icon {
+	switch s {
+	case NotificationWarning:
+		return IconWarning()
+
+	case NotificationError:
+		return IconError()
+	}
+
+	return IconInformation()
+}
+
+// NotificationAction is a button displayed with a Notification.
+//
+// When the user clicks it, NotificationCenter.ActionTriggered is published
+// with the ID of the action.
+type NotificationAction struct {
+	ID   string
+	Text string
+}
+
+// Notification is an entry of a NotificationCenter.
+type Notification struct {
+	Title    string
+	Text     string
+	Severity NotificationSeverity
+	Time     time.Time
+	Read     bool
+	Actions  []NotificationAction
+}
+
+type notificationActionEventHandlerInfo struct {
+	handler NotificationActionEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+type NotificationActionEventHandler func(notification *Notification, actionID string)
+
+type NotificationActionEvent struct {
+	handlers []notificationActionEventHandlerInfo
+}
+
+func (e *NotificationActionEvent) Attach(handler NotificationActionEventHandler) int {
+	handlerInfo := notificationActionEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *NotificationActionEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *NotificationActionEvent) Once(handler NotificationActionEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *NotificationActionEvent) AttachScoped(owner Window, handler NotificationActionEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type NotificationActionEventPublisher struct {
+	event NotificationActionEvent
+}
+
+func (p *NotificationActionEventPublisher) Event() *NotificationActionEvent {
+	return &p.event
+}
+
+func (p *NotificationActionEventPublisher) Publish(notification *Notification, actionID string) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(notification, actionID)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
+
+// NotificationCenter keeps a history of the notifications of an application
+// and displays them in a pane that slides in from the right edge of the
+// screen.
+//
+// If a NotifyIcon is set, notifications posted with Notify are shown as
+// balloon messages first. Only messages the user did not click land in the
+// NotificationCenter.
+type NotificationCenter struct {
+	owner                         Form
+	notifications                 []*Notification
+	maxHistory                    int
+	persistent                    bool
+	settingsKey                   string
+	notifyIcon                    *NotifyIcon
+	pendingNotification           *Notification
+	clickedHandle                 int
+	timedOutHandle                int
+	pane                          *notificationPane
+	notificationsChangedPublisher EventPublisher
+	actionTriggeredPublisher      NotificationActionEventPublisher
+}
+
+// NewNotificationCenter creates a new NotificationCenter, whose pane is owned
+// by owner.
+func NewNotificationCenter(owner Form) *NotificationCenter {
+	return &NotificationCenter{
+		owner:       owner,
+		maxHistory:  100,
+		settingsKey: "NotificationCenter",
+	}
+}
+
+// Notifications returns the notifications, most recent first.
+func (nc *NotificationCenter) Notifications() []*Notification {
+	return append([]*Notification(nil), nc.notifications...)
+}
+
+// UnreadCount returns the number of notifications that were not read yet.
+func (nc *NotificationCenter) UnreadCount() int {
+	var count int
+	for _, n := range nc.notifications {
+		if !n.Read {
+			count++
+		}
+	}
+
+	return count
+}
+
+// NotificationsChanged returns the event that is published when
+// notifications were added, removed or marked as read.
+func (nc *NotificationCenter) NotificationsChanged() *Event {
+	return nc.notificationsChangedPublisher.Event()
+}
+
+// ActionTriggered returns the event that is published when the user clicked
+// an action of a notification.
+func (nc *NotificationCenter) ActionTriggered() *NotificationActionEvent {
+	return nc.actionTriggeredPublisher.Event()
+}
+
+// MaxHistory returns the maximum number of notifications that are kept.
+func (nc *NotificationCenter) MaxHistory() int {
+	return nc.maxHistory
+}
+
+// SetMaxHistory sets the maximum number of notifications that are kept.
+// The oldest notifications are dropped first.
+func (nc *NotificationCenter) SetMaxHistory(max int) {
+	nc.maxHistory = max
+
+	if len(nc.notifications) > max {
+		nc.notifications = nc.notifications[:max]
+		nc.changed()
+	}
+}
+
+// Add adds a notification to the NotificationCenter, without showing it as
+// a balloon message.
+//
+// If the Time of the notification is zero, it is set to the current time.
+func (nc *NotificationCenter) Add(notification *Notification) {
+	if notification.Time.IsZero() {
+		notification.Time = time.Now()
+	}
+
+	nc.notifications = append([]*Notification{notification}, nc.notifications...)
+	if len(nc.notifications) > nc.maxHistory {
+		nc.notifications = nc.notifications[:nc.maxHistory]
+	}
+
+	nc.changed()
+}
+
+// Notify posts a notification.
+//
+// If a visible NotifyIcon is set, the notification is shown as a balloon
+// message and only added to the NotificationCenter if the user does not
+// click it. Otherwise it is added right away.
+func (nc *NotificationCenter) Notify(notification *Notification) error {
+	if nc.notifyIcon == nil || !nc.notifyIcon.Visible() {
+		nc.Add(notification)
+		return nil
+	}
+
+	if notification.Time.IsZero() {
+		notification.Time = time.Now()
+	}
+
+	var err error
+	switch notification.Severity {
+	case NotificationWarning:
+		err = nc.notifyIcon.ShowWarning(notification.Title, notification.Text)
+
+	case NotificationError:
+		err = nc.notifyIcon.ShowError(notification.Title, notification.Text)
+
+	default:
+		err = nc.notifyIcon.ShowInfo(notification.Title, notification.Text)
+	}
+
+	if err != nil {
+		nc.Add(notification)
+		return err
+	}
+
+	nc.pendingNotification = notification
+
+	return nil
+}
+
+// Remove removes a notification from the NotificationCenter.
+func (nc *NotificationCenter) Remove(notification *Notification) {
+	for i, n := range nc.notifications {
+		if n == notification {
+			nc.notifications = append(nc.notifications[:i], nc.notifications[i+1:]...)
+			nc.changed()
+			return
+		}
+	}
+}
+
+// Clear removes all notifications from the NotificationCenter.
+func (nc *NotificationCenter) Clear() {
+	if len(nc.notifications) == 0 {
+		return
+	}
+
+	nc.notifications = nil
+
+	nc.changed()
+}
+
+// MarkRead marks a notification as read.
+func (nc *NotificationCenter) MarkRead(notification *Notification) {
+	if notification.Read {
+		return
+	}
+
+	notification.Read = true
+
+	nc.changed()
+}
+
+// MarkAllRead marks all notifications as read.
+func (nc *NotificationCenter) MarkAllRead() {
+	if nc.UnreadCount() == 0 {
+		return
+	}
+
+	for _, n := range nc.notifications {
+		n.Read = true
+	}
+
+	nc.changed()
+}
+
+// NotifyIcon returns the NotifyIcon balloon messages are shown with.
+func (nc *NotificationCenter) NotifyIcon() *NotifyIcon {
+	return nc.notifyIcon
+}
+
+// SetNotifyIcon sets the NotifyIcon balloon messages are shown with.
+//
+// Any balloon message of ni that times out or is closed by the user without
+// being clicked is added to the NotificationCenter, also if it was not shown
+// using Notify.
+func (nc *NotificationCenter) SetNotifyIcon(ni *NotifyIcon) {
+	if nc.notifyIcon != nil {
+		nc.notifyIcon.MessageClicked().Detach(nc.clickedHandle)
+		nc.notifyIcon.MessageTimedOut().Detach(nc.timedOutHandle)
+	}
+
+	nc.notifyIcon = ni
+	nc.pendingNotification = nil
+
+	if ni == nil {
+		return
+	}
+
+	nc.clickedHandle = ni.MessageClicked().Attach(func() {
+		nc.pendingNotification = nil
+	})
+
+	nc.timedOutHandle = ni.MessageTimedOut().Attach(func() {
+		n := nc.pendingNotification
+		nc.pendingNotification = nil
+
+		if n == nil || n.Title != ni.lastMessageTitle || n.Text != ni.lastMessageInfo {
+			n = &Notification{
+				Title: ni.lastMessageTitle,
+				Text:  ni.lastMessageInfo,
+			}
+
+			switch ni.lastMessageIconType {
+			case win.NIIF_WARNING:
+				n.Severity = NotificationWarning
+
+			case win.NIIF_ERROR:
+				n.Severity = NotificationError
+			}
+		}
+
+		nc.Add(n)
+	})
+}
+
+// Persistent returns whether the notifications are stored in the settings
+// of the application.
+func (nc *NotificationCenter) Persistent() bool {
+	return nc.persistent
+}
+
+// SetPersistent sets whether the notifications are stored in the settings
+// of the application.
+func (nc *NotificationCenter) SetPersistent(value bool) {
+	nc.persistent = value
+}
+
+// SaveState stores the notifications in the settings of the application.
+func (nc *NotificationCenter) SaveState() error {
+	settings := App().Settings()
+	if settings == nil {
+		return newError("App().Settings() must not be nil")
+	}
+
+	data, err := json.Marshal(nc.notifications)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	return settings.Put(nc.settingsKey, string(data))
+}
+
+// RestoreState loads the notifications from the settings of the application.
+func (nc *NotificationCenter) RestoreState() error {
+	settings := App().Settings()
+	if settings == nil {
+		return newError("App().Settings() must not be nil")
+	}
+
+	data, ok := settings.Get(nc.settingsKey)
+	if !ok {
+		return nil
+	}
+
+	var notifications []*Notification
+	if err := json.Unmarshal([]byte(data), &notifications); err != nil {
+		return wrapError(err)
+	}
+
+	nc.notifications = notifications
+
+	nc.notificationsChangedPublisher.Publish()
+
+	if nc.pane != nil {
+		nc.pane.scheduleUpdate()
+	}
+
+	return nil
+}
+
+func (nc *NotificationCenter) changed() {
+	if nc.persistent && App().Settings() != nil {
+		nc.SaveState()
+	}
+
+	nc.notificationsChangedPublisher.Publish()
+
+	if nc.pane != nil {
+		nc.pane.scheduleUpdate()
+	}
+}
+
+func (nc *NotificationCenter) triggerAction(notification *Notification, actionID string) {
+	nc.MarkRead(notification)
+
+	nc.actionTriggeredPublisher.Publish(notification, actionID)
+}
+
+// PaneVisible returns whether the pane of the NotificationCenter is
+// currently displayed.
+func (nc *NotificationCenter) PaneVisible() bool {
+	return nc.pane != nil
+}
+
+// ShowPane slides in the pane of the NotificationCenter.
+func (nc *NotificationCenter) ShowPane() error {
+	if nc.pane != nil {
+		nc.pane.Activate()
+		return nil
+	}
+
+	pane, err := newNotificationPane(nc)
+	if err != nil {
+		return err
+	}
+
+	nc.pane = pane
+
+	pane.slideIn()
+
+	return nil
+}
+
+// HidePane closes the pane of the NotificationCenter.
+func (nc *NotificationCenter) HidePane() {
+	if nc.pane != nil {
+		nc.pane.Cancel()
+	}
+}
+
+// TogglePane shows the pane if it is hidden and hides it otherwise.
+func (nc *NotificationCenter) TogglePane() error {
+	if nc.pane != nil {
+		nc.HidePane()
+		return nil
+	}
+
+	return nc.ShowPane()
+}
+
+const notificationPaneSlideTimerId = 1
+
+type notificationPane struct {
+	*Dialog
+	center     *NotificationCenter
+	scrollView *ScrollView
+	emptyLabel *Label
+	boldFont   *Font
+	rows       []*Composite
+	finalX     int
+	step       int
+	updating   bool
+}
+
+func newNotificationPane(center *NotificationCenter) (*notificationPane, error) {
+	dlg, err := NewDialogWithFixedSize(center.owner)
+	if err != nil {
+		return nil, err
+	}
+
+	np := &notificationPane{Dialog: dlg, center: center}
+	np.centerInOwnerWhenRun = false
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			np.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(np); err != nil {
+		return nil, err
+	}
+
+	np.ensureExtendedStyleBits(win.WS_EX_TOOLWINDOW, true)
+
+	np.SetTitle(tr("Notifications", "walk"))
+
+	if err := np.SetLayout(NewVBoxLayout()); err != nil {
+		return nil, err
+	}
+
+	header, err := NewComposite(np)
+	if err != nil {
+		return nil, err
+	}
+
+	headerLayout := NewHBoxLayout()
+	headerLayout.SetMargins(Margins{})
+	if err := header.SetLayout(headerLayout); err != nil {
+		return nil, err
+	}
+
+	if _, err := NewHSpacer(header); err != nil {
+		return nil, err
+	}
+
+	markAllRead, err := NewLinkLabel(header)
+	if err != nil {
+		return nil, err
+	}
+	markAllRead.SetText("<a>" + tr("Mark all as read", "walk") + "</a>")
+	markAllRead.LinkActivated().Attach(func(*LinkLabelLink) {
+		center.MarkAllRead()
+	})
+
+	clearAll, err := NewLinkLabel(header)
+	if err != nil {
+		return nil, err
+	}
+	clearAll.SetText("<a>" + tr("Clear all", "walk") + "</a>")
+	clearAll.LinkActivated().Attach(func(*LinkLabelLink) {
+		center.Clear()
+	})
+
+	if np.scrollView, err = NewScrollView(np); err != nil {
+		return nil, err
+	}
+	np.scrollView.SetScrollbars(false, true)
+
+	listLayout := NewVBoxLayout()
+	listLayout.SetMargins(Margins{})
+	listLayout.SetAlignment(AlignHNearVNear)
+	if err := np.scrollView.SetLayout(listLayout); err != nil {
+		return nil, err
+	}
+
+	if np.emptyLabel, err = NewLabel(np.scrollView); err != nil {
+		return nil, err
+	}
+	np.emptyLabel.SetText(tr("No notifications", "walk"))
+	np.emptyLabel.SetTextAlignment(AlignCenter)
+
+	font := np.Font()
+	if np.boldFont, err = NewFont(font.Family(), font.PointSize(), font.Style()|FontBold); err != nil {
+		return nil, err
+	}
+
+	np.update()
+
+	np.Disposing().Attach(func() {
+		np.boldFont.Dispose()
+		center.pane = nil
+	})
+
+	succeeded = true
+
+	return np, nil
+}
+
+func formatNotificationTime(t time.Time) string {
+	now := time.Now()
+
+	switch d := now.Sub(t); {
+	case d < time.Minute:
+		return tr("Just now", "walk")
+
+	case sameDay(t, now):
+		return t.Format("15:04")
+
+	case t.Year() == now.Year():
+		return t.Format("Jan 2, 15:04")
+	}
+
+	return t.Format("Jan 2, 2006")
+}
+
+// scheduleUpdate makes the pane rebuild its rows later. The rows must not be
+// rebuilt right away, because the change may originate from one of their
+// buttons.
+func (np *notificationPane) scheduleUpdate() {
+	if np.updating {
+		return
+	}
+
+	np.updating = true
+
+	np.Synchronize(func() {
+		np.updating = false
+
+		if !np.IsDisposed() {
+			np.update()
+		}
+	})
+}
+
+// update rebuilds the rows of the pane from the notifications.
+func (np *notificationPane) update() {
+	np.scrollView.SetSuspended(true)
+	defer np.scrollView.SetSuspended(false)
+
+	for _, row := range np.rows {
+		row.Dispose()
+	}
+	np.rows = nil
+
+	np.emptyLabel.SetVisible(len(np.center.notifications) == 0)
+
+	for _, n := range np.center.notifications {
+		row, err := np.newRow(n)
+		if err != nil {
+			break
+		}
+
+		np.rows = append(np.rows, row)
+	}
+}
+
+func (np *notificationPane) newRow(n *Notification) (*Composite, error) {
+	row, err := NewCompositeWithStyle(np.scrollView, win.WS_BORDER)
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			row.Dispose()
+		}
+	}()
+
+	layout := NewGridLayout()
+	layout.SetSpacing(4)
+	if err := row.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	icon, err := NewImageView(row)
+	if err != nil {
+		return nil, err
+	}
+	icon.SetImage(n.Severity.icon())
+	icon.SetAlignment(AlignHCenterVNear)
+	layout.SetRange(icon, Rectangle{0, 0, 1, 3})
+
+	title, err := NewLabel(row)
+	if err != nil {
+		return nil, err
+	}
+	title.SetText(n.Title)
+	title.SetEllipsisMode(EllipsisEnd)
+	if !n.Read {
+		title.SetFont(np.boldFont)
+	}
+	layout.SetRange(title, Rectangle{1, 0, 1, 1})
+
+	timeLabel, err := NewLabel(row)
+	if err != nil {
+		return nil, err
+	}
+	timeLabel.SetText(formatNotificationTime(n.Time))
+	timeLabel.SetToolTipText(n.Time.Format(time.RFC1123))
+	timeLabel.SetTextColor(Color(win.GetSysColor(win.COLOR_GRAYTEXT)))
+	layout.SetRange(timeLabel, Rectangle{2, 0, 1, 1})
+
+	dismiss, err := NewPushButton(row)
+	if err != nil {
+		return nil, err
+	}
+	dismiss.SetText("×")
+	dismiss.SetToolTipText(tr("Dismiss", "walk"))
+	dismiss.SetMinMaxSize(Size{}, Size{24, 24})
+	dismiss.Clicked().Attach(func() {
+		np.center.Remove(n)
+	})
+	layout.SetRange(dismiss, Rectangle{3, 0, 1, 1})
+
+	if n.Text != "" {
+		text, err := NewTextLabel(row)
+		if err != nil {
+			return nil, err
+		}
+		text.SetText(n.Text)
+		layout.SetRange(text, Rectangle{1, 1, 3, 1})
+	}
+
+	if len(n.Actions) > 0 {
+		actions, err := NewComposite(row)
+		if err != nil {
+			return nil, err
+		}
+
+		actionsLayout := NewHBoxLayout()
+		actionsLayout.SetMargins(Margins{})
+		if err := actions.SetLayout(actionsLayout); err != nil {
+			return nil, err
+		}
+
+		for _, action := range n.Actions {
+			action := action
+
+			button, err := NewPushButton(actions)
+			if err != nil {
+				return nil, err
+			}
+			button.SetText(action.Text)
+			button.Clicked().Attach(func() {
+				np.center.triggerAction(n, action.ID)
+			})
+		}
+
+		if _, err := NewHSpacer(actions); err != nil {
+			return nil, err
+		}
+
+		layout.SetRange(actions, Rectangle{1, 2, 3, 1})
+	}
+
+	markRead := func(x, y int, button MouseButton) {
+		np.center.MarkRead(n)
+	}
+	row.MouseDown().Attach(markRead)
+	title.MouseDown().Attach(markRead)
+
+	succeeded = true
+
+	return row, nil
+}
+
+// slideIn places the pane at the right edge of the work area of the monitor
+// of the owner and slides it in.
+func (np *notificationPane) slideIn() {
+	var mi win.MONITORINFO
+	mi.CbSize = uint32(unsafe.Sizeof(mi))
+
+	hMonitor := win.MonitorFromWindow(np.center.owner.Handle(), win.MONITOR_DEFAULTTONEAREST)
+	if !win.GetMonitorInfo(hMonitor, &mi) {
+		return
+	}
+
+	work := rectangleFromRECT(mi.RcWork)
+	width := IntFrom96DPI(360, np.DPI())
+
+	np.finalX = work.X + work.Width - width
+	np.step = 0
+
+	np.SetBoundsPixels(Rectangle{work.X + work.Width, work.Y, width, work.Height})
+	np.Show()
+
+	win.SetTimer(np.hWnd, notificationPaneSlideTimerId, 15, 0)
+}
+
+func (np *notificationPane) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_TIMER:
+		if wParam == notificationPaneSlideTimerId {
+			const steps = 10
+
+			np.step++
+
+			b := np.BoundsPixels()
+			width := b.Width
+			start := np.finalX + width
+
+			// Ease out, so the pane slows down when arriving.
+			t := float64(np.step) / steps
+			b.X = start - int(float64(width)*(1-(1-t)*(1-t)))
+
+			if np.step >= steps {
+				b.X = np.finalX
+				win.KillTimer(hwnd, notificationPaneSlideTimerId)
+			}
+
+			win.SetWindowPos(hwnd, 0, int32(b.X), int32(b.Y), 0, 0, win.SWP_NOSIZE|win.SWP_NOZORDER|win.SWP_NOACTIVATE)
+
+			return 0
+		}
+
+	case win.WM_ACTIVATE:
+		if win.LOWORD(uint32(wParam)) == win.WA_INACTIVE {
+			// Like the system notification center, the pane goes away when
+			// the user turns to something else.
+			np.Synchronize(func() {
+				if !np.IsDisposed() {
+					np.Cancel()
+				}
+			})
+		}
+
+	case win.WM_DESTROY:
+		win.KillTimer(hwnd, notificationPaneSlideTimerId)
+	}
+
+	return np.Dialog.WndProc(hwnd, msg, wParam, lParam)
+}
@@ -65,6 +65,9 @@ func notifyIconWndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) (resul
 		return 0
 	case win.NIN_BALLOONUSERCLICK:
 		ni.messageClickedPublisher.Publish()
+
+	case win.NIN_BALLOONTIMEOUT:
+		ni.messageTimedOutPublisher.Publish()
 	}
 
 	return win.DefWindowProc(hwnd, msg, wParam, lParam)
@@ -72,16 +75,20 @@ func notifyIconWndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) (resul
 
 // NotifyIcon represents an icon in the taskbar notification area.
 type NotifyIcon struct {
-	id                      uint32
-	hWnd                    win.HWND
-	lastDPI                 int
-	contextMenu             *Menu
-	icon                    Image
-	toolTip                 string
-	visible                 bool
-	mouseDownPublisher      MouseEventPublisher
-	mouseUpPublisher        MouseEventPublisher
-	messageClickedPublisher EventPublisher
+	id                       uint32
+	hWnd                     win.HWND
+	lastDPI                  int
+	contextMenu              *Menu
+	icon                     Image
+	toolTip                  string
+	visible                  bool
+	mouseDownPublisher       MouseEventPublisher
+	mouseUpPublisher         MouseEventPublisher
+	messageClickedPublisher  EventPublisher
+	messageTimedOutPublisher EventPublisher
+	lastMessageTitle         string
+	lastMessageInfo          string
+	lastMessageIconType      uint32
 }
 
 // NewNotifyIcon creates and returns a new NotifyIcon.
@@ -207,6 +214,9 @@ func (ni *NotifyIcon) showMessage(title, info string, iconType uint32, icon Imag
 	if !win.Shell_NotifyIcon(win.NIM_MODIFY, nid) {
 		return newError("Shell_NotifyIcon")
 	}
+	ni.lastMessageTitle = title
+	ni.lastMessageInfo = info
+	ni.lastMessageIconType = iconType
 	if oldIcon != nil {
 		ni.icon = nil
 		ni.SetIcon(oldIcon)
@@ -374,3 +384,10 @@ func (ni *NotifyIcon) MouseUp() *MouseEvent {
 func (ni *NotifyIcon) MessageClicked() *Event {
 	return ni.messageClickedPublisher.Event()
 }
+
+// MessageTimedOut occurs when a message shown with ShowMessage or one of its
+// iconed variants disappeared without being clicked, either because it timed
+// out or because the user closed it.
+func (ni *NotifyIcon) MessageTimedOut() *Event {
+	return ni.messageTimedOutPublisher.Event()
+}
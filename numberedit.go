@@ -463,9 +463,12 @@ func (ne *NumberEdit) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr)
 }
 
 func (ne *NumberEdit) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	idealSize := ne.dialogBaseUnitsToPixels(Size{50, 12})
+
 	return &numberEditLayoutItem{
-		idealSize: ne.dialogBaseUnitsToPixels(Size{50, 12}),
+		idealSize: idealSize,
 		minSize:   ne.dialogBaseUnitsToPixels(Size{20, 12}),
+		baseline:  ne.centeredTextBaseline(idealSize.Height),
 	}
 }
 
@@ -473,6 +476,11 @@ type numberEditLayoutItem struct {
 	LayoutItemBase
 	idealSize Size // in native pixels
 	minSize   Size // in native pixels
+	baseline  int  // in native pixels
+}
+
+func (li *numberEditLayoutItem) BaselineOffset() int {
+	return li.baseline
 }
 
 func (*numberEditLayoutItem) LayoutFlags() LayoutFlags {
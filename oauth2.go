@@ -0,0 +1,525 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/lxn/win"
+)
+
+// OAuth2Method selects how the user signs in during an OAuth2Login.
+type OAuth2Method int
+
+const (
+	// OAuth2SystemBrowser opens the authorization page in the default web
+	// browser and receives the response via a listener on the loopback
+	// interface. This is what RFC 8252 recommends for native apps.
+	OAuth2SystemBrowser OAuth2Method = iota
+
+	// OAuth2EmbeddedBrowser opens the authorization page in a dialog, using
+	// a WebView. Note that some identity providers refuse embedded browsers.
+	OAuth2EmbeddedBrowser
+)
+
+// OAuth2Config describes an OAuth2 client and the endpoints of its
+// authorization server.
+type OAuth2Config struct {
+	AuthURL      string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string // Optional, as public clients are secured by PKCE.
+	Scopes       []string
+
+	// RedirectURL is required for OAuth2EmbeddedBrowser. The dialog closes
+	// when the browser is about to navigate to it. It is ignored for
+	// OAuth2SystemBrowser, which uses a loopback URL like
+	// http://127.0.0.1:50123/.
+	RedirectURL string
+
+	// AuthParams are added to the query of the authorization URL, e.g.
+	// "prompt" or "login_hint".
+	AuthParams map[string]string
+}
+
+// OAuth2Token holds the tokens returned by an authorization server.
+type OAuth2Token struct {
+	AccessToken  string
+	TokenType    string
+	RefreshToken string
+	IDToken      string
+	Expiry       time.Time // The zero value means the token does not expire.
+}
+
+// Expired returns whether the access token has expired, allowing for some
+// clock skew.
+func (t *OAuth2Token) Expired() bool {
+	return !t.Expiry.IsZero() && time.Now().Add(10*time.Second).After(t.Expiry)
+}
+
+// Refresh uses refreshToken to obtain a new token without user interaction.
+func (c *OAuth2Config) Refresh(ctx context.Context, refreshToken string) (*OAuth2Token, error) {
+	values := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}
+
+	token, err := c.requestToken(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+
+	return token, nil
+}
+
+func (c *OAuth2Config) authCodeURL(redirectURL, state, codeChallenge string) (string, error) {
+	u, err := url.Parse(c.AuthURL)
+	if err != nil {
+		return "", wrapError(err)
+	}
+
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", c.ClientID)
+	query.Set("redirect_uri", redirectURL)
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	if len(c.Scopes) > 0 {
+		query.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	for k, v := range c.AuthParams {
+		query.Set(k, v)
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+func (c *OAuth2Config) exchange(ctx context.Context, code, redirectURL, codeVerifier string) (*OAuth2Token, error) {
+	return c.requestToken(ctx, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURL},
+		"code_verifier": {codeVerifier},
+	})
+}
+
+func (c *OAuth2Config) requestToken(ctx context.Context, values url.Values) (*OAuth2Token, error) {
+	values.Set("client_id", c.ClientID)
+	if c.ClientSecret != "" {
+		values.Set("client_secret", c.ClientSecret)
+	}
+
+	req, err := http.NewRequest("POST", c.TokenURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, wrapErrorNoPanic(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, wrapErrorNoPanic(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, wrapErrorNoPanic(err)
+	}
+
+	var data struct {
+		AccessToken      string      `json:"access_token"`
+		TokenType        string      `json:"token_type"`
+		RefreshToken     string      `json:"refresh_token"`
+		IDToken          string      `json:"id_token"`
+		ExpiresIn        json.Number `json:"expires_in"`
+		Error            string      `json:"error"`
+		ErrorDescription string      `json:"error_description"`
+	}
+
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, newErrorNoPanic(fmt.Sprintf("invalid token response (HTTP %d)", resp.StatusCode))
+	}
+
+	if data.Error != "" {
+		return nil, newOAuth2Error(data.Error, data.ErrorDescription)
+	}
+
+	if resp.StatusCode != http.StatusOK || data.AccessToken == "" {
+		return nil, newErrorNoPanic(fmt.Sprintf("token request failed (HTTP %d)", resp.StatusCode))
+	}
+
+	token := &OAuth2Token{
+		AccessToken:  data.AccessToken,
+		TokenType:    data.TokenType,
+		RefreshToken: data.RefreshToken,
+		IDToken:      data.IDToken,
+	}
+
+	if seconds, err := data.ExpiresIn.Int64(); err == nil && seconds > 0 {
+		token.Expiry = time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+
+	return token, nil
+}
+
+func newOAuth2Error(code, description string) error {
+	if description != "" {
+		return newErrorNoPanic(fmt.Sprintf("%s: %s", code, description))
+	}
+
+	return newErrorNoPanic(code)
+}
+
+// OAuth2Login runs an OAuth2 authorization code flow with PKCE.
+//
+// Progress is displayed in a dialog the user may cancel and errors are
+// reported in a message box.
+type OAuth2Login struct {
+	Config  OAuth2Config
+	Method  OAuth2Method
+	Title   string        // The title of the dialogs. Defaults to "Sign In".
+	Timeout time.Duration // How long to wait for the system browser. Defaults to 5 minutes.
+	Token   *OAuth2Token  // The token obtained by Run.
+}
+
+// Run runs the flow and returns whether it succeeded, in which case the token
+// is available from the Token field.
+//
+// If the user canceled, accepted is false and err is nil.
+func (l *OAuth2Login) Run(owner Form) (accepted bool, err error) {
+	title := l.Title
+	if title == "" {
+		title = tr("Sign In", "walk")
+	}
+
+	defer func() {
+		if err != nil {
+			ShowError(owner, err, &ErrorDialogOptions{Title: title})
+		}
+	}()
+
+	verifier, err := oauth2RandomString()
+	if err != nil {
+		return false, err
+	}
+
+	state, err := oauth2RandomString()
+	if err != nil {
+		return false, err
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	var code, redirectURL string
+	switch l.Method {
+	case OAuth2EmbeddedBrowser:
+		redirectURL = l.Config.RedirectURL
+		code, err = l.authorizeEmbedded(owner, title, redirectURL, state, challenge)
+
+	default:
+		code, redirectURL, err = l.authorizeSystemBrowser(owner, title, state, challenge)
+	}
+
+	if err != nil || code == "" {
+		return false, err
+	}
+
+	var token *OAuth2Token
+	canceled, err := runWithProgressDialog(owner, title, tr("Signing in...", "walk"), func(ctx context.Context) (err error) {
+		token, err = l.Config.exchange(ctx, code, redirectURL, verifier)
+		return
+	})
+	if canceled || err != nil {
+		return false, err
+	}
+
+	l.Token = token
+
+	return true, nil
+}
+
+func (l *OAuth2Login) authorizeEmbedded(owner Form, title, redirectURL, state, challenge string) (code string, err error) {
+	if redirectURL == "" {
+		return "", newError("RedirectURL is required for OAuth2EmbeddedBrowser")
+	}
+
+	authURL, err := l.Config.authCodeURL(redirectURL, state, challenge)
+	if err != nil {
+		return "", err
+	}
+
+	dlg, err := NewDialog(owner)
+	if err != nil {
+		return "", err
+	}
+	defer dlg.Dispose()
+
+	dlg.SetTitle(title)
+	dlg.SetMinMaxSize(Size{640, 600}, Size{})
+
+	layout := NewVBoxLayout()
+	layout.SetMargins(Margins{})
+	if err := dlg.SetLayout(layout); err != nil {
+		return "", err
+	}
+
+	wv, err := NewWebView(dlg)
+	if err != nil {
+		return "", err
+	}
+
+	wv.Navigating().Attach(func(eventData *WebViewNavigatingEventData) {
+		u := eventData.Url()
+		if !strings.HasPrefix(u, redirectURL) {
+			return
+		}
+
+		eventData.SetCanceled(true)
+
+		code, err = oauth2ParseCallback(u, state)
+		dlg.Accept()
+	})
+
+	if err := wv.SetURL(authURL); err != nil {
+		return "", err
+	}
+
+	if dlg.Run() != DlgCmdOK {
+		return "", nil
+	}
+
+	return code, err
+}
+
+func (l *OAuth2Login) authorizeSystemBrowser(owner Form, title, state, challenge string) (code, redirectURL string, err error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", "", wrapError(err)
+	}
+
+	redirectURL = fmt.Sprintf("http://127.0.0.1:%d/", listener.Addr().(*net.TCPAddr).Port)
+
+	authURL, err := l.Config.authCodeURL(redirectURL, state, challenge)
+	if err != nil {
+		listener.Close()
+		return "", "", err
+	}
+
+	type callbackResult struct {
+		code string
+		err  error
+	}
+	results := make(chan callbackResult, 1)
+	var once sync.Once
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/" {
+				http.NotFound(w, r)
+				return
+			}
+
+			code, err := oauth2ParseCallback(r.URL.String(), state)
+
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			if err != nil {
+				fmt.Fprintf(w, "<html><body><p>%s</p></body></html>", tr("Sign-in failed. You can close this window.", "walk"))
+			} else {
+				fmt.Fprintf(w, "<html><body><p>%s</p></body></html>", tr("Sign-in complete. You can close this window.", "walk"))
+			}
+
+			once.Do(func() {
+				results <- callbackResult{code, err}
+			})
+		}),
+	}
+	go server.Serve(listener)
+	defer server.Close()
+
+	var hwnd win.HWND
+	if owner != nil {
+		hwnd = owner.Handle()
+	}
+
+	if ret := shellExecute(
+		hwnd,
+		syscall.StringToUTF16Ptr("open"),
+		syscall.StringToUTF16Ptr(authURL),
+		nil,
+		nil,
+		win.SW_SHOWNORMAL); ret <= 32 {
+
+		return "", "", newError(fmt.Sprintf("ShellExecute failed: %d", ret))
+	}
+
+	timeout := l.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
+
+	canceled, err := runWithProgressDialog(owner, title, tr("Please complete the sign-in in your web browser.", "walk"), func(ctx context.Context) error {
+		select {
+		case result := <-results:
+			code = result.code
+			return result.err
+
+		case <-time.After(timeout):
+			return newErrorNoPanic("timed out waiting for the sign-in to complete")
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	if canceled || err != nil {
+		return "", "", err
+	}
+
+	return code, redirectURL, nil
+}
+
+// oauth2ParseCallback extracts the authorization code from the URL the
+// authorization server redirected to.
+func oauth2ParseCallback(rawURL, state string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", wrapErrorNoPanic(err)
+	}
+
+	query := u.Query()
+
+	if e := query.Get("error"); e != "" {
+		return "", newOAuth2Error(e, query.Get("error_description"))
+	}
+
+	if query.Get("state") != state {
+		return "", newErrorNoPanic("invalid state in authorization response")
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		return "", newErrorNoPanic("missing code in authorization response")
+	}
+
+	return code, nil
+}
+
+func oauth2RandomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", wrapError(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// runWithProgressDialog runs work on a separate goroutine while a modal dialog
+// displaying text and an indeterminate progress bar is shown.
+//
+// If the user cancels the dialog, the context passed to work is canceled and
+// canceled is returned as true without waiting for work to return.
+func runWithProgressDialog(owner Form, title, text string, work func(ctx context.Context) error) (canceled bool, err error) {
+	dlg, err := NewDialog(owner)
+	if err != nil {
+		return false, err
+	}
+	defer dlg.Dispose()
+
+	dlg.SetTitle(title)
+	dlg.SetMinMaxSize(Size{360, 0}, Size{})
+
+	if err := dlg.SetLayout(NewVBoxLayout()); err != nil {
+		return false, err
+	}
+
+	label, err := NewLabel(dlg)
+	if err != nil {
+		return false, err
+	}
+	label.SetText(text)
+
+	pb, err := NewProgressBar(dlg)
+	if err != nil {
+		return false, err
+	}
+	pb.SetMarqueeMode(true)
+
+	buttons, err := NewComposite(dlg)
+	if err != nil {
+		return false, err
+	}
+
+	buttonsLayout := NewHBoxLayout()
+	buttonsLayout.SetMargins(Margins{})
+	if err := buttons.SetLayout(buttonsLayout); err != nil {
+		return false, err
+	}
+
+	if _, err := NewHSpacer(buttons); err != nil {
+		return false, err
+	}
+
+	cancelButton, err := NewPushButton(buttons)
+	if err != nil {
+		return false, err
+	}
+	cancelButton.SetText(tr("Cancel", "walk"))
+	cancelButton.Clicked().Attach(dlg.Cancel)
+
+	if err := dlg.SetCancelButton(cancelButton); err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var workErr error
+	done := false
+
+	go func() {
+		err := work(ctx)
+
+		dlg.Synchronize(func() {
+			if done {
+				return
+			}
+
+			workErr = err
+			dlg.Accept()
+		})
+	}()
+
+	result := dlg.Run()
+	done = true
+
+	if result != DlgCmdOK {
+		return true, nil
+	}
+
+	return false, workErr
+}
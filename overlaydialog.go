@@ -0,0 +1,502 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"github.com/lxn/win"
+)
+
+const overlayDialogMargin = 16 // in 1/96"
+
+// overlayLayer is the window of an OverlayDialog, that covers the client area
+// of the form with a dimmed snapshot of its contents.
+type overlayLayer struct {
+	*Composite
+	dialog *OverlayDialog
+}
+
+func (ol *overlayLayer) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_ERASEBKGND:
+		return 1
+
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+
+		hdc := win.BeginPaint(hwnd, &ps)
+		defer win.EndPaint(hwnd, &ps)
+
+		canvas, err := newCanvasFromHDC(hdc)
+		if err != nil {
+			return 0
+		}
+		defer canvas.Dispose()
+
+		ol.dialog.paint(canvas)
+
+		return 0
+
+	case win.WM_SIZE:
+		ol.dialog.layoutContent()
+
+		return 0
+	}
+
+	return ol.Composite.WndProc(hwnd, msg, wParam, lParam)
+}
+
+// OverlayDialog is a modal dialog that is displayed inside the client area of
+// a form, instead of in a window of its own.
+//
+// While it is shown, the rest of the client area is dimmed and can not be
+// interacted with, and keyboard focus stays within the dialog. As it is part
+// of the form, it can not get lost behind other windows.
+//
+// The OverlayDialog itself is the Container that holds the contents of the
+// dialog. It is centered in the client area and sized to the minimum size of
+// its layout.
+//
+// Unlike Dialog, showing an OverlayDialog does not block. The Finished event
+// is published with the result when it has been closed. An OverlayDialog is
+// disposed after it has been closed.
+type OverlayDialog struct {
+	*Composite
+	form              Form
+	layer             *overlayLayer
+	previous          *OverlayDialog
+	result            int
+	shown             bool
+	snapshot          *Bitmap
+	dimOpacity        byte
+	disabledHWnds     []win.HWND
+	prevFocusHWnd     win.HWND
+	defaultButton     *PushButton
+	cancelButton      *PushButton
+	sizeChangedHandle int
+	finishedPublisher IntEventPublisher
+}
+
+// NewOverlayDialog creates and initializes a new, hidden OverlayDialog in the
+// client area of form.
+func NewOverlayDialog(form Form) (*OverlayDialog, error) {
+	if form == nil {
+		return nil, newError("form must not be nil")
+	}
+
+	layerComposite, err := NewCompositeWithStyle(form, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	layer := &overlayLayer{Composite: layerComposite}
+
+	od := &OverlayDialog{
+		form:       form,
+		layer:      layer,
+		dimOpacity: 128,
+	}
+	layer.dialog = od
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			layer.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(layer); err != nil {
+		return nil, err
+	}
+
+	layer.SetVisible(false)
+
+	// The layer must not take part in the layout of the form.
+	layer.parent = nil
+	form.Children().Remove(layer)
+	layer.parent = form
+	win.SetParent(layer.hWnd, form.Handle())
+
+	composite, err := NewCompositeWithStyle(layer, 0)
+	if err != nil {
+		return nil, err
+	}
+	od.Composite = composite
+
+	if err := InitWrapperWindow(od); err != nil {
+		return nil, err
+	}
+
+	brush, err := NewSystemColorBrush(SysColorBtnFace)
+	if err != nil {
+		return nil, err
+	}
+	od.SetBackground(brush)
+
+	layout := NewVBoxLayout()
+	if err := od.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	succeeded = true
+
+	return od, nil
+}
+
+// Form returns the form the OverlayDialog is displayed in.
+func (od *OverlayDialog) Form() Form {
+	return od.form
+}
+
+// DimOpacity returns how strongly the rest of the client area is darkened,
+// from 0 (not at all) to 255 (black).
+func (od *OverlayDialog) DimOpacity() byte {
+	return od.dimOpacity
+}
+
+// SetDimOpacity sets how strongly the rest of the client area is darkened,
+// from 0 (not at all) to 255 (black). It must be called before Show.
+func (od *OverlayDialog) SetDimOpacity(opacity byte) {
+	od.dimOpacity = opacity
+}
+
+// DefaultButton returns the button that is clicked when the user presses
+// Enter.
+func (od *OverlayDialog) DefaultButton() *PushButton {
+	return od.defaultButton
+}
+
+// SetDefaultButton sets the button that is clicked when the user presses
+// Enter.
+func (od *OverlayDialog) SetDefaultButton(button *PushButton) error {
+	if button != nil && !win.IsChild(od.hWnd, button.hWnd) {
+		return newError("not a descendant of the dialog")
+	}
+
+	succeeded := false
+	if od.defaultButton != nil {
+		if err := od.defaultButton.setAndClearStyleBits(win.BS_PUSHBUTTON, win.BS_DEFPUSHBUTTON); err != nil {
+			return err
+		}
+
+		defer func() {
+			if !succeeded {
+				od.defaultButton.setAndClearStyleBits(win.BS_DEFPUSHBUTTON, win.BS_PUSHBUTTON)
+			}
+		}()
+	}
+
+	if button != nil {
+		if err := button.setAndClearStyleBits(win.BS_DEFPUSHBUTTON, win.BS_PUSHBUTTON); err != nil {
+			return err
+		}
+	}
+
+	od.defaultButton = button
+
+	succeeded = true
+
+	return nil
+}
+
+// CancelButton returns the button that is clicked when the user presses
+// Escape.
+func (od *OverlayDialog) CancelButton() *PushButton {
+	return od.cancelButton
+}
+
+// SetCancelButton sets the button that is clicked when the user presses
+// Escape. If there is none, Escape cancels the dialog.
+func (od *OverlayDialog) SetCancelButton(button *PushButton) error {
+	if button != nil && !win.IsChild(od.hWnd, button.hWnd) {
+		return newError("not a descendant of the dialog")
+	}
+
+	od.cancelButton = button
+
+	return nil
+}
+
+// Result returns the result the OverlayDialog was closed with.
+func (od *OverlayDialog) Result() int {
+	return od.result
+}
+
+// Finished returns the event that is published with the result, when the
+// OverlayDialog has been closed.
+func (od *OverlayDialog) Finished() *IntEvent {
+	return od.finishedPublisher.Event()
+}
+
+// Show dims the client area of the form and displays the OverlayDialog on
+// top of it.
+func (od *OverlayDialog) Show() error {
+	if od.shown {
+		return nil
+	}
+
+	fb := od.form.AsFormBase()
+
+	if err := od.captureSnapshot(); err != nil {
+		return err
+	}
+
+	od.prevFocusHWnd = win.GetFocus()
+
+	// Trap the focus by disabling everything else in the form.
+	for hwnd := win.GetWindow(fb.hWnd, win.GW_CHILD); hwnd != 0; hwnd = win.GetWindow(hwnd, win.GW_HWNDNEXT) {
+		if hwnd != od.layer.hWnd && win.IsWindowEnabled(hwnd) {
+			win.EnableWindow(hwnd, false)
+			od.disabledHWnds = append(od.disabledHWnds, hwnd)
+		}
+	}
+
+	od.previous = fb.overlayDialog
+	fb.overlayDialog = od
+	od.shown = true
+
+	od.sizeChangedHandle = od.form.SizeChanged().Attach(od.updateLayerBounds)
+	od.updateLayerBounds()
+
+	win.SetWindowPos(od.layer.hWnd, win.HWND_TOP, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE)
+	od.layer.SetVisible(true)
+
+	if w := firstFocusableDescendant(od); w != nil {
+		w.SetFocus()
+	} else {
+		od.SetFocus()
+	}
+
+	return nil
+}
+
+// ShowAsync shows the OverlayDialog and returns a channel, that receives the
+// result once, when the OverlayDialog has been closed, and is closed
+// afterwards.
+func (od *OverlayDialog) ShowAsync() <-chan DialogResult {
+	results := make(chan DialogResult, 1)
+
+	if err := od.Show(); err != nil {
+		results <- DialogResult{Err: err}
+		close(results)
+		return results
+	}
+
+	od.Finished().Once(func(result int) {
+		results <- DialogResult{Result: result}
+		close(results)
+	})
+
+	return results
+}
+
+// Accept closes the OverlayDialog with result DlgCmdOK.
+func (od *OverlayDialog) Accept() {
+	od.Close(DlgCmdOK)
+}
+
+// Cancel closes the OverlayDialog with result DlgCmdCancel.
+func (od *OverlayDialog) Cancel() {
+	od.Close(DlgCmdCancel)
+}
+
+// Close hides and disposes the OverlayDialog, restores the form and publishes
+// the Finished event with result.
+func (od *OverlayDialog) Close(result int) {
+	if !od.shown {
+		return
+	}
+
+	od.result = result
+	od.shown = false
+
+	fb := od.form.AsFormBase()
+
+	od.form.SizeChanged().Detach(od.sizeChangedHandle)
+
+	if fb.overlayDialog == od {
+		fb.overlayDialog = od.previous
+	}
+
+	for _, hwnd := range od.disabledHWnds {
+		win.EnableWindow(hwnd, true)
+	}
+	od.disabledHWnds = nil
+
+	od.layer.SetVisible(false)
+
+	if od.prevFocusHWnd != 0 {
+		win.SetFocus(od.prevFocusHWnd)
+	}
+
+	od.finishedPublisher.Publish(result)
+
+	od.Dispose()
+}
+
+// Dispose releases the resources of the OverlayDialog and destroys its
+// windows. It is called automatically by Close.
+func (od *OverlayDialog) Dispose() {
+	if od.shown {
+		od.Close(DlgCmdNone)
+		return
+	}
+
+	if od.snapshot != nil {
+		od.snapshot.Dispose()
+		od.snapshot = nil
+	}
+
+	od.layer.Dispose()
+}
+
+func (od *OverlayDialog) handleCommand(cmdId uint16) {
+	var button *PushButton
+	if cmdId == win.IDOK {
+		button = od.defaultButton
+	} else {
+		button = od.cancelButton
+	}
+
+	if button != nil {
+		if button.Visible() && button.Enabled() {
+			button.raiseClicked()
+		}
+	} else if cmdId == win.IDCANCEL {
+		od.Cancel()
+	}
+}
+
+func (od *OverlayDialog) updateLayerBounds() {
+	var rc win.RECT
+	if !win.GetClientRect(od.form.Handle(), &rc) {
+		return
+	}
+
+	od.layer.SetBoundsPixels(Rectangle{0, 0, int(rc.Right), int(rc.Bottom)})
+}
+
+func (od *OverlayDialog) layoutContent() {
+	if od.Composite == nil {
+		return
+	}
+
+	var rc win.RECT
+	if !win.GetClientRect(od.layer.hWnd, &rc) {
+		return
+	}
+
+	margin := IntFrom96DPI(overlayDialogMargin, od.DPI())
+	width, height := int(rc.Right), int(rc.Bottom)
+
+	size := maxSize(od.MinSizeHint(), od.MinSizePixels())
+	size.Width = maxi(0, mini(size.Width, width-2*margin))
+	size.Height = maxi(0, mini(size.Height, height-2*margin))
+
+	od.SetBoundsPixels(Rectangle{
+		X:      (width - size.Width) / 2,
+		Y:      (height - size.Height) / 2,
+		Width:  size.Width,
+		Height: size.Height,
+	})
+
+	od.layer.Invalidate()
+}
+
+// captureSnapshot takes a dimmed snapshot of the client area of the form.
+func (od *OverlayDialog) captureSnapshot() error {
+	hwnd := od.form.Handle()
+
+	var rc win.RECT
+	if !win.GetClientRect(hwnd, &rc) {
+		return lastError("GetClientRect")
+	}
+
+	if rc.Right <= 0 || rc.Bottom <= 0 {
+		return nil
+	}
+
+	bmp, err := NewBitmapForDPI(Size{int(rc.Right), int(rc.Bottom)}, od.form.DPI())
+	if err != nil {
+		return err
+	}
+
+	if err := bmp.withSelectedIntoMemDC(func(hdcMem win.HDC) error {
+		if !printWindow(hwnd, hdcMem, pwClientOnly|pwRenderFullContent) && !printWindow(hwnd, hdcMem, pwClientOnly) {
+			return lastError("PrintWindow")
+		}
+
+		return nil
+	}); err != nil {
+		bmp.Dispose()
+		return err
+	}
+
+	factor := 255 - int(od.dimOpacity)
+
+	if err := bmp.withPixels(func(bi *win.BITMAPINFO, hdc win.HDC, pixels *[maxPixels]bgraPixel, pixelsLen int) error {
+		for i := 0; i < pixelsLen; i++ {
+			p := &pixels[i]
+			p.B = byte(int(p.B) * factor / 255)
+			p.G = byte(int(p.G) * factor / 255)
+			p.R = byte(int(p.R) * factor / 255)
+			p.A = 0xff
+		}
+
+		if 0 == win.SetDIBits(hdc, bmp.hBmp, 0, uint32(bi.BmiHeader.BiHeight), &pixels[0].B, bi, win.DIB_RGB_COLORS) {
+			return newError("SetDIBits")
+		}
+
+		return nil
+	}); err != nil {
+		bmp.Dispose()
+		return err
+	}
+
+	if od.snapshot != nil {
+		od.snapshot.Dispose()
+	}
+	od.snapshot = bmp
+
+	return nil
+}
+
+func (od *OverlayDialog) paint(canvas *Canvas) {
+	var rc win.RECT
+	win.GetClientRect(od.layer.hWnd, &rc)
+	bounds := Rectangle{0, 0, int(rc.Right), int(rc.Bottom)}
+
+	// Areas the snapshot does not cover, e.g. after the form was enlarged,
+	// get the dimmed background color.
+	factor := 255 - int(od.dimOpacity)
+	bg := Color(win.GetSysColor(win.COLOR_BTNFACE))
+	dimmed := RGB(
+		byte(int(bg.R())*factor/255),
+		byte(int(bg.G())*factor/255),
+		byte(int(bg.B())*factor/255))
+
+	if brush, err := NewSolidColorBrush(dimmed); err == nil {
+		canvas.FillRectanglePixels(brush, bounds)
+		brush.Dispose()
+	}
+
+	if od.snapshot != nil {
+		canvas.DrawImagePixels(od.snapshot, Point{})
+	}
+
+	if od.Composite == nil {
+		return
+	}
+
+	// Frame the dialog.
+	b := od.BoundsPixels()
+	frame := Rectangle{b.X - 1, b.Y - 1, b.Width + 2, b.Height + 2}
+
+	if pen, err := NewCosmeticPen(PenSolid, Color(win.GetSysColor(win.COLOR_3DDKSHADOW))); err == nil {
+		canvas.DrawRectanglePixels(pen, frame)
+		pen.Dispose()
+	}
+}
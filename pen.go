@@ -7,6 +7,8 @@
 package walk
 
 import (
+	"math"
+
 	"github.com/lxn/win"
 )
 
@@ -138,11 +140,35 @@ func (p *CosmeticPen) Width() int {
 	return 1
 }
 
+// StrokeStyle describes the lines drawn by a pen created with NewStrokePen.
+// Lengths are specified in 1/96" units, so the lines look the same at any DPI.
+type StrokeStyle struct {
+	// Width is the width of the lines.
+	Width float64
+
+	// Dashes are the lengths of the alternating dashes and gaps of the lines,
+	// starting with a dash. At most 16 lengths are supported. If Dashes is
+	// empty, the lines are solid.
+	Dashes []float64
+
+	// Cap is the style of the ends of the lines and dashes, PenCapRound,
+	// PenCapSquare or PenCapFlat. The default is PenCapRound.
+	Cap PenStyle
+
+	// Join is the style of the corners, where lines meet, PenJoinRound,
+	// PenJoinBevel or PenJoinMiter. The default is PenJoinRound.
+	Join PenStyle
+}
+
+// maxStrokeDashes is the maximum number of dash lengths ExtCreatePen accepts.
+const maxStrokeDashes = 16
+
 type GeometricPen struct {
 	dpi2hPen   map[int]win.HPEN
 	style      PenStyle
 	brush      Brush
-	width96dpi int
+	width96dpi float64
+	dashes     []float64 // in 1/96" units
 }
 
 // NewGeometricPen prepares new geometric pen. width parameter is specified in 1/96" units.
@@ -155,11 +181,63 @@ func NewGeometricPen(style PenStyle, width int, brush Brush) (*GeometricPen, err
 
 	return &GeometricPen{
 		style:      style,
-		width96dpi: width,
+		width96dpi: float64(width),
 		brush:      brush,
 	}, nil
 }
 
+// NewStrokePen returns a new geometric pen, that draws lines with brush as
+// described by stroke.
+func NewStrokePen(stroke StrokeStyle, brush Brush) (*GeometricPen, error) {
+	if brush == nil {
+		return nil, newError("brush cannot be nil")
+	}
+
+	if stroke.Width <= 0 {
+		return nil, newError("width must be positive")
+	}
+
+	switch stroke.Cap {
+	case PenCapRound, PenCapSquare, PenCapFlat:
+	default:
+		return nil, newError("invalid cap")
+	}
+
+	switch stroke.Join {
+	case PenJoinRound, PenJoinBevel, PenJoinMiter:
+	default:
+		return nil, newError("invalid join")
+	}
+
+	style := PenStyle(win.PS_GEOMETRIC) | stroke.Cap | stroke.Join
+
+	if len(stroke.Dashes) > 0 {
+		if len(stroke.Dashes) > maxStrokeDashes {
+			return nil, newError("too many dashes")
+		}
+
+		var total float64
+		for _, length := range stroke.Dashes {
+			if length < 0 {
+				return nil, newError("dash lengths must not be negative")
+			}
+			total += length
+		}
+		if total == 0 {
+			return nil, newError("dash lengths must not all be zero")
+		}
+
+		style |= PenUserStyle
+	}
+
+	return &GeometricPen{
+		style:      style,
+		width96dpi: stroke.Width,
+		brush:      brush,
+		dashes:     append([]float64(nil), stroke.Dashes...),
+	}, nil
+}
+
 func (p *GeometricPen) Dispose() {
 	if len(p.dpi2hPen) == 0 {
 		return
@@ -183,10 +261,27 @@ func (p *GeometricPen) handleForDPIWithError(dpi int) (win.HPEN, error) {
 		return handle, nil
 	}
 
+	scale := float64(dpi) / 96
+
+	var dashes []uint32
+	var dashesPtr *uint32
+	if len(p.dashes) > 0 {
+		dashes = make([]uint32, len(p.dashes))
+		for i, length := range p.dashes {
+			dashes[i] = uint32(math.Round(length * scale))
+		}
+		dashesPtr = &dashes[0]
+	}
+
+	width := uint32(math.Round(p.width96dpi * scale))
+	if width == 0 && p.width96dpi > 0 {
+		width = 1
+	}
+
 	hPen := win.ExtCreatePen(
 		uint32(p.style),
-		uint32(IntFrom96DPI(p.width96dpi, dpi)),
-		p.brush.logbrush(), 0, nil)
+		width,
+		p.brush.logbrush(), uint32(len(dashes)), dashesPtr)
 	if hPen == 0 {
 		return 0, newError("ExtCreatePen failed")
 	}
@@ -202,7 +297,17 @@ func (p *GeometricPen) Style() PenStyle {
 
 // Width returns pen width in 1/96" units.
 func (p *GeometricPen) Width() int {
-	return p.width96dpi
+	return int(math.Round(p.width96dpi))
+}
+
+// StrokeStyle returns the width, dashes, cap and join of the pen.
+func (p *GeometricPen) StrokeStyle() StrokeStyle {
+	return StrokeStyle{
+		Width:  p.width96dpi,
+		Dashes: append([]float64(nil), p.dashes...),
+		Cap:    p.style & win.PS_ENDCAP_MASK,
+		Join:   p.style & win.PS_JOIN_MASK,
+	}
 }
 
 func (p *GeometricPen) Brush() Brush {
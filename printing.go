@@ -0,0 +1,467 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// PrintDocument is implemented by content, that can be printed with Print and
+// previewed with ShowPrintPreview.
+//
+// paper is the bounds of the whole sheet of paper in native pixels of the
+// canvas. Its origin may be negative, because printers can not print on the
+// edges of the paper, see Canvas.BoundsPixels for the printable area.
+type PrintDocument interface {
+	// Paginate lays the document out on sheets of paper and returns the
+	// number of pages. It is called before the pages are printed, every time
+	// the document is printed or previewed.
+	Paginate(canvas *Canvas, paper Rectangle) (pageCount int, err error)
+
+	// PrintPage draws the page with the zero based index page.
+	PrintPage(canvas *Canvas, paper Rectangle, page int) error
+}
+
+// maxPrintPageRanges is the maximum number of page ranges the user can enter
+// in the print dialog.
+const maxPrintPageRanges = 16
+
+// Print shows the print dialog owned by owner and prints doc as docName on
+// the printer the user selects. It returns false if the user canceled the
+// dialog.
+//
+// The user may select the pages to print. Copies and collation are left to the
+// printer driver.
+func Print(owner Form, doc PrintDocument, docName string) (printed bool, err error) {
+	ranges := make([]win.PRINTPAGERANGE, maxPrintPageRanges)
+
+	pd := win.PRINTDLGEX{
+		Flags:          win.PD_RETURNDC | win.PD_NOSELECTION | win.PD_NOCURRENTPAGE | win.PD_USEDEVMODECOPIESANDCOLLATE,
+		NMaxPageRanges: maxPrintPageRanges,
+		LpPageRanges:   &ranges[0],
+		NMinPage:       1,
+		NMaxPage:       0xffff,
+		NCopies:        1,
+		NStartPage:     win.START_PAGE_GENERAL,
+	}
+
+	hdc, err := printDialog(owner, &pd)
+	if err != nil || hdc == 0 {
+		return false, err
+	}
+	defer win.DeleteDC(hdc)
+
+	if pd.DwResultAction != win.PD_RESULT_PRINT {
+		return false, nil
+	}
+
+	var printPage func(page int) bool
+	if pd.Flags&win.PD_PAGENUMS != 0 {
+		ranges = ranges[:pd.NPageRanges]
+
+		printPage = func(page int) bool {
+			for _, r := range ranges {
+				if uint32(page+1) >= r.NFromPage && uint32(page+1) <= r.NToPage {
+					return true
+				}
+			}
+
+			return false
+		}
+	}
+
+	if err := printDocument(hdc, doc, docName, printPage); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// printDialog shows the print dialog, or with PD_RETURNDEFAULT just gets the
+// default printer, and returns the device context of the printer, which is 0
+// if the user canceled.
+func printDialog(owner Form, pd *win.PRINTDLGEX) (win.HDC, error) {
+	pd.LStructSize = uint32(unsafe.Sizeof(*pd))
+
+	// The print dialog requires an owner window.
+	if owner != nil {
+		pd.HwndOwner = owner.Handle()
+	} else if form := App().ActiveForm(); form != nil {
+		pd.HwndOwner = form.Handle()
+	} else {
+		pd.HwndOwner = win.GetDesktopWindow()
+	}
+
+	hr := win.PrintDlgEx(pd)
+
+	if pd.HDevMode != 0 {
+		win.GlobalFree(pd.HDevMode)
+	}
+	if pd.HDevNames != 0 {
+		win.GlobalFree(pd.HDevNames)
+	}
+
+	if win.FAILED(hr) {
+		return 0, errorFromHRESULT("PrintDlgEx", hr)
+	}
+
+	if pd.HDC == 0 && pd.Flags&win.PD_RETURNDEFAULT != 0 {
+		return 0, newError(fmt.Sprintf("no default printer (CommDlgExtendedError: 0x%x)", win.CommDlgExtendedError()))
+	}
+
+	return pd.HDC, nil
+}
+
+// defaultPrinterDC returns a device context of the default printer.
+func defaultPrinterDC(owner Form) (win.HDC, error) {
+	return printDialog(owner, &win.PRINTDLGEX{Flags: win.PD_RETURNDEFAULT | win.PD_RETURNDC})
+}
+
+// printerPaper returns the bounds of the paper of the printer in native
+// pixels, relative to the printable area.
+func printerPaper(hdc win.HDC) Rectangle {
+	return Rectangle{
+		-int(win.GetDeviceCaps(hdc, win.PHYSICALOFFSETX)),
+		-int(win.GetDeviceCaps(hdc, win.PHYSICALOFFSETY)),
+		int(win.GetDeviceCaps(hdc, win.PHYSICALWIDTH)),
+		int(win.GetDeviceCaps(hdc, win.PHYSICALHEIGHT)),
+	}
+}
+
+// printDocument prints the pages of doc, for which printPage returns true, or
+// all pages, if printPage is nil.
+func printDocument(hdc win.HDC, doc PrintDocument, docName string, printPage func(page int) bool) (err error) {
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return err
+	}
+	defer canvas.Dispose()
+
+	paper := printerPaper(hdc)
+
+	pageCount, err := doc.Paginate(canvas, paper)
+	if err != nil {
+		return err
+	}
+
+	di := win.DOCINFO{LpszDocName: syscall.StringToUTF16Ptr(docName)}
+	di.CbSize = int32(unsafe.Sizeof(di))
+
+	if win.StartDoc(hdc, &di) <= 0 {
+		return lastError("StartDoc")
+	}
+	defer func() {
+		if err != nil {
+			win.AbortDoc(hdc)
+		} else if win.EndDoc(hdc) <= 0 {
+			err = lastError("EndDoc")
+		}
+	}()
+
+	for page := 0; page < pageCount; page++ {
+		if printPage != nil && !printPage(page) {
+			continue
+		}
+
+		if win.StartPage(hdc) <= 0 {
+			return lastError("StartPage")
+		}
+
+		if err := doc.PrintPage(canvas, paper, page); err != nil {
+			return err
+		}
+
+		if win.EndPage(hdc) <= 0 {
+			return lastError("EndPage")
+		}
+	}
+
+	return nil
+}
+
+// printPreviewPages paginates doc for the printer and records its pages as
+// metafiles, whose frame is the whole sheet of paper.
+func printPreviewPages(hdc win.HDC, doc PrintDocument) (pages []*Metafile, paperSize Size, err error) {
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return nil, Size{}, err
+	}
+	defer canvas.Dispose()
+
+	paper := printerPaper(hdc)
+
+	pageCount, err := doc.Paginate(canvas, paper)
+	if err != nil {
+		return nil, Size{}, err
+	}
+
+	defer func() {
+		if err != nil {
+			for _, mf := range pages {
+				mf.Dispose()
+			}
+			pages = nil
+		}
+	}()
+
+	// The frame of a metafile is specified in .01 millimeters.
+	frame := win.RECT{
+		Right:  int32(paper.Width * 2540 / int(win.GetDeviceCaps(hdc, win.LOGPIXELSX))),
+		Bottom: int32(paper.Height * 2540 / int(win.GetDeviceCaps(hdc, win.LOGPIXELSY))),
+	}
+
+	// In the metafile, the origin is at the edge of the paper.
+	paper.X, paper.Y = 0, 0
+
+	for page := 0; page < pageCount; page++ {
+		mfHDC := win.CreateEnhMetaFile(hdc, nil, &frame, nil)
+		if mfHDC == 0 {
+			return pages, Size{}, newError("CreateEnhMetaFile failed")
+		}
+
+		mf := &Metafile{hdc: mfHDC}
+		pages = append(pages, mf)
+
+		mfCanvas, err := NewCanvasFromImage(mf)
+		if err != nil {
+			return pages, Size{}, err
+		}
+
+		err = doc.PrintPage(mfCanvas, paper, page)
+
+		mfCanvas.Dispose()
+
+		if err != nil {
+			return pages, Size{}, err
+		}
+	}
+
+	return pages, paper.Size(), nil
+}
+
+// ShowPrintPreview shows a modal dialog owned by owner, that previews doc as
+// it is printed on the default printer. From the dialog, the user can print
+// doc with Print.
+func ShowPrintPreview(owner Form, doc PrintDocument, docName string) error {
+	hdc, err := defaultPrinterDC(owner)
+	if err != nil {
+		return err
+	}
+
+	pages, paperSize, err := printPreviewPages(hdc, doc)
+	win.DeleteDC(hdc)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, mf := range pages {
+			mf.Dispose()
+		}
+	}()
+
+	dlg, err := NewDialog(owner)
+	if err != nil {
+		return err
+	}
+	defer dlg.Dispose()
+
+	title := tr("Print Preview", "walk")
+	if docName != "" {
+		title = docName + " - " + title
+	}
+	dlg.SetTitle(title)
+
+	if err := dlg.SetLayout(NewVBoxLayout()); err != nil {
+		return err
+	}
+
+	var current int
+
+	backgroundBrush, err := NewSystemColorBrush(SysColorAppWorkspace)
+	if err != nil {
+		return err
+	}
+	defer backgroundBrush.Dispose()
+
+	paperBrush, err := NewSolidColorBrush(RGB(255, 255, 255))
+	if err != nil {
+		return err
+	}
+	defer paperBrush.Dispose()
+
+	borderPen, err := NewCosmeticPen(PenSolid, RGB(0, 0, 0))
+	if err != nil {
+		return err
+	}
+	defer borderPen.Dispose()
+
+	view, err := NewCustomWidgetPixels(dlg, win.WS_TABSTOP, func(canvas *Canvas, updateBounds Rectangle) error {
+		bounds := canvas.BoundsPixels()
+
+		if err := canvas.FillRectanglePixels(backgroundBrush, bounds); err != nil {
+			return err
+		}
+
+		if len(pages) == 0 || paperSize.Width <= 0 || paperSize.Height <= 0 {
+			return nil
+		}
+
+		// Fit the page into the view, keeping its aspect ratio.
+		margin := IntFrom96DPI(12, canvas.DPI())
+		avail := Size{bounds.Width - 2*margin, bounds.Height - 2*margin}
+		if avail.Width <= 0 || avail.Height <= 0 {
+			return nil
+		}
+
+		scale := float64(avail.Width) / float64(paperSize.Width)
+		if s := float64(avail.Height) / float64(paperSize.Height); s < scale {
+			scale = s
+		}
+
+		size := Size{scaleInt(paperSize.Width, scale), scaleInt(paperSize.Height, scale)}
+		page := Rectangle{
+			(bounds.Width - size.Width) / 2,
+			(bounds.Height - size.Height) / 2,
+			size.Width,
+			size.Height,
+		}
+
+		if err := canvas.FillRectanglePixels(paperBrush, page); err != nil {
+			return err
+		}
+		if err := canvas.DrawRectanglePixels(borderPen, page); err != nil {
+			return err
+		}
+
+		return canvas.DrawImageStretchedPixels(pages[current], page)
+	})
+	if err != nil {
+		return err
+	}
+	view.SetPaintMode(PaintBuffered)
+	view.SetInvalidatesOnResize(true)
+	view.SetMinMaxSizePixels(Size{IntFrom96DPI(480, dlg.DPI()), IntFrom96DPI(600, dlg.DPI())}, Size{})
+
+	buttons, err := NewComposite(dlg)
+	if err != nil {
+		return err
+	}
+	buttonsLayout := NewHBoxLayout()
+	buttonsLayout.SetMargins(Margins{})
+	if err := buttons.SetLayout(buttonsLayout); err != nil {
+		return err
+	}
+
+	prevButton, err := NewPushButton(buttons)
+	if err != nil {
+		return err
+	}
+	prevButton.SetText(tr("&Previous", "walk"))
+
+	pageLabel, err := NewTextLabel(buttons)
+	if err != nil {
+		return err
+	}
+
+	nextButton, err := NewPushButton(buttons)
+	if err != nil {
+		return err
+	}
+	nextButton.SetText(tr("&Next", "walk"))
+
+	if _, err := NewHSpacer(buttons); err != nil {
+		return err
+	}
+
+	printButton, err := NewPushButton(buttons)
+	if err != nil {
+		return err
+	}
+	printButton.SetText(tr("&Print...", "walk"))
+
+	closeButton, err := NewPushButton(buttons)
+	if err != nil {
+		return err
+	}
+	closeButton.SetText(tr("Close", "walk"))
+
+	setCurrent := func(page int) {
+		if page < 0 || page >= len(pages) {
+			return
+		}
+
+		current = page
+
+		pageLabel.SetText(fmt.Sprintf(tr("Page %d of %d", "walk"), current+1, len(pages)))
+		prevButton.SetEnabled(current > 0)
+		nextButton.SetEnabled(current < len(pages)-1)
+
+		view.Invalidate()
+	}
+
+	prevButton.Clicked().Attach(func() {
+		setCurrent(current - 1)
+	})
+	nextButton.Clicked().Attach(func() {
+		setCurrent(current + 1)
+	})
+
+	view.KeyDown().Attach(func(key Key) {
+		switch key {
+		case KeyPrior, KeyLeft, KeyUp:
+			setCurrent(current - 1)
+
+		case KeyNext, KeyRight, KeyDown:
+			setCurrent(current + 1)
+
+		case KeyHome:
+			setCurrent(0)
+
+		case KeyEnd:
+			setCurrent(len(pages) - 1)
+		}
+	})
+
+	printButton.Clicked().Attach(func() {
+		printed, err := Print(dlg, doc, docName)
+		if err != nil {
+			ShowError(dlg, err, nil)
+			return
+		}
+
+		if printed {
+			dlg.Accept()
+		}
+	})
+
+	closeButton.Clicked().Attach(dlg.Cancel)
+
+	if err := dlg.SetCancelButton(closeButton); err != nil {
+		return err
+	}
+
+	if len(pages) == 0 {
+		pageLabel.SetText(tr("No pages", "walk"))
+		prevButton.SetEnabled(false)
+		nextButton.SetEnabled(false)
+		printButton.SetEnabled(false)
+	} else {
+		setCurrent(0)
+	}
+
+	dlg.Starting().Once(func() {
+		view.SetFocus()
+	})
+
+	dlg.Run()
+
+	return nil
+}
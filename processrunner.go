@@ -0,0 +1,346 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"sync"
+	"syscall"
+)
+
+const createNoWindow = 0x08000000
+
+// ProcessStream identifies an output stream of a child process.
+type ProcessStream int
+
+const (
+	ProcessStdout ProcessStream = iota
+	ProcessStderr
+)
+
+type processOutputEventHandlerInfo struct {
+	handler ProcessOutputEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+// ProcessOutputEventHandler is called with output of a child process.
+type ProcessOutputEventHandler func(text string, stream ProcessStream)
+
+type ProcessOutputEvent struct {
+	handlers []processOutputEventHandlerInfo
+}
+
+func (e *ProcessOutputEvent) Attach(handler ProcessOutputEventHandler) int {
+	handlerInfo := processOutputEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *ProcessOutputEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *ProcessOutputEvent) Once(handler ProcessOutputEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *ProcessOutputEvent) AttachScoped(owner Window, handler ProcessOutputEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type ProcessOutputEventPublisher struct {
+	event ProcessOutputEvent
+}
+
+func (p *ProcessOutputEventPublisher) Event() *ProcessOutputEvent {
+	return &p.event
+}
+
+func (p *ProcessOutputEventPublisher) Publish(text string, stream ProcessStream) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(text, stream)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
+
+// ProcessRunner launches a child process and streams its output to the UI
+// thread of a window.
+//
+// All events of a ProcessRunner are published on the UI thread of the owner
+// window, so handlers may safely access widgets.
+type ProcessRunner struct {
+	owner            Window
+	cmd              *exec.Cmd
+	cancel           context.CancelFunc
+	stdin            io.WriteCloser
+	stdinMutex       sync.Mutex
+	terminalView     *TerminalView
+	running          bool
+	exitCode         int
+	outputPublisher  ProcessOutputEventPublisher
+	exitedPublisher  IntEventPublisher
+	errorPublisher   ErrorEventPublisher
+	startedPublisher EventPublisher
+}
+
+// NewProcessRunner creates a new ProcessRunner for the program name with the
+// given arguments. Events are published on the UI thread of owner.
+//
+// The process is not started until Start is called, so its *exec.Cmd may be
+// configured further, e.g. to set the working directory or environment.
+func NewProcessRunner(owner Window, name string, args ...string) *ProcessRunner {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		HideWindow:    true,
+		CreationFlags: createNoWindow,
+	}
+
+	return &ProcessRunner{
+		owner:    owner,
+		cmd:      cmd,
+		cancel:   cancel,
+		exitCode: -1,
+	}
+}
+
+// Cmd returns the *exec.Cmd of the ProcessRunner.
+//
+// Stdin, Stdout and Stderr of the *exec.Cmd are managed by the ProcessRunner
+// and must not be set.
+func (pr *ProcessRunner) Cmd() *exec.Cmd {
+	return pr.cmd
+}
+
+// TerminalView returns the *TerminalView output is appended to, if any.
+func (pr *ProcessRunner) TerminalView() *TerminalView {
+	return pr.terminalView
+}
+
+// SetTerminalView sets a *TerminalView output is appended to.
+//
+// Output on stderr is displayed using the ErrorTextColor of the TerminalView.
+func (pr *ProcessRunner) SetTerminalView(tv *TerminalView) {
+	pr.terminalView = tv
+}
+
+// Running returns whether the process has been started and not exited yet.
+func (pr *ProcessRunner) Running() bool {
+	return pr.running
+}
+
+// ExitCode returns the exit code of the process, or -1 if the process has not
+// exited yet.
+func (pr *ProcessRunner) ExitCode() int {
+	return pr.exitCode
+}
+
+// Output returns the event that is published for each chunk of output of the
+// process.
+func (pr *ProcessRunner) Output() *ProcessOutputEvent {
+	return pr.outputPublisher.Event()
+}
+
+// Started returns the event that is published when the process was started.
+func (pr *ProcessRunner) Started() *Event {
+	return pr.startedPublisher.Event()
+}
+
+// Exited returns the event that is published with the exit code when the
+// process has exited and all of its output has been published.
+func (pr *ProcessRunner) Exited() *IntEvent {
+	return pr.exitedPublisher.Event()
+}
+
+// Error returns the event that is published when waiting for the process or
+// reading its output fails.
+func (pr *ProcessRunner) Error() *ErrorEvent {
+	return pr.errorPublisher.Event()
+}
+
+// Start starts the process.
+func (pr *ProcessRunner) Start() error {
+	if pr.running || pr.cmd.Process != nil {
+		return newError("process already started")
+	}
+
+	stdin, err := pr.cmd.StdinPipe()
+	if err != nil {
+		return wrapError(err)
+	}
+
+	stdout, err := pr.cmd.StdoutPipe()
+	if err != nil {
+		return wrapError(err)
+	}
+
+	stderr, err := pr.cmd.StderrPipe()
+	if err != nil {
+		return wrapError(err)
+	}
+
+	if err := pr.cmd.Start(); err != nil {
+		return wrapError(err)
+	}
+
+	pr.stdinMutex.Lock()
+	pr.stdin = stdin
+	pr.stdinMutex.Unlock()
+
+	pr.running = true
+	pr.startedPublisher.Publish()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go pr.readOutput(stdout, ProcessStdout, &wg)
+	go pr.readOutput(stderr, ProcessStderr, &wg)
+
+	go func() {
+		wg.Wait()
+
+		err := pr.cmd.Wait()
+		pr.cancel()
+
+		exitCode := -1
+		if state := pr.cmd.ProcessState; state != nil {
+			exitCode = state.ExitCode()
+		}
+
+		if _, ok := err.(*exec.ExitError); ok {
+			err = nil
+		}
+
+		pr.owner.Synchronize(func() {
+			pr.running = false
+			pr.exitCode = exitCode
+
+			if err != nil {
+				pr.errorPublisher.Publish(wrapErrorNoPanic(err))
+			}
+
+			pr.exitedPublisher.Publish(exitCode)
+		})
+	}()
+
+	return nil
+}
+
+func (pr *ProcessRunner) readOutput(r io.Reader, stream ProcessStream, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	buf := make([]byte, 4096)
+	var rest []byte
+
+	for {
+		n, err := r.Read(buf)
+
+		if n > 0 {
+			var complete []byte
+			complete, rest = splitIncompleteUTF8(append(rest, buf[:n]...))
+			rest = append([]byte(nil), rest...)
+
+			if len(complete) > 0 {
+				pr.publishOutput(string(complete), stream)
+			}
+		}
+
+		if err != nil {
+			if len(rest) > 0 {
+				pr.publishOutput(string(rest), stream)
+			}
+
+			if err != io.EOF {
+				pr.owner.Synchronize(func() {
+					pr.errorPublisher.Publish(wrapErrorNoPanic(err))
+				})
+			}
+
+			return
+		}
+	}
+}
+
+func (pr *ProcessRunner) publishOutput(text string, stream ProcessStream) {
+	pr.owner.Synchronize(func() {
+		if tv := pr.terminalView; tv != nil {
+			if stream == ProcessStderr {
+				tv.AppendErrorText(text)
+			} else {
+				tv.AppendText(text)
+			}
+		}
+
+		pr.outputPublisher.Publish(text, stream)
+	})
+}
+
+// Write writes p to the standard input of the process. It may be called from
+// any goroutine.
+func (pr *ProcessRunner) Write(p []byte) (int, error) {
+	pr.stdinMutex.Lock()
+	defer pr.stdinMutex.Unlock()
+
+	if pr.stdin == nil {
+		return 0, newError("process not started")
+	}
+
+	return pr.stdin.Write(p)
+}
+
+// WriteString writes s to the standard input of the process.
+func (pr *ProcessRunner) WriteString(s string) (int, error) {
+	return pr.Write([]byte(s))
+}
+
+// CloseStdin closes the standard input of the process, signaling end of input.
+func (pr *ProcessRunner) CloseStdin() error {
+	pr.stdinMutex.Lock()
+	defer pr.stdinMutex.Unlock()
+
+	if pr.stdin == nil {
+		return nil
+	}
+
+	err := pr.stdin.Close()
+	pr.stdin = nil
+
+	return err
+}
+
+// Cancel terminates the process. The Exited event is still published when it
+// has exited.
+func (pr *ProcessRunner) Cancel() {
+	pr.cancel()
+}
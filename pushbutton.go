@@ -12,6 +12,7 @@ import (
 
 type PushButton struct {
 	Button
+	shield bool
 }
 
 func NewPushButton(parent Container) (*PushButton, error) {
@@ -48,6 +49,21 @@ func (pb *PushButton) SetImageAboveText(value bool) error {
 	return pb.SetImage(pb.image)
 }
 
+// Shield returns whether the PushButton displays the UAC shield icon.
+func (pb *PushButton) Shield() bool {
+	return pb.shield
+}
+
+// SetShield sets whether the PushButton displays the UAC shield icon, to
+// indicate that clicking it requires elevated privileges.
+func (pb *PushButton) SetShield(value bool) {
+	pb.shield = value
+
+	pb.SendMessage(win.BCM_SETSHIELD, 0, uintptr(win.BoolToBOOL(value)))
+
+	pb.RequestLayout()
+}
+
 func (pb *PushButton) ensureProperDialogDefaultButton(hwndFocus win.HWND) {
 	widget := windowFromHandle(hwndFocus)
 	if widget == nil {
@@ -116,9 +132,12 @@ func (pb *PushButton) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr)
 }
 
 func (pb *PushButton) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	idealSize := pb.idealSize()
+
 	return &pushButtonLayoutItem{
 		buttonLayoutItem: buttonLayoutItem{
-			idealSize: pb.idealSize(),
+			idealSize: idealSize,
+			baseline:  pb.centeredTextBaseline(idealSize.Height),
 		},
 	}
 }
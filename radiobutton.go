@@ -10,9 +10,21 @@ import (
 	"github.com/lxn/win"
 )
 
+// RadioButtonGroup is a group of RadioButtons, of which at most one is
+// checked. Consecutive RadioButtons of a Container are grouped automatically.
+// Buttons can also be added to a group explicitly with Add, e.g. when they do
+// not share a parent.
+//
+// The value of the group is the value of its checked button.
 type RadioButtonGroup struct {
-	buttons       []*RadioButton
-	checkedButton *RadioButton
+	buttons               []*RadioButton
+	checkedButton         *RadioButton
+	valueChangedPublisher EventPublisher
+}
+
+// NewRadioButtonGroup returns a new, empty RadioButtonGroup.
+func NewRadioButtonGroup() *RadioButtonGroup {
+	return new(RadioButtonGroup)
 }
 
 func (rbg *RadioButtonGroup) Buttons() []*RadioButton {
@@ -25,6 +37,111 @@ func (rbg *RadioButtonGroup) CheckedButton() *RadioButton {
 	return rbg.checkedButton
 }
 
+// Add moves button from its current group to rbg and sets its value.
+//
+// Windows still unchecks the buttons, that follow each other in the same
+// parent, when one of them is checked, so they should be added to the same
+// group.
+func (rbg *RadioButtonGroup) Add(button *RadioButton, value interface{}) error {
+	if button == nil {
+		return newError("button cannot be nil")
+	}
+
+	if old := button.group; old != rbg {
+		if old != nil {
+			old.remove(button)
+		}
+
+		button.group = rbg
+		rbg.buttons = append(rbg.buttons, button)
+	}
+
+	button.value = value
+
+	if button.Checked() {
+		rbg.setCheckedButton(button)
+	}
+
+	return nil
+}
+
+func (rbg *RadioButtonGroup) remove(button *RadioButton) {
+	for i, b := range rbg.buttons {
+		if b == button {
+			rbg.buttons = append(rbg.buttons[:i], rbg.buttons[i+1:]...)
+			break
+		}
+	}
+
+	if rbg.checkedButton == button {
+		rbg.checkedButton = nil
+		rbg.valueChangedPublisher.Publish()
+	}
+}
+
+// Value returns the value of the checked button, or nil if no button is
+// checked.
+func (rbg *RadioButtonGroup) Value() interface{} {
+	if rbg.checkedButton == nil {
+		return nil
+	}
+
+	return rbg.checkedButton.value
+}
+
+// SetValue checks the button with value. If value is nil, no button is
+// checked.
+func (rbg *RadioButtonGroup) SetValue(value interface{}) error {
+	if value == nil {
+		rbg.setCheckedButton(nil)
+		return nil
+	}
+
+	for _, b := range rbg.buttons {
+		if b.value == value {
+			rbg.setCheckedButton(b)
+			return nil
+		}
+	}
+
+	return newError("no button with value")
+}
+
+// ValueChanged returns the event that is published when another button, or
+// none, becomes checked.
+func (rbg *RadioButtonGroup) ValueChanged() *Event {
+	return rbg.valueChangedPublisher.Event()
+}
+
+// setCheckedButton makes button the checked button, unchecking the previous
+// one. Button may be nil.
+func (rbg *RadioButtonGroup) setCheckedButton(button *RadioButton) {
+	prev := rbg.checkedButton
+	if button == prev {
+		return
+	}
+
+	rbg.checkedButton = button
+
+	if prev != nil && prev.Checked() {
+		prev.setChecked(false)
+	}
+
+	if button != nil && !button.Checked() {
+		button.setChecked(true)
+	}
+
+	rbg.valueChangedPublisher.Publish()
+}
+
+func (rbg *RadioButtonGroup) onButtonCheckedChanged(button *RadioButton) {
+	if button.Checked() {
+		rbg.setCheckedButton(button)
+	} else if button == rbg.checkedButton {
+		rbg.setCheckedButton(nil)
+	}
+}
+
 type radioButtonish interface {
 	radioButton() *RadioButton
 }
@@ -74,16 +191,20 @@ func NewRadioButton(parent Container) (*RadioButton, error) {
 			return nil
 		},
 		func(v interface{}) error {
-			checked := v == rb.value
-			if checked {
-				rb.group.checkedButton = rb
+			if v == rb.value {
+				rb.group.setCheckedButton(rb)
+			} else {
+				rb.SetChecked(false)
 			}
-			rb.SetChecked(checked)
 
 			return nil
 		},
 		rb.CheckedChanged()))
 
+	rb.CheckedChanged().Attach(func() {
+		rb.group.onButtonCheckedChanged(rb)
+	})
+
 	rb.group.buttons = append(rb.group.buttons, rb)
 
 	return rb, nil
@@ -118,14 +239,10 @@ func (rb *RadioButton) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr
 	case win.WM_COMMAND:
 		switch win.HIWORD(uint32(wParam)) {
 		case win.BN_CLICKED:
-			prevChecked := rb.group.checkedButton
-			rb.group.checkedButton = rb
-
-			if prevChecked != rb {
-				if prevChecked != nil {
-					prevChecked.setChecked(false)
-				}
+			if rb.group.checkedButton != rb {
+				rb.group.setCheckedButton(rb)
 
+				// Windows has checked rb already.
 				rb.setChecked(true)
 			}
 		}
@@ -96,6 +96,44 @@ func (m *reflectListModel) Value(index int) interface{} {
 	return valueFromSlice(m.dataSource, m.value, m.displayMember, index)
 }
 
+// MoveItem moves the item at from to to in the slice of the model.
+//
+// If the data source is a ReflectListModel, it must implement ListItemMover
+// itself.
+func (m *reflectListModel) MoveItem(from, to int) error {
+	if rlm, ok := m.dataSource.(ReflectListModel); ok {
+		if mover, ok := rlm.(ListItemMover); ok {
+			return mover.MoveItem(from, to)
+		}
+
+		return newError("data source does not implement ListItemMover")
+	}
+
+	count := m.value.Len()
+	if from < 0 || from >= count || to < 0 || to >= count {
+		return newError("index out of range")
+	}
+
+	if from == to {
+		return nil
+	}
+
+	item := reflect.New(m.value.Type().Elem()).Elem()
+	item.Set(m.value.Index(from))
+
+	if from < to {
+		reflect.Copy(m.value.Slice(from, to), m.value.Slice(from+1, to+1))
+	} else {
+		reflect.Copy(m.value.Slice(to+1, from+1), m.value.Slice(to, from))
+	}
+
+	m.value.Index(to).Set(item)
+
+	m.PublishItemsReset()
+
+	return nil
+}
+
 type lessFuncsSetter interface {
 	setLessFuncs(lessFuncs []func(i, j int) bool)
 }
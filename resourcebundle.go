@@ -0,0 +1,290 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ResourcePrefix is the prefix of names that refer to resources added via
+// ResourceManager.AddFS, e.g. "res:icons/settings".
+const ResourcePrefix = "res:"
+
+// resourceVariant is a file that provides an image for one scale factor.
+type resourceVariant struct {
+	fsys  fs.FS
+	path  string
+	scale float64
+}
+
+func (rv resourceVariant) isIcon() bool {
+	return strings.EqualFold(path.Ext(rv.path), ".ico")
+}
+
+// splitResourceName splits a file path like "icons/settings@2x.png" into the
+// resource name "icons/settings" and the scale factor 2.
+func splitResourceName(filePath string) (name string, scale float64) {
+	name = strings.TrimSuffix(filePath, path.Ext(filePath))
+	scale = 1
+
+	if i := strings.LastIndex(name, "@"); i > 0 && strings.HasSuffix(name, "x") {
+		if s, err := strconv.ParseFloat(name[i+1:len(name)-1], 64); err == nil && s > 0 {
+			name, scale = name[:i], s
+		}
+	}
+
+	return
+}
+
+// AddFS adds the files of fsys, usually an embed.FS, as resources.
+//
+// Images (.png, .jpg, .jpeg, .gif and .ico files) are available by
+// their path without extension and with ResourcePrefix, e.g. the file
+// "icons/settings.png" as "res:icons/settings", from Bitmap, Icon, Image and
+// ImageFrom, and in declarative image properties. Variants for higher DPI
+// settings can be provided as files with a scale suffix like
+// "icons/settings@2x.png" or "icons/settings@1.5x.png". The variant that
+// best matches the DPI is used.
+//
+// Fonts (.ttf, .otf and .ttc files) are loaded privately for the process, so
+// their families can be used with NewFont and in declarative Font values.
+//
+// Files added later take precedence over files with the same name added
+// earlier.
+func (rm *ResourceManager) AddFS(fsys fs.FS) error {
+	if rm.bundledImages == nil {
+		rm.bundledImages = make(map[string][]resourceVariant)
+	}
+
+	return fs.WalkDir(fsys, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return wrapError(err)
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		switch strings.ToLower(path.Ext(filePath)) {
+		case ".png", ".jpg", ".jpeg", ".gif", ".ico":
+			name, scale := splitResourceName(filePath)
+
+			variants := rm.bundledImages[name]
+			for i, v := range variants {
+				if v.scale == scale {
+					variants = append(variants[:i], variants[i+1:]...)
+					break
+				}
+			}
+			variants = append(variants, resourceVariant{fsys, filePath, scale})
+			sort.Slice(variants, func(i, j int) bool {
+				return variants[i].scale < variants[j].scale
+			})
+
+			rm.bundledImages[name] = variants
+
+			rm.forgetBundled(name)
+
+		case ".ttf", ".otf", ".ttc":
+			data, err := fs.ReadFile(fsys, filePath)
+			if err != nil {
+				return wrapError(err)
+			}
+
+			fmr, err := newFontMemResourceFromBytes(data)
+			if err != nil {
+				return err
+			}
+
+			rm.fonts = append(rm.fonts, fmr)
+		}
+
+		return nil
+	})
+}
+
+// forgetBundled removes cached images of the resource with the given name.
+func (rm *ResourceManager) forgetBundled(name string) {
+	prefix := ResourcePrefix + name
+
+	for key := range rm.bitmaps {
+		if key == prefix || strings.HasPrefix(key, prefix+"\x00") {
+			delete(rm.bitmaps, key)
+		}
+	}
+
+	delete(rm.icons, prefix)
+}
+
+// bundledImageForDPI returns the variant of the resource name that best
+// matches dpi, i.e. the smallest one that does not need to be enlarged or,
+// if there is none, the largest one.
+func (rm *ResourceManager) bundledImageForDPI(name string, dpi int) (resourceVariant, bool) {
+	variants := rm.bundledImages[strings.TrimPrefix(name, ResourcePrefix)]
+	if len(variants) == 0 {
+		return resourceVariant{}, false
+	}
+
+	scale := float64(dpi) / 96
+
+	for _, v := range variants {
+		if v.scale >= scale {
+			return v, true
+		}
+	}
+
+	return variants[len(variants)-1], true
+}
+
+func (rm *ResourceManager) bundledBitmap(name string, dpi int) (*Bitmap, error) {
+	key := fmt.Sprintf("%s\x00%d", name, dpi)
+	if bm := rm.bitmaps[key]; bm != nil {
+		return bm, nil
+	}
+
+	v, ok := rm.bundledImageForDPI(name, dpi)
+	if !ok {
+		return nil, rm.notFoundErr("bitmap", name)
+	}
+
+	data, err := fs.ReadFile(v.fsys, v.path)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	im, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	bm, err := NewBitmapFromImageForDPI(im, int(96*v.scale+0.5))
+	if err != nil {
+		return nil, err
+	}
+
+	rm.bitmaps[key] = bm
+
+	return bm, nil
+}
+
+func (rm *ResourceManager) bundledIcon(name string) (*Icon, error) {
+	if icon := rm.icons[name]; icon != nil {
+		return icon, nil
+	}
+
+	dpi := screenDPI()
+
+	v, ok := rm.bundledImageForDPI(name, dpi)
+	if !ok {
+		return nil, rm.notFoundErr("icon", name)
+	}
+
+	data, err := fs.ReadFile(v.fsys, v.path)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	var icon *Icon
+	if v.isIcon() {
+		icon, err = newIconFromICOData(data, IntFrom96DPI(32, dpi), dpi)
+	} else {
+		var im image.Image
+		if im, _, err = image.Decode(bytes.NewReader(data)); err != nil {
+			return nil, wrapError(err)
+		}
+
+		icon, err = NewIconFromImageForDPI(im, int(96*v.scale+0.5))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rm.icons[name] = icon
+
+	return icon, nil
+}
+
+func (rm *ResourceManager) bundledImage(name string) (Image, error) {
+	dpi := screenDPI()
+
+	v, ok := rm.bundledImageForDPI(name, dpi)
+	if !ok {
+		return nil, rm.notFoundErr("image", name)
+	}
+
+	if v.isIcon() {
+		return rm.bundledIcon(name)
+	}
+
+	return rm.bundledBitmap(name, dpi)
+}
+
+// newIconFromICOData creates an icon from the entry of an .ico file, whose
+// size is closest to, but preferably not smaller than, size.
+func newIconFromICOData(data []byte, size, dpi int) (*Icon, error) {
+	type iconDirEntry struct {
+		Width, Height, ColorCount, Reserved uint8
+		Planes, BitCount                    uint16
+		BytesInRes, ImageOffset             uint32
+	}
+
+	r := bytes.NewReader(data)
+
+	var header struct {
+		Reserved, Type, Count uint16
+	}
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil || header.Type != 1 || header.Count == 0 {
+		return nil, newError("invalid icon data")
+	}
+
+	var best iconDirEntry
+	bestSize := -1
+
+	for i := 0; i < int(header.Count); i++ {
+		var e iconDirEntry
+		if err := binary.Read(r, binary.LittleEndian, &e); err != nil {
+			return nil, newError("invalid icon data")
+		}
+
+		s := int(e.Width)
+		if s == 0 {
+			s = 256
+		}
+
+		better := bestSize < 0 ||
+			s >= size && (bestSize < size || s < bestSize) ||
+			s < size && bestSize < size && s > bestSize ||
+			s == bestSize && e.BitCount > best.BitCount
+
+		if better {
+			best, bestSize = e, s
+		}
+	}
+
+	end := uint64(best.ImageOffset) + uint64(best.BytesInRes)
+	if best.BytesInRes == 0 || end > uint64(len(data)) {
+		return nil, newError("invalid icon data")
+	}
+
+	hIcon := createIconFromResourceEx(&data[best.ImageOffset], best.BytesInRes, true, 0x00030000, int32(bestSize), int32(bestSize), 0)
+	if hIcon == 0 {
+		return nil, lastError("CreateIconFromResourceEx")
+	}
+
+	return NewIconFromHICONForDPI(hIcon, dpi)
+}
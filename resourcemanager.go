@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 )
 
 func init() {
@@ -24,11 +25,14 @@ var Resources ResourceManager
 
 // ResourceManager is a cache for sharing resources like bitmaps and icons.
 // The resources can be either embedded in the running executable
-// file or located below a specified root directory in the file system.
+// file, located below a specified root directory in the file system or
+// provided by a file system added via AddFS.
 type ResourceManager struct {
-	rootDirPath string
-	bitmaps     map[string]*Bitmap
-	icons       map[string]*Icon
+	rootDirPath   string
+	bitmaps       map[string]*Bitmap
+	icons         map[string]*Icon
+	bundledImages map[string][]resourceVariant
+	fonts         []*FontMemResource
 }
 
 // RootDirPath returns the root directory path where resources are to be loaded from.
@@ -59,6 +63,10 @@ func (rm *ResourceManager) Bitmap(name string) (*Bitmap, error) {
 // BitmapForDPI loads a bitmap from file or resource identified by name, or an error if it could
 // not be found. When bitmap is loaded, given DPI is assumed.
 func (rm *ResourceManager) BitmapForDPI(name string, dpi int) (*Bitmap, error) {
+	if strings.HasPrefix(name, ResourcePrefix) {
+		return rm.bundledBitmap(name, dpi)
+	}
+
 	if bm := rm.bitmaps[name]; bm != nil {
 		return bm, nil
 	}
@@ -85,6 +93,10 @@ func (rm *ResourceManager) BitmapForDPI(name string, dpi int) (*Bitmap, error) {
 
 // Icon returns the Icon identified by name, or an error if it could not be found.
 func (rm *ResourceManager) Icon(name string) (*Icon, error) {
+	if strings.HasPrefix(name, ResourcePrefix) {
+		return rm.bundledIcon(name)
+	}
+
 	if icon := rm.icons[name]; icon != nil {
 		return icon, nil
 	}
@@ -111,6 +123,10 @@ func (rm *ResourceManager) Icon(name string) (*Icon, error) {
 
 // Image returns the Image identified by name, or an error if it could not be found.
 func (rm *ResourceManager) Image(name string) (Image, error) {
+	if strings.HasPrefix(name, ResourcePrefix) {
+		return rm.bundledImage(name)
+	}
+
 	if icon, err := rm.Icon(name); err == nil {
 		return icon, nil
 	}
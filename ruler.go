@@ -0,0 +1,661 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/lxn/win"
+)
+
+// RulerUnit is the unit a Ruler is labeled in.
+type RulerUnit int
+
+const (
+	RulerPixels      RulerUnit = iota // 1/96" units, the device independent pixels of walk
+	RulerMillimeters                  // millimeters
+	RulerPoints                       // typographic points, 1/72"
+	RulerInches                       // inches
+)
+
+func (u RulerUnit) length() Length {
+	switch u {
+	case RulerMillimeters:
+		return MM(1)
+
+	case RulerPoints:
+		return Pt(1)
+
+	case RulerInches:
+		return Inch(1)
+	}
+
+	return DIP(1)
+}
+
+// Ruler displays a scale along the edge of a view, like a canvas or the root
+// of a Designer, with a marker at the mouse position.
+//
+// Positions on the scale are Lengths from the origin of the content of the
+// view. The origin can be aligned to the client area of a view with SetView
+// and moved, e.g. for scrolling, with SetOffset.
+//
+// A Ruler also holds guides, which are positions along its axis the user can
+// add by clicking the Ruler, move by dragging and remove by dragging them off
+// the Ruler or right clicking them. The guides of a horizontal Ruler are
+// vertical lines and vice versa. Views draw them with DrawGuidesPixels and
+// snap content to them with SnapToGuide.
+type Ruler struct {
+	*CustomWidget
+	vertical                bool
+	unit                    RulerUnit
+	view                    Window
+	viewBoundsChangedHandle int
+	viewMouseMoveHandle     int
+	offset                  int // in native pixels
+	zoom                    float64
+	marker                  Length
+	markerVisible           bool
+	guides                  []Length
+	dragGuide               int // index of the guide being dragged or -1
+	unitChangedPublisher    EventPublisher
+	guidesChangedPublisher  EventPublisher
+}
+
+// NewHRuler creates and initializes a new horizontal Ruler.
+func NewHRuler(parent Container) (*Ruler, error) {
+	return newRuler(parent, false)
+}
+
+// NewVRuler creates and initializes a new vertical Ruler.
+func NewVRuler(parent Container) (*Ruler, error) {
+	return newRuler(parent, true)
+}
+
+func newRuler(parent Container, vertical bool) (*Ruler, error) {
+	r := &Ruler{
+		vertical:  vertical,
+		zoom:      1,
+		dragGuide: -1,
+	}
+
+	cw, err := NewCustomWidgetPixels(parent, 0, func(canvas *Canvas, updateBounds Rectangle) error {
+		return r.draw(canvas)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.CustomWidget = cw
+
+	if err := InitWrapperWindow(r); err != nil {
+		r.Dispose()
+		return nil, err
+	}
+
+	r.SetPaintMode(PaintBuffered)
+	r.SetInvalidatesOnResize(true)
+
+	r.MouseDown().Attach(r.onMouseDown)
+	r.MouseMove().Attach(r.onMouseMove)
+	r.MouseUp().Attach(r.onMouseUp)
+
+	r.Disposing().Attach(func() {
+		r.SetView(nil)
+	})
+
+	return r, nil
+}
+
+// Vertical returns if the Ruler is vertical.
+func (r *Ruler) Vertical() bool {
+	return r.vertical
+}
+
+// Unit returns the unit the Ruler is labeled in.
+func (r *Ruler) Unit() RulerUnit {
+	return r.unit
+}
+
+// SetUnit sets the unit the Ruler is labeled in.
+func (r *Ruler) SetUnit(unit RulerUnit) {
+	if unit == r.unit {
+		return
+	}
+
+	r.unit = unit
+	r.Invalidate()
+
+	r.unitChangedPublisher.Publish()
+}
+
+// UnitChanged returns the event that is published when the unit changed.
+func (r *Ruler) UnitChanged() *Event {
+	return r.unitChangedPublisher.Event()
+}
+
+// View returns the window the origin of the Ruler is aligned to.
+func (r *Ruler) View() Window {
+	return r.view
+}
+
+// SetView aligns the origin of the Ruler to the client area of view, which is
+// moved by Offset. The marker follows the mouse over view. Pass nil to align
+// the origin to the Ruler itself.
+func (r *Ruler) SetView(view Window) {
+	if r.view != nil {
+		r.view.BoundsChanged().Detach(r.viewBoundsChangedHandle)
+		r.view.MouseMove().Detach(r.viewMouseMoveHandle)
+	}
+
+	r.view = view
+
+	if view != nil {
+		r.viewBoundsChangedHandle = view.BoundsChanged().Attach(func() {
+			r.Invalidate()
+		})
+
+		r.viewMouseMoveHandle = view.MouseMove().Attach(func(x, y int, button MouseButton) {
+			if r.vertical {
+				r.setMarkerPixels(r.viewOrigin() + y)
+			} else {
+				r.setMarkerPixels(r.viewOrigin() + x)
+			}
+		})
+	}
+
+	r.Invalidate()
+}
+
+// viewOrigin returns the position of the client area of the view along the
+// axis, in native pixels of the Ruler.
+func (r *Ruler) viewOrigin() int {
+	if r.view == nil || r.view.IsDisposed() {
+		return 0
+	}
+
+	var ptView, ptRuler win.POINT
+	win.ClientToScreen(r.view.Handle(), &ptView)
+	win.ClientToScreen(r.hWnd, &ptRuler)
+
+	if r.vertical {
+		return int(ptView.Y - ptRuler.Y)
+	}
+
+	return int(ptView.X - ptRuler.X)
+}
+
+// Offset returns the distance in native pixels of the origin of the content
+// from the origin of the view.
+func (r *Ruler) Offset() int {
+	return r.offset
+}
+
+// SetOffset sets the distance in native pixels of the origin of the content
+// from the origin of the view. Scrolled views pass the negative scroll
+// position.
+func (r *Ruler) SetOffset(offset int) {
+	if offset == r.offset {
+		return
+	}
+
+	r.offset = offset
+	r.Invalidate()
+}
+
+// Zoom returns the factor the content of the view is scaled by.
+func (r *Ruler) Zoom() float64 {
+	return r.zoom
+}
+
+// SetZoom sets the factor the content of the view is scaled by.
+func (r *Ruler) SetZoom(zoom float64) error {
+	if zoom <= 0 {
+		return newError("zoom must be > 0")
+	}
+
+	r.zoom = zoom
+	r.Invalidate()
+
+	return nil
+}
+
+// pixelsFromLength returns the position of pos in native pixels of the Ruler.
+func (r *Ruler) pixelsFromLength(pos Length) int {
+	return r.viewOrigin() + r.offset + int(math.Round(float64(pos)*r.zoom*float64(r.DPI())/96))
+}
+
+// lengthFromPixels returns the position on the scale of x, in native pixels
+// of the Ruler.
+func (r *Ruler) lengthFromPixels(x int) Length {
+	return LengthFromPixels(x-r.viewOrigin()-r.offset, r.DPI()) / Length(r.zoom)
+}
+
+// Marker returns the position of the marker and if it is visible.
+func (r *Ruler) Marker() (Length, bool) {
+	return r.marker, r.markerVisible
+}
+
+// SetMarker shows the marker at pos, e.g. for the mouse position in a view
+// that does not publish mouse events.
+func (r *Ruler) SetMarker(pos Length) {
+	if r.markerVisible && pos == r.marker {
+		return
+	}
+
+	r.marker = pos
+	r.markerVisible = true
+	r.Invalidate()
+}
+
+// ClearMarker hides the marker.
+func (r *Ruler) ClearMarker() {
+	if !r.markerVisible {
+		return
+	}
+
+	r.markerVisible = false
+	r.Invalidate()
+}
+
+func (r *Ruler) setMarkerPixels(x int) {
+	r.SetMarker(r.lengthFromPixels(x))
+}
+
+// Guides returns the positions of the guides in ascending order.
+func (r *Ruler) Guides() []Length {
+	return append([]Length(nil), r.guides...)
+}
+
+// SetGuides sets the positions of the guides.
+func (r *Ruler) SetGuides(guides []Length) {
+	r.guides = append(r.guides[:0], guides...)
+	r.sortGuides()
+
+	r.Invalidate()
+	r.guidesChangedPublisher.Publish()
+}
+
+// AddGuide adds a guide at pos.
+func (r *Ruler) AddGuide(pos Length) {
+	r.SetGuides(append(r.Guides(), pos))
+}
+
+// RemoveGuide removes the guide at pos, if there is one.
+func (r *Ruler) RemoveGuide(pos Length) {
+	for i, g := range r.guides {
+		if g == pos {
+			r.guides = append(r.guides[:i], r.guides[i+1:]...)
+
+			r.Invalidate()
+			r.guidesChangedPublisher.Publish()
+			return
+		}
+	}
+}
+
+// GuidesChanged returns the event that is published when guides were added,
+// moved or removed. While the user drags a guide, it is published for every
+// move, so views can redraw the guides.
+func (r *Ruler) GuidesChanged() *Event {
+	return r.guidesChangedPublisher.Event()
+}
+
+func (r *Ruler) sortGuides() {
+	sort.Slice(r.guides, func(i, j int) bool {
+		return r.guides[i] < r.guides[j]
+	})
+}
+
+// SnapToGuide returns the position of the guide nearest to pos and true, if
+// it is within tolerance, otherwise pos and false.
+func (r *Ruler) SnapToGuide(pos, tolerance Length) (Length, bool) {
+	nearest, found := pos, false
+
+	for _, g := range r.guides {
+		if d := Length(math.Abs(float64(g - pos))); d <= tolerance {
+			tolerance = d
+			nearest, found = g, true
+		}
+	}
+
+	return nearest, found
+}
+
+// DrawGuidesPixels draws the guides as dashed lines across bounds of canvas,
+// which are in native pixels. The origin of the content is at the top left
+// corner of bounds, scaled by Zoom.
+func (r *Ruler) DrawGuidesPixels(canvas *Canvas, bounds Rectangle) error {
+	if len(r.guides) == 0 {
+		return nil
+	}
+
+	pen, err := NewCosmeticPen(PenDash, rulerGuideColor)
+	if err != nil {
+		return err
+	}
+	defer pen.Dispose()
+
+	dpi := canvas.DPI()
+
+	for _, g := range r.guides {
+		p := int(math.Round(float64(g) * r.zoom * float64(dpi) / 96))
+
+		var from, to Point
+		if r.vertical {
+			from = Point{bounds.X, bounds.Y + p}
+			to = Point{bounds.X + bounds.Width, bounds.Y + p}
+		} else {
+			from = Point{bounds.X + p, bounds.Y}
+			to = Point{bounds.X + p, bounds.Y + bounds.Height}
+		}
+
+		if err := canvas.DrawLinePixels(pen, from, to); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var rulerGuideColor = RGB(0, 160, 220)
+
+// guideAt returns the index of the guide within a few pixels of x, in native
+// pixels of the Ruler, or -1.
+func (r *Ruler) guideAt(x int) int {
+	tolerance := r.IntFrom96DPI(3)
+
+	for i, g := range r.guides {
+		if absi(r.pixelsFromLength(g)-x) <= tolerance {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// axisPos returns the coordinate along the axis of the Ruler.
+func (r *Ruler) axisPos(x, y int) int {
+	if r.vertical {
+		return y
+	}
+
+	return x
+}
+
+// axisExtent returns the size of the Ruler along its axis.
+func (r *Ruler) axisExtent() int {
+	size := r.ClientBoundsPixels().Size()
+	if r.vertical {
+		return size.Height
+	}
+
+	return size.Width
+}
+
+func (r *Ruler) onMouseDown(x, y int, button MouseButton) {
+	pos := r.axisPos(x, y)
+
+	switch button {
+	case LeftButton:
+		if r.dragGuide = r.guideAt(pos); r.dragGuide == -1 {
+			r.guides = append(r.guides, r.lengthFromPixels(pos))
+			r.dragGuide = len(r.guides) - 1
+
+			r.Invalidate()
+			r.guidesChangedPublisher.Publish()
+		}
+
+	case RightButton:
+		if i := r.guideAt(pos); i != -1 {
+			r.RemoveGuide(r.guides[i])
+		}
+	}
+}
+
+func (r *Ruler) onMouseMove(x, y int, button MouseButton) {
+	pos := r.axisPos(x, y)
+
+	r.setMarkerPixels(pos)
+
+	if r.dragGuide != -1 {
+		r.guides[r.dragGuide] = r.lengthFromPixels(pos)
+
+		r.Invalidate()
+		r.guidesChangedPublisher.Publish()
+		return
+	}
+
+	if r.guideAt(pos) != -1 {
+		if r.vertical {
+			r.SetCursor(CursorSizeNS())
+		} else {
+			r.SetCursor(CursorSizeWE())
+		}
+	} else {
+		r.SetCursor(nil)
+	}
+}
+
+func (r *Ruler) onMouseUp(x, y int, button MouseButton) {
+	if r.dragGuide == -1 || button != LeftButton {
+		return
+	}
+
+	i := r.dragGuide
+	r.dragGuide = -1
+
+	// Dragging a guide off the ends of the Ruler removes it.
+	if pos := r.axisPos(x, y); pos < 0 || pos >= r.axisExtent() {
+		r.guides = append(r.guides[:i], r.guides[i+1:]...)
+	}
+
+	r.sortGuides()
+
+	r.Invalidate()
+	r.guidesChangedPublisher.Publish()
+}
+
+// tickSteps returns the distance of major ticks in units and the number of
+// minor ticks per major tick, so labels do not overlap.
+func (r *Ruler) tickSteps() (major float64, minorCount int) {
+	dpi := float64(r.DPI())
+	unitPixels := float64(r.unit.length()) * r.zoom * dpi / 96
+	minMajorPixels := 50 * dpi / 96
+
+	for exp := -3; exp < 9; exp++ {
+		base := math.Pow(10, float64(exp))
+
+		for _, m := range [...]float64{1, 2, 5} {
+			step := m * base
+			if step*unitPixels < minMajorPixels {
+				continue
+			}
+
+			// Integral units only, except for inches.
+			if r.unit != RulerInches && step < 1 {
+				continue
+			}
+
+			minorCount = 10
+			if m == 2 {
+				minorCount = 4
+			}
+			if step*unitPixels/float64(minorCount) < 4*dpi/96 {
+				minorCount /= 2
+			}
+
+			return step, minorCount
+		}
+	}
+
+	return 1, 1
+}
+
+func (r *Ruler) draw(canvas *Canvas) error {
+	bounds := r.ClientBoundsPixels()
+
+	bgBrush, err := NewSystemColorBrush(SysColorBtnFace)
+	if err != nil {
+		return err
+	}
+	defer bgBrush.Dispose()
+
+	if err := canvas.FillRectanglePixels(bgBrush, bounds); err != nil {
+		return err
+	}
+
+	textColor := Color(win.GetSysColor(win.COLOR_WINDOWTEXT))
+
+	linePen, err := NewCosmeticPen(PenSolid, Color(win.GetSysColor(win.COLOR_BTNSHADOW)))
+	if err != nil {
+		return err
+	}
+	defer linePen.Dispose()
+
+	tickPen, err := NewCosmeticPen(PenSolid, textColor)
+	if err != nil {
+		return err
+	}
+	defer tickPen.Dispose()
+
+	thickness := bounds.Height
+	if r.vertical {
+		thickness = bounds.Width
+	}
+
+	// line draws a line across the Ruler at pos along the axis, from depth
+	// to the inner edge.
+	line := func(pen Pen, pos, depth int) error {
+		if r.vertical {
+			return canvas.DrawLinePixels(pen, Point{depth, pos}, Point{thickness, pos})
+		}
+
+		return canvas.DrawLinePixels(pen, Point{pos, depth}, Point{pos, thickness})
+	}
+
+	// The inner edge borders the view.
+	if r.vertical {
+		canvas.DrawLinePixels(linePen, Point{thickness - 1, 0}, Point{thickness - 1, bounds.Height})
+	} else {
+		canvas.DrawLinePixels(linePen, Point{0, thickness - 1}, Point{bounds.Width, thickness - 1})
+	}
+
+	major, minorCount := r.tickSteps()
+	unit := float64(r.unit.length())
+	extent := r.axisExtent()
+
+	first := math.Floor(float64(r.lengthFromPixels(0))/unit/major) * major
+	last := float64(r.lengthFromPixels(extent)) / unit
+
+	font := r.Font()
+	padding := r.IntFrom96DPI(2)
+
+	for value := first; value <= last; value += major {
+		pos := r.pixelsFromLength(Length(value * unit))
+
+		if err := line(tickPen, pos, 0); err != nil {
+			return err
+		}
+
+		for i := 1; i < minorCount; i++ {
+			minorPos := r.pixelsFromLength(Length((value + major*float64(i)/float64(minorCount)) * unit))
+
+			depth := thickness * 3 / 4
+			if minorCount%2 == 0 && i == minorCount/2 {
+				depth = thickness / 2
+			}
+
+			if err := line(tickPen, minorPos, depth); err != nil {
+				return err
+			}
+		}
+
+		label := strconv.FormatFloat(math.Round(value*1000)/1000, 'f', -1, 64)
+
+		if r.vertical {
+			// Rotated labels read from bottom to top.
+			origin := Point{padding, pos - padding}
+			if err := canvas.DrawTextRotatedPixels(label, font, textColor, origin, 90); err != nil {
+				return err
+			}
+		} else {
+			textBounds := Rectangle{pos + padding, 0, r.IntFrom96DPI(60), thickness / 2}
+			if err := canvas.DrawTextPixels(label, font, textColor, textBounds, TextLeft|TextTop|TextSingleLine|TextNoPrefix|TextNoClip); err != nil {
+				return err
+			}
+		}
+	}
+
+	guidePen, err := NewCosmeticPen(PenSolid, rulerGuideColor)
+	if err != nil {
+		return err
+	}
+	defer guidePen.Dispose()
+
+	for _, g := range r.guides {
+		if err := line(guidePen, r.pixelsFromLength(g), 0); err != nil {
+			return err
+		}
+	}
+
+	if r.markerVisible {
+		markerPen, err := NewCosmeticPen(PenSolid, RGB(220, 0, 0))
+		if err != nil {
+			return err
+		}
+		defer markerPen.Dispose()
+
+		if err := line(markerPen, r.pixelsFromLength(r.marker), 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Ruler) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	var layoutFlags LayoutFlags
+	if r.vertical {
+		layoutFlags = GrowableVert | GreedyVert | ShrinkableVert
+	} else {
+		layoutFlags = GrowableHorz | GreedyHorz | ShrinkableHorz
+	}
+
+	thickness := r.calculateTextSizeImpl("0").Height + IntFrom96DPI(8, ctx.dpi)
+
+	return &rulerLayoutItem{
+		layoutFlags: layoutFlags,
+		thickness:   thickness,
+		vertical:    r.vertical,
+	}
+}
+
+type rulerLayoutItem struct {
+	LayoutItemBase
+	layoutFlags LayoutFlags
+	thickness   int // in native pixels
+	vertical    bool
+}
+
+func (li *rulerLayoutItem) LayoutFlags() LayoutFlags {
+	return li.layoutFlags
+}
+
+func (li *rulerLayoutItem) IdealSize() Size {
+	return li.MinSize()
+}
+
+func (li *rulerLayoutItem) MinSize() Size {
+	if li.vertical {
+		return Size{li.thickness, 0}
+	}
+
+	return Size{0, li.thickness}
+}
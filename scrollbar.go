@@ -0,0 +1,691 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+const scrollBarWindowClass = `\o/ Walk_ScrollBar_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClass(scrollBarWindowClass)
+	})
+}
+
+const (
+	scrollBarFadeTimerId = 1 + iota
+)
+
+const (
+	scrollBarFadeDelay    = 1200 * time.Millisecond
+	scrollBarFadeInterval = 40 // in milliseconds
+	scrollBarFadeStep     = 32
+)
+
+// ScrollBarStyle specifies the appearance of scroll bars.
+type ScrollBarStyle int
+
+const (
+	// ScrollBarStyleSystem uses the native scroll bars of Windows.
+	ScrollBarStyleSystem ScrollBarStyle = iota
+
+	// ScrollBarStyleThin draws a slim thumb on a plain track.
+	ScrollBarStyleThin
+
+	// ScrollBarStyleOverlay draws a thin thumb without track, that widens
+	// while the mouse is over it and fades out when the scroll bar is idle.
+	ScrollBarStyleOverlay
+)
+
+// scrollBarColorKey is painted where an overlay scroll bar is transparent.
+var scrollBarColorKey = RGB(0xff, 0x00, 0xfe)
+
+// ScrollBar is a custom drawn scroll bar, that scales crisply with the DPI
+// and follows the system colors.
+//
+// Its range, page size and value have the same meaning as the ones of a
+// native scroll bar, i.e. the value ranges from Minimum to
+// Maximum - PageSize + 1.
+//
+// See CustomScrollBars for replacing the native scroll bars of other widgets.
+type ScrollBar struct {
+	WidgetBase
+	orientation           Orientation
+	style                 ScrollBarStyle
+	minimum               int
+	maximum               int
+	pageSize              int
+	value                 int
+	lineSize              int
+	thumbColor            Color
+	trackColor            Color
+	customThumbColor      bool
+	customTrackColor      bool
+	hover                 bool
+	dragging              bool
+	trackingMouse         bool
+	dragOffset            int // in native pixels
+	layered               bool
+	alpha                 byte
+	fading                bool
+	lastActivity          time.Time
+	wheelTarget           win.HWND
+	valueChangedPublisher EventPublisher
+	scrolledPublisher     EventPublisher
+}
+
+// NewScrollBar creates a new ScrollBar with ScrollBarStyleThin.
+func NewScrollBar(parent Container, orientation Orientation) (*ScrollBar, error) {
+	return newScrollBar(parent, orientation)
+}
+
+func newScrollBar(parent Window, orientation Orientation) (*ScrollBar, error) {
+	sb := &ScrollBar{
+		orientation: orientation,
+		style:       ScrollBarStyleThin,
+		maximum:     100,
+		pageSize:    10,
+		lineSize:    1,
+		alpha:       255,
+	}
+
+	if err := InitWidget(
+		sb,
+		parent,
+		scrollBarWindowClass,
+		win.WS_VISIBLE|win.WS_CLIPSIBLINGS,
+		0); err != nil {
+		return nil, err
+	}
+
+	sb.MustRegisterProperty("Value", NewProperty(
+		func() interface{} {
+			return sb.Value()
+		},
+		func(v interface{}) error {
+			sb.SetValue(assertIntOr(v, 0))
+			return nil
+		},
+		sb.valueChangedPublisher.Event()))
+
+	return sb, nil
+}
+
+func (sb *ScrollBar) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	var layoutFlags LayoutFlags
+	if sb.orientation == Vertical {
+		layoutFlags = ShrinkableVert | GrowableVert | GreedyVert
+	} else {
+		layoutFlags = ShrinkableHorz | GrowableHorz | GreedyHorz
+	}
+
+	return &scrollBarLayoutItem{
+		layoutFlags: layoutFlags,
+	}
+}
+
+type scrollBarLayoutItem struct {
+	LayoutItemBase
+	layoutFlags LayoutFlags
+}
+
+func (li *scrollBarLayoutItem) LayoutFlags() LayoutFlags {
+	return li.layoutFlags
+}
+
+func (li *scrollBarLayoutItem) IdealSize() Size {
+	return li.MinSize()
+}
+
+func (li *scrollBarLayoutItem) MinSize() Size {
+	return SizeFrom96DPI(Size{12, 12}, li.ctx.dpi)
+}
+
+// Orientation returns the orientation of the ScrollBar.
+func (sb *ScrollBar) Orientation() Orientation {
+	return sb.orientation
+}
+
+// Style returns the style of the ScrollBar.
+func (sb *ScrollBar) Style() ScrollBarStyle {
+	return sb.style
+}
+
+// SetStyle sets the style of the ScrollBar. ScrollBarStyleSystem is treated
+// like ScrollBarStyleThin.
+//
+// The overlay style requires Windows 8 or later for transparency and
+// fading. Otherwise it looks like the thin style without track.
+func (sb *ScrollBar) SetStyle(style ScrollBarStyle) error {
+	if style < ScrollBarStyleSystem || style > ScrollBarStyleOverlay {
+		return newError("invalid style")
+	}
+
+	sb.style = style
+
+	if style == ScrollBarStyleOverlay {
+		sb.layered = sb.ensureExtendedStyleBits(win.WS_EX_LAYERED, true) == nil && sb.applyAlpha(255)
+		if !sb.layered {
+			sb.ensureExtendedStyleBits(win.WS_EX_LAYERED, false)
+		}
+	} else {
+		sb.stopFading()
+		sb.layered = false
+		sb.alpha = 255
+		sb.ensureExtendedStyleBits(win.WS_EX_LAYERED, false)
+	}
+
+	sb.wake()
+	sb.Invalidate()
+
+	return nil
+}
+
+// Minimum returns the minimum of the range of the ScrollBar.
+func (sb *ScrollBar) Minimum() int {
+	return sb.minimum
+}
+
+// Maximum returns the maximum of the range of the ScrollBar.
+func (sb *ScrollBar) Maximum() int {
+	return sb.maximum
+}
+
+// SetRange sets the range of the ScrollBar.
+func (sb *ScrollBar) SetRange(min, max int) error {
+	if min > max {
+		return newError("invalid range")
+	}
+
+	if min == sb.minimum && max == sb.maximum {
+		return nil
+	}
+
+	sb.minimum, sb.maximum = min, max
+
+	sb.SetValue(sb.value)
+	sb.Invalidate()
+
+	return nil
+}
+
+// PageSize returns the size of the visible part of the scrolled content,
+// in the units of the range.
+func (sb *ScrollBar) PageSize() int {
+	return sb.pageSize
+}
+
+// SetPageSize sets the size of the visible part of the scrolled content, in
+// the units of the range. It determines the length of the thumb.
+func (sb *ScrollBar) SetPageSize(pageSize int) {
+	if pageSize < 0 {
+		pageSize = 0
+	}
+
+	if pageSize == sb.pageSize {
+		return
+	}
+
+	sb.pageSize = pageSize
+
+	sb.SetValue(sb.value)
+	sb.Invalidate()
+}
+
+// LineSize returns the amount the value changes per line when the mouse
+// wheel is turned over the ScrollBar. The default is 1.
+func (sb *ScrollBar) LineSize() int {
+	return sb.lineSize
+}
+
+// SetLineSize sets the amount the value changes per line when the mouse wheel
+// is turned over the ScrollBar.
+func (sb *ScrollBar) SetLineSize(lineSize int) {
+	if lineSize < 1 {
+		lineSize = 1
+	}
+
+	sb.lineSize = lineSize
+}
+
+// Value returns the position of the ScrollBar.
+func (sb *ScrollBar) Value() int {
+	return sb.value
+}
+
+// SetValue sets the position of the ScrollBar. It is clamped to the valid
+// range.
+func (sb *ScrollBar) SetValue(value int) {
+	value = sb.clampValue(value)
+
+	if value == sb.value {
+		return
+	}
+
+	sb.value = value
+
+	sb.wake()
+	sb.Invalidate()
+
+	sb.valueChangedPublisher.Publish()
+}
+
+// ValueChanged returns the event that is published when the value of the
+// ScrollBar changed.
+func (sb *ScrollBar) ValueChanged() *Event {
+	return sb.valueChangedPublisher.Event()
+}
+
+// Scrolled returns the event that is published when the user changed the
+// value of the ScrollBar.
+func (sb *ScrollBar) Scrolled() *Event {
+	return sb.scrolledPublisher.Event()
+}
+
+// ThumbColor returns the color of the thumb.
+func (sb *ScrollBar) ThumbColor() Color {
+	if sb.customThumbColor {
+		return sb.thumbColor
+	}
+
+	var weight byte
+	switch {
+	case sb.dragging:
+		weight = 190
+
+	case sb.hover:
+		weight = 150
+
+	default:
+		weight = 110
+	}
+
+	return blendColor(Color(win.GetSysColor(win.COLOR_WINDOW)), Color(win.GetSysColor(win.COLOR_WINDOWTEXT)), weight)
+}
+
+// SetThumbColor sets the color of the thumb. By default, it is derived from
+// the system colors.
+func (sb *ScrollBar) SetThumbColor(color Color) {
+	sb.thumbColor = color
+	sb.customThumbColor = true
+
+	sb.Invalidate()
+}
+
+// TrackColor returns the color of the track.
+func (sb *ScrollBar) TrackColor() Color {
+	if sb.customTrackColor {
+		return sb.trackColor
+	}
+
+	return blendColor(Color(win.GetSysColor(win.COLOR_WINDOW)), Color(win.GetSysColor(win.COLOR_WINDOWTEXT)), 12)
+}
+
+// SetTrackColor sets the color of the track. By default, it is derived from
+// the system colors. Overlay scroll bars have no track.
+func (sb *ScrollBar) SetTrackColor(color Color) {
+	sb.trackColor = color
+	sb.customTrackColor = true
+
+	sb.Invalidate()
+}
+
+func (sb *ScrollBar) maxValue() int {
+	max := sb.maximum
+	if sb.pageSize > 0 {
+		max -= sb.pageSize - 1
+	}
+
+	if max < sb.minimum {
+		max = sb.minimum
+	}
+
+	return max
+}
+
+func (sb *ScrollBar) clampValue(value int) int {
+	if value < sb.minimum {
+		return sb.minimum
+	}
+
+	if max := sb.maxValue(); value > max {
+		return max
+	}
+
+	return value
+}
+
+func (sb *ScrollBar) setValueByUser(value int) {
+	old := sb.value
+
+	sb.SetValue(value)
+
+	if sb.value != old {
+		sb.scrolledPublisher.Publish()
+	}
+}
+
+// primary returns the coordinate of p along the ScrollBar.
+func (sb *ScrollBar) primary(p Point) int {
+	if sb.orientation == Vertical {
+		return p.Y
+	}
+
+	return p.X
+}
+
+// trackLength returns the length and the thickness of the ScrollBar in native
+// pixels.
+func (sb *ScrollBar) trackLength() (length, thickness int) {
+	size := sb.ClientBoundsPixels().Size()
+
+	if sb.orientation == Vertical {
+		return size.Height, size.Width
+	}
+
+	return size.Width, size.Height
+}
+
+// thumbExtent returns the position and the length of the thumb along the
+// ScrollBar in native pixels. ok is false if there is nothing to scroll.
+func (sb *ScrollBar) thumbExtent() (pos, length int, ok bool) {
+	trackLength, _ := sb.trackLength()
+
+	rangeSize := sb.maximum - sb.minimum + 1
+	if rangeSize <= 0 || sb.pageSize <= 0 || sb.pageSize >= rangeSize || trackLength <= 0 {
+		return 0, 0, false
+	}
+
+	length = trackLength * sb.pageSize / rangeSize
+	if min := sb.IntFrom96DPI(24); length < min {
+		length = min
+	}
+	if length > trackLength {
+		length = trackLength
+	}
+
+	pos = (sb.value - sb.minimum) * (trackLength - length) / (rangeSize - sb.pageSize)
+
+	return pos, length, true
+}
+
+// thumbBounds returns the bounds of the thumb in native pixels.
+func (sb *ScrollBar) thumbBounds() (Rectangle, bool) {
+	pos, length, ok := sb.thumbExtent()
+	if !ok {
+		return Rectangle{}, false
+	}
+
+	_, thickness := sb.trackLength()
+
+	var offset, width int
+	if sb.style == ScrollBarStyleOverlay && !sb.hover && !sb.dragging {
+		width = sb.IntFrom96DPI(3)
+		offset = thickness - width - sb.IntFrom96DPI(2)
+	} else {
+		offset = sb.IntFrom96DPI(2)
+		width = thickness - 2*offset
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+	if width < 1 {
+		width = 1
+	}
+
+	if sb.orientation == Vertical {
+		return Rectangle{offset, pos, width, length}, true
+	}
+
+	return Rectangle{pos, offset, length, width}, true
+}
+
+func (sb *ScrollBar) valueForThumbPos(pos int) int {
+	trackLength, _ := sb.trackLength()
+
+	_, length, ok := sb.thumbExtent()
+	if !ok || trackLength <= length {
+		return sb.value
+	}
+
+	space := trackLength - length
+	maxPos := sb.maxValue() - sb.minimum
+
+	return sb.minimum + (pos*maxPos+space/2)/space
+}
+
+func (sb *ScrollBar) applyAlpha(alpha byte) bool {
+	sb.alpha = alpha
+
+	return setLayeredWindowAttributes(sb.hWnd, win.COLORREF(scrollBarColorKey), alpha, lwaColorKey|lwaAlpha)
+}
+
+// wake makes an overlay scroll bar fully visible and restarts the delay,
+// after which it fades out.
+func (sb *ScrollBar) wake() {
+	sb.lastActivity = time.Now()
+
+	if !sb.layered {
+		return
+	}
+
+	if sb.alpha != 255 {
+		sb.applyAlpha(255)
+	}
+
+	if !sb.fading {
+		sb.fading = true
+		win.SetTimer(sb.hWnd, scrollBarFadeTimerId, scrollBarFadeInterval, 0)
+	}
+}
+
+func (sb *ScrollBar) stopFading() {
+	if sb.fading {
+		sb.fading = false
+		win.KillTimer(sb.hWnd, scrollBarFadeTimerId)
+	}
+}
+
+func (sb *ScrollBar) fade() {
+	if sb.hover || sb.dragging || time.Since(sb.lastActivity) < scrollBarFadeDelay {
+		return
+	}
+
+	alpha := int(sb.alpha) - scrollBarFadeStep
+	if alpha <= 0 {
+		alpha = 0
+		sb.stopFading()
+	}
+
+	sb.applyAlpha(byte(alpha))
+}
+
+func (sb *ScrollBar) paint(hdc win.HDC) error {
+	size := sb.ClientBoundsPixels().Size()
+	if size.Width <= 0 || size.Height <= 0 {
+		return nil
+	}
+
+	bmp, err := NewBitmapForDPI(size, sb.DPI())
+	if err != nil {
+		return err
+	}
+	defer bmp.Dispose()
+
+	canvas, err := NewCanvasFromImage(bmp)
+	if err != nil {
+		return err
+	}
+	defer canvas.Dispose()
+
+	var trackColor Color
+	switch {
+	case sb.style != ScrollBarStyleOverlay:
+		trackColor = sb.TrackColor()
+
+	case sb.layered:
+		trackColor = scrollBarColorKey
+
+	default:
+		trackColor = Color(win.GetSysColor(win.COLOR_WINDOW))
+	}
+
+	trackBrush, err := NewSolidColorBrush(trackColor)
+	if err != nil {
+		return err
+	}
+	defer trackBrush.Dispose()
+
+	if err := canvas.FillRectanglePixels(trackBrush, Rectangle{0, 0, size.Width, size.Height}); err != nil {
+		return err
+	}
+
+	if bounds, ok := sb.thumbBounds(); ok {
+		thumbBrush, err := NewSolidColorBrush(sb.ThumbColor())
+		if err != nil {
+			return err
+		}
+		defer thumbBrush.Dispose()
+
+		radius := bounds.Width
+		if bounds.Height < radius {
+			radius = bounds.Height
+		}
+
+		if err := canvas.FillRoundedRectanglePixels(thumbBrush, bounds, Size{radius, radius}); err != nil {
+			return err
+		}
+	}
+
+	canvas.Dispose()
+
+	return bmp.withSelectedIntoMemDC(func(hdcMem win.HDC) error {
+		if !win.BitBlt(hdc, 0, 0, int32(size.Width), int32(size.Height), hdcMem, 0, 0, win.SRCCOPY) {
+			return newError("BitBlt failed")
+		}
+
+		return nil
+	})
+}
+
+func (sb *ScrollBar) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_ERASEBKGND:
+		return 1
+
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+
+		hdc := win.BeginPaint(hwnd, &ps)
+		defer win.EndPaint(hwnd, &ps)
+
+		sb.paint(hdc)
+
+		return 0
+
+	case win.WM_TIMER:
+		if wParam == scrollBarFadeTimerId {
+			sb.fade()
+		}
+
+		return 0
+
+	case win.WM_LBUTTONDOWN:
+		p := Point{int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))}
+
+		if bounds, ok := sb.thumbBounds(); ok {
+			thumbPos := sb.primary(bounds.Location())
+			_, thumbLength, _ := sb.thumbExtent()
+
+			switch pos := sb.primary(p); {
+			case pos < thumbPos:
+				sb.setValueByUser(sb.value - sb.pageSize)
+
+			case pos >= thumbPos+thumbLength:
+				sb.setValueByUser(sb.value + sb.pageSize)
+
+			default:
+				sb.dragging = true
+				sb.dragOffset = pos - thumbPos
+			}
+		}
+
+		sb.wake()
+		sb.Invalidate()
+
+	case win.WM_LBUTTONUP:
+		if sb.dragging {
+			sb.dragging = false
+			sb.Invalidate()
+		}
+
+	case win.WM_MOUSEMOVE:
+		if !sb.trackingMouse {
+			tme := win.TRACKMOUSEEVENT{
+				DwFlags:   win.TME_LEAVE,
+				HwndTrack: hwnd,
+			}
+			tme.CbSize = uint32(unsafe.Sizeof(tme))
+
+			sb.trackingMouse = win.TrackMouseEvent(&tme)
+		}
+
+		if !sb.hover {
+			sb.hover = true
+			sb.Invalidate()
+		}
+
+		if sb.dragging {
+			p := Point{int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))}
+
+			sb.setValueByUser(sb.valueForThumbPos(sb.primary(p) - sb.dragOffset))
+		}
+
+		sb.wake()
+
+	case win.WM_MOUSELEAVE:
+		sb.trackingMouse = false
+		sb.hover = false
+
+		sb.wake()
+		sb.Invalidate()
+
+	case win.WM_MOUSEWHEEL:
+		if sb.wheelTarget != 0 {
+			return win.SendMessage(sb.wheelTarget, msg, wParam, lParam)
+		}
+
+		delta := int(int16(win.HIWORD(uint32(wParam))))
+
+		sb.setValueByUser(sb.value - delta*3*sb.lineSize/120)
+
+		return 0
+
+	case win.WM_SIZE:
+		sb.Invalidate()
+
+	case win.WM_DESTROY:
+		sb.stopFading()
+	}
+
+	return sb.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
+}
+
+// blendColor mixes c2 into c1 with weight c2/255.
+func blendColor(c1, c2 Color, weight byte) Color {
+	mix := func(a, b byte) byte {
+		return byte((int(a)*(255-int(weight)) + int(b)*int(weight)) / 255)
+	}
+
+	return RGB(mix(c1.R(), c2.R()), mix(c1.G(), c2.G()), mix(c1.B(), c2.B()))
+}
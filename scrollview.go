@@ -7,6 +7,7 @@
 package walk
 
 import (
+	"math"
 	"unsafe"
 
 	"github.com/lxn/win"
@@ -14,6 +15,21 @@ import (
 
 const scrollViewWindowClass = `\o/ Walk_ScrollView_Class \o/`
 
+const (
+	scrollViewOverscrollTimerId = 1 + iota
+)
+
+const (
+	scrollViewOverscrollInterval      = 16 // in milliseconds
+	scrollViewMaxOverscroll96dpi      = 120
+	scrollViewRefreshThreshold96dpi   = 72
+	scrollViewRefreshIndicator96dpi   = 48
+	scrollViewWheelOverscroll96dpi    = 24
+	scrollViewRefreshIndicatorDots    = 8
+	scrollViewRefreshIndicatorRadius  = 10
+	scrollViewRefreshIndicatorDotSize = 4
+)
+
 func init() {
 	AppendToWalkInit(func() {
 		MustRegisterWindowClass(scrollViewWindowClass)
@@ -22,9 +38,19 @@ func init() {
 
 type ScrollView struct {
 	WidgetBase
-	composite  *Composite
-	horizontal bool
-	vertical   bool
+	composite        *Composite
+	horizontal       bool
+	vertical         bool
+	customScrollBars *CustomScrollBars
+
+	overscroll                bool
+	pullToRefresh             bool
+	refreshing                bool
+	panning                   bool
+	overscrollOffset          float64 // in native pixels, > 0 at the top
+	panLast                   Point
+	refreshIndicatorPhase     int
+	refreshRequestedPublisher EventPublisher
 }
 
 func NewScrollView(parent Container) (*ScrollView, error) {
@@ -90,6 +116,86 @@ func (sv *ScrollView) SetScrollbars(horizontal, vertical bool) {
 	sv.ensureStyleBits(win.WS_VSCROLL, vertical)
 }
 
+// ScrollBarStyle returns the style of the scroll bars of the ScrollView.
+func (sv *ScrollView) ScrollBarStyle() ScrollBarStyle {
+	if sv.customScrollBars == nil {
+		return ScrollBarStyleSystem
+	}
+
+	return sv.customScrollBars.Style()
+}
+
+// SetScrollBarStyle sets the style of the scroll bars of the ScrollView.
+// Styles other than ScrollBarStyleSystem replace the native scroll bars with
+// ScrollBars.
+func (sv *ScrollView) SetScrollBarStyle(style ScrollBarStyle) error {
+	return setScrollBarStyle(&sv.customScrollBars, sv, style)
+}
+
+// Overscroll returns if the content of the ScrollView can be panned beyond
+// its edges by touch, so it bounces back when released.
+func (sv *ScrollView) Overscroll() bool {
+	return sv.overscroll
+}
+
+// SetOverscroll sets if the content of the ScrollView can be panned beyond
+// its edges by touch, so it bounces back when released. With overscroll, the
+// mouse wheel also bounces the content at the edges.
+func (sv *ScrollView) SetOverscroll(overscroll bool) {
+	sv.overscroll = overscroll
+
+	if !overscroll && sv.overscrollOffset != 0 {
+		sv.startOverscrollAnimation()
+	}
+}
+
+// PullToRefresh returns if pulling the content down at the top requests a
+// refresh.
+func (sv *ScrollView) PullToRefresh() bool {
+	return sv.pullToRefresh
+}
+
+// SetPullToRefresh sets if pulling the content down at the top by touch
+// requests a refresh.
+//
+// When the content is released after being pulled far enough, the ScrollView
+// displays a progress indicator above the content and publishes
+// RefreshRequested. Call SetRefreshing(false) once the refresh has finished.
+func (sv *ScrollView) SetPullToRefresh(pullToRefresh bool) {
+	sv.pullToRefresh = pullToRefresh
+
+	if !pullToRefresh && (sv.refreshing || sv.overscrollOffset != 0) {
+		sv.refreshing = false
+		sv.startOverscrollAnimation()
+	}
+}
+
+// Refreshing returns if the ScrollView displays the refresh progress
+// indicator.
+func (sv *ScrollView) Refreshing() bool {
+	return sv.refreshing
+}
+
+// SetRefreshing shows or hides the refresh progress indicator. It is shown
+// when the user has pulled to refresh and must be hidden by the application
+// when the refresh has finished.
+func (sv *ScrollView) SetRefreshing(refreshing bool) {
+	if refreshing == sv.refreshing {
+		return
+	}
+
+	sv.refreshing = refreshing
+	sv.refreshIndicatorPhase = 0
+
+	sv.startOverscrollAnimation()
+}
+
+// RefreshRequested returns the event that is published when the user has
+// pulled to refresh.
+func (sv *ScrollView) RefreshRequested() *Event {
+	return sv.refreshRequestedPublisher.Event()
+}
+
 func (sv *ScrollView) SetSuspended(suspend bool) {
 	sv.composite.SetSuspended(suspend)
 	sv.WidgetBase.SetSuspended(suspend)
@@ -191,8 +297,25 @@ func (sv *ScrollView) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr)
 				break
 			}
 
+			delta := int16(win.HIWORD(uint32(wParam)))
+
+			if sv.overscroll {
+				if pos, max := sv.verticalScrollPosition(); delta > 0 && pos == 0 || delta < 0 && pos == max {
+					nudge := float64(sv.IntFrom96DPI(scrollViewWheelOverscroll96dpi))
+					if delta < 0 {
+						nudge = -nudge
+					}
+
+					sv.overscrollOffset = sv.dampedOverscroll(sv.overscrollOffset, nudge)
+					sv.applyOverscroll()
+					sv.startOverscrollAnimation()
+
+					return 0
+				}
+			}
+
 			var cmd uint16
-			if delta := int16(win.HIWORD(uint32(wParam))); delta < 0 {
+			if delta < 0 {
 				cmd = win.SB_LINEDOWN
 			} else {
 				cmd = win.SB_LINEUP
@@ -203,6 +326,57 @@ func (sv *ScrollView) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr)
 
 			return 0
 
+		case wmGesture:
+			if !sv.overscroll && !sv.pullToRefresh {
+				break
+			}
+
+			var gi gestureInfo
+			gi.CbSize = uint32(unsafe.Sizeof(gi))
+
+			if !getGestureInfo(lParam, &gi) || gi.DwID != gidPan {
+				break
+			}
+
+			p := Point{int(gi.PtsLocation.X), int(gi.PtsLocation.Y)}
+
+			if gi.DwFlags&gfBegin != 0 {
+				sv.panning = true
+			} else {
+				sv.pan(p.X-sv.panLast.X, p.Y-sv.panLast.Y)
+			}
+			sv.panLast = p
+
+			// Inertia begins when the finger is lifted.
+			if gi.DwFlags&(gfInertia|gfEnd) != 0 && sv.panning {
+				sv.releasePan()
+			}
+
+			closeGestureInfoHandle(lParam)
+
+			return 0
+
+		case win.WM_TIMER:
+			if wParam == scrollViewOverscrollTimerId {
+				sv.animateOverscroll()
+
+				return 0
+			}
+
+		case win.WM_PAINT:
+			if !sv.pullToRefresh || sv.overscrollOffset < 1 {
+				break
+			}
+
+			var ps win.PAINTSTRUCT
+
+			hdc := win.BeginPaint(hwnd, &ps)
+			defer win.EndPaint(hwnd, &ps)
+
+			sv.paintRefreshIndicator(hdc)
+
+			return 0
+
 		case win.WM_COMMAND, win.WM_NOTIFY:
 			sv.composite.WndProc(hwnd, msg, wParam, lParam)
 
@@ -259,6 +433,210 @@ func (sv *ScrollView) updateScrollBars() {
 	sv.composite.SetBoundsPixels(newCompositeBounds)
 }
 
+// scrollToPosition scrolls to pos in native pixels, e.g. after it has been
+// changed using a custom scroll bar.
+func (sv *ScrollView) scrollToPosition(bar int32, pos int) {
+	var si win.SCROLLINFO
+	si.CbSize = uint32(unsafe.Sizeof(si))
+	si.FMask = win.SIF_POS
+	si.NPos = int32(pos)
+	win.SetScrollInfo(sv.hWnd, bar, &si, false)
+
+	if bar == win.SB_HORZ {
+		sv.composite.SetXPixels(sv.scroll(bar, win.SB_THUMBPOSITION))
+	} else {
+		sv.composite.SetYPixels(sv.scroll(bar, win.SB_THUMBPOSITION))
+	}
+
+	if sv.hasComplexBackground() {
+		sv.composite.Invalidate()
+	}
+}
+
+// verticalScrollPosition returns the vertical scroll position and its
+// maximum in native pixels.
+func (sv *ScrollView) verticalScrollPosition() (pos, max int) {
+	var si win.SCROLLINFO
+	si.CbSize = uint32(unsafe.Sizeof(si))
+	si.FMask = win.SIF_PAGE | win.SIF_POS | win.SIF_RANGE
+
+	win.GetScrollInfo(sv.hWnd, win.SB_VERT, &si)
+
+	return int(si.NPos), maxi(0, int(si.NMax)+1-int(si.NPage))
+}
+
+// dampedOverscroll returns offset moved by delta, with a resistance that
+// grows with the distance from the edge.
+func (sv *ScrollView) dampedOverscroll(offset, delta float64) float64 {
+	if offset != 0 && (delta > 0) != (offset > 0) {
+		// Moving back towards the edge is not damped.
+		return offset + delta
+	}
+
+	limit := float64(sv.IntFrom96DPI(scrollViewMaxOverscroll96dpi))
+
+	offset += delta * (limit - math.Abs(offset)) / (2 * limit)
+
+	return math.Max(-limit, math.Min(limit, offset))
+}
+
+// pan scrolls the content by dx and dy native pixels of touch movement and
+// turns vertical movement beyond the edges into overscroll.
+func (sv *ScrollView) pan(dx, dy int) {
+	if dx != 0 && sv.horizontal {
+		var si win.SCROLLINFO
+		si.CbSize = uint32(unsafe.Sizeof(si))
+		si.FMask = win.SIF_POS
+
+		win.GetScrollInfo(sv.hWnd, win.SB_HORZ, &si)
+
+		sv.scrollToPosition(win.SB_HORZ, int(si.NPos)-dx)
+	}
+
+	if dy == 0 {
+		return
+	}
+
+	if sv.overscrollOffset == 0 {
+		pos, max := sv.verticalScrollPosition()
+
+		newPos := pos - dy
+		switch {
+		case newPos < 0:
+			if sv.overscroll || sv.pullToRefresh {
+				sv.overscrollOffset = sv.dampedOverscroll(0, float64(-newPos))
+			}
+			newPos = 0
+
+		case newPos > max:
+			if sv.overscroll {
+				sv.overscrollOffset = sv.dampedOverscroll(0, float64(max-newPos))
+			}
+			newPos = max
+		}
+
+		if newPos != pos {
+			sv.scrollToPosition(win.SB_VERT, newPos)
+		}
+	} else {
+		offset := sv.dampedOverscroll(sv.overscrollOffset, float64(dy))
+		if offset*sv.overscrollOffset <= 0 {
+			offset = 0
+		}
+
+		sv.overscrollOffset = offset
+	}
+
+	sv.applyOverscroll()
+}
+
+// releasePan is called when the finger has been lifted after panning.
+func (sv *ScrollView) releasePan() {
+	sv.panning = false
+
+	threshold := float64(sv.IntFrom96DPI(scrollViewRefreshThreshold96dpi))
+
+	if sv.pullToRefresh && !sv.refreshing && sv.overscrollOffset >= threshold {
+		sv.refreshing = true
+		sv.refreshIndicatorPhase = 0
+
+		sv.refreshRequestedPublisher.Publish()
+	}
+
+	sv.startOverscrollAnimation()
+}
+
+func (sv *ScrollView) startOverscrollAnimation() {
+	if sv.hWnd != 0 {
+		win.SetTimer(sv.hWnd, scrollViewOverscrollTimerId, scrollViewOverscrollInterval, 0)
+	}
+}
+
+// animateOverscroll moves the content towards its resting position, which
+// leaves room for the refresh indicator while refreshing.
+func (sv *ScrollView) animateOverscroll() {
+	var target float64
+	if sv.refreshing && sv.pullToRefresh {
+		target = float64(sv.IntFrom96DPI(scrollViewRefreshIndicator96dpi))
+		sv.refreshIndicatorPhase++
+	}
+
+	if !sv.panning {
+		if diff := target - sv.overscrollOffset; math.Abs(diff) < 1 {
+			sv.overscrollOffset = target
+		} else {
+			sv.overscrollOffset += diff / 4
+		}
+	}
+
+	sv.applyOverscroll()
+
+	if !sv.refreshing && (sv.panning || sv.overscrollOffset == target) {
+		win.KillTimer(sv.hWnd, scrollViewOverscrollTimerId)
+	}
+}
+
+// applyOverscroll moves the composite by the overscroll offset.
+func (sv *ScrollView) applyOverscroll() {
+	pos, _ := sv.verticalScrollPosition()
+
+	sv.composite.SetYPixels(-pos + int(sv.overscrollOffset))
+
+	if sv.pullToRefresh && sv.overscrollOffset >= 1 {
+		rc := win.RECT{Right: int32(sv.ClientBoundsPixels().Width), Bottom: int32(sv.overscrollOffset)}
+		win.InvalidateRect(sv.hWnd, &rc, true)
+	}
+}
+
+// paintRefreshIndicator draws a ring of dots into the space above the
+// content, which fills up while pulling and spins while refreshing.
+func (sv *ScrollView) paintRefreshIndicator(hdc win.HDC) {
+	canvas, err := newCanvasFromHDC(hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	textBrush, err := NewSolidColorBrush(Color(win.GetSysColor(win.COLOR_WINDOWTEXT)))
+	if err != nil {
+		return
+	}
+	defer textBrush.Dispose()
+
+	grayBrush, err := NewSolidColorBrush(Color(win.GetSysColor(win.COLOR_GRAYTEXT)))
+	if err != nil {
+		return
+	}
+	defer grayBrush.Dispose()
+
+	radius := float64(sv.IntFrom96DPI(scrollViewRefreshIndicatorRadius))
+	dotSize := sv.IntFrom96DPI(scrollViewRefreshIndicatorDotSize)
+	centerX := float64(sv.ClientBoundsPixels().Width) / 2
+	centerY := sv.overscrollOffset - float64(sv.IntFrom96DPI(scrollViewRefreshIndicator96dpi))/2
+
+	count := scrollViewRefreshIndicatorDots
+	if !sv.refreshing {
+		progress := sv.overscrollOffset / float64(sv.IntFrom96DPI(scrollViewRefreshThreshold96dpi))
+		count = int(math.Ceil(math.Min(1, progress) * scrollViewRefreshIndicatorDots))
+	}
+
+	highlighted := (sv.refreshIndicatorPhase / 4) % scrollViewRefreshIndicatorDots
+
+	for i := 0; i < count; i++ {
+		angle := 2*math.Pi*float64(i)/scrollViewRefreshIndicatorDots - math.Pi/2
+
+		brush := textBrush
+		if sv.refreshing && i != highlighted {
+			brush = grayBrush
+		}
+
+		x := int(centerX+radius*math.Cos(angle)) - dotSize/2
+		y := int(centerY+radius*math.Sin(angle)) - dotSize/2
+
+		canvas.FillEllipsePixels(brush, Rectangle{x, y, dotSize, dotSize})
+	}
+}
+
 // scroll scrolls and returns new position in native pixels.
 func (sv *ScrollView) scroll(sb int32, cmd uint16) int {
 	var pos int32
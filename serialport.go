@@ -0,0 +1,216 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/lxn/win"
+	"golang.org/x/sys/windows/registry"
+)
+
+// SerialPorts returns the names of the serial ports currently present in the
+// system, e.g. "COM1", in natural order.
+func SerialPorts() ([]string, error) {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, `HARDWARE\DEVICEMAP\SERIALCOMM`, registry.QUERY_VALUE)
+	if err != nil {
+		if err == registry.ErrNotExist {
+			// The key only exists while at least one port is present.
+			return nil, nil
+		}
+
+		return nil, wrapError(err)
+	}
+	defer key.Close()
+
+	valueNames, err := key.ReadValueNames(0)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	ports := make([]string, 0, len(valueNames))
+	for _, valueName := range valueNames {
+		if port, _, err := key.GetStringValue(valueName); err == nil && port != "" {
+			ports = append(ports, port)
+		}
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		return serialPortLess(ports[i], ports[j])
+	})
+
+	return ports, nil
+}
+
+func serialPortLess(a, b string) bool {
+	aPrefix, aNum := splitSerialPortName(a)
+	bPrefix, bNum := splitSerialPortName(b)
+
+	if aPrefix != bPrefix {
+		return aPrefix < bPrefix
+	}
+
+	return aNum < bNum
+}
+
+func splitSerialPortName(name string) (prefix string, num int) {
+	i := strings.LastIndexFunc(name, func(r rune) bool {
+		return r < '0' || r > '9'
+	}) + 1
+
+	num, _ = strconv.Atoi(name[i:])
+
+	return strings.ToUpper(name[:i]), num
+}
+
+// SerialPortComboBox is a drop down box that lists the serial ports of the
+// system.
+//
+// The list is refreshed automatically when devices are added to or removed
+// from the system. The selected port is kept, as long as it is still present.
+type SerialPortComboBox struct {
+	*ComboBox
+	ports                 []string
+	devNotify             uintptr
+	portsChangedPublisher EventPublisher
+}
+
+// NewSerialPortComboBox creates and initializes a new SerialPortComboBox.
+func NewSerialPortComboBox(parent Container) (*SerialPortComboBox, error) {
+	cb, err := NewDropDownBox(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	spcb := &SerialPortComboBox{ComboBox: cb}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			spcb.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(spcb); err != nil {
+		return nil, err
+	}
+
+	filter := devBroadcastDeviceInterface{
+		DbccDeviceType: dbtDevTypDeviceInterface,
+	}
+	filter.DbccSize = uint32(unsafe.Sizeof(filter))
+
+	spcb.devNotify = registerDeviceNotification(
+		spcb.hWnd,
+		unsafe.Pointer(&filter),
+		deviceNotifyWindowHandle|deviceNotifyAllInterfaceClasses)
+	if spcb.devNotify == 0 {
+		return nil, lastError("RegisterDeviceNotification")
+	}
+
+	if err := spcb.Refresh(); err != nil {
+		return nil, err
+	}
+
+	succeeded = true
+
+	return spcb, nil
+}
+
+// Ports returns the names of the listed serial ports.
+func (spcb *SerialPortComboBox) Ports() []string {
+	return append([]string(nil), spcb.ports...)
+}
+
+// PortName returns the name of the selected serial port, or an empty string
+// if none is selected.
+func (spcb *SerialPortComboBox) PortName() string {
+	if i := spcb.CurrentIndex(); i > -1 && i < len(spcb.ports) {
+		return spcb.ports[i]
+	}
+
+	return ""
+}
+
+// SetPortName selects the serial port with the given name.
+//
+// If no such port is present, the selection is cleared.
+func (spcb *SerialPortComboBox) SetPortName(name string) error {
+	for i, port := range spcb.ports {
+		if strings.EqualFold(port, name) {
+			return spcb.SetCurrentIndex(i)
+		}
+	}
+
+	return spcb.SetCurrentIndex(-1)
+}
+
+// PortsChanged returns the event that is published when the list of serial
+// ports changed.
+func (spcb *SerialPortComboBox) PortsChanged() *Event {
+	return spcb.portsChangedPublisher.Event()
+}
+
+// Refresh updates the list of serial ports.
+//
+// There is usually no need to call this, as the list is refreshed
+// automatically on device changes.
+func (spcb *SerialPortComboBox) Refresh() error {
+	ports, err := SerialPorts()
+	if err != nil {
+		return err
+	}
+
+	if spcb.ports != nil && len(ports) == len(spcb.ports) {
+		same := true
+		for i, port := range ports {
+			if port != spcb.ports[i] {
+				same = false
+				break
+			}
+		}
+
+		if same {
+			return nil
+		}
+	}
+
+	if ports == nil {
+		ports = []string{}
+	}
+
+	spcb.ports = ports
+
+	if err := spcb.SetModel(ports); err != nil {
+		return err
+	}
+
+	spcb.portsChangedPublisher.Publish()
+
+	return nil
+}
+
+func (spcb *SerialPortComboBox) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_DEVICECHANGE:
+		switch wParam {
+		case dbtDeviceArrival, dbtDeviceRemoveComplete:
+			spcb.Refresh()
+		}
+
+	case win.WM_DESTROY:
+		if spcb.devNotify != 0 {
+			unregisterDeviceNotification(spcb.devNotify)
+			spcb.devNotify = 0
+		}
+	}
+
+	return spcb.ComboBox.WndProc(hwnd, msg, wParam, lParam)
+}
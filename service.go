@@ -0,0 +1,304 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// SessionChangeReason describes the kind of a session change.
+type SessionChangeReason uint32
+
+const (
+	SessionConsoleConnect    SessionChangeReason = 0x1
+	SessionConsoleDisconnect SessionChangeReason = 0x2
+	SessionRemoteConnect     SessionChangeReason = 0x3
+	SessionRemoteDisconnect  SessionChangeReason = 0x4
+	SessionLogon             SessionChangeReason = 0x5
+	SessionLogoff            SessionChangeReason = 0x6
+	SessionLock              SessionChangeReason = 0x7
+	SessionUnlock            SessionChangeReason = 0x8
+	SessionRemoteControl     SessionChangeReason = 0x9
+	SessionCreate            SessionChangeReason = 0xA
+	SessionTerminate         SessionChangeReason = 0xB
+)
+
+type sessionChangeEventHandlerInfo struct {
+	handler SessionChangeEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+type SessionChangeEventHandler func(reason SessionChangeReason, sessionID uint32)
+
+type SessionChangeEvent struct {
+	handlers []sessionChangeEventHandlerInfo
+}
+
+func (e *SessionChangeEvent) Attach(handler SessionChangeEventHandler) int {
+	handlerInfo := sessionChangeEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *SessionChangeEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *SessionChangeEvent) Once(handler SessionChangeEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *SessionChangeEvent) AttachScoped(owner Window, handler SessionChangeEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type SessionChangeEventPublisher struct {
+	event SessionChangeEvent
+}
+
+func (p *SessionChangeEventPublisher) Event() *SessionChangeEvent {
+	return &p.event
+}
+
+func (p *SessionChangeEventPublisher) Publish(reason SessionChangeReason, sessionID uint32) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(reason, sessionID)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
+
+// CurrentSessionID returns the ID of the Terminal Services session the
+// current process runs in.
+func CurrentSessionID() uint32 {
+	var sessionID uint32
+	processIdToSessionId(uint32(os.Getpid()), &sessionID)
+
+	return sessionID
+}
+
+// ActiveConsoleSessionID returns the ID of the session attached to the
+// physical console, or 0xFFFFFFFF if there is none.
+func ActiveConsoleSessionID() uint32 {
+	return wtsGetActiveConsoleSessionId()
+}
+
+// ServiceState is the state of a Windows service.
+type ServiceState uint32
+
+const (
+	ServiceStopped         ServiceState = windows.SERVICE_STOPPED
+	ServiceStartPending    ServiceState = windows.SERVICE_START_PENDING
+	ServiceStopPending     ServiceState = windows.SERVICE_STOP_PENDING
+	ServiceRunning         ServiceState = windows.SERVICE_RUNNING
+	ServiceContinuePending ServiceState = windows.SERVICE_CONTINUE_PENDING
+	ServicePausePending    ServiceState = windows.SERVICE_PAUSE_PENDING
+	ServicePaused          ServiceState = windows.SERVICE_PAUSED
+)
+
+// withService calls f with the service with the given name. The errors it
+// returns are not wrapped, so callers decide how to report them.
+func withService(name string, access uint32, f func(service windows.Handle) error) error {
+	manager, err := windows.OpenSCManager(nil, nil, windows.SC_MANAGER_CONNECT)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseServiceHandle(manager)
+
+	namePtr, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+
+	service, err := windows.OpenService(manager, namePtr, access)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseServiceHandle(service)
+
+	return f(service)
+}
+
+// QueryServiceState returns the current state of the service with the given
+// name.
+func QueryServiceState(name string) (ServiceState, error) {
+	state, err := queryServiceState(name)
+	if err != nil {
+		return state, wrapError(err)
+	}
+
+	return state, nil
+}
+
+func queryServiceState(name string) (ServiceState, error) {
+	var state ServiceState
+
+	err := withService(name, windows.SERVICE_QUERY_STATUS, func(service windows.Handle) error {
+		var status windows.SERVICE_STATUS
+		if err := windows.QueryServiceStatus(service, &status); err != nil {
+			return err
+		}
+
+		state = ServiceState(status.CurrentState)
+
+		return nil
+	})
+
+	return state, err
+}
+
+// StartService asks the service control manager to start the service with the
+// given name. This usually requires elevated privileges.
+func StartService(name string) error {
+	err := withService(name, windows.SERVICE_START, func(service windows.Handle) error {
+		return windows.StartService(service, 0, nil)
+	})
+	if err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+// StopService asks the service control manager to stop the service with the
+// given name. This usually requires elevated privileges.
+func StopService(name string) error {
+	err := withService(name, windows.SERVICE_STOP, func(service windows.Handle) error {
+		var status windows.SERVICE_STATUS
+		return windows.ControlService(service, windows.SERVICE_CONTROL_STOP, &status)
+	})
+	if err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+// ServiceMonitor periodically queries the state of a Windows service and
+// publishes changes on the UI thread of its owner window.
+//
+// It allows the UI of an application, whose core runs as a service, to
+// reflect whether the service is available.
+type ServiceMonitor struct {
+	owner                 Window
+	name                  string
+	state                 ServiceState
+	err                   error
+	stateChangedPublisher EventPublisher
+	quit                  chan struct{}
+	disposeOnce           sync.Once
+}
+
+// NewServiceMonitor creates a new ServiceMonitor for the service with the
+// given name, that queries its state at the given interval.
+func NewServiceMonitor(owner Window, name string, interval time.Duration) *ServiceMonitor {
+	sm := &ServiceMonitor{
+		owner: owner,
+		name:  name,
+		quit:  make(chan struct{}),
+	}
+
+	sm.state, sm.err = queryServiceState(name)
+	if sm.err != nil {
+		sm.err = wrapErrorNoPanic(sm.err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				// The monitor runs off the UI thread and queries the service
+				// repeatedly, so errors must not panic and are logged only
+				// when the availability of the service changed.
+				state, err := queryServiceState(name)
+
+				owner.Synchronize(func() {
+					changed := state != sm.state || (err == nil) != (sm.err == nil)
+
+					if err != nil {
+						if changed {
+							err = wrapErrorNoPanic(err)
+						} else {
+							err = wrapErr(err)
+						}
+					}
+
+					sm.state, sm.err = state, err
+
+					if changed {
+						sm.stateChangedPublisher.Publish()
+					}
+				})
+
+			case <-sm.quit:
+				return
+			}
+		}
+	}()
+
+	return sm
+}
+
+// Name returns the name of the monitored service.
+func (sm *ServiceMonitor) Name() string {
+	return sm.name
+}
+
+// State returns the last known state of the service.
+func (sm *ServiceMonitor) State() ServiceState {
+	return sm.state
+}
+
+// Err returns the error of the last query, e.g. if the service is not
+// installed.
+func (sm *ServiceMonitor) Err() error {
+	return sm.err
+}
+
+// StateChanged returns the event that is published when the state of the
+// service, or the availability of that state, changed.
+func (sm *ServiceMonitor) StateChanged() *Event {
+	return sm.stateChangedPublisher.Event()
+}
+
+// Dispose stops monitoring the service.
+func (sm *ServiceMonitor) Dispose() {
+	sm.disposeOnce.Do(func() {
+		close(sm.quit)
+	})
+}
@@ -0,0 +1,546 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"math"
+	"syscall"
+
+	"github.com/lxn/win"
+)
+
+// gdiplusStarted is true once startGdiplus succeeded.
+var gdiplusStarted bool
+
+// startGdiplus starts GDI+ for SmoothCanvases and SmoothPaths. It stays
+// started until the process exits.
+func startGdiplus() error {
+	if gdiplusStarted {
+		return nil
+	}
+
+	var si win.GdiplusStartupInput
+	si.GdiplusVersion = 1
+	if status := win.GdiplusStartup(&si, nil); status != win.Ok {
+		return newError(fmt.Sprintf("GdiplusStartup failed with status '%s'", status))
+	}
+
+	gdiplusStarted = true
+
+	return nil
+}
+
+func gdiplusError(function string, status win.GpStatus) error {
+	return newError(fmt.Sprintf("%s failed with status '%s'", function, status))
+}
+
+// PointF is a point with fractional coordinates, as used by SmoothCanvas.
+type PointF struct {
+	X, Y float64
+}
+
+// RectangleF is a rectangle with fractional coordinates, as used by
+// SmoothCanvas.
+type RectangleF struct {
+	X, Y, Width, Height float64
+}
+
+// RectangleFFrom returns r with fractional coordinates.
+func RectangleFFrom(r Rectangle) RectangleF {
+	return RectangleF{float64(r.X), float64(r.Y), float64(r.Width), float64(r.Height)}
+}
+
+// SmoothPen describes the lines a SmoothCanvas or D2DContext draws.
+type SmoothPen struct {
+	Color AlphaColor
+	Width float64 // in 1/96" units, 0 means 1
+}
+
+// SmoothCanvas draws antialiased lines, shapes, paths and text with alpha
+// blending on a Canvas, using GDI+. Get one from Canvas.BeginSmooth.
+//
+// Coordinates are in 1/96" units and transformed by the transforms pushed on
+// the Canvas, see Canvas.PushTransform.
+type SmoothCanvas struct {
+	canvas     *Canvas
+	graphics   *gpGraphics
+	worldPrev  xform // the world transform of the canvas, see BeginSmooth
+	worldReset bool
+}
+
+// BeginSmooth returns a SmoothCanvas drawing on c. c itself must not be drawn
+// on until SmoothCanvas.End is called.
+//
+// Drawing with GDI+ is slower than with GDI, so Canvas keeps using GDI unless
+// smooth drawing is asked for like this.
+func (c *Canvas) BeginSmooth() (*SmoothCanvas, error) {
+	if err := startGdiplus(); err != nil {
+		return nil, err
+	}
+
+	sc := &SmoothCanvas{canvas: c}
+
+	factor := float64(c.DPI()) / 96
+	t := ScalingTransform(factor, factor)
+
+	// GDI+ ignores the world transform of the device context, so it takes it
+	// over until End.
+	if len(c.transformStack) > 0 {
+		if !getWorldTransform(c.hdc, &sc.worldPrev) {
+			return nil, newError("GetWorldTransform failed")
+		}
+
+		t = t.Then(transformFromXFORM(sc.worldPrev))
+
+		if !modifyWorldTransform(c.hdc, nil, mwtIdentity) {
+			return nil, newError("ModifyWorldTransform failed")
+		}
+		sc.worldReset = true
+	}
+
+	if status := gdipCreateFromHDC(c.hdc, &sc.graphics); status != win.Ok {
+		sc.restoreWorldTransform()
+		return nil, gdiplusError("GdipCreateFromHDC", status)
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			sc.End()
+		}
+	}()
+
+	if status := gdipSetSmoothingMode(sc.graphics, gpSmoothingModeAntiAlias); status != win.Ok {
+		return nil, gdiplusError("GdipSetSmoothingMode", status)
+	}
+
+	// Makes pixel centers lie at half coordinates like with GDI, so 1 pixel
+	// wide lines at whole coordinates stay crisp.
+	if status := gdipSetPixelOffsetMode(sc.graphics, gpPixelOffsetModeHalf); status != win.Ok {
+		return nil, gdiplusError("GdipSetPixelOffsetMode", status)
+	}
+
+	var matrix *gpMatrix
+	if status := gdipCreateMatrix2(float32(t.M11), float32(t.M12), float32(t.M21), float32(t.M22), float32(t.Dx), float32(t.Dy), &matrix); status != win.Ok {
+		return nil, gdiplusError("GdipCreateMatrix2", status)
+	}
+	defer gdipDeleteMatrix(matrix)
+
+	if status := gdipSetWorldTransform(sc.graphics, matrix); status != win.Ok {
+		return nil, gdiplusError("GdipSetWorldTransform", status)
+	}
+
+	succeeded = true
+
+	return sc, nil
+}
+
+// WithSmooth calls f with a SmoothCanvas drawing on c, see BeginSmooth.
+func (c *Canvas) WithSmooth(f func(sc *SmoothCanvas) error) error {
+	sc, err := c.BeginSmooth()
+	if err != nil {
+		return err
+	}
+	defer sc.End()
+
+	return f(sc)
+}
+
+// End finishes drawing. Afterwards, the Canvas can be drawn on again.
+func (sc *SmoothCanvas) End() {
+	if sc.graphics == nil {
+		return
+	}
+
+	gdipFlush(sc.graphics, gpFlushIntentionSync)
+	gdipDeleteGraphics(sc.graphics)
+	sc.graphics = nil
+
+	sc.restoreWorldTransform()
+}
+
+func (sc *SmoothCanvas) restoreWorldTransform() {
+	if sc.worldReset {
+		setWorldTransform(sc.canvas.hdc, &sc.worldPrev)
+		sc.worldReset = false
+	}
+}
+
+func (sc *SmoothCanvas) withPen(pen SmoothPen, f func(p *gpPen) win.GpStatus, function string) error {
+	width := pen.Width
+	if width == 0 {
+		width = 1
+	}
+
+	var p *gpPen
+	if status := gdipCreatePen1(pen.Color.argb(), float32(width), gpUnitWorld, &p); status != win.Ok {
+		return gdiplusError("GdipCreatePen1", status)
+	}
+	defer gdipDeletePen(p)
+
+	if status := gdipSetPenLineJoin(p, gpLineJoinRound); status != win.Ok {
+		return gdiplusError("GdipSetPenLineJoin", status)
+	}
+
+	if status := f(p); status != win.Ok {
+		return gdiplusError(function, status)
+	}
+
+	return nil
+}
+
+func (sc *SmoothCanvas) withBrush(color AlphaColor, f func(b *gpBrush) win.GpStatus, function string) error {
+	var b *gpBrush
+	if status := gdipCreateSolidFill(color.argb(), &b); status != win.Ok {
+		return gdiplusError("GdipCreateSolidFill", status)
+	}
+	defer gdipDeleteBrush(b)
+
+	if status := f(b); status != win.Ok {
+		return gdiplusError(function, status)
+	}
+
+	return nil
+}
+
+// DrawLine draws a line from from to to.
+func (sc *SmoothCanvas) DrawLine(pen SmoothPen, from, to PointF) error {
+	return sc.withPen(pen, func(p *gpPen) win.GpStatus {
+		return gdipDrawLine(sc.graphics, p, float32(from.X), float32(from.Y), float32(to.X), float32(to.Y))
+	}, "GdipDrawLine")
+}
+
+// DrawPolyline draws lines connecting points.
+func (sc *SmoothCanvas) DrawPolyline(pen SmoothPen, points []PointF) error {
+	if len(points) < 2 {
+		return nil
+	}
+
+	gpPoints := gpPointsFrom(points)
+
+	return sc.withPen(pen, func(p *gpPen) win.GpStatus {
+		return gdipDrawLines(sc.graphics, p, &gpPoints[0], int32(len(gpPoints)))
+	}, "GdipDrawLines")
+}
+
+// DrawPolygon draws the outline of the polygon with corners points.
+func (sc *SmoothCanvas) DrawPolygon(pen SmoothPen, points []PointF) error {
+	if len(points) < 2 {
+		return nil
+	}
+
+	gpPoints := gpPointsFrom(points)
+
+	return sc.withPen(pen, func(p *gpPen) win.GpStatus {
+		return gdipDrawPolygon(sc.graphics, p, &gpPoints[0], int32(len(gpPoints)))
+	}, "GdipDrawPolygon")
+}
+
+// FillPolygon fills the polygon with corners points with color.
+func (sc *SmoothCanvas) FillPolygon(color AlphaColor, points []PointF) error {
+	if len(points) < 3 {
+		return nil
+	}
+
+	gpPoints := gpPointsFrom(points)
+
+	return sc.withBrush(color, func(b *gpBrush) win.GpStatus {
+		return gdipFillPolygon(sc.graphics, b, &gpPoints[0], int32(len(gpPoints)), gpFillModeAlternate)
+	}, "GdipFillPolygon")
+}
+
+// DrawRectangle draws the outline of bounds.
+func (sc *SmoothCanvas) DrawRectangle(pen SmoothPen, bounds RectangleF) error {
+	return sc.withPen(pen, func(p *gpPen) win.GpStatus {
+		return gdipDrawRectangle(sc.graphics, p, float32(bounds.X), float32(bounds.Y), float32(bounds.Width), float32(bounds.Height))
+	}, "GdipDrawRectangle")
+}
+
+// FillRectangle fills bounds with color.
+func (sc *SmoothCanvas) FillRectangle(color AlphaColor, bounds RectangleF) error {
+	return sc.withBrush(color, func(b *gpBrush) win.GpStatus {
+		return gdipFillRectangle(sc.graphics, b, float32(bounds.X), float32(bounds.Y), float32(bounds.Width), float32(bounds.Height))
+	}, "GdipFillRectangle")
+}
+
+// DrawEllipse draws the outline of the ellipse fitting into bounds.
+func (sc *SmoothCanvas) DrawEllipse(pen SmoothPen, bounds RectangleF) error {
+	return sc.withPen(pen, func(p *gpPen) win.GpStatus {
+		return gdipDrawEllipse(sc.graphics, p, float32(bounds.X), float32(bounds.Y), float32(bounds.Width), float32(bounds.Height))
+	}, "GdipDrawEllipse")
+}
+
+// FillEllipse fills the ellipse fitting into bounds with color.
+func (sc *SmoothCanvas) FillEllipse(color AlphaColor, bounds RectangleF) error {
+	return sc.withBrush(color, func(b *gpBrush) win.GpStatus {
+		return gdipFillEllipse(sc.graphics, b, float32(bounds.X), float32(bounds.Y), float32(bounds.Width), float32(bounds.Height))
+	}, "GdipFillEllipse")
+}
+
+// DrawPath draws the outline of path.
+func (sc *SmoothCanvas) DrawPath(pen SmoothPen, path *SmoothPath) error {
+	return sc.withPen(pen, func(p *gpPen) win.GpStatus {
+		return gdipDrawPath(sc.graphics, p, path.path)
+	}, "GdipDrawPath")
+}
+
+// FillPath fills path with color.
+func (sc *SmoothCanvas) FillPath(color AlphaColor, path *SmoothPath) error {
+	return sc.withBrush(color, func(b *gpBrush) win.GpStatus {
+		return gdipFillPath(sc.graphics, b, path.path)
+	}, "GdipFillPath")
+}
+
+// DrawText draws text in font and color into bounds.
+//
+// Of the format flags, the horizontal and vertical alignment,
+// TextSingleLine, TextWordbreak, TextNoClip, the ellipsis flags, the prefix
+// flags and TextRTLReading are supported.
+func (sc *SmoothCanvas) DrawText(text string, font *Font, color AlphaColor, bounds RectangleF, format DrawTextFormat) error {
+	if text == "" {
+		return nil
+	}
+
+	hdc := sc.canvas.hdc
+
+	// The world transform scales from 1/96" units, so the font is for 96 dpi.
+	hFontOld := win.SelectObject(hdc, win.HGDIOBJ(font.handleForDPI(96)))
+	var gpFont *gpFont
+	status := gdipCreateFontFromDC(hdc, &gpFont)
+	win.SelectObject(hdc, hFontOld)
+	if status != win.Ok {
+		return gdiplusError("GdipCreateFontFromDC", status)
+	}
+	defer gdipDeleteFont(gpFont)
+
+	stringFormat, err := newGpStringFormat(format)
+	if err != nil {
+		return err
+	}
+	defer gdipDeleteStringFormat(stringFormat)
+
+	// ClearType needs an opaque text color.
+	hint := int32(gpTextRenderingHintClearTypeGridFit)
+	if color.Alpha < 255 {
+		hint = gpTextRenderingHintAntiAliasGridFit
+	}
+	if status := gdipSetTextRenderingHint(sc.graphics, hint); status != win.Ok {
+		return gdiplusError("GdipSetTextRenderingHint", status)
+	}
+
+	text16, err := syscall.UTF16FromString(text)
+	if err != nil {
+		return err
+	}
+
+	rect := gpRectF{float32(bounds.X), float32(bounds.Y), float32(bounds.Width), float32(bounds.Height)}
+
+	return sc.withBrush(color, func(b *gpBrush) win.GpStatus {
+		return gdipDrawString(sc.graphics, &text16[0], int32(len(text16)-1), gpFont, &rect, stringFormat, b)
+	}, "GdipDrawString")
+}
+
+// newGpStringFormat returns a GDI+ string format corresponding to format.
+func newGpStringFormat(format DrawTextFormat) (*gpStringFormat, error) {
+	var flags int32
+	if format&TextWordbreak == 0 || format&TextSingleLine != 0 {
+		flags |= gpStringFormatFlagsNoWrap
+	}
+	if format&TextNoClip != 0 {
+		flags |= gpStringFormatFlagsNoClip
+	}
+	if format&TextRTLReading != 0 {
+		flags |= gpStringFormatFlagsDirectionRightToLeft
+	}
+
+	var sf *gpStringFormat
+	if status := gdipCreateStringFormat(flags, 0, &sf); status != win.Ok {
+		return nil, gdiplusError("GdipCreateStringFormat", status)
+	}
+
+	align := int32(gpStringAlignmentNear)
+	switch {
+	case format&TextCenter != 0:
+		align = gpStringAlignmentCenter
+
+	case format&TextRight != 0:
+		align = gpStringAlignmentFar
+	}
+
+	lineAlign := int32(gpStringAlignmentNear)
+	switch {
+	case format&TextVCenter != 0:
+		lineAlign = gpStringAlignmentCenter
+
+	case format&TextBottom != 0:
+		lineAlign = gpStringAlignmentFar
+	}
+
+	trimming := int32(gpStringTrimmingNone)
+	switch {
+	case format&TextEndEllipsis != 0:
+		trimming = gpStringTrimmingEllipsisCharacter
+
+	case format&TextWordEllipsis != 0:
+		trimming = gpStringTrimmingEllipsisWord
+
+	case format&TextPathEllipsis != 0:
+		trimming = gpStringTrimmingEllipsisPath
+	}
+
+	hotkeyPrefix := int32(gpHotkeyPrefixShow)
+	switch {
+	case format&TextNoPrefix != 0:
+		hotkeyPrefix = gpHotkeyPrefixNone
+
+	case format&TextHidePrefix != 0:
+		hotkeyPrefix = gpHotkeyPrefixHide
+	}
+
+	for _, set := range []struct {
+		function string
+		status   win.GpStatus
+	}{
+		{"GdipSetStringFormatAlign", gdipSetStringFormatAlign(sf, align)},
+		{"GdipSetStringFormatLineAlign", gdipSetStringFormatLineAlign(sf, lineAlign)},
+		{"GdipSetStringFormatTrimming", gdipSetStringFormatTrimming(sf, trimming)},
+		{"GdipSetStringFormatHotkeyPrefix", gdipSetStringFormatHotkeyPrefix(sf, hotkeyPrefix)},
+	} {
+		if set.status != win.Ok {
+			gdipDeleteStringFormat(sf)
+			return nil, gdiplusError(set.function, set.status)
+		}
+	}
+
+	return sf, nil
+}
+
+func gpPointsFrom(points []PointF) []gpPointF {
+	gpPoints := make([]gpPointF, len(points))
+
+	for i, p := range points {
+		gpPoints[i] = gpPointF{float32(p.X), float32(p.Y)}
+	}
+
+	return gpPoints
+}
+
+// SmoothPath is a shape of lines and curves, that a SmoothCanvas can draw and
+// fill. It consists of figures, each starting where the previous one ended,
+// unless StartFigure is called.
+//
+// Coordinates are in 1/96" units and angles in degrees, measured clockwise
+// from the x axis.
+type SmoothPath struct {
+	path *gpPath
+}
+
+// NewSmoothPath returns a new, empty SmoothPath.
+func NewSmoothPath() (*SmoothPath, error) {
+	if err := startGdiplus(); err != nil {
+		return nil, err
+	}
+
+	p := new(SmoothPath)
+	if status := gdipCreatePath(gpFillModeAlternate, &p.path); status != win.Ok {
+		return nil, gdiplusError("GdipCreatePath", status)
+	}
+
+	return p, nil
+}
+
+// Dispose releases the GDI+ path.
+func (p *SmoothPath) Dispose() {
+	if p.path != nil {
+		gdipDeletePath(p.path)
+		p.path = nil
+	}
+}
+
+// StartFigure starts a new figure, without connecting it to the current one.
+func (p *SmoothPath) StartFigure() error {
+	return p.check("GdipStartPathFigure", gdipStartPathFigure(p.path))
+}
+
+// CloseFigure closes the current figure with a line to its start.
+func (p *SmoothPath) CloseFigure() error {
+	return p.check("GdipClosePathFigure", gdipClosePathFigure(p.path))
+}
+
+// AddLine adds a line from from to to.
+func (p *SmoothPath) AddLine(from, to PointF) error {
+	return p.check("GdipAddPathLine", gdipAddPathLine(p.path, float32(from.X), float32(from.Y), float32(to.X), float32(to.Y)))
+}
+
+// AddBezier adds a cubic Bézier curve from start to end with control points
+// c1 and c2.
+func (p *SmoothPath) AddBezier(start, c1, c2, end PointF) error {
+	return p.check("GdipAddPathBezier", gdipAddPathBezier(p.path,
+		float32(start.X), float32(start.Y),
+		float32(c1.X), float32(c1.Y),
+		float32(c2.X), float32(c2.Y),
+		float32(end.X), float32(end.Y)))
+}
+
+// AddArc adds an arc of the ellipse fitting into bounds, from startAngle
+// sweeping by sweepAngle.
+func (p *SmoothPath) AddArc(bounds RectangleF, startAngle, sweepAngle float64) error {
+	return p.check("GdipAddPathArc", gdipAddPathArc(p.path,
+		float32(bounds.X), float32(bounds.Y), float32(bounds.Width), float32(bounds.Height),
+		float32(startAngle), float32(sweepAngle)))
+}
+
+// AddEllipse adds the ellipse fitting into bounds as a closed figure.
+func (p *SmoothPath) AddEllipse(bounds RectangleF) error {
+	return p.check("GdipAddPathEllipse", gdipAddPathEllipse(p.path, float32(bounds.X), float32(bounds.Y), float32(bounds.Width), float32(bounds.Height)))
+}
+
+// AddRectangle adds bounds as a closed figure.
+func (p *SmoothPath) AddRectangle(bounds RectangleF) error {
+	return p.check("GdipAddPathRectangle", gdipAddPathRectangle(p.path, float32(bounds.X), float32(bounds.Y), float32(bounds.Width), float32(bounds.Height)))
+}
+
+// AddRoundedRectangle adds bounds with corners rounded by radius as a closed
+// figure.
+func (p *SmoothPath) AddRoundedRectangle(bounds RectangleF, radius float64) error {
+	radius = math.Min(radius, math.Min(bounds.Width, bounds.Height)/2)
+	if radius <= 0 {
+		return p.AddRectangle(bounds)
+	}
+
+	d := 2 * radius
+	right, bottom := bounds.X+bounds.Width, bounds.Y+bounds.Height
+
+	if err := p.StartFigure(); err != nil {
+		return err
+	}
+
+	for _, arc := range []struct {
+		bounds     RectangleF
+		startAngle float64
+	}{
+		{RectangleF{bounds.X, bounds.Y, d, d}, 180},
+		{RectangleF{right - d, bounds.Y, d, d}, 270},
+		{RectangleF{right - d, bottom - d, d, d}, 0},
+		{RectangleF{bounds.X, bottom - d, d, d}, 90},
+	} {
+		if err := p.AddArc(arc.bounds, arc.startAngle, 90); err != nil {
+			return err
+		}
+	}
+
+	return p.CloseFigure()
+}
+
+func (p *SmoothPath) check(function string, status win.GpStatus) error {
+	if status != win.Ok {
+		return gdiplusError(function, status)
+	}
+
+	return nil
+}
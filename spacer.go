@@ -19,16 +19,32 @@ type Spacer struct {
 	sizeHint96dpi     Size
 	layoutFlags       LayoutFlags
 	greedyLocallyOnly bool
+	stretchFactor     int
 }
 
 type SpacerCfg struct {
 	LayoutFlags       LayoutFlags
 	SizeHint          Size // in 1/96" units
 	GreedyLocallyOnly bool
+
+	// StretchFactor is the share of the excess space the spacer gets in a
+	// BoxLayout relative to the other items, unless the layout has a stretch
+	// factor for it. 0 means 1.
+	StretchFactor int
 }
 
 func NewSpacerWithCfg(parent Container, cfg *SpacerCfg) (*Spacer, error) {
-	return newSpacer(parent, cfg.LayoutFlags, cfg.SizeHint, cfg.GreedyLocallyOnly)
+	s, err := newSpacer(parent, cfg.LayoutFlags, cfg.SizeHint, cfg.GreedyLocallyOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.SetStretchFactor(cfg.StretchFactor); err != nil {
+		s.Dispose()
+		return nil, err
+	}
+
+	return s, nil
 }
 
 func newSpacer(parent Container, layoutFlags LayoutFlags, sizeHint96dpi Size, greedyLocallyOnly bool) (*Spacer, error) {
@@ -66,11 +82,35 @@ func NewVSpacerFixed(parent Container, height int) (*Spacer, error) {
 	return newSpacer(parent, 0, Size{0, height}, false)
 }
 
+// StretchFactor returns the stretch factor of the spacer, 0 if it has none.
+func (s *Spacer) StretchFactor() int {
+	return s.stretchFactor
+}
+
+// SetStretchFactor sets the share of the excess space the spacer gets in a
+// BoxLayout relative to the other items, e.g. 2 and 1 for two spacers to
+// divide the space 2:1. A stretch factor set on the layout for the spacer
+// takes precedence. 0 means 1.
+func (s *Spacer) SetStretchFactor(factor int) error {
+	if factor < 0 {
+		return newError("factor must be >= 0")
+	}
+
+	if factor != s.stretchFactor {
+		s.stretchFactor = factor
+
+		s.RequestLayout()
+	}
+
+	return nil
+}
+
 func (s *Spacer) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	return &spacerLayoutItem{
 		idealSize96dpi:    s.sizeHint96dpi,
 		layoutFlags:       s.layoutFlags,
 		greedyLocallyOnly: s.greedyLocallyOnly,
+		stretchFactor:     s.stretchFactor,
 	}
 }
 
@@ -79,6 +119,11 @@ type spacerLayoutItem struct {
 	idealSize96dpi    Size
 	layoutFlags       LayoutFlags
 	greedyLocallyOnly bool
+	stretchFactor     int
+}
+
+func (li *spacerLayoutItem) StretchFactor() int {
+	return li.stretchFactor
 }
 
 func (li *spacerLayoutItem) LayoutFlags() LayoutFlags {
@@ -7,6 +7,7 @@
 package walk
 
 import (
+	"strings"
 	"syscall"
 	"unsafe"
 
@@ -30,6 +31,9 @@ type static struct {
 	origStaticWndProcPtr uintptr
 	textAlignment        Alignment2D
 	textColor            Color
+	wrap                 bool
+	wrapEllipsis         bool
+	maxLines             int
 }
 
 func (s *static) init(widget Widget, parent Container, style uint32) error {
@@ -174,6 +178,8 @@ func (s *static) setText(text string) (changed bool, err error) {
 		return false, err
 	}
 
+	s.updateStaticText()
+
 	s.RequestLayout()
 
 	return true, nil
@@ -189,6 +195,140 @@ func (s *static) SetTextColor(c Color) {
 	s.Invalidate()
 }
 
+// Wrap returns if the text wraps at word boundaries, see SetWrap.
+func (s *static) Wrap() bool {
+	return s.wrap
+}
+
+// SetWrap sets if the text wraps at word boundaries.
+//
+// A wrapping widget can be made as narrow as its longest word and gets the
+// height its wrapped text requires for the width the layout gives it, so
+// multi-line text needs no fixed size. The EllipsisMode of a Label must be
+// EllipsisNone for its text to wrap.
+func (s *static) SetWrap(wrap bool) error {
+	if wrap == s.wrap {
+		return nil
+	}
+
+	s.wrap = wrap
+
+	s.updateStaticText()
+
+	s.RequestLayout()
+
+	return nil
+}
+
+// MaxLines returns the maximum number of lines of wrapped text, see
+// SetMaxLines.
+func (s *static) MaxLines() int {
+	return s.maxLines
+}
+
+// SetMaxLines sets the maximum number of lines of wrapped text. The widget
+// does not grow higher than required for that number of lines and the lines
+// exceeding it are cut off, see SetWrapEllipsis. A value of 0 means no limit.
+func (s *static) SetMaxLines(maxLines int) error {
+	if maxLines == s.maxLines {
+		return nil
+	}
+	if maxLines < 0 {
+		return newError("maxLines must be >= 0")
+	}
+
+	s.maxLines = maxLines
+
+	s.updateStaticText()
+
+	s.RequestLayout()
+
+	return nil
+}
+
+// WrapEllipsis returns if wrapped text that exceeds MaxLines ends in an
+// ellipsis.
+func (s *static) WrapEllipsis() bool {
+	return s.wrapEllipsis
+}
+
+// SetWrapEllipsis sets if wrapped text that exceeds MaxLines is shortened to
+// end in an ellipsis. The full text is then shown as tool tip.
+func (s *static) SetWrapEllipsis(ellipsis bool) error {
+	if ellipsis == s.wrapEllipsis {
+		return nil
+	}
+
+	s.wrapEllipsis = ellipsis
+
+	s.updateStaticText()
+
+	return nil
+}
+
+// updateStaticText sets the text of the static control, which is the text of
+// the widget, shortened to MaxLines if required.
+func (s *static) updateStaticText() {
+	if s.hwndStatic == 0 {
+		return
+	}
+
+	text := s.text()
+
+	var truncated bool
+	if s.wrap && s.maxLines > 0 && s.wrapEllipsis {
+		text, truncated = s.truncatedText(text, s.ClientBoundsPixels().Width)
+
+		if truncated {
+			s.SetToolTipText(s.text())
+		} else {
+			s.SetToolTipText("")
+		}
+	}
+
+	if text != windowText(s.hwndStatic) {
+		setWindowText(s.hwndStatic, text)
+	}
+}
+
+// truncatedText returns text shortened with an ellipsis so that it fits into
+// MaxLines lines of width in native pixels.
+func (s *static) truncatedText(text string, width int) (string, bool) {
+	if width <= 0 {
+		return text, false
+	}
+
+	font := s.Font()
+	dpi := s.DPI()
+	maxHeight := s.maxLines * s.dialogBaseUnits().Height
+
+	fits := func(text string) bool {
+		return calculateTextSize(text, font, dpi, width, s.hWnd).Height <= maxHeight
+	}
+
+	if fits(text) {
+		return text, false
+	}
+
+	const ellipsis = "…"
+
+	runes := []rune(text)
+
+	// Find the longest prefix that still fits with the ellipsis appended.
+	lo, hi := 0, len(runes)
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+
+		if fits(strings.TrimRight(string(runes[:mid]), " \r\n\t") + ellipsis) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return strings.TrimRight(string(runes[:lo]), " \r\n\t") + ellipsis, true
+}
+
 func (s *static) shrinkable() bool {
 	if em, ok := s.window.(interface{ EllipsisMode() EllipsisMode }); ok {
 		return em.EllipsisMode() != EllipsisNone
@@ -224,9 +364,16 @@ func (s *static) updateStaticBounds() {
 
 	cb := s.ClientBoundsPixels()
 
+	s.updateStaticText()
+
 	if shrinkable := s.shrinkable(); shrinkable || format&TextVCenter != 0 || format&TextBottom != 0 {
 		var size Size
-		if _, ok := s.window.(HeightForWidther); ok {
+		if s.wrap {
+			size = s.calculateTextSizeImplForWidth(windowText(s.hwndStatic), cb.Width)
+			if s.maxLines > 0 {
+				size.Height = mini(size.Height, s.maxLines*s.dialogBaseUnits().Height)
+			}
+		} else if _, ok := s.window.(HeightForWidther); ok {
 			size = s.calculateTextSizeForWidth(cb.Width)
 		} else {
 			size = s.calculateTextSize()
@@ -304,6 +451,10 @@ func staticWndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr {
 }
 
 func (s *static) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	if s.wrap {
+		return s.newTextLabelLayoutItem(GrowableHorz)
+	}
+
 	var layoutFlags LayoutFlags
 	if s.textAlignment1D() != AlignNear {
 		layoutFlags = GrowableHorz
@@ -312,15 +463,18 @@ func (s *static) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	}
 
 	idealSize := s.calculateTextSize()
+	baseline := s.fontAscent()
 	if s.hasStyleBits(win.WS_BORDER) {
 		border := s.IntFrom96DPI(1) * 2
 		idealSize.Width += border
 		idealSize.Height += border * 2
+		baseline += border
 	}
 
 	return &staticLayoutItem{
 		layoutFlags: layoutFlags,
 		idealSize:   idealSize,
+		baseline:    baseline,
 	}
 }
 
@@ -328,6 +482,11 @@ type staticLayoutItem struct {
 	LayoutItemBase
 	layoutFlags LayoutFlags
 	idealSize   Size // in native pixels
+	baseline    int  // in native pixels
+}
+
+func (li *staticLayoutItem) BaselineOffset() int {
+	return li.baseline
 }
 
 func (li *staticLayoutItem) LayoutFlags() LayoutFlags {
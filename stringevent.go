@@ -9,6 +9,7 @@ package walk
 type stringEventHandlerInfo struct {
 	handler StringEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type StringEventHandler func(s string)
@@ -18,7 +19,7 @@ type StringEvent struct {
 }
 
 func (e *StringEvent) Attach(handler StringEventHandler) int {
-	handlerInfo := stringEventHandlerInfo{handler, false}
+	handlerInfo := stringEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *StringEvent) Attach(handler StringEventHandler) int {
 }
 
 func (e *StringEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -41,6 +43,17 @@ func (e *StringEvent) Once(handler StringEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *StringEvent) AttachScoped(owner Window, handler StringEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type StringEventPublisher struct {
 	event StringEvent
 }
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
+	"strings"
 	"syscall"
 	"time"
 	"unsafe"
@@ -48,6 +50,25 @@ type TableViewCfg struct {
 	CustomRowHeight    int // in native pixels?
 }
 
+// TableViewRowColors are the background and text colors of rows in a state,
+// like selected or hovered.
+type TableViewRowColors struct {
+	Background Color
+	Text       Color
+}
+
+// TableViewGridlines specifies the grid lines a TableView draws between its
+// cells.
+type TableViewGridlines int
+
+const (
+	GridlinesHorizontal TableViewGridlines = 1 << iota
+	GridlinesVertical
+
+	GridlinesNone TableViewGridlines = 0
+	GridlinesBoth                    = GridlinesHorizontal | GridlinesVertical
+)
+
 // TableView is a model based widget for record centric, tabular data.
 //
 // TableView is implemented as a virtual mode list view to support quite large
@@ -67,15 +88,23 @@ type TableView struct {
 	model                              TableModel
 	providedModel                      interface{}
 	itemChecker                        ItemChecker
+	cellValueSetter                    CellValueSetter
+	rowAppender                        RowAppender
 	imageProvider                      ImageProvider
 	styler                             CellStyler
+	cellToolTipProvider                CellToolTipProvider
+	clippedCellToolTips                bool
 	style                              CellStyle
 	itemFont                           *Font
 	hIml                               win.HIMAGELIST
 	usingSysIml                        bool
 	imageUintptr2Index                 map[uintptr]int32
 	filePath2IconIndex                 map[string]int32
+	imageList                          *ManagedImageList
+	imageListDPI                       int
+	imageListChangedHandle             int
 	rowsResetHandlerHandle             int
+	modelValidator                     *ModelValidator
 	rowChangedHandlerHandle            int
 	rowsChangedHandlerHandle           int
 	rowsInsertedHandlerHandle          int
@@ -90,6 +119,7 @@ type TableView struct {
 	selectedIndexesChangedPublisher    EventPublisher
 	itemActivatedPublisher             EventPublisher
 	columnClickedPublisher             IntEventPublisher
+	pasteRejectedPublisher             CellErrorsEventPublisher
 	columnsOrderableChangedPublisher   EventPublisher
 	columnsSizableChangedPublisher     EventPublisher
 	itemCountChangedPublisher          EventPublisher
@@ -97,6 +127,9 @@ type TableView struct {
 	inSetSelectedIndexes               bool
 	lastColumnStretched                bool
 	persistent                         bool
+	currentViewPreset                  string
+	viewPresetsChangedPublisher        EventPublisher
+	currentViewPresetChangedPublisher  EventPublisher
 	itemStateChangedEventDelay         int
 	themeNormalBGColor                 Color
 	themeNormalTextColor               Color
@@ -108,12 +141,33 @@ type TableView struct {
 	alternatingRowBGColor              Color
 	alternatingRowTextColor            Color
 	alternatingRowBG                   bool
+	alternatingRowColors               *TableViewRowColors
+	selectionColors                    *TableViewRowColors
+	hoverColors                        *TableViewRowColors
+	hoverHighlight                     bool
+	hoverRow                           int
+	hoverLV                            win.HWND
+	darkTheme                          bool
+	visibleGridlines                   TableViewGridlines
+	gridlineColor                      Color
+	gridlineColorSet                   bool
+	searchColumn                       int
+	searchMode                         SearchMode
+	typeAhead                          typeAhead
+	findBar                            *findBar
+	copyFormats                        CopyFormat
+	copyIncludesHeader                 bool
 	delayedCurrentIndexChangedCanceled bool
 	sortedColumnIndex                  int
 	sortOrder                          SortOrder
 	formActivatingHandle               int
 	customHeaderHeight                 int // in native pixels?
 	customRowHeight                    int // in native pixels?
+	measuredRowHeight                  int // in native pixels, see updateRowHeight
+	autoRowHeight                      bool
+	rowHeighter                        RowHeighter
+	rowHeightUpdatePending             bool
+	lvPainting                         win.HWND
 	dpiOfPrevStretchLastColumn         int
 	scrolling                          bool
 	inSetCurrentIndex                  bool
@@ -124,6 +178,7 @@ type TableView struct {
 	ignoreNowhere                      bool
 	updateLVSizesNeedsSpecialCare      bool
 	scrollbarOrientation               Orientation
+	customScrollBars                   *CustomScrollBars
 	currentItemChangedPublisher        EventPublisher
 	currentItemID                      interface{}
 	restoringCurrentItemOnReset        bool
@@ -152,6 +207,9 @@ func NewTableViewWithCfg(parent Container, cfg *TableViewCfg) (*TableView, error
 		customRowHeight:             cfg.CustomRowHeight,
 		scrollbarOrientation:        Horizontal | Vertical,
 		restoringCurrentItemOnReset: true,
+		hoverRow:                    -1,
+		copyFormats:                 FormatTSV | FormatCSV | FormatHTML,
+		clippedCellToolTips:         true,
 	}
 
 	tv.columns = newTableViewColumnList(tv)
@@ -236,7 +294,7 @@ func NewTableViewWithCfg(parent Container, cfg *TableViewCfg) (*TableView, error
 	tv.SetPersistent(true)
 
 	exStyle := win.SendMessage(tv.hwndFrozenLV, win.LVM_GETEXTENDEDLISTVIEWSTYLE, 0, 0)
-	exStyle |= win.LVS_EX_DOUBLEBUFFER | win.LVS_EX_FULLROWSELECT | win.LVS_EX_HEADERDRAGDROP | win.LVS_EX_LABELTIP | win.LVS_EX_SUBITEMIMAGES
+	exStyle |= win.LVS_EX_DOUBLEBUFFER | win.LVS_EX_FULLROWSELECT | win.LVS_EX_HEADERDRAGDROP | win.LVS_EX_SUBITEMIMAGES
 	win.SendMessage(tv.hwndFrozenLV, win.LVM_SETEXTENDEDLISTVIEWSTYLE, 0, exStyle)
 	win.SendMessage(tv.hwndNormalLV, win.LVM_SETEXTENDEDLISTVIEWSTYLE, 0, exStyle)
 
@@ -252,6 +310,8 @@ func NewTableViewWithCfg(parent Container, cfg *TableViewCfg) (*TableView, error
 
 	tv.group.toolTip.addTool(tv.hwndFrozenHdr, false)
 	tv.group.toolTip.addTool(tv.hwndNormalHdr, false)
+	tv.group.toolTip.addTool(tv.hwndFrozenLV, false)
+	tv.group.toolTip.addTool(tv.hwndNormalLV, false)
 
 	tv.applyFont(parent.Font())
 
@@ -349,14 +409,21 @@ func (tv *TableView) Dispose() {
 		}
 	}
 
+	if tv.findBar != nil {
+		tv.findBar.dispose()
+		tv.findBar = nil
+	}
+
 	if tv.hwndFrozenLV != 0 {
 		tv.group.toolTip.removeTool(tv.hwndFrozenHdr)
+		tv.group.toolTip.removeTool(tv.hwndFrozenLV)
 		win.DestroyWindow(tv.hwndFrozenLV)
 		tv.hwndFrozenLV = 0
 	}
 
 	if tv.hwndNormalLV != 0 {
 		tv.group.toolTip.removeTool(tv.hwndNormalHdr)
+		tv.group.toolTip.removeTool(tv.hwndNormalLV)
 		win.DestroyWindow(tv.hwndNormalLV)
 		tv.hwndNormalLV = 0
 	}
@@ -379,6 +446,12 @@ func (tv *TableView) applyEnabled(enabled bool) {
 }
 
 func (tv *TableView) applyFont(font *Font) {
+	tv.invalidateRowHeight()
+
+	if tv.findBar.visible() {
+		tv.findBar.applyFont(font, tv.DPI())
+	}
+
 	if tv.customHeaderHeight > 0 || tv.customRowHeight > 0 {
 		return
 	}
@@ -403,7 +476,18 @@ func (tv *TableView) ApplyDPI(dpi int) {
 		column.update()
 	}
 
-	if tv.hIml != 0 {
+	tv.applyColumnAutoSize()
+
+	if tv.findBar.visible() {
+		tv.findBar.applyFont(tv.Font(), dpi)
+	}
+
+	tv.invalidateRowHeight()
+
+	if tv.imageList != nil {
+		tv.disposeImageListAndCaches()
+		tv.applyImageListForImage(nil)
+	} else if tv.hIml != 0 {
 		tv.disposeImageListAndCaches()
 
 		if bmp, err := NewBitmapForDPI(SizeFrom96DPI(Size{16, 16}, dpi), dpi); err == nil {
@@ -416,6 +500,10 @@ func (tv *TableView) ApplyDPI(dpi int) {
 func (tv *TableView) ApplySysColors() {
 	tv.WidgetBase.ApplySysColors()
 
+	if tv.imageList != nil {
+		tv.imageList.updateSysColorDependent()
+	}
+
 	// As some combinations of property and state may be invalid for any theme,
 	// we set some defaults here.
 	tv.themeNormalBGColor = Color(win.GetSysColor(win.COLOR_WINDOW))
@@ -467,6 +555,17 @@ func (tv *TableView) ApplySysColors() {
 		})
 	}
 
+	// The list view theme has no dark variant, so with a dark color scheme its
+	// light selection colors would stand out. We derive all colors from the
+	// background and text colors then and draw the selection ourselves.
+	if tv.darkTheme = isDarkColor(tv.themeNormalBGColor); tv.darkTheme {
+		tv.themeSelectedBGColor = blendColor(tv.themeNormalBGColor, Color(win.GetSysColor(win.COLOR_HIGHLIGHT)), 128)
+		tv.themeSelectedTextColor = tv.themeNormalTextColor
+		tv.themeSelectedNotFocusedBGColor = blendColor(tv.themeNormalBGColor, tv.themeNormalTextColor, 48)
+		tv.alternatingRowBGColor = blendColor(tv.themeNormalBGColor, tv.themeNormalTextColor, 16)
+		tv.alternatingRowTextColor = tv.themeNormalTextColor
+	}
+
 	win.SendMessage(tv.hwndNormalLV, win.LVM_SETBKCOLOR, 0, uintptr(tv.themeNormalBGColor))
 	win.SendMessage(tv.hwndFrozenLV, win.LVM_SETBKCOLOR, 0, uintptr(tv.themeNormalBGColor))
 }
@@ -592,29 +691,703 @@ func (tv *TableView) SetAlternatingRowBG(enabled bool) {
 	tv.Invalidate()
 }
 
-// Gridlines returns if the rows are separated by grid lines.
+// AlternatingRowColors returns the colors of every second row if
+// AlternatingRowBG is enabled, or nil if they are derived from the theme.
+func (tv *TableView) AlternatingRowColors() *TableViewRowColors {
+	return tv.alternatingRowColors
+}
+
+// SetAlternatingRowColors sets the colors of every second row if
+// AlternatingRowBG is enabled, nil to derive them from the theme.
+func (tv *TableView) SetAlternatingRowColors(colors *TableViewRowColors) {
+	tv.alternatingRowColors = colors
+
+	tv.Invalidate()
+}
+
+// SelectionColors returns the colors of selected rows, or nil if they are
+// derived from the theme.
+func (tv *TableView) SelectionColors() *TableViewRowColors {
+	return tv.selectionColors
+}
+
+// SetSelectionColors sets the colors of selected rows, nil to derive them
+// from the theme.
+//
+// While the TableView is not focused, the background of selected rows is
+// blended with the normal background.
+func (tv *TableView) SetSelectionColors(colors *TableViewRowColors) {
+	tv.selectionColors = colors
+
+	tv.Invalidate()
+}
+
+// HoverHighlight returns if the row under the mouse cursor is highlighted.
+func (tv *TableView) HoverHighlight() bool {
+	return tv.hoverHighlight
+}
+
+// SetHoverHighlight sets if the row under the mouse cursor is highlighted,
+// see SetHoverColors.
+func (tv *TableView) SetHoverHighlight(enabled bool) {
+	if enabled == tv.hoverHighlight {
+		return
+	}
+
+	tv.hoverHighlight = enabled
+	tv.hoverRow = -1
+	tv.hoverLV = 0
+
+	tv.Invalidate()
+}
+
+// HoverColors returns the colors of the row under the mouse cursor if
+// HoverHighlight is enabled, or nil if they are derived from the theme.
+func (tv *TableView) HoverColors() *TableViewRowColors {
+	return tv.hoverColors
+}
+
+// SetHoverColors sets the colors of the row under the mouse cursor if
+// HoverHighlight is enabled, nil to derive them from the theme. Selected rows
+// keep their selection colors.
+func (tv *TableView) SetHoverColors(colors *TableViewRowColors) {
+	tv.hoverColors = colors
+
+	tv.Invalidate()
+}
+
+// Gridlines returns if the cells are separated by grid lines.
 func (tv *TableView) Gridlines() bool {
-	exStyle := win.SendMessage(tv.hwndNormalLV, win.LVM_GETEXTENDEDLISTVIEWSTYLE, 0, 0)
-	return exStyle&win.LVS_EX_GRIDLINES > 0
+	return tv.visibleGridlines != GridlinesNone
 }
 
-// SetGridlines sets if the rows are separated by grid lines.
+// SetGridlines sets if the cells are separated by horizontal and vertical
+// grid lines, see SetVisibleGridlines.
 func (tv *TableView) SetGridlines(enabled bool) {
-	var hwnd win.HWND
-	if tv.hasFrozenColumn {
-		hwnd = tv.hwndFrozenLV
+	if enabled {
+		tv.SetVisibleGridlines(GridlinesBoth)
 	} else {
-		hwnd = tv.hwndNormalLV
+		tv.SetVisibleGridlines(GridlinesNone)
 	}
+}
 
-	exStyle := win.SendMessage(hwnd, win.LVM_GETEXTENDEDLISTVIEWSTYLE, 0, 0)
-	if enabled {
-		exStyle |= win.LVS_EX_GRIDLINES
+// VisibleGridlines returns which grid lines separate the cells.
+func (tv *TableView) VisibleGridlines() TableViewGridlines {
+	return tv.visibleGridlines
+}
+
+// SetVisibleGridlines sets which grid lines separate the cells.
+//
+// The grid lines are drawn by the TableView, so they look the same on all
+// Windows versions and only separate rows that exist.
+func (tv *TableView) SetVisibleGridlines(gridlines TableViewGridlines) {
+	tv.visibleGridlines = gridlines
+
+	tv.Invalidate()
+}
+
+// GridlineColor returns the color of the grid lines.
+func (tv *TableView) GridlineColor() Color {
+	if tv.gridlineColorSet {
+		return tv.gridlineColor
+	}
+
+	return blendColor(tv.themeNormalBGColor, tv.themeNormalTextColor, 32)
+}
+
+// SetGridlineColor sets the color of the grid lines, overriding the color
+// derived from the theme.
+func (tv *TableView) SetGridlineColor(c Color) {
+	tv.gridlineColor = c
+	tv.gridlineColorSet = true
+
+	tv.Invalidate()
+}
+
+// SearchColumn returns the index of the column type-ahead search and the find
+// bar search in.
+func (tv *TableView) SearchColumn() int {
+	return tv.searchColumn
+}
+
+// SetSearchColumn sets the index of the column type-ahead search and the find
+// bar search in. It defaults to the first column.
+func (tv *TableView) SetSearchColumn(index int) error {
+	if index < 0 {
+		return newError("index must be >= 0")
+	}
+
+	tv.searchColumn = index
+
+	tv.Invalidate()
+
+	return nil
+}
+
+// SearchMode returns how type-ahead search and the find bar match the text of
+// the search column.
+func (tv *TableView) SearchMode() SearchMode {
+	return tv.searchMode
+}
+
+// SetSearchMode sets how type-ahead search and the find bar match the text of
+// the search column.
+func (tv *TableView) SetSearchMode(mode SearchMode) {
+	tv.searchMode = mode
+
+	if mode == SearchDisabled {
+		tv.HideFindBar()
+	}
+
+	tv.Invalidate()
+}
+
+// ShowFindBar shows the find bar at the top right corner of the TableView and
+// focuses it. The user shows it by pressing Ctrl+F.
+//
+// While the find bar is shown, the cells of the search column matching its
+// text are highlighted. F3 and Shift+F3 find the next and previous match.
+func (tv *TableView) ShowFindBar() error {
+	if tv.searchMode == SearchDisabled {
+		return newError("search is disabled")
+	}
+
+	if tv.findBar == nil {
+		fb, err := newFindBar(tv.hWnd, tv.findRow, func() {
+			tv.Invalidate()
+		})
+		if err != nil {
+			return err
+		}
+
+		tv.findBar = fb
+	}
+
+	tv.findBar.show(tv.Font(), tv.DPI())
+
+	return nil
+}
+
+// HideFindBar hides the find bar.
+func (tv *TableView) HideFindBar() {
+	if tv.findBar != nil {
+		tv.findBar.hide()
+	}
+}
+
+// FindBarVisible returns if the find bar is shown.
+func (tv *TableView) FindBarVisible() bool {
+	return tv.findBar.visible()
+}
+
+// FindNext makes the next or, if backward is true, the previous row matching
+// the text of the find bar the current row. It returns if there is one.
+func (tv *TableView) FindNext(backward bool) bool {
+	if tv.findBar == nil {
+		return false
+	}
+
+	return tv.findBar.findNext(backward)
+}
+
+// handleSearchKey handles the keys that show and hide the find bar and find
+// the next match. It returns if key was handled.
+func (tv *TableView) handleSearchKey(key Key) bool {
+	if tv.searchMode == SearchDisabled {
+		return false
+	}
+
+	switch {
+	case key == KeyF && ControlDown():
+		if err := tv.ShowFindBar(); err != nil {
+			wrapError(err)
+		}
+		return true
+
+	case key == KeyF3 && tv.findBar != nil && tv.findBar.text != "":
+		tv.FindNext(ShiftDown())
+		return true
+
+	case key == KeyEscape && tv.findBar.visible():
+		tv.HideFindBar()
+		return true
+	}
+
+	return false
+}
+
+// findRow makes the first row whose text in the search column matches text
+// the current row and returns if there is one. The search starts at the
+// current row, or next to it if next is true, and wraps around.
+func (tv *TableView) findRow(text string, next, backward bool) bool {
+	if tv.model == nil || tv.searchColumn >= tv.columns.Len() {
+		return false
+	}
+
+	count := tv.model.RowCount()
+	if count == 0 {
+		return false
+	}
+
+	start := tv.currentIndex
+	switch {
+	case start < 0:
+		start = 0
+
+	case next && backward:
+		start--
+
+	case next:
+		start++
+	}
+
+	row := searchIndexes(count, start, backward, func(row int) bool {
+		return searchMatches(tv.cellText(row, tv.searchColumn), text, tv.searchMode)
+	})
+	if row == -1 {
+		return false
+	}
+
+	if err := tv.SetCurrentIndex(row); err != nil {
+		wrapError(err)
+	}
+
+	return true
+}
+
+// CopyFormats returns the formats Ctrl+C copies the selected rows in.
+func (tv *TableView) CopyFormats() CopyFormat {
+	return tv.copyFormats
+}
+
+// SetCopyFormats sets the formats Ctrl+C copies the selected rows in. 0 turns
+// copying by Ctrl+C off.
+func (tv *TableView) SetCopyFormats(formats CopyFormat) {
+	tv.copyFormats = formats
+}
+
+// CopyIncludesHeader returns if CopySelection copies the column titles as the
+// first row.
+func (tv *TableView) CopyIncludesHeader() bool {
+	return tv.copyIncludesHeader
+}
+
+// SetCopyIncludesHeader sets if CopySelection copies the column titles as the
+// first row.
+func (tv *TableView) SetCopyIncludesHeader(value bool) {
+	tv.copyIncludesHeader = value
+}
+
+// CopySelection copies the selected rows, or the current row if none is
+// selected, to the clipboard in formats, e.g. FormatTSV|FormatHTML.
+//
+// The cells of the visible columns are copied in display order, with the text
+// the TableView displays. With FormatHTML, they paste into separate cells of
+// spreadsheets like Excel, even if they contain tabs or line breaks.
+func (tv *TableView) CopySelection(formats CopyFormat) error {
+	if formats == 0 {
+		return newError("no formats")
+	}
+
+	if tv.model == nil {
+		return nil
+	}
+
+	indexes := tv.SelectedIndexes()
+	if len(indexes) == 0 && tv.currentIndex > -1 {
+		indexes = []int{tv.currentIndex}
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+	sort.Ints(indexes)
+
+	cols := tv.VisibleColumnsInDisplayOrder()
+
+	var rows [][]string
+
+	if tv.copyIncludesHeader {
+		header := make([]string, len(cols))
+		for i, tvc := range cols {
+			header[i] = tvc.TitleEffective()
+		}
+		rows = append(rows, header)
+	}
+
+	for _, row := range indexes {
+		cells := make([]string, len(cols))
+		for i, tvc := range cols {
+			cells[i] = tv.cellText(row, tv.columns.Index(tvc))
+		}
+		rows = append(rows, cells)
+	}
+
+	return Clipboard().setTable(rows, tv.copyIncludesHeader, formats)
+}
+
+// Paste sets the cells starting at the selection anchor to the tab or comma
+// separated values in the clipboard, as spreadsheets copy them. The user
+// pastes by pressing Ctrl+V. The model must implement CellValueSetter.
+//
+// The pasted columns go into the visible columns in display order, starting
+// with the first one. If a single row is pasted while several rows are
+// selected, it is pasted into all of them. Rows that do not fit in are added,
+// if the model implements RowAppender.
+//
+// Cells whose value cannot be set are not pasted. They are returned and
+// published with the PasteRejected event. The returned error is about the
+// paste as a whole.
+func (tv *TableView) Paste() ([]*CellError, error) {
+	if tv.cellValueSetter == nil {
+		return nil, newError("model does not implement CellValueSetter")
+	}
+
+	table, err := Clipboard().table()
+	if err != nil {
+		return nil, err
+	}
+	if len(table) == 0 {
+		return nil, nil
+	}
+
+	var rows []int
+	if selected := tv.SelectedIndexes(); len(table) == 1 && len(selected) > 1 {
+		sort.Ints(selected)
+		rows = selected
 	} else {
-		exStyle &^= win.LVS_EX_GRIDLINES
+		anchor := tv.pasteAnchor()
+
+		if missing := anchor + len(table) - tv.model.RowCount(); missing > 0 && tv.rowAppender != nil {
+			if err := tv.rowAppender.AppendRows(missing); err != nil {
+				return nil, err
+			}
+		}
+
+		for i := range table {
+			rows = append(rows, anchor+i)
+		}
+	}
+
+	cols := tv.VisibleColumnsInDisplayOrder()
+	rowCount := tv.model.RowCount()
+
+	var rejected []*CellError
+
+	for i, row := range rows {
+		cells := table[0]
+		if len(table) > 1 {
+			cells = table[i]
+		}
+
+		for j, text := range cells {
+			ce := &CellError{Row: row, Col: -1, Text: text}
+
+			switch {
+			case row >= rowCount:
+				ce.Err = newErr("row out of range")
+
+			case j >= len(cols):
+				ce.Err = newErr("column out of range")
+
+			default:
+				ce.Col = tv.columns.Index(cols[j])
+				ce.Err = tv.cellValueSetter.SetValue(row, ce.Col, text)
+			}
+
+			if ce.Err != nil {
+				rejected = append(rejected, ce)
+			}
+		}
+	}
+
+	if len(rejected) > 0 {
+		tv.pasteRejectedPublisher.Publish(rejected)
+	}
+
+	return rejected, nil
+}
+
+// PasteRejected returns the event that is published with the cells, whose
+// value could not be set by Paste.
+func (tv *TableView) PasteRejected() *CellErrorsEvent {
+	return tv.pasteRejectedPublisher.Event()
+}
+
+// pasteAnchor returns the row Paste starts at: the row the selection was
+// started at, the first selected row, the current row or the first row.
+func (tv *TableView) pasteAnchor() int {
+	selected := tv.SelectedIndexes()
+
+	if mark := int(int32(win.SendMessage(tv.hwndNormalLV, win.LVM_GETSELECTIONMARK, 0, 0))); mark > -1 {
+		for _, row := range selected {
+			if row == mark {
+				return mark
+			}
+		}
+	}
+
+	if len(selected) > 0 {
+		sort.Ints(selected)
+		return selected[0]
+	}
+
+	return maxi(0, tv.currentIndex)
+}
+
+// paintsSelection returns if the TableView draws the selection itself instead
+// of the list view theme.
+func (tv *TableView) paintsSelection() bool {
+	return tv.selectionColors != nil || tv.darkTheme
+}
+
+// rowColors returns the background and text colors of row, before the
+// CellStyler is applied.
+func (tv *TableView) rowColors(row int, selected bool) (bg, text Color) {
+	switch {
+	case selected:
+		if tv.selectionColors != nil {
+			bg, text = tv.selectionColors.Background, tv.selectionColors.Text
+		} else {
+			bg, text = tv.themeSelectedBGColor, tv.themeSelectedTextColor
+		}
+
+		if tv.paintsSelection() && !tv.Focused() {
+			bg = tv.selectedNotFocusedBGColor()
+		}
+
+	case tv.hoverHighlight && row == tv.hoverRow:
+		if tv.hoverColors != nil {
+			return tv.hoverColors.Background, tv.hoverColors.Text
+		}
+
+		if tv.darkTheme {
+			bg = blendColor(tv.themeNormalBGColor, tv.themeNormalTextColor, 32)
+		} else {
+			bg = blendColor(tv.themeNormalBGColor, Color(win.GetSysColor(win.COLOR_HIGHLIGHT)), 32)
+		}
+		text = tv.themeNormalTextColor
+
+	case tv.alternatingRowBG && row%2 == 1:
+		if tv.alternatingRowColors != nil {
+			return tv.alternatingRowColors.Background, tv.alternatingRowColors.Text
+		}
+
+		bg, text = tv.alternatingRowBGColor, tv.alternatingRowTextColor
+
+	default:
+		bg, text = tv.themeNormalBGColor, tv.themeNormalTextColor
+	}
+
+	return
+}
+
+// selectedNotFocusedBGColor returns the background color of selected rows,
+// while the TableView is not focused.
+func (tv *TableView) selectedNotFocusedBGColor() Color {
+	if tv.selectionColors != nil {
+		return blendColor(tv.themeNormalBGColor, tv.selectionColors.Background, 160)
+	}
+
+	return tv.themeSelectedNotFocusedBGColor
+}
+
+// updateHoverRow updates the row under the mouse cursor and redraws the rows
+// that gain or lose the hover highlight.
+func (tv *TableView) updateHoverRow() {
+	if !tv.hoverHighlight {
+		return
+	}
+
+	row := -1
+
+	var pt win.POINT
+	win.GetCursorPos(&pt)
+
+	hwnd := win.WindowFromPoint(pt)
+	if hwnd == tv.hwndFrozenLV || hwnd == tv.hwndNormalLV {
+		win.ScreenToClient(hwnd, &pt)
+
+		hti := win.LVHITTESTINFO{Pt: pt}
+		win.SendMessage(hwnd, win.LVM_HITTEST, 0, uintptr(unsafe.Pointer(&hti)))
+
+		if hti.Flags&win.LVHT_ONITEM != 0 {
+			row = int(hti.IItem)
+		}
+	} else {
+		hwnd = 0
+	}
+
+	if hwnd != tv.hoverLV {
+		tv.hoverLV = hwnd
+
+		if hwnd != 0 {
+			// The mouse may leave the list view while above a row.
+			var tme win.TRACKMOUSEEVENT
+			tme.CbSize = uint32(unsafe.Sizeof(tme))
+			tme.DwFlags = win.TME_LEAVE
+			tme.HwndTrack = hwnd
+
+			win.TrackMouseEvent(&tme)
+		}
+	}
+
+	if row == tv.hoverRow {
+		return
+	}
+
+	prevRow := tv.hoverRow
+	tv.hoverRow = row
+
+	tv.redrawRow(prevRow)
+	tv.redrawRow(row)
+}
+
+// updateCellToolTip sets the tool tip text of the list view hwnd for the cell
+// at the mouse position lp of a WM_MOUSEMOVE, see cellToolTipText.
+func (tv *TableView) updateCellToolTip(hwnd, hwndOther win.HWND, wp, lp uintptr) {
+	tt := tv.group.toolTip
+	if tt == nil {
+		return
+	}
+
+	var text string
+
+	hti := win.LVHITTESTINFO{Pt: win.POINT{X: win.GET_X_LPARAM(lp), Y: win.GET_Y_LPARAM(lp)}}
+	win.SendMessage(hwnd, win.LVM_SUBITEMHITTEST, 0, uintptr(unsafe.Pointer(&hti)))
+
+	if hti.IItem > -1 && hti.Flags&win.LVHT_ONITEM != 0 {
+		if col := tv.fromLVColIdx(hwnd == tv.hwndFrozenLV, hti.ISubItem); col > -1 {
+			text = tv.cellToolTipText(hwnd, int(hti.IItem), col, hti.ISubItem)
+		}
+	}
+
+	// The mouse messages are forwarded to the other list view, which must not
+	// show the tool tip of a cell it had before.
+	if tt.text(hwndOther) != "" {
+		tt.setText(hwndOther, "")
+	}
+
+	if tt.text(hwnd) == text {
+		return
+	}
+
+	tt.setText(hwnd, text)
+
+	m := win.MSG{
+		HWnd:    hwnd,
+		Message: win.WM_MOUSEMOVE,
+		WParam:  wp,
+		LParam:  lp,
+		Pt:      hti.Pt,
+	}
+
+	tt.SendMessage(win.TTM_RELAYEVENT, 0, uintptr(unsafe.Pointer(&m)))
+}
+
+// cellToolTipText returns the tool tip text of the cell at row and col, which
+// is the column lvCol of the list view hwnd. It is the text of the
+// CellToolTipProvider or, if that is empty and ClippedCellToolTips is true, the
+// text of the cell, if it does not fit in the cell.
+func (tv *TableView) cellToolTipText(hwnd win.HWND, row, col int, lvCol int32) string {
+	if tv.cellToolTipProvider != nil {
+		if text := tv.cellToolTipProvider.CellToolTip(row, col); text != "" {
+			return text
+		}
+	}
+
+	if !tv.clippedCellToolTips {
+		return ""
+	}
+
+	text := tv.cellText(row, col)
+	if text == "" {
+		return ""
+	}
+
+	rc := win.RECT{Top: lvCol, Left: win.LVIR_LABEL}
+	if win.SendMessage(hwnd, win.LVM_GETSUBITEMRECT, uintptr(row), uintptr(unsafe.Pointer(&rc))) == 0 {
+		return ""
+	}
+
+	dpi := tv.DPI()
+	padding := IntFrom96DPI(tableViewCellPadding96dpi, dpi)
+
+	bounds := rectangleFromRECT(rc)
+	width := bounds.Width - 4*padding
+	if width <= 0 {
+		return text
+	}
+
+	font := tv.itemFont
+	if font == nil {
+		font = tv.Font()
+	}
+
+	if tv.columns.items[col].textFormat()&TextWordbreak != 0 {
+		// The text is wrapped, so it is clipped if it is too tall.
+		if calculateTextSize(text, font, dpi, width, hwnd).Height > bounds.Height-2*padding {
+			return text
+		}
+
+		return ""
+	}
+
+	size := calculateTextSize(text, font, dpi, 0, hwnd)
+	if size.Width > width || strings.ContainsAny(text, "\r\n") && size.Height > bounds.Height-2*padding {
+		return text
+	}
+
+	return ""
+}
+
+func (tv *TableView) redrawRow(row int) {
+	if row < 0 {
+		return
+	}
+
+	win.SendMessage(tv.hwndFrozenLV, win.LVM_REDRAWITEMS, uintptr(row), uintptr(row))
+	win.SendMessage(tv.hwndNormalLV, win.LVM_REDRAWITEMS, uintptr(row), uintptr(row))
+}
+
+// drawGridlines draws the visible grid lines of the row nmlvcd is about, after
+// the list view hwnd has drawn it.
+func (tv *TableView) drawGridlines(hwnd win.HWND, nmlvcd *win.NMLVCUSTOMDRAW) {
+	brush, err := NewSolidColorBrush(tv.GridlineColor())
+	if err != nil {
+		return
+	}
+	defer brush.Dispose()
+
+	canvas, err := newCanvasFromHDC(nmlvcd.Nmcd.Hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
+
+	bounds := rectangleFromRECT(nmlvcd.Nmcd.Rc)
+
+	if tv.visibleGridlines&GridlinesHorizontal != 0 {
+		canvas.FillRectanglePixels(brush, Rectangle{bounds.X, bounds.Y + bounds.Height - 1, bounds.Width, 1})
+	}
+
+	if tv.visibleGridlines&GridlinesVertical != 0 {
+		hwndHdr := win.HWND(win.SendMessage(hwnd, win.LVM_GETHEADER, 0, 0))
+		count := int32(win.SendMessage(hwndHdr, win.HDM_GETITEMCOUNT, 0, 0))
+
+		for i := int32(0); i < count; i++ {
+			// The bounds of the first subitem are those of the whole row, its
+			// label ends where the column ends.
+			rc := win.RECT{Top: i, Left: win.LVIR_BOUNDS}
+			if i == 0 {
+				rc.Left = win.LVIR_LABEL
+			}
+			if win.SendMessage(hwnd, win.LVM_GETSUBITEMRECT, nmlvcd.Nmcd.DwItemSpec, uintptr(unsafe.Pointer(&rc))) == 0 {
+				continue
+			}
+
+			canvas.FillRectanglePixels(brush, Rectangle{int(rc.Right) - 1, bounds.Y, 1, bounds.Height})
+		}
 	}
-	win.SendMessage(tv.hwndFrozenLV, win.LVM_SETEXTENDEDLISTVIEWSTYLE, 0, exStyle)
-	win.SendMessage(tv.hwndNormalLV, win.LVM_SETEXTENDEDLISTVIEWSTYLE, 0, exStyle)
 }
 
 // Columns returns the list of columns.
@@ -696,6 +1469,12 @@ func (tv *TableView) UpdateItem(index int) error {
 }
 
 func (tv *TableView) attachModel() {
+	if validateModels {
+		// Attached first, so violations are reported before the handlers
+		// below trip over them.
+		tv.modelValidator = NewTableModelValidator(tv.model)
+	}
+
 	restoreCurrentItemOrFallbackToFirst := func(ip IDProvider) {
 		if tv.itemStateChangedEventDelay == 0 {
 			defer tv.currentItemChangedPublisher.Publish()
@@ -724,6 +1503,8 @@ func (tv *TableView) attachModel() {
 	tv.rowsResetHandlerHandle = tv.model.RowsReset().Attach(func() {
 		tv.setItemCount()
 
+		tv.applyColumnAutoSize()
+
 		if ip, ok := tv.providedModel.(IDProvider); ok && tv.restoringCurrentItemOnReset {
 			if _, ok := tv.model.(Sorter); !ok {
 				restoreCurrentItemOrFallbackToFirst(ip)
@@ -736,10 +1517,14 @@ func (tv *TableView) attachModel() {
 	})
 
 	tv.rowChangedHandlerHandle = tv.model.RowChanged().Attach(func(row int) {
+		tv.invalidateRowHeight()
+
 		tv.UpdateItem(row)
 	})
 
 	tv.rowsChangedHandlerHandle = tv.model.RowsChanged().Attach(func(from, to int) {
+		tv.invalidateRowHeight()
+
 		if s, ok := tv.model.(Sorter); ok {
 			s.Sort(s.SortedColumn(), s.SortOrder())
 		} else {
@@ -798,6 +1583,11 @@ func (tv *TableView) attachModel() {
 }
 
 func (tv *TableView) detachModel() {
+	if tv.modelValidator != nil {
+		tv.modelValidator.Dispose()
+		tv.modelValidator = nil
+	}
+
 	tv.model.RowsReset().Detach(tv.rowsResetHandlerHandle)
 	tv.model.RowChanged().Detach(tv.rowChangedHandlerHandle)
 	tv.model.RowsInserted().Detach(tv.rowsInsertedHandlerHandle)
@@ -853,11 +1643,19 @@ func (tv *TableView) SetModel(mdl interface{}) error {
 		tv.styler = styler
 	}
 
+	oldProvidedModelToolTipProvider, _ := tv.providedModel.(CellToolTipProvider)
+	if provider, ok := mdl.(CellToolTipProvider); ok || tv.cellToolTipProvider == oldProvidedModelToolTipProvider {
+		tv.cellToolTipProvider = provider
+	}
+
 	tv.providedModel = mdl
 	tv.model = model
 
 	tv.itemChecker, _ = model.(ItemChecker)
+	tv.cellValueSetter, _ = mdl.(CellValueSetter)
+	tv.rowAppender, _ = mdl.(RowAppender)
 	tv.imageProvider, _ = model.(ImageProvider)
+	tv.rowHeighter, _ = model.(RowHeighter)
 
 	if model != nil {
 		tv.attachModel()
@@ -874,59 +1672,298 @@ func (tv *TableView) SetModel(mdl interface{}) error {
 			dms.setDataMembers(dataMembers)
 		}
 
+		lessFuncs := make([]func(i, j int) bool, tv.columns.Len())
+		for i, c := range tv.columns.items {
+			lessFuncs[i] = c.lessFunc
+		}
+
 		if lfs, ok := model.(lessFuncsSetter); ok {
-			lessFuncs := make([]func(i, j int) bool, tv.columns.Len())
-			for i, c := range tv.columns.items {
-				lessFuncs[i] = c.lessFunc
-			}
 			lfs.setLessFuncs(lessFuncs)
 		}
 
+		if tv.modelValidator != nil {
+			tv.modelValidator.setLessFuncs(lessFuncs)
+			tv.modelValidator.SetColumnCount(tv.columns.Len())
+		}
+
 		if sorter, ok := tv.model.(Sorter); ok {
 			if tv.sortedColumnIndex >= tv.visibleColumnCount() {
 				tv.sortedColumnIndex = maxi(-1, mini(0, tv.visibleColumnCount()-1))
 				tv.sortOrder = SortAscending
 			}
 
-			sorter.Sort(tv.sortedColumnIndex, tv.sortOrder)
-		}
+			sorter.Sort(tv.sortedColumnIndex, tv.sortOrder)
+		}
+	}
+
+	tv.SetCurrentIndex(-1)
+
+	tv.setItemCount()
+
+	tv.applyColumnAutoSize()
+
+	tv.itemCountChangedPublisher.Publish()
+
+	return nil
+}
+
+// TableModel returns the TableModel of the TableView.
+func (tv *TableView) TableModel() TableModel {
+	return tv.model
+}
+
+// ItemChecker returns the ItemChecker of the TableView.
+func (tv *TableView) ItemChecker() ItemChecker {
+	return tv.itemChecker
+}
+
+// SetItemChecker sets the ItemChecker of the TableView.
+func (tv *TableView) SetItemChecker(itemChecker ItemChecker) {
+	tv.itemChecker = itemChecker
+}
+
+// CellStyler returns the CellStyler of the TableView.
+func (tv *TableView) CellStyler() CellStyler {
+	return tv.styler
+}
+
+// SetCellStyler sets the CellStyler of the TableView.
+func (tv *TableView) SetCellStyler(styler CellStyler) {
+	tv.styler = styler
+}
+
+// CellToolTipProvider returns the CellToolTipProvider of the TableView.
+func (tv *TableView) CellToolTipProvider() CellToolTipProvider {
+	return tv.cellToolTipProvider
+}
+
+// SetCellToolTipProvider sets the CellToolTipProvider of the TableView. If the
+// model implements CellToolTipProvider, it is used by default.
+func (tv *TableView) SetCellToolTipProvider(provider CellToolTipProvider) {
+	tv.cellToolTipProvider = provider
+}
+
+// ClippedCellToolTips returns if the TableView shows the text of a cell, that
+// does not fit in it, as tool tip. This is the default.
+func (tv *TableView) ClippedCellToolTips() bool {
+	return tv.clippedCellToolTips
+}
+
+// SetClippedCellToolTips sets if the TableView shows the text of a cell, that
+// does not fit in it, as tool tip.
+func (tv *TableView) SetClippedCellToolTips(value bool) {
+	tv.clippedCellToolTips = value
+}
+
+// AutoRowHeight returns if the rows are made tall enough for the text of the
+// multi-line columns.
+func (tv *TableView) AutoRowHeight() bool {
+	return tv.autoRowHeight
+}
+
+// SetAutoRowHeight sets if the rows are made tall enough for the text of the
+// multi-line columns, see TableViewColumn.SetMultiline.
+//
+// The list view control the TableView is built on requires all rows to have
+// the same height, so the rows get the height of the tallest cell. To keep
+// this affordable for large models, only the first maxAutoRowHeightRows rows
+// are measured.
+func (tv *TableView) SetAutoRowHeight(autoRowHeight bool) {
+	if autoRowHeight != tv.autoRowHeight {
+		tv.autoRowHeight = autoRowHeight
+
+		tv.updateRowHeight()
+	}
+}
+
+// maxAutoRowHeightRows is the number of rows measured for AutoRowHeight and
+// asked for their height, if the model is a RowHeighter.
+const maxAutoRowHeightRows = 1000
+
+// invalidateRowHeight schedules updating the row height, if it depends on the
+// model or the columns. Multiple calls while handling a message result in a
+// single update.
+func (tv *TableView) invalidateRowHeight() {
+	if tv.rowHeightUpdatePending || (!tv.autoRowHeight && tv.rowHeighter == nil) {
+		return
+	}
+
+	tv.rowHeightUpdatePending = true
+
+	tv.Synchronize(func() {
+		tv.rowHeightUpdatePending = false
+
+		if !tv.IsDisposed() {
+			tv.updateRowHeight()
+		}
+	})
+}
+
+// updateRowHeight measures the height the rows need and applies it, if it
+// changed.
+func (tv *TableView) updateRowHeight() {
+	var height int
+	if tv.autoRowHeight || tv.rowHeighter != nil {
+		height = tv.measureRowHeight()
+	}
+
+	if height == tv.measuredRowHeight {
+		return
+	}
+
+	tv.measuredRowHeight = height
+
+	tv.applyRowHeight()
+}
+
+// rowHeightEffective returns the height in native pixels of the rows.
+func (tv *TableView) rowHeightEffective() int {
+	if height := maxi(tv.customRowHeight, tv.measuredRowHeight); height > 0 {
+		return height
+	}
+
+	return tv.measureRowHeight()
+}
+
+// applyRowHeight makes the list views ask for the height of their rows again.
+func (tv *TableView) applyRowHeight() {
+	for _, hwnd := range [...]win.HWND{tv.hwndFrozenLV, tv.hwndNormalLV} {
+		// The WM_MEASUREITEM handler clears the style of both list views, so
+		// one is done after the other.
+		if err := ensureWindowLongBits(hwnd, win.GWL_STYLE, win.LVS_OWNERDRAWFIXED, true); err != nil {
+			continue
+		}
+
+		// List views with this style only measure their rows, when they are
+		// told their position changed.
+		var rc win.RECT
+		win.GetWindowRect(hwnd, &rc)
+
+		wp := win.WINDOWPOS{
+			Hwnd:  hwnd,
+			Cx:    rc.Right - rc.Left,
+			Cy:    rc.Bottom - rc.Top,
+			Flags: win.SWP_NOACTIVATE | win.SWP_NOMOVE | win.SWP_NOOWNERZORDER | win.SWP_NOZORDER,
+		}
+		win.SendMessage(hwnd, win.WM_WINDOWPOSCHANGED, 0, uintptr(unsafe.Pointer(&wp)))
+	}
+
+	tv.Invalidate()
+}
+
+// measureRowHeight returns the height in native pixels, that the rows need
+// for a single line of text, the RowHeighter of the model and, with
+// AutoRowHeight, the text of the multi-line columns.
+func (tv *TableView) measureRowHeight() int {
+	dpi := tv.DPI()
+
+	hdc := win.GetDC(tv.hWnd)
+	defer win.ReleaseDC(tv.hWnd, hdc)
+
+	defer win.SelectObject(hdc, win.SelectObject(hdc, win.HGDIOBJ(tv.Font().handleForDPI(dpi))))
+
+	padding := IntFrom96DPI(tableViewCellPadding96dpi, dpi)
+
+	measure := func(text string, width int, format DrawTextFormat) int {
+		rc := win.RECT{Right: int32(width)}
+		win.DrawTextEx(hdc, stringToUTF16Ptr(text), -1, &rc, uint32(format|TextCalcRect|TextEditControl), nil)
+
+		return int(rc.Bottom - rc.Top)
+	}
+
+	height := measure("gM", 0, TextSingleLine) + 2*padding
+	height = maxi(height, int(win.GetSystemMetricsForDpi(win.SM_CYSMICON, uint32(dpi)))+padding)
+
+	if tv.model == nil {
+		return height
+	}
+
+	count := tv.model.RowCount()
+
+	if tv.rowHeighter != nil {
+		for row := 0; row < count && row < maxAutoRowHeightRows; row++ {
+			height = maxi(height, IntFrom96DPI(tv.rowHeighter.RowHeight(row), dpi))
+		}
+	}
+
+	if tv.autoRowHeight {
+		for col, tvc := range tv.columns.items {
+			if !tvc.visible || !tvc.multiline {
+				continue
+			}
+
+			width := IntFrom96DPI(tvc.Width(), dpi) - 2*padding
+
+			for row := 0; row < count && row < maxAutoRowHeightRows; row++ {
+				height = maxi(height, measure(tv.cellText(row, col), width, TextWordbreak|TextNoPrefix)+2*padding)
+			}
+		}
+	}
+
+	return height
+}
+
+// tableViewCellPadding96dpi is the distance in 1/96" units, that the
+// TableView keeps between the text it draws itself and the cell edges.
+const tableViewCellPadding96dpi = 3
+
+// drawCellText draws the text of a cell of a column, whose text the list view
+// does not draw, see TableViewColumn.drawsText.
+func (tv *TableView) drawCellText(hwnd win.HWND, nmlvcd *win.NMLVCUSTOMDRAW, row, col int) {
+	rc := win.RECT{Top: nmlvcd.ISubItem, Left: win.LVIR_LABEL}
+	if win.SendMessage(hwnd, win.LVM_GETSUBITEMRECT, nmlvcd.Nmcd.DwItemSpec, uintptr(unsafe.Pointer(&rc))) == 0 {
+		return
 	}
 
-	tv.SetCurrentIndex(-1)
+	dpi := tv.DPI()
+	padding := IntFrom96DPI(tableViewCellPadding96dpi, dpi)
+	tvc := tv.columns.items[col]
 
-	tv.setItemCount()
+	bounds := rectangleFromRECT(rc)
+	bounds.X += 2 * padding
+	bounds.Width -= 4 * padding
+	bounds.Y += padding
+	bounds.Height -= 2 * padding
 
-	tv.itemCountChangedPublisher.Publish()
+	font := tv.itemFont
+	if tv.styler != nil && tv.style.Font != nil {
+		font = tv.style.Font
+	}
+	if font == nil {
+		font = tv.Font()
+	}
 
-	return nil
-}
+	canvas, err := newCanvasFromHDC(nmlvcd.Nmcd.Hdc)
+	if err != nil {
+		return
+	}
+	defer canvas.Dispose()
 
-// TableModel returns the TableModel of the TableView.
-func (tv *TableView) TableModel() TableModel {
-	return tv.model
-}
+	text := tv.cellText(row, col)
+	format := tvc.textFormat()
 
-// ItemChecker returns the ItemChecker of the TableView.
-func (tv *TableView) ItemChecker() ItemChecker {
-	return tv.itemChecker
-}
+	if tvc.verticalAlignment != AlignNear {
+		rc := win.RECT{Right: int32(bounds.Width)}
 
-// SetItemChecker sets the ItemChecker of the TableView.
-func (tv *TableView) SetItemChecker(itemChecker ItemChecker) {
-	tv.itemChecker = itemChecker
-}
+		win.SelectObject(nmlvcd.Nmcd.Hdc, win.HGDIOBJ(font.handleForDPI(dpi)))
+		win.DrawTextEx(nmlvcd.Nmcd.Hdc, stringToUTF16Ptr(text), -1, &rc, uint32(format|TextCalcRect|TextEditControl), nil)
 
-// CellStyler returns the CellStyler of the TableView.
-func (tv *TableView) CellStyler() CellStyler {
-	return tv.styler
-}
+		if h := int(rc.Bottom - rc.Top); h < bounds.Height {
+			if tvc.verticalAlignment == AlignFar {
+				bounds.Y += bounds.Height - h
+			} else {
+				bounds.Y += (bounds.Height - h) / 2
+			}
+			bounds.Height = h
+		}
+	}
 
-// SetCellStyler sets the CellStyler of the TableView.
-func (tv *TableView) SetCellStyler(styler CellStyler) {
-	tv.styler = styler
+	canvas.DrawTextPixels(text, font, Color(nmlvcd.ClrText), bounds, format)
 }
 
 func (tv *TableView) setItemCount() error {
+	tv.invalidateRowHeight()
+
 	var count int
 
 	if tv.model != nil {
@@ -1573,6 +2610,206 @@ func (tv *TableView) StretchLastColumn() error {
 	return nil
 }
 
+// maxAutoSizeRows is the maximum number of rows, whose texts are measured for
+// columns with AutoSizeFitContent or AutoSizeFitContentAndHeader.
+const maxAutoSizeRows = 1000
+
+// applyColumnAutoSize sizes the visible columns with an auto size mode, see
+// TableViewColumn.SetAutoSizeMode.
+func (tv *TableView) applyColumnAutoSize() {
+	if tv.hwndNormalLV == 0 {
+		return
+	}
+
+	var frozenChanged bool
+
+	for _, tvc := range tv.columns.items {
+		if !tvc.visible {
+			continue
+		}
+
+		switch tvc.autoSizeMode {
+		case AutoSizeFitHeader, AutoSizeFitContent, AutoSizeFitContentAndHeader:
+			if tv.setColumnWidthPixels(tvc, tv.fitColumnWidthPixels(tvc)) && tvc.frozen {
+				frozenChanged = true
+			}
+		}
+	}
+
+	if frozenChanged {
+		tv.updateLVSizes()
+	}
+
+	tv.applyFillColumnWidths()
+}
+
+// hasFillColumns returns if a visible column has AutoSizeFill.
+func (tv *TableView) hasFillColumns() bool {
+	for _, tvc := range tv.columns.items {
+		if tvc.visible && tvc.autoSizeMode == AutoSizeFill {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyFillColumnWidths distributes the width, that the other columns leave,
+// among the visible columns with AutoSizeFill by their fill weights.
+func (tv *TableView) applyFillColumnWidths() {
+	if tv.hwndNormalLV == 0 {
+		return
+	}
+
+	var fillCols []*TableViewColumn
+	var totalWeight int
+
+	width := tv.ClientBoundsPixels().Width
+	if hasWindowLongBits(tv.hwndNormalLV, win.GWL_STYLE, win.WS_VSCROLL) {
+		width -= int(win.GetSystemMetricsForDpi(win.SM_CXVSCROLL, uint32(tv.DPI())))
+	}
+
+	for _, tvc := range tv.columns.items {
+		if !tvc.visible {
+			continue
+		}
+
+		if tvc.autoSizeMode == AutoSizeFill {
+			fillCols = append(fillCols, tvc)
+			totalWeight += tvc.fillWeight
+		} else {
+			width -= tv.columnWidthPixels(tvc)
+		}
+	}
+
+	if len(fillCols) == 0 {
+		return
+	}
+
+	minWidth := tv.IntFrom96DPI(20)
+	var frozenChanged bool
+
+	// Rounding remainders go to the following columns, so the widths add up.
+	for _, tvc := range fillCols {
+		w := maxi(0, width) * tvc.fillWeight / totalWeight
+		width -= w
+		totalWeight -= tvc.fillWeight
+
+		if tv.setColumnWidthPixels(tvc, maxi(minWidth, w)) && tvc.frozen {
+			frozenChanged = true
+		}
+	}
+
+	if frozenChanged {
+		tv.updateLVSizes()
+	}
+}
+
+// fitColumnWidthPixels returns the width of tvc, that fits its title and/or
+// content according to its auto size mode.
+func (tv *TableView) fitColumnWidthPixels(tvc *TableViewColumn) int {
+	dpi := tv.DPI()
+	padding := IntFrom96DPI(tableViewCellPadding96dpi, dpi)
+
+	var width int
+
+	if tvc.autoSizeMode != AutoSizeFitContent {
+		// Leave room for the sort arrow.
+		width = tv.maxTextWidthPixels(tv.Font(), []string{tvc.TitleEffective()}) + 4*padding + IntFrom96DPI(16, dpi)
+	}
+
+	if tvc.autoSizeMode != AutoSizeFitHeader && tv.model != nil {
+		col := tv.columns.Index(tvc)
+
+		texts := make([]string, mini(tv.model.RowCount(), maxAutoSizeRows))
+		for row := range texts {
+			texts[row] = tv.cellText(row, col)
+		}
+
+		font := tv.itemFont
+		if font == nil {
+			font = tv.Font()
+		}
+
+		contentWidth := tv.maxTextWidthPixels(font, texts) + 4*padding
+
+		if tvc.indexInListView() == 0 {
+			if tv.imageProvider != nil {
+				contentWidth += IntFrom96DPI(20, dpi)
+			}
+			if tv.CheckBoxes() {
+				contentWidth += IntFrom96DPI(20, dpi)
+			}
+		}
+
+		width = maxi(width, contentWidth)
+	}
+
+	return maxi(width, IntFrom96DPI(20, dpi))
+}
+
+// maxTextWidthPixels returns the width of the widest line of texts in font.
+func (tv *TableView) maxTextWidthPixels(font *Font, texts []string) int {
+	hdc := win.GetDC(tv.hwndNormalLV)
+	if hdc == 0 {
+		newError("GetDC failed")
+		return 0
+	}
+	defer win.ReleaseDC(tv.hwndNormalLV, hdc)
+
+	hFontOld := win.SelectObject(hdc, win.HGDIOBJ(font.handleForDPI(tv.DPI())))
+	defer win.SelectObject(hdc, hFontOld)
+
+	var width int
+
+	for _, text := range texts {
+		for _, line := range strings.Split(text, "\n") {
+			line = strings.TrimRight(line, "\r ")
+			if line == "" {
+				continue
+			}
+
+			str := stringToUTF16(line)
+
+			var s win.SIZE
+			if win.GetTextExtentPoint32(hdc, &str[0], int32(len(str)-1), &s) {
+				width = maxi(width, int(s.CX))
+			}
+		}
+	}
+
+	return width
+}
+
+// columnWidthPixels returns the width of the visible column tvc.
+func (tv *TableView) columnWidthPixels(tvc *TableViewColumn) int {
+	hwnd := tv.hwndNormalLV
+	if tvc.frozen {
+		hwnd = tv.hwndFrozenLV
+	}
+
+	return int(win.SendMessage(hwnd, win.LVM_GETCOLUMNWIDTH, uintptr(tvc.indexInListView()), 0))
+}
+
+// setColumnWidthPixels sets the width of the visible column tvc and returns if
+// it changed.
+func (tv *TableView) setColumnWidthPixels(tvc *TableViewColumn, width int) bool {
+	if width == tv.columnWidthPixels(tvc) {
+		return false
+	}
+
+	hwnd := tv.hwndNormalLV
+	if tvc.frozen {
+		hwnd = tv.hwndFrozenLV
+	}
+
+	win.SendMessage(hwnd, win.LVM_SETCOLUMNWIDTH, uintptr(tvc.indexInListView()), uintptr(width))
+
+	tvc.width = tv.IntTo96DPI(width)
+
+	return true
+}
+
 // Persistent returns if the *TableView should persist its UI state, like column
 // widths. See *App.Settings for details.
 func (tv *TableView) Persistent() bool {
@@ -1625,8 +2862,21 @@ func (tv *TableView) SaveState() error {
 		tv.state = new(tableViewState)
 	}
 
-	tvs := tv.state
+	if err := tv.captureState(tv.state); err != nil {
+		return err
+	}
+
+	state, err := json.Marshal(tv.state)
+	if err != nil {
+		return err
+	}
+
+	return tv.WriteState(string(state))
+}
 
+// captureState stores the current columns and sorting of the *TableView in
+// tvs.
+func (tv *TableView) captureState(tvs *tableViewState) error {
 	tvs.SortColumnName = tv.columns.items[tv.sortedColumnIndex].name
 	tvs.SortOrder = tv.sortOrder
 
@@ -1684,12 +2934,7 @@ func (tv *TableView) SaveState() error {
 		tvs.ColumnDisplayOrder[i] = visibleCols[j].name
 	}
 
-	state, err := json.Marshal(tvs)
-	if err != nil {
-		return err
-	}
-
-	return tv.WriteState(string(state))
+	return nil
 }
 
 // RestoreState restores the UI state of the *TableView from the settings.
@@ -1715,6 +2960,13 @@ func (tv *TableView) RestoreState() error {
 		return err
 	}
 
+	return tv.applyState(tvs)
+}
+
+// applyState applies the columns and sorting stored in tvs to the *TableView.
+// Columns of tvs that are unknown to the *TableView and have not been seen for
+// a while are dropped from tvs.
+func (tv *TableView) applyState(tvs *tableViewState) error {
 	name2tvc := make(map[string]*TableViewColumn)
 
 	for _, tvc := range tv.columns.items {
@@ -1851,8 +3103,53 @@ func (tv *TableView) toggleItemChecked(index int) error {
 	return nil
 }
 
+// ImageList returns the ManagedImageList the images of the cells are drawn
+// from, or nil if the TableView manages its images itself.
+func (tv *TableView) ImageList() *ManagedImageList {
+	return tv.imageList
+}
+
+// SetImageList sets a ManagedImageList, that the images of the cells are added
+// to and drawn from, so they are rendered for the DPI of the TableView and can
+// be shared with other views. If l is nil, the TableView manages its images
+// itself.
+func (tv *TableView) SetImageList(l *ManagedImageList) error {
+	if l == tv.imageList {
+		return nil
+	}
+
+	tv.disposeImageListAndCaches()
+
+	if tv.imageList != nil {
+		tv.imageList.Changed().Detach(tv.imageListChangedHandle)
+		tv.replaceResource(tv.imageList, nil)
+	}
+
+	tv.imageList = l
+
+	if l != nil {
+		tv.replaceResource(nil, l)
+		tv.imageListChangedHandle = l.Changed().AttachScoped(tv, func() {
+			tv.Invalidate()
+		})
+
+		tv.applyImageListForImage(nil)
+	}
+
+	return tv.Invalidate()
+}
+
 func (tv *TableView) applyImageListForImage(image interface{}) {
-	tv.hIml, tv.usingSysIml, _ = imageListForImage(image, tv.DPI())
+	if tv.imageList != nil {
+		hIml, err := tv.imageList.acquire(tv.DPI())
+		if err != nil {
+			wrapError(err)
+			return
+		}
+		tv.hIml, tv.usingSysIml, tv.imageListDPI = hIml, false, tv.DPI()
+	} else {
+		tv.hIml, tv.usingSysIml, _ = imageListForImage(image, tv.DPI())
+	}
 
 	tv.applyImageList()
 
@@ -1870,7 +3167,11 @@ func (tv *TableView) disposeImageListAndCaches() {
 		win.SendMessage(tv.hwndFrozenLV, win.LVM_SETIMAGELIST, win.LVSIL_SMALL, 0)
 		win.SendMessage(tv.hwndNormalLV, win.LVM_SETIMAGELIST, win.LVSIL_SMALL, 0)
 
-		win.ImageList_Destroy(tv.hIml)
+		if tv.imageList != nil {
+			tv.imageList.release(tv.imageListDPI)
+		} else {
+			win.ImageList_Destroy(tv.hIml)
+		}
 	}
 	tv.hIml = 0
 
@@ -1947,6 +3248,56 @@ func tableViewNormalLVWndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr
 	return result
 }
 
+// cellText returns the text, that the cell at row and col displays.
+func (tv *TableView) cellText(row, col int) string {
+	value := tv.model.Value(row, col)
+	var text string
+	if format := tv.columns.items[col].formatFunc; format != nil {
+		text = format(value)
+	} else {
+		switch val := value.(type) {
+		case string:
+			text = val
+
+		case float32:
+			prec := tv.columns.items[col].precision
+			if prec == 0 {
+				prec = 2
+			}
+			text = FormatFloatGrouped(float64(val), prec)
+
+		case float64:
+			prec := tv.columns.items[col].precision
+			if prec == 0 {
+				prec = 2
+			}
+			text = FormatFloatGrouped(val, prec)
+
+		case time.Time:
+			if val.Year() > 1601 {
+				text = val.Format(tv.columns.items[col].format)
+			}
+
+		case bool:
+			if val {
+				text = checkmark
+			}
+
+		case *big.Rat:
+			prec := tv.columns.items[col].precision
+			if prec == 0 {
+				prec = 2
+			}
+			text = formatBigRatGrouped(val, prec)
+
+		default:
+			text = fmt.Sprintf(tv.columns.items[col].format, val)
+		}
+	}
+
+	return text
+}
+
 func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr {
 	var hwndOther win.HWND
 	if hwnd == tv.hwndFrozenLV {
@@ -2031,6 +3382,12 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 			tv.inMouseEvent = false
 		}()
 
+		if msg == win.WM_MOUSEMOVE {
+			tv.updateCellToolTip(hwnd, hwndOther, wp, lp)
+		}
+
+		tv.updateHoverRow()
+
 		if msg == win.WM_MOUSEMOVE {
 			y := int(win.GET_Y_LPARAM(lp))
 			lp = uintptr(win.MAKELONG(0, uint16(y)))
@@ -2039,6 +3396,24 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 		win.SendMessage(hwndOther, msg, wp, lp)
 
 	case win.WM_KEYDOWN:
+		if tv.handleSearchKey(Key(wp)) {
+			return 0
+		}
+
+		if Key(wp) == KeyC && ControlDown() && tv.copyFormats != 0 {
+			if err := tv.CopySelection(tv.copyFormats); err != nil {
+				wrapError(err)
+			}
+			return 0
+		}
+
+		if Key(wp) == KeyV && ControlDown() && tv.cellValueSetter != nil {
+			if _, err := tv.Paste(); err != nil {
+				wrapError(err)
+			}
+			return 0
+		}
+
 		if wp == win.VK_SPACE &&
 			tv.currentIndex > -1 &&
 			tv.itemChecker != nil &&
@@ -2052,6 +3427,15 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 	case win.WM_KEYUP:
 		tv.handleKeyUp(wp, lp)
 
+	case win.WM_CHAR:
+		if tv.searchMode != SearchDisabled && wp >= ' ' {
+			if text, next := tv.typeAhead.add(rune(wp)); text != "" {
+				tv.findRow(text, next, false)
+			}
+
+			return 0
+		}
+
 	case win.WM_NOTIFY:
 		nmh := ((*win.NMHDR)(unsafe.Pointer(lp)))
 		switch nmh.HwndFrom {
@@ -2072,56 +3456,12 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 			}
 
 			if di.Item.Mask&win.LVIF_TEXT > 0 {
-				value := tv.model.Value(row, col)
 				var text string
-				if format := tv.columns.items[col].formatFunc; format != nil {
-					text = format(value)
-				} else {
-					switch val := value.(type) {
-					case string:
-						text = val
-
-					case float32:
-						prec := tv.columns.items[col].precision
-						if prec == 0 {
-							prec = 2
-						}
-						text = FormatFloatGrouped(float64(val), prec)
-
-					case float64:
-						prec := tv.columns.items[col].precision
-						if prec == 0 {
-							prec = 2
-						}
-						text = FormatFloatGrouped(val, prec)
-
-					case time.Time:
-						if val.Year() > 1601 {
-							text = val.Format(tv.columns.items[col].format)
-						}
-
-					case bool:
-						if val {
-							text = checkmark
-						}
-
-					case *big.Rat:
-						prec := tv.columns.items[col].precision
-						if prec == 0 {
-							prec = 2
-						}
-						text = formatBigRatGrouped(val, prec)
-
-					default:
-						text = fmt.Sprintf(tv.columns.items[col].format, val)
-					}
+				if tv.lvPainting != hwnd || !tv.columns.items[col].drawsText() {
+					text = tv.cellText(row, col)
 				}
 
-				utf16 := syscall.StringToUTF16(text)
-				buf := (*[264]uint16)(unsafe.Pointer(di.Item.PszText))
-				max := mini(len(utf16), int(di.Item.CchTextMax))
-				copy((*buf)[:], utf16[:max])
-				(*buf)[max-1] = 0
+				copyStringToUTF16Buffer((*[1 << 20]uint16)(unsafe.Pointer(di.Item.PszText))[:di.Item.CchTextMax:di.Item.CchTextMax], text)
 			}
 
 			if (tv.imageProvider != nil || tv.styler != nil) && di.Item.Mask&win.LVIF_IMAGE > 0 {
@@ -2148,13 +3488,17 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 						tv.applyImageListForImage(image)
 					}
 
-					di.Item.IImage = imageIndexMaybeAdd(
-						image,
-						tv.hIml,
-						tv.usingSysIml,
-						tv.imageUintptr2Index,
-						tv.filePath2IconIndex,
-						tv.DPI())
+					if tv.imageList != nil {
+						di.Item.IImage = tv.imageList.indexMaybeAdd(image)
+					} else {
+						di.Item.IImage = imageIndexMaybeAdd(
+							image,
+							tv.hIml,
+							tv.usingSysIml,
+							tv.imageUintptr2Index,
+							tv.filePath2IconIndex,
+							tv.DPI())
+					}
 				}
 			}
 
@@ -2223,23 +3567,28 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 
 				switch nmlvcd.Nmcd.DwDrawStage {
 				case win.CDDS_PREPAINT:
-					return win.CDRF_NOTIFYITEMDRAW
+					tv.lvPainting = hwnd
+
+					return win.CDRF_NOTIFYITEMDRAW | win.CDRF_NOTIFYPOSTPAINT
+
+				case win.CDDS_POSTPAINT:
+					tv.lvPainting = 0
+
+					return win.CDRF_DODEFAULT
 
 				case win.CDDS_ITEMPREPAINT:
-					var selected bool
-					if itemState := win.SendMessage(hwnd, win.LVM_GETITEMSTATE, nmlvcd.Nmcd.DwItemSpec, win.LVIS_SELECTED); itemState&win.LVIS_SELECTED != 0 {
-						selected = true
+					itemState := win.SendMessage(hwnd, win.LVM_GETITEMSTATE, nmlvcd.Nmcd.DwItemSpec, win.LVIS_SELECTED)
+					selected := itemState&win.LVIS_SELECTED != 0
 
-						tv.itemBGColor = tv.themeSelectedBGColor
-						tv.itemTextColor = tv.themeSelectedTextColor
-					} else {
-						tv.itemBGColor = tv.themeNormalBGColor
-						tv.itemTextColor = tv.themeNormalTextColor
-					}
+					tv.itemBGColor, tv.itemTextColor = tv.rowColors(row, selected)
 
-					if !selected && tv.alternatingRowBG && row%2 == 1 {
-						tv.itemBGColor = tv.alternatingRowBGColor
-						tv.itemTextColor = tv.alternatingRowTextColor
+					// Keep the theme from drawing its selection or hot
+					// tracking over ours.
+					if tv.paintsSelection() {
+						nmlvcd.Nmcd.UItemState &^= win.CDIS_SELECTED
+					}
+					if tv.hoverHighlight || tv.paintsSelection() {
+						nmlvcd.Nmcd.UItemState &^= win.CDIS_HOT
 					}
 
 					tv.style.BackgroundColor = tv.itemBGColor
@@ -2270,7 +3619,7 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 					if tv.style.BackgroundColor != tv.themeNormalBGColor {
 						var color Color
 						if selected && !tv.Focused() {
-							color = tv.themeSelectedNotFocusedBGColor
+							color = tv.selectedNotFocusedBGColor()
 						} else {
 							color = tv.style.BackgroundColor
 						}
@@ -2286,8 +3635,17 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 					nmlvcd.ClrText = win.COLORREF(tv.style.TextColor)
 					nmlvcd.ClrTextBk = win.COLORREF(tv.style.BackgroundColor)
 
+					if tv.visibleGridlines != GridlinesNone {
+						return win.CDRF_NOTIFYSUBITEMDRAW | win.CDRF_NOTIFYPOSTPAINT
+					}
+
 					return win.CDRF_NOTIFYSUBITEMDRAW
 
+				case win.CDDS_ITEMPOSTPAINT:
+					tv.drawGridlines(hwnd, nmlvcd)
+
+					return win.CDRF_DODEFAULT
+
 				case win.CDDS_ITEMPREPAINT | win.CDDS_SUBITEM:
 					if tv.itemFont != nil {
 						win.SelectObject(nmlvcd.Nmcd.Hdc, win.HGDIOBJ(tv.itemFont.handleForDPI(tv.DPI())))
@@ -2297,6 +3655,14 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 						return win.CDRF_SKIPDEFAULT
 					}
 
+					if text := tv.findBar.searchText(); text != "" {
+						if col == tv.searchColumn && searchMatches(tv.cellText(row, col), text, tv.searchMode) {
+							nmlvcd.ClrTextBk = win.COLORREF(findHighlightColor(tv.themeNormalBGColor))
+						} else if tv.styler == nil {
+							nmlvcd.ClrTextBk = win.COLORREF(tv.itemBGColor)
+						}
+					}
+
 					return win.CDRF_NEWFONT | win.CDRF_SKIPPOSTPAINT | win.CDRF_NOTIFYPOSTPAINT
 
 				case win.CDDS_ITEMPOSTPAINT | win.CDDS_SUBITEM:
@@ -2304,6 +3670,10 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 						return win.CDRF_SKIPDEFAULT
 					}
 
+					if tv.columns.items[col].drawsText() {
+						tv.drawCellText(hwnd, nmlvcd, row, col)
+					}
+
 					return win.CDRF_NEWFONT | win.CDRF_SKIPPOSTPAINT
 				}
 
@@ -2423,6 +3793,10 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 
 		case win.HDN_ITEMCHANGING:
 			tv.updateLVSizes()
+
+			if tv.autoRowHeight {
+				tv.invalidateRowHeight()
+			}
 		}
 
 	case win.WM_UPDATEUISTATE:
@@ -2468,7 +3842,13 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd win.HWND, msg uint32
 	}
 
 	if maybeStretchLastColumn {
-		if tv.lastColumnStretched && !tv.busyStretchingLastColumn {
+		if tv.hasFillColumns() && !tv.busyStretchingLastColumn {
+			tv.busyStretchingLastColumn = true
+			defer func() {
+				tv.busyStretchingLastColumn = false
+			}()
+			tv.applyFillColumnWidths()
+		} else if tv.lastColumnStretched && !tv.busyStretchingLastColumn {
 			if normalVisColCount := tv.visibleColumnCount() - tv.visibleFrozenColumnCount(); normalVisColCount == 0 || normalVisColCount > 0 == (hwnd == tv.hwndNormalLV) {
 				tv.busyStretchingLastColumn = true
 				defer func() {
@@ -2599,6 +3979,10 @@ func tableViewHdrWndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr {
 }
 
 func (tv *TableView) WndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr {
+	if result, ok := tv.findBar.handleParentMessage(msg, wp, lp); ok {
+		return result
+	}
+
 	switch msg {
 	case win.WM_NOTIFY:
 		nmh := (*win.NMHDR)(unsafe.Pointer(lp))
@@ -2629,6 +4013,10 @@ func (tv *TableView) WndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr
 
 		tv.updateLVSizes()
 
+		if tv.findBar.visible() {
+			tv.findBar.layout(tv.DPI())
+		}
+
 		// FIXME: The InvalidateRect and redrawItems calls below prevent
 		// painting glitches on resize. Though this seems to work reasonably
 		// well, in the long run we would like to find the root cause of this
@@ -2664,7 +4052,7 @@ func (tv *TableView) WndProc(hwnd win.HWND, msg uint32, wp, lp uintptr) uintptr
 
 	case win.WM_MEASUREITEM:
 		mis := (*win.MEASUREITEMSTRUCT)(unsafe.Pointer(lp))
-		mis.ItemHeight = uint32(tv.customRowHeight)
+		mis.ItemHeight = uint32(tv.rowHeightEffective())
 
 		ensureWindowLongBits(tv.hwndFrozenLV, win.GWL_STYLE, win.LVS_OWNERDRAWFIXED, false)
 		ensureWindowLongBits(tv.hwndNormalLV, win.GWL_STYLE, win.LVS_OWNERDRAWFIXED, false)
@@ -2745,3 +4133,23 @@ func (tv *TableView) SetScrollbarOrientation(orientation Orientation) {
 func (tv *TableView) ScrollbarOrientation() Orientation {
 	return tv.scrollbarOrientation
 }
+
+// ScrollBarStyle returns the style of the scroll bars of the TableView.
+func (tv *TableView) ScrollBarStyle() ScrollBarStyle {
+	if tv.customScrollBars == nil {
+		return ScrollBarStyleSystem
+	}
+
+	return tv.customScrollBars.Style()
+}
+
+// SetScrollBarStyle sets the style of the scroll bars of the TableView. Styles
+// other than ScrollBarStyleSystem replace the native scroll bars with
+// ScrollBars.
+func (tv *TableView) SetScrollBarStyle(style ScrollBarStyle) error {
+	return setScrollBarStyle(&tv.customScrollBars, tv, style)
+}
+
+func (tv *TableView) nativeScrollBarsHandle() win.HWND {
+	return tv.hwndNormalLV
+}
@@ -13,29 +13,82 @@ import (
 	"github.com/lxn/win"
 )
 
+// CellEllipsis specifies how the text of a cell is shortened, if it does not
+// fit into the cell.
+type CellEllipsis int
+
+const (
+	// CellEllipsisEnd replaces the end of the text with an ellipsis.
+	CellEllipsisEnd CellEllipsis = iota
+
+	// CellEllipsisWord replaces the end of the text with an ellipsis, after
+	// the last word that fits.
+	CellEllipsisWord
+
+	// CellEllipsisPath replaces the middle of the text with an ellipsis,
+	// keeping as much of the text after the last backslash as possible, which
+	// suits file paths.
+	CellEllipsisPath
+
+	// CellEllipsisNone clips the text.
+	CellEllipsisNone
+)
+
+// ColumnAutoSizeMode specifies how the width of a TableViewColumn is adjusted
+// automatically.
+type ColumnAutoSizeMode int
+
+const (
+	// AutoSizeNone keeps the width set with SetWidth or by the user.
+	AutoSizeNone ColumnAutoSizeMode = iota
+
+	// AutoSizeFitHeader makes the column as wide as its title.
+	AutoSizeFitHeader
+
+	// AutoSizeFitContent makes the column as wide as the widest text of its
+	// cells.
+	AutoSizeFitContent
+
+	// AutoSizeFitContentAndHeader makes the column as wide as its title or the
+	// widest text of its cells, whichever is wider.
+	AutoSizeFitContentAndHeader
+
+	// AutoSizeFill makes the column share the width, that the other columns
+	// leave, with the other AutoSizeFill columns in proportion to their fill
+	// weights.
+	AutoSizeFill
+)
+
 // TableViewColumn represents a column in a TableView.
 type TableViewColumn struct {
-	tv            *TableView
-	name          string
-	dataMember    string
-	alignment     Alignment1D
-	format        string
-	precision     int
-	title         string
-	titleOverride string
-	width         int
-	lessFunc      func(i, j int) bool
-	formatFunc    func(value interface{}) string
-	visible       bool
-	frozen        bool
+	tv                *TableView
+	name              string
+	dataMember        string
+	alignment         Alignment1D
+	format            string
+	precision         int
+	title             string
+	titleOverride     string
+	width             int
+	lessFunc          func(i, j int) bool
+	formatFunc        func(value interface{}) string
+	visible           bool
+	frozen            bool
+	multiline         bool
+	ellipsis          CellEllipsis
+	verticalAlignment Alignment1D
+	autoSizeMode      ColumnAutoSizeMode
+	fillWeight        int
 }
 
 // NewTableViewColumn returns a new TableViewColumn.
 func NewTableViewColumn() *TableViewColumn {
 	return &TableViewColumn{
-		format:  "%v",
-		visible: true,
-		width:   50,
+		format:            "%v",
+		visible:           true,
+		width:             50,
+		verticalAlignment: AlignCenter,
+		fillWeight:        1,
 	}
 }
 
@@ -66,6 +119,124 @@ func (tvc *TableViewColumn) SetAlignment(alignment Alignment1D) (err error) {
 	return tvc.update()
 }
 
+// VerticalAlignment returns the vertical alignment of the text in the cells of
+// the TableViewColumn.
+func (tvc *TableViewColumn) VerticalAlignment() Alignment1D {
+	return tvc.verticalAlignment
+}
+
+// SetVerticalAlignment sets the vertical alignment of the text in the cells of
+// the TableViewColumn, which matters for rows taller than the text. The
+// default is AlignCenter.
+func (tvc *TableViewColumn) SetVerticalAlignment(alignment Alignment1D) error {
+	if alignment == AlignDefault {
+		alignment = AlignCenter
+	}
+
+	if alignment == tvc.verticalAlignment {
+		return nil
+	}
+
+	tvc.verticalAlignment = alignment
+
+	if tvc.tv == nil {
+		return nil
+	}
+
+	return tvc.tv.Invalidate()
+}
+
+// Multiline returns if the text of the cells of the TableViewColumn wraps into
+// multiple lines.
+func (tvc *TableViewColumn) Multiline() bool {
+	return tvc.multiline
+}
+
+// SetMultiline sets if the text of the cells of the TableViewColumn wraps into
+// multiple lines at word boundaries and line breaks, as far as the row height
+// allows. See TableView.SetAutoRowHeight to make the rows tall enough.
+func (tvc *TableViewColumn) SetMultiline(multiline bool) error {
+	if multiline == tvc.multiline {
+		return nil
+	}
+
+	tvc.multiline = multiline
+
+	if tvc.tv == nil {
+		return nil
+	}
+
+	tvc.tv.invalidateRowHeight()
+
+	return tvc.tv.Invalidate()
+}
+
+// Ellipsis returns how the text of the cells of the TableViewColumn is
+// shortened, if it does not fit.
+func (tvc *TableViewColumn) Ellipsis() CellEllipsis {
+	return tvc.ellipsis
+}
+
+// SetEllipsis sets how the text of the cells of the TableViewColumn is
+// shortened, if it does not fit.
+func (tvc *TableViewColumn) SetEllipsis(ellipsis CellEllipsis) error {
+	if ellipsis < CellEllipsisEnd || ellipsis > CellEllipsisNone {
+		return newError("invalid ellipsis")
+	}
+
+	if ellipsis == tvc.ellipsis {
+		return nil
+	}
+
+	tvc.ellipsis = ellipsis
+
+	if tvc.tv == nil {
+		return nil
+	}
+
+	return tvc.tv.Invalidate()
+}
+
+// drawsText returns if the TableView draws the text of the cells of the
+// column itself, instead of the list view, which only draws single lines,
+// vertically centered, with an ellipsis at the end.
+func (tvc *TableViewColumn) drawsText() bool {
+	return tvc.multiline || tvc.ellipsis != CellEllipsisEnd || tvc.verticalAlignment != AlignCenter
+}
+
+// textFormat returns the format, that the TableView draws the text of the
+// cells of the column with.
+func (tvc *TableViewColumn) textFormat() DrawTextFormat {
+	format := TextNoPrefix
+
+	switch tvc.alignment {
+	case AlignCenter:
+		format |= TextCenter
+
+	case AlignFar:
+		format |= TextRight
+	}
+
+	switch tvc.ellipsis {
+	case CellEllipsisEnd:
+		format |= TextEndEllipsis
+
+	case CellEllipsisWord:
+		format |= TextWordEllipsis
+
+	case CellEllipsisPath:
+		format |= TextPathEllipsis
+	}
+
+	if tvc.multiline {
+		format |= TextWordbreak
+	} else {
+		format |= TextSingleLine
+	}
+
+	return format
+}
+
 // DataMember returns the data member this TableViewColumn is bound against.
 func (tvc *TableViewColumn) DataMember() string {
 	return tvc.dataMember
@@ -329,6 +500,65 @@ func (tvc *TableViewColumn) SetWidth(width int) (err error) {
 	return tvc.update()
 }
 
+// AutoSizeMode returns how the width of the TableViewColumn is adjusted
+// automatically.
+func (tvc *TableViewColumn) AutoSizeMode() ColumnAutoSizeMode {
+	return tvc.autoSizeMode
+}
+
+// SetAutoSizeMode sets how the width of the TableViewColumn is adjusted
+// automatically.
+//
+// The widths are recalculated when the model is set or reset and, for
+// AutoSizeFill, when the TableView is resized. AutoSizeFitContent and
+// AutoSizeFitContentAndHeader measure at most the first 1000 rows. Columns with
+// AutoSizeFill take precedence over TableView.SetLastColumnStretched and can
+// not be resized by the user.
+func (tvc *TableViewColumn) SetAutoSizeMode(mode ColumnAutoSizeMode) error {
+	if mode < AutoSizeNone || mode > AutoSizeFill {
+		return newError("invalid auto size mode")
+	}
+
+	if mode == tvc.autoSizeMode {
+		return nil
+	}
+
+	tvc.autoSizeMode = mode
+
+	if tvc.tv != nil {
+		tvc.tv.applyColumnAutoSize()
+	}
+
+	return nil
+}
+
+// FillWeight returns the weight, by which the TableViewColumn shares the width
+// with the other columns with AutoSizeFill. The default is 1.
+func (tvc *TableViewColumn) FillWeight() int {
+	return tvc.fillWeight
+}
+
+// SetFillWeight sets the weight, by which the TableViewColumn shares the width
+// with the other columns with AutoSizeFill. A column with weight 2 gets twice
+// the width of a column with weight 1.
+func (tvc *TableViewColumn) SetFillWeight(weight int) error {
+	if weight < 1 {
+		return newError("weight must be positive")
+	}
+
+	if weight == tvc.fillWeight {
+		return nil
+	}
+
+	tvc.fillWeight = weight
+
+	if tvc.tv != nil && tvc.autoSizeMode == AutoSizeFill {
+		tvc.tv.applyFillColumnWidths()
+	}
+
+	return nil
+}
+
 // LessFunc returns the less func of this TableViewColumn.
 //
 // This function is used to provide custom sorting for models based on ReflectTableModel only.
@@ -0,0 +1,243 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// tableViewPreset is a named tableViewState, as stored in the settings.
+type tableViewPreset struct {
+	Name  string
+	State *tableViewState
+}
+
+// ViewPresets returns the names of the view presets of the *TableView, sorted
+// alphabetically.
+//
+// A view preset stores the order, visibility, width and frozen state of the
+// columns and the sorting of a *TableView under a name, so users can switch
+// between different views of the same data. Presets are stored per user in
+// App().Settings(), so the *TableView and its ancestors need names, like for
+// persistence.
+func (tv *TableView) ViewPresets() []string {
+	presets, err := tv.readViewPresets()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, len(presets))
+	for i, preset := range presets {
+		names[i] = preset.Name
+	}
+
+	return names
+}
+
+// CurrentViewPreset returns the name of the view preset that was saved or
+// loaded last, or an empty string if there is none.
+func (tv *TableView) CurrentViewPreset() string {
+	return tv.currentViewPreset
+}
+
+// SaveViewPreset stores the current view of the *TableView as the preset with
+// the given name, replacing any preset with the same name.
+func (tv *TableView) SaveViewPreset(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return newError("name must not be empty")
+	}
+	if tv.columns.Len() == 0 {
+		return newError("TableView has no columns")
+	}
+
+	presets, err := tv.readViewPresets()
+	if err != nil {
+		return err
+	}
+
+	state := new(tableViewState)
+	if err := tv.captureState(state); err != nil {
+		return err
+	}
+
+	if i := viewPresetIndex(presets, name); i > -1 {
+		presets[i].State = state
+	} else {
+		presets = append(presets, &tableViewPreset{Name: name, State: state})
+		sort.SliceStable(presets, func(i, j int) bool {
+			return strings.ToLower(presets[i].Name) < strings.ToLower(presets[j].Name)
+		})
+	}
+
+	if err := tv.writeViewPresets(presets); err != nil {
+		return err
+	}
+
+	tv.viewPresetsChangedPublisher.Publish()
+
+	tv.setCurrentViewPreset(name)
+
+	return nil
+}
+
+// LoadViewPreset applies the preset with the given name to the *TableView.
+//
+// Columns that did not exist when the preset was saved keep their state.
+func (tv *TableView) LoadViewPreset(name string) error {
+	presets, err := tv.readViewPresets()
+	if err != nil {
+		return err
+	}
+
+	i := viewPresetIndex(presets, name)
+	if i == -1 {
+		return newError("unknown view preset: " + name)
+	}
+
+	tv.SetSuspended(true)
+	defer tv.SetSuspended(false)
+
+	if err := tv.applyState(presets[i].State); err != nil {
+		return err
+	}
+
+	tv.setCurrentViewPreset(presets[i].Name)
+
+	return nil
+}
+
+// DeleteViewPreset removes the preset with the given name. It does nothing if
+// there is no such preset.
+func (tv *TableView) DeleteViewPreset(name string) error {
+	presets, err := tv.readViewPresets()
+	if err != nil {
+		return err
+	}
+
+	i := viewPresetIndex(presets, name)
+	if i == -1 {
+		return nil
+	}
+
+	presets = append(presets[:i], presets[i+1:]...)
+
+	if err := tv.writeViewPresets(presets); err != nil {
+		return err
+	}
+
+	tv.viewPresetsChangedPublisher.Publish()
+
+	if tv.currentViewPreset == name {
+		tv.setCurrentViewPreset("")
+	}
+
+	return nil
+}
+
+// ViewPresetsChanged returns the event that is published after a view preset
+// was saved or deleted.
+func (tv *TableView) ViewPresetsChanged() *Event {
+	return tv.viewPresetsChangedPublisher.Event()
+}
+
+// CurrentViewPresetChanged returns the event that is published after the
+// value of CurrentViewPreset changed.
+func (tv *TableView) CurrentViewPresetChanged() *Event {
+	return tv.currentViewPresetChangedPublisher.Event()
+}
+
+func (tv *TableView) setCurrentViewPreset(name string) {
+	if name == tv.currentViewPreset {
+		return
+	}
+
+	tv.currentViewPreset = name
+
+	tv.currentViewPresetChangedPublisher.Publish()
+}
+
+// viewPresetsKey returns the settings key of the view presets of the
+// *TableView.
+func (tv *TableView) viewPresetsKey() (string, error) {
+	p := tv.path()
+	if p == "" ||
+		strings.HasPrefix(p, "/") ||
+		strings.HasSuffix(p, "/") ||
+		strings.Contains(p, "//") {
+
+		return "", newError("TableView and its ancestors must have names to store view presets")
+	}
+
+	return p + "/ViewPresets", nil
+}
+
+func (tv *TableView) readViewPresets() ([]*tableViewPreset, error) {
+	settings := App().Settings()
+	if settings == nil {
+		return nil, newError("App().Settings() must not be nil")
+	}
+
+	key, err := tv.viewPresetsKey()
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := settings.Get(key)
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	var presets []*tableViewPreset
+	if err := json.Unmarshal([]byte(value), &presets); err != nil {
+		return nil, wrapError(err)
+	}
+
+	retained := presets[:0]
+	for _, preset := range presets {
+		if preset != nil && preset.State != nil {
+			retained = append(retained, preset)
+		}
+	}
+
+	return retained, nil
+}
+
+func (tv *TableView) writeViewPresets(presets []*tableViewPreset) error {
+	settings := App().Settings()
+	if settings == nil {
+		return newError("App().Settings() must not be nil")
+	}
+
+	key, err := tv.viewPresetsKey()
+	if err != nil {
+		return err
+	}
+
+	if len(presets) == 0 {
+		return settings.Remove(key)
+	}
+
+	value, err := json.Marshal(presets)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	return settings.Put(key, string(value))
+}
+
+func viewPresetIndex(presets []*tableViewPreset, name string) int {
+	for i, preset := range presets {
+		if preset.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,472 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TableViewPrintScaling specifies how TableView.Print fits the columns to the
+// width of the page.
+type TableViewPrintScaling int
+
+const (
+	// PrintShrinkToFit shrinks the table, if its columns are wider than the
+	// page.
+	PrintShrinkToFit TableViewPrintScaling = iota
+
+	// PrintFitToWidth shrinks or enlarges the table, so its columns fill the
+	// width of the page.
+	PrintFitToWidth
+
+	// PrintActualSize prints the columns in their widths on screen. Columns
+	// beyond the right margin are cut off.
+	PrintActualSize
+)
+
+// TableViewPrintSettings configures how TableView.Print prints the table.
+//
+// Header and Footer are printed at the top and bottom of every page. They can
+// consist of up to three parts separated by tabs, which are aligned left,
+// centered and aligned right. In the Title, Header and Footer, {title} is
+// replaced by the Title, {page} by the page number, {pages} by the number of
+// pages and {date} by the current date.
+type TableViewPrintSettings struct {
+	// Title is printed above the table on the first page and is the name of
+	// the print job.
+	Title string
+
+	// Header is printed at the top of every page.
+	Header string
+
+	// Footer is printed at the bottom of every page.
+	Footer string
+
+	// Font is the font of the table. The default is the font of the
+	// TableView.
+	Font *Font
+
+	// Margins are the margins of the page in 1/96" units. The default is
+	// 48, i.e. 1/2", on all sides.
+	Margins Margins
+
+	// Scaling specifies how the columns are fitted to the width of the page.
+	Scaling TableViewPrintScaling
+
+	// NoGridLines turns off the lines between the cells.
+	NoGridLines bool
+}
+
+// DefaultTableViewPrintSettings returns the settings used by TableView.Print
+// if none are specified. They print the page numbers in the footer.
+func DefaultTableViewPrintSettings() *TableViewPrintSettings {
+	return &TableViewPrintSettings{
+		Footer: "\t" + tr("Page {page} of {pages}", "walk"),
+	}
+}
+
+// Print shows the print dialog owned by the form of the TableView and prints
+// the rows of the table in their current order, with the visible columns in
+// display order. The column headers are repeated on every page. It returns
+// false if the user canceled the dialog.
+//
+// If settings is nil, DefaultTableViewPrintSettings are used.
+func (tv *TableView) Print(settings *TableViewPrintSettings) (printed bool, err error) {
+	doc := tv.PrintDocument(settings)
+
+	return Print(tv.Form(), doc, doc.docName())
+}
+
+// PrintPreview shows a print preview of the table, see Print.
+func (tv *TableView) PrintPreview(settings *TableViewPrintSettings) error {
+	doc := tv.PrintDocument(settings)
+
+	return ShowPrintPreview(tv.Form(), doc, doc.docName())
+}
+
+// PrintDocument returns the PrintDocument, that prints the table as described
+// at Print. The rows are read from the model every time the document is
+// paginated.
+func (tv *TableView) PrintDocument(settings *TableViewPrintSettings) *TableViewPrintDocument {
+	if settings == nil {
+		settings = DefaultTableViewPrintSettings()
+	}
+
+	return &TableViewPrintDocument{tv: tv, settings: *settings}
+}
+
+// TableViewPrintDocument is the PrintDocument of a TableView, see
+// TableView.PrintDocument.
+type TableViewPrintDocument struct {
+	tv       *TableView
+	settings TableViewPrintSettings
+
+	// Set by Paginate, in native pixels of the printer.
+	cols        []*TableViewColumn
+	texts       [][]string // by row and display column
+	widths      []int      // by display column, before scaling
+	scale       float64
+	paper       Rectangle
+	area        Rectangle // the paper without the margins
+	lineHeight  int
+	titleHeight int
+	rowHeight   int
+	headHeight  int // of the row of column headers
+	gap         int // between header, title, table and footer
+	pageStarts  []int
+	date        string
+}
+
+func (doc *TableViewPrintDocument) docName() string {
+	if doc.settings.Title != "" {
+		return doc.settings.Title
+	}
+
+	if form := doc.tv.Form(); form != nil {
+		return form.Title()
+	}
+
+	return ""
+}
+
+func (doc *TableViewPrintDocument) font() *Font {
+	if doc.settings.Font != nil {
+		return doc.settings.Font
+	}
+
+	return doc.tv.Font()
+}
+
+// Paginate implements PrintDocument.
+func (doc *TableViewPrintDocument) Paginate(canvas *Canvas, paper Rectangle) (int, error) {
+	tv := doc.tv
+	dpi := canvas.DPI()
+
+	doc.date = time.Now().Format("2006-01-02")
+
+	doc.cols = tv.VisibleColumnsInDisplayOrder()
+	doc.texts = nil
+	if tv.model != nil {
+		indexes := make([]int, len(doc.cols))
+		for i, tvc := range doc.cols {
+			indexes[i] = tv.columns.Index(tvc)
+		}
+
+		rowCount := tv.model.RowCount()
+		doc.texts = make([][]string, rowCount)
+		for row := 0; row < rowCount; row++ {
+			texts := make([]string, len(indexes))
+			for i, col := range indexes {
+				texts[i] = tv.cellText(row, col)
+			}
+			doc.texts[row] = texts
+		}
+	}
+
+	doc.paper = paper
+
+	margins := doc.settings.Margins
+	if margins.isZero() {
+		margins = Margins{48, 48, 48, 48}
+	}
+	margins = MarginsFrom96DPI(margins, dpi)
+
+	doc.area = Rectangle{
+		paper.X + margins.HNear,
+		paper.Y + margins.VNear,
+		maxi(1, paper.Width-margins.HNear-margins.HFar),
+		maxi(1, paper.Height-margins.VNear-margins.VFar),
+	}
+
+	font := doc.font()
+	var err error
+	if doc.lineHeight, err = canvas.fontHeight(font); err != nil {
+		return 0, err
+	}
+
+	headFont, err := NewFont(font.Family(), font.PointSize(), font.Style()|FontBold)
+	if err != nil {
+		return 0, err
+	}
+	defer headFont.Dispose()
+
+	headLineHeight, err := canvas.fontHeight(headFont)
+	if err != nil {
+		return 0, err
+	}
+
+	doc.gap = IntFrom96DPI(8, dpi)
+	padding := IntFrom96DPI(2, dpi)
+	doc.rowHeight = doc.lineHeight + 2*padding
+	doc.headHeight = headLineHeight + 2*padding
+
+	doc.titleHeight = 0
+	if doc.settings.Title != "" {
+		titleFont, err := doc.newTitleFont()
+		if err != nil {
+			return 0, err
+		}
+		defer titleFont.Dispose()
+
+		if doc.titleHeight, err = canvas.fontHeight(titleFont); err != nil {
+			return 0, err
+		}
+	}
+
+	doc.widths = make([]int, len(doc.cols))
+	var tableWidth int
+	for i, tvc := range doc.cols {
+		doc.widths[i] = IntFrom96DPI(tvc.Width(), dpi)
+		tableWidth += doc.widths[i]
+	}
+
+	doc.scale = 1
+	if tableWidth > 0 {
+		fit := float64(doc.area.Width) / float64(tableWidth)
+
+		switch doc.settings.Scaling {
+		case PrintShrinkToFit:
+			if fit < 1 {
+				doc.scale = fit
+			}
+
+		case PrintFitToWidth:
+			doc.scale = fit
+		}
+	}
+
+	doc.pageStarts = doc.pageStarts[:0]
+	for row := 0; ; {
+		doc.pageStarts = append(doc.pageStarts, row)
+
+		top, bottom := doc.tableTopBottom(len(doc.pageStarts) - 1)
+		rows := maxi(1, int(float64(bottom-top)/doc.scale-float64(doc.headHeight))/doc.rowHeight)
+
+		if row += rows; row >= len(doc.texts) {
+			break
+		}
+	}
+
+	return len(doc.pageStarts), nil
+}
+
+func (doc *TableViewPrintDocument) newTitleFont() (*Font, error) {
+	font := doc.font()
+
+	return NewFont(font.Family(), font.PointSize()*3/2, FontBold)
+}
+
+// tableTopBottom returns the vertical bounds of the table on page.
+func (doc *TableViewPrintDocument) tableTopBottom(page int) (top, bottom int) {
+	top, bottom = doc.area.Y, doc.area.Bottom()
+
+	if doc.settings.Header != "" {
+		top += doc.lineHeight + doc.gap
+	}
+	if doc.settings.Footer != "" {
+		bottom -= doc.lineHeight + doc.gap
+	}
+	if page == 0 && doc.titleHeight > 0 {
+		top += doc.titleHeight + doc.gap
+	}
+
+	return top, bottom
+}
+
+// expand replaces the placeholders in text, see TableViewPrintSettings.
+func (doc *TableViewPrintDocument) expand(text string, page int) string {
+	return strings.NewReplacer(
+		"{title}", doc.settings.Title,
+		"{page}", strconv.Itoa(page+1),
+		"{pages}", strconv.Itoa(len(doc.pageStarts)),
+		"{date}", doc.date,
+	).Replace(text)
+}
+
+// PrintPage implements PrintDocument.
+func (doc *TableViewPrintDocument) PrintPage(canvas *Canvas, paper Rectangle, page int) error {
+	font := doc.font()
+
+	// In a print preview, the origin of paper differs from Paginate.
+	dx, dy := paper.X-doc.paper.X, paper.Y-doc.paper.Y
+	area := doc.area
+	area.X += dx
+	area.Y += dy
+
+	const format = TextSingleLine | TextVCenter | TextEndEllipsis | TextNoPrefix
+
+	drawParts := func(text string, bounds Rectangle) error {
+		parts := strings.SplitN(doc.expand(text, page), "\t", 3)
+		for i, part := range parts {
+			align := [...]DrawTextFormat{TextLeft, TextCenter, TextRight}[i]
+
+			if err := canvas.DrawTextPixels(part, font, RGB(0, 0, 0), bounds, format|align); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if doc.settings.Header != "" {
+		if err := drawParts(doc.settings.Header, Rectangle{area.X, area.Y, area.Width, doc.lineHeight}); err != nil {
+			return err
+		}
+	}
+
+	if doc.settings.Footer != "" {
+		if err := drawParts(doc.settings.Footer, Rectangle{area.X, area.Bottom() - doc.lineHeight, area.Width, doc.lineHeight}); err != nil {
+			return err
+		}
+	}
+
+	top, _ := doc.tableTopBottom(page)
+	top += dy
+
+	if page == 0 && doc.titleHeight > 0 {
+		titleFont, err := doc.newTitleFont()
+		if err != nil {
+			return err
+		}
+		defer titleFont.Dispose()
+
+		bounds := Rectangle{area.X, top - doc.titleHeight - doc.gap, area.Width, doc.titleHeight}
+		if err := canvas.DrawTextPixels(doc.expand(doc.settings.Title, page), titleFont, RGB(0, 0, 0), bounds, format|TextLeft); err != nil {
+			return err
+		}
+	}
+
+	if len(doc.cols) == 0 {
+		return nil
+	}
+
+	first := doc.pageStarts[page]
+	last := len(doc.texts)
+	if page+1 < len(doc.pageStarts) {
+		last = doc.pageStarts[page+1]
+	}
+
+	// The table is drawn unscaled at the origin and transformed into place.
+	if err := canvas.PushTransformPixels(ScalingTransform(doc.scale, doc.scale).Translate(float64(area.X), float64(top))); err != nil {
+		return err
+	}
+	defer canvas.PopTransform()
+
+	// Columns beyond the right margin are cut off.
+	maxWidth := int(float64(area.Width) / doc.scale)
+	var tableWidth int
+	for _, w := range doc.widths {
+		tableWidth += w
+	}
+	tableWidth = mini(tableWidth, maxWidth)
+	tableHeight := doc.headHeight + (last-first)*doc.rowHeight
+
+	headFont, err := NewFont(font.Family(), font.PointSize(), font.Style()|FontBold)
+	if err != nil {
+		return err
+	}
+	defer headFont.Dispose()
+
+	headBrush, err := NewSolidColorBrush(RGB(230, 230, 230))
+	if err != nil {
+		return err
+	}
+	defer headBrush.Dispose()
+
+	if err := canvas.FillRectanglePixels(headBrush, Rectangle{0, 0, tableWidth, doc.headHeight}); err != nil {
+		return err
+	}
+
+	padding := IntFrom96DPI(4, canvas.DPI())
+
+	drawRow := func(texts []string, y, height int, font *Font) error {
+		var x int
+		for i, text := range texts {
+			if x >= maxWidth {
+				break
+			}
+
+			width := mini(doc.widths[i], maxWidth-x)
+
+			align := TextLeft
+			switch doc.cols[i].Alignment() {
+			case AlignCenter:
+				align = TextCenter
+
+			case AlignFar:
+				align = TextRight
+			}
+
+			bounds := Rectangle{x + padding, y, width - 2*padding, height}
+			if bounds.Width > 0 {
+				if err := canvas.DrawTextPixels(text, font, RGB(0, 0, 0), bounds, format|align); err != nil {
+					return err
+				}
+			}
+
+			x += doc.widths[i]
+		}
+
+		return nil
+	}
+
+	titles := make([]string, len(doc.cols))
+	for i, tvc := range doc.cols {
+		titles[i] = tvc.TitleEffective()
+	}
+	if err := drawRow(titles, 0, doc.headHeight, headFont); err != nil {
+		return err
+	}
+
+	for row := first; row < last; row++ {
+		if err := drawRow(doc.texts[row], doc.headHeight+(row-first)*doc.rowHeight, doc.rowHeight, font); err != nil {
+			return err
+		}
+	}
+
+	if doc.settings.NoGridLines {
+		return nil
+	}
+
+	lineBrush, err := NewSolidColorBrush(RGB(160, 160, 160))
+	if err != nil {
+		return err
+	}
+	defer lineBrush.Dispose()
+
+	pen, err := NewGeometricPen(PenSolid|PenCapFlat, maxi(1, IntFrom96DPI(1, canvas.DPI())/2), lineBrush)
+	if err != nil {
+		return err
+	}
+	defer pen.Dispose()
+
+	for i := -1; i <= last-first; i++ {
+		y := doc.headHeight + i*doc.rowHeight
+		if i < 0 {
+			y = 0
+		}
+
+		if err := canvas.DrawLinePixels(pen, Point{0, y}, Point{tableWidth, y}); err != nil {
+			return err
+		}
+	}
+
+	var x int
+	for i := 0; i <= len(doc.widths) && x <= tableWidth; i++ {
+		if err := canvas.DrawLinePixels(pen, Point{x, 0}, Point{x, tableHeight}); err != nil {
+			return err
+		}
+
+		if i < len(doc.widths) {
+			x += doc.widths[i]
+		}
+	}
+
+	return nil
+}
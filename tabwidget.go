@@ -119,6 +119,22 @@ func (tw *TabWidget) applyFont(font *Font) {
 	// applyFontToDescendants(tw, font)
 }
 
+func (tw *TabWidget) applyRightToLeft(rtl bool) error {
+	// The system mirrors the tabs and the positions of the pages, the
+	// layouts of the pages mirror their content.
+	if err := tw.ensureExtendedStyleBits(win.WS_EX_LAYOUTRTL, rtl); err != nil {
+		return err
+	}
+
+	for i := tw.pages.Len() - 1; i >= 0; i-- {
+		if err := tw.pages.At(i).SetRightToLeft(rtl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (tw *TabWidget) ApplyDPI(dpi int) {
 	tw.WidgetBase.ApplyDPI(dpi)
 
@@ -560,6 +576,10 @@ func (tw *TabWidget) onInsertedPage(index int, page *TabPage) (err error) {
 
 	page.applyFont(tw.Font())
 
+	if err := page.SetRightToLeft(tw.rightToLeft); err != nil {
+		return err
+	}
+
 	tw.Invalidate()
 
 	return
@@ -0,0 +1,714 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+const terminalViewWindowClass = `\o/ Walk_TerminalView_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClass(terminalViewWindowClass)
+	})
+}
+
+// terminalPalette holds the 16 standard ANSI colors.
+var terminalPalette = [16]Color{
+	RGB(0, 0, 0),
+	RGB(205, 49, 49),
+	RGB(13, 188, 121),
+	RGB(229, 229, 16),
+	RGB(36, 114, 200),
+	RGB(188, 63, 188),
+	RGB(17, 168, 205),
+	RGB(229, 229, 229),
+	RGB(102, 102, 102),
+	RGB(241, 76, 76),
+	RGB(35, 209, 139),
+	RGB(245, 245, 67),
+	RGB(59, 142, 234),
+	RGB(214, 112, 214),
+	RGB(41, 184, 219),
+	RGB(255, 255, 255),
+}
+
+type terminalStyle struct {
+	fg, bg       Color
+	hasFg, hasBg bool
+	bold         bool
+}
+
+type terminalRun struct {
+	text  string
+	style terminalStyle
+}
+
+type terminalLine []terminalRun
+
+func (l terminalLine) text() string {
+	var sb strings.Builder
+	for _, run := range l {
+		sb.WriteString(run.text)
+	}
+	return sb.String()
+}
+
+func (l terminalLine) runeCount() int {
+	var n int
+	for _, run := range l {
+		n += utf8.RuneCountInString(run.text)
+	}
+	return n
+}
+
+// terminalParser keeps the state of an ANSI escape sequence parser between
+// chunks of text.
+type terminalParser struct {
+	style     terminalStyle
+	pending   string
+	pendingCR bool
+}
+
+// TerminalView is a read-only widget that displays text in a monospaced font,
+// interpreting ANSI SGR escape sequences for colors.
+//
+// It is typically used to display the output of a child process, see
+// ProcessRunner.
+type TerminalView struct {
+	WidgetBase
+	lines           []terminalLine
+	maxLines        int
+	outParser       terminalParser
+	errParser       terminalParser
+	textColor       Color
+	errorTextColor  Color
+	backgroundColor Color
+	scrollPos       int
+	cellSize        Size // in native pixels
+	writeMutex      sync.Mutex
+	writeRest       []byte
+}
+
+// NewTerminalView creates and initializes a new TerminalView.
+func NewTerminalView(parent Container) (*TerminalView, error) {
+	tv := &TerminalView{
+		lines:           []terminalLine{nil},
+		maxLines:        10000,
+		textColor:       RGB(204, 204, 204),
+		errorTextColor:  RGB(241, 76, 76),
+		backgroundColor: RGB(12, 12, 12),
+	}
+
+	if err := InitWidget(
+		tv,
+		parent,
+		terminalViewWindowClass,
+		win.WS_TABSTOP|win.WS_VISIBLE|win.WS_VSCROLL,
+		win.WS_EX_CLIENTEDGE); err != nil {
+		return nil, err
+	}
+
+	if font, err := NewFont("Consolas", 10, 0); err == nil {
+		tv.SetFont(font)
+	}
+
+	return tv, nil
+}
+
+// MaxLines returns the maximum number of lines the TerminalView keeps.
+func (tv *TerminalView) MaxLines() int {
+	return tv.maxLines
+}
+
+// SetMaxLines sets the maximum number of lines the TerminalView keeps. When
+// more lines are appended, the oldest ones are discarded.
+func (tv *TerminalView) SetMaxLines(maxLines int) {
+	if maxLines < 1 {
+		maxLines = 1
+	}
+
+	tv.maxLines = maxLines
+
+	tv.trimLines()
+	tv.updateScrollBar(false)
+	tv.Invalidate()
+}
+
+// TextColor returns the default text color.
+func (tv *TerminalView) TextColor() Color {
+	return tv.textColor
+}
+
+// SetTextColor sets the default text color.
+func (tv *TerminalView) SetTextColor(c Color) {
+	tv.textColor = c
+	tv.Invalidate()
+}
+
+// ErrorTextColor returns the default color of text appended via
+// AppendErrorText.
+func (tv *TerminalView) ErrorTextColor() Color {
+	return tv.errorTextColor
+}
+
+// SetErrorTextColor sets the default color of text appended via
+// AppendErrorText.
+func (tv *TerminalView) SetErrorTextColor(c Color) {
+	tv.errorTextColor = c
+	tv.Invalidate()
+}
+
+// BackgroundColor returns the default background color.
+func (tv *TerminalView) BackgroundColor() Color {
+	return tv.backgroundColor
+}
+
+// SetBackgroundColor sets the default background color.
+func (tv *TerminalView) SetBackgroundColor(c Color) {
+	tv.backgroundColor = c
+	tv.Invalidate()
+}
+
+// Text returns the text of the TerminalView without any formatting.
+func (tv *TerminalView) Text() string {
+	texts := make([]string, len(tv.lines))
+	for i, line := range tv.lines {
+		texts[i] = line.text()
+	}
+
+	return strings.Join(texts, "\r\n")
+}
+
+// Clear removes all text from the TerminalView.
+func (tv *TerminalView) Clear() {
+	tv.lines = []terminalLine{nil}
+	tv.outParser = terminalParser{}
+	tv.errParser = terminalParser{}
+	tv.scrollPos = 0
+
+	tv.updateScrollBar(false)
+	tv.Invalidate()
+}
+
+// AppendText appends text, which may contain ANSI escape sequences.
+//
+// This method must be called on the UI thread. Use Write from other
+// goroutines.
+func (tv *TerminalView) AppendText(text string) {
+	tv.appendText(&tv.outParser, text, tv.textColor)
+}
+
+// AppendErrorText appends text, which may contain ANSI escape sequences,
+// using ErrorTextColor as the default text color.
+//
+// This method must be called on the UI thread.
+func (tv *TerminalView) AppendErrorText(text string) {
+	tv.appendText(&tv.errParser, text, tv.errorTextColor)
+}
+
+// Write implements io.Writer. It may be called from any goroutine.
+func (tv *TerminalView) Write(p []byte) (int, error) {
+	tv.writeMutex.Lock()
+	b := append(tv.writeRest, p...)
+	complete, rest := splitIncompleteUTF8(b)
+	tv.writeRest = append([]byte(nil), rest...)
+	tv.writeMutex.Unlock()
+
+	if len(complete) > 0 {
+		text := string(complete)
+		tv.Synchronize(func() {
+			tv.AppendText(text)
+		})
+	}
+
+	return len(p), nil
+}
+
+// splitIncompleteUTF8 splits b into a part that ends on a rune boundary and an
+// incomplete rune at its end, if any.
+func splitIncompleteUTF8(b []byte) (complete, rest []byte) {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return b[:i], b[i:]
+			}
+			break
+		}
+	}
+
+	return b, nil
+}
+
+func (tv *TerminalView) atBottom() bool {
+	return tv.scrollPos >= len(tv.lines)-tv.visibleLineCount()
+}
+
+func (tv *TerminalView) appendText(p *terminalParser, text string, defaultFg Color) {
+	followTail := tv.atBottom()
+
+	text = p.pending + text
+	p.pending = ""
+
+	var sb strings.Builder
+
+	flush := func() {
+		if sb.Len() == 0 {
+			return
+		}
+
+		style := p.style
+		if !style.hasFg {
+			style.fg, style.hasFg = defaultFg, true
+		}
+
+		last := len(tv.lines) - 1
+		tv.lines[last] = append(tv.lines[last], terminalRun{sb.String(), style})
+		sb.Reset()
+	}
+
+	for i := 0; i < len(text); {
+		c := text[i]
+
+		if p.pendingCR {
+			p.pendingCR = false
+
+			if c != '\n' {
+				flush()
+				tv.lines[len(tv.lines)-1] = nil
+			}
+		}
+
+		switch c {
+		case '\x1b':
+			n, complete := p.parseEscape(text[i:])
+			if !complete {
+				flush()
+				p.pending = text[i:]
+				i = len(text)
+				continue
+			}
+			flush()
+			i += n
+
+		case '\r':
+			p.pendingCR = true
+			i++
+
+		case '\n':
+			flush()
+			tv.lines = append(tv.lines, nil)
+			i++
+
+		case '\t':
+			col := tv.lines[len(tv.lines)-1].runeCount() + utf8.RuneCountInString(sb.String())
+			sb.WriteString(strings.Repeat(" ", 8-col%8))
+			i++
+
+		case '\b':
+			if s := sb.String(); s != "" {
+				_, size := utf8.DecodeLastRuneInString(s)
+				sb.Reset()
+				sb.WriteString(s[:len(s)-size])
+			}
+			i++
+
+		default:
+			if c < 0x20 {
+				i++
+				continue
+			}
+
+			sb.WriteByte(c)
+			i++
+		}
+	}
+
+	flush()
+
+	tv.trimLines()
+
+	tv.updateScrollBar(followTail)
+	tv.Invalidate()
+}
+
+// parseEscape parses the escape sequence at the start of s. It returns the
+// length of the sequence and whether it is complete.
+func (p *terminalParser) parseEscape(s string) (n int, complete bool) {
+	if len(s) < 2 {
+		return 0, false
+	}
+
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if c := s[i]; c >= 0x40 && c <= 0x7E {
+				if c == 'm' {
+					p.applySGR(s[2:i])
+				}
+				return i + 1, true
+			}
+		}
+		return 0, false
+
+	case ']':
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1, true
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2, true
+			}
+		}
+		return 0, false
+	}
+
+	return 2, true
+}
+
+func (p *terminalParser) applySGR(params string) {
+	var codes []int
+	for _, s := range strings.Split(params, ";") {
+		code, _ := strconv.Atoi(s)
+		codes = append(codes, code)
+	}
+
+	extendedColor := func(i int) (Color, int, bool) {
+		if i+1 >= len(codes) {
+			return 0, len(codes), false
+		}
+
+		switch codes[i+1] {
+		case 5:
+			if i+2 < len(codes) {
+				return terminal256Color(codes[i+2]), i + 2, true
+			}
+
+		case 2:
+			if i+4 < len(codes) {
+				return RGB(byte(codes[i+2]), byte(codes[i+3]), byte(codes[i+4])), i + 4, true
+			}
+		}
+
+		return 0, len(codes), false
+	}
+
+	for i := 0; i < len(codes); i++ {
+		switch code := codes[i]; {
+		case code == 0:
+			p.style = terminalStyle{}
+
+		case code == 1:
+			p.style.bold = true
+
+		case code == 22:
+			p.style.bold = false
+
+		case code >= 30 && code <= 37:
+			p.style.fg, p.style.hasFg = terminalPalette[code-30], true
+			if p.style.bold {
+				p.style.fg = terminalPalette[code-30+8]
+			}
+
+		case code >= 90 && code <= 97:
+			p.style.fg, p.style.hasFg = terminalPalette[code-90+8], true
+
+		case code == 39:
+			p.style.hasFg = false
+
+		case code >= 40 && code <= 47:
+			p.style.bg, p.style.hasBg = terminalPalette[code-40], true
+
+		case code >= 100 && code <= 107:
+			p.style.bg, p.style.hasBg = terminalPalette[code-100+8], true
+
+		case code == 49:
+			p.style.hasBg = false
+
+		case code == 38:
+			var c Color
+			var ok bool
+			if c, i, ok = extendedColor(i); ok {
+				p.style.fg, p.style.hasFg = c, true
+			}
+
+		case code == 48:
+			var c Color
+			var ok bool
+			if c, i, ok = extendedColor(i); ok {
+				p.style.bg, p.style.hasBg = c, true
+			}
+		}
+	}
+}
+
+func terminal256Color(n int) Color {
+	switch {
+	case n < 0:
+		return terminalPalette[0]
+
+	case n < 16:
+		return terminalPalette[n]
+
+	case n < 232:
+		n -= 16
+		level := func(v int) byte {
+			if v == 0 {
+				return 0
+			}
+			return byte(55 + v*40)
+		}
+		return RGB(level(n/36), level(n/6%6), level(n%6))
+
+	case n < 256:
+		v := byte(8 + (n-232)*10)
+		return RGB(v, v, v)
+	}
+
+	return terminalPalette[15]
+}
+
+func (tv *TerminalView) trimLines() {
+	if excess := len(tv.lines) - tv.maxLines; excess > 0 {
+		tv.lines = append(tv.lines[:0:0], tv.lines[excess:]...)
+
+		tv.scrollPos -= excess
+		if tv.scrollPos < 0 {
+			tv.scrollPos = 0
+		}
+	}
+}
+
+func (tv *TerminalView) updateCellSize() {
+	tv.cellSize = calculateTextSize("M", tv.Font(), tv.DPI(), 0, tv.hWnd)
+	if tv.cellSize.Height == 0 {
+		tv.cellSize.Height = 1
+	}
+}
+
+func (tv *TerminalView) visibleLineCount() int {
+	if tv.cellSize.Height == 0 {
+		tv.updateCellSize()
+	}
+
+	return maxi(1, tv.ClientBoundsPixels().Height/tv.cellSize.Height)
+}
+
+func (tv *TerminalView) updateScrollBar(followTail bool) {
+	page := tv.visibleLineCount()
+
+	maxPos := maxi(0, len(tv.lines)-page)
+	if followTail || tv.scrollPos > maxPos {
+		tv.scrollPos = maxPos
+	}
+
+	var si win.SCROLLINFO
+	si.CbSize = uint32(unsafe.Sizeof(si))
+	si.FMask = win.SIF_PAGE | win.SIF_POS | win.SIF_RANGE | win.SIF_DISABLENOSCROLL
+	si.NMax = int32(len(tv.lines) - 1)
+	si.NPage = uint32(page)
+	si.NPos = int32(tv.scrollPos)
+
+	win.SetScrollInfo(tv.hWnd, win.SB_VERT, &si, true)
+}
+
+func (tv *TerminalView) scrollTo(pos int) {
+	maxPos := maxi(0, len(tv.lines)-tv.visibleLineCount())
+	if pos > maxPos {
+		pos = maxPos
+	}
+	if pos < 0 {
+		pos = 0
+	}
+
+	if pos == tv.scrollPos {
+		return
+	}
+
+	tv.scrollPos = pos
+
+	tv.updateScrollBar(false)
+	tv.Invalidate()
+}
+
+func (tv *TerminalView) paint(canvas *Canvas) error {
+	bounds := tv.ClientBoundsPixels()
+
+	bgBrush, err := NewSolidColorBrush(tv.backgroundColor)
+	if err != nil {
+		return err
+	}
+	defer bgBrush.Dispose()
+
+	if err := canvas.FillRectanglePixels(bgBrush, bounds); err != nil {
+		return err
+	}
+
+	font := tv.Font()
+	cell := tv.cellSize
+	padding := IntFrom96DPI(2, tv.DPI())
+
+	last := mini(len(tv.lines), tv.scrollPos+tv.visibleLineCount()+1)
+
+	for i := tv.scrollPos; i < last; i++ {
+		y := (i - tv.scrollPos) * cell.Height
+		x := padding
+
+		for _, run := range tv.lines[i] {
+			width := utf8.RuneCountInString(run.text) * cell.Width
+			r := Rectangle{x, y, width, cell.Height}
+
+			if run.style.hasBg {
+				brush, err := NewSolidColorBrush(run.style.bg)
+				if err != nil {
+					return err
+				}
+				err = canvas.FillRectanglePixels(brush, r)
+				brush.Dispose()
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := canvas.DrawTextPixels(run.text, font, run.style.fg, r, TextSingleLine|TextNoPrefix|TextNoClip); err != nil {
+				return err
+			}
+
+			x += width
+		}
+	}
+
+	return nil
+}
+
+func (*TerminalView) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	return NewGreedyLayoutItem()
+}
+
+func (tv *TerminalView) applyFont(font *Font) {
+	tv.WidgetBase.applyFont(font)
+
+	tv.updateCellSize()
+	tv.updateScrollBar(false)
+}
+
+func (tv *TerminalView) ApplyDPI(dpi int) {
+	tv.WidgetBase.ApplyDPI(dpi)
+
+	tv.updateCellSize()
+	tv.updateScrollBar(false)
+}
+
+func (tv *TerminalView) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+
+		hdc := win.BeginPaint(hwnd, &ps)
+		if hdc == 0 {
+			newError("BeginPaint failed")
+			break
+		}
+		defer win.EndPaint(hwnd, &ps)
+
+		canvas, err := newCanvasFromHDC(hdc)
+		if err != nil {
+			break
+		}
+		defer canvas.Dispose()
+
+		tv.paint(canvas)
+
+		return 0
+
+	case win.WM_ERASEBKGND:
+		return 1
+
+	case win.WM_VSCROLL:
+		page := tv.visibleLineCount()
+
+		switch win.LOWORD(uint32(wParam)) {
+		case win.SB_LINEUP:
+			tv.scrollTo(tv.scrollPos - 1)
+
+		case win.SB_LINEDOWN:
+			tv.scrollTo(tv.scrollPos + 1)
+
+		case win.SB_PAGEUP:
+			tv.scrollTo(tv.scrollPos - page)
+
+		case win.SB_PAGEDOWN:
+			tv.scrollTo(tv.scrollPos + page)
+
+		case win.SB_TOP:
+			tv.scrollTo(0)
+
+		case win.SB_BOTTOM:
+			tv.scrollTo(len(tv.lines))
+
+		case win.SB_THUMBTRACK, win.SB_THUMBPOSITION:
+			var si win.SCROLLINFO
+			si.CbSize = uint32(unsafe.Sizeof(si))
+			si.FMask = win.SIF_TRACKPOS
+			win.GetScrollInfo(hwnd, win.SB_VERT, &si)
+
+			tv.scrollTo(int(si.NTrackPos))
+		}
+
+		return 0
+
+	case win.WM_MOUSEWHEEL:
+		delta := int(int16(win.HIWORD(uint32(wParam))))
+		tv.scrollTo(tv.scrollPos - delta*3/120)
+
+		return 0
+
+	case win.WM_KEYDOWN:
+		switch Key(wParam) {
+		case KeyHome:
+			if ControlDown() {
+				tv.scrollTo(0)
+			}
+
+		case KeyEnd:
+			if ControlDown() {
+				tv.scrollTo(len(tv.lines))
+			}
+
+		case KeyPrior:
+			tv.scrollTo(tv.scrollPos - tv.visibleLineCount())
+
+		case KeyNext:
+			tv.scrollTo(tv.scrollPos + tv.visibleLineCount())
+
+		case KeyUp:
+			tv.scrollTo(tv.scrollPos - 1)
+
+		case KeyDown:
+			tv.scrollTo(tv.scrollPos + 1)
+		}
+
+	case win.WM_WINDOWPOSCHANGED:
+		wp := (*win.WINDOWPOS)(unsafe.Pointer(lParam))
+
+		if wp.Flags&win.SWP_NOSIZE != 0 {
+			break
+		}
+
+		tv.updateScrollBar(tv.atBottom())
+		tv.Invalidate()
+	}
+
+	return tv.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
+}
@@ -73,11 +73,24 @@ func (tl *TextLabel) SetText(text string) error {
 }
 
 func (tl *TextLabel) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	return tl.newTextLabelLayoutItem(GrowableHorz | GrowableVert)
+}
+
+func (s *static) newTextLabelLayoutItem(layoutFlags LayoutFlags) *textLabelLayoutItem {
+	var lineHeight int
+	if s.maxLines > 0 {
+		lineHeight = s.dialogBaseUnits().Height
+	}
+
 	return &textLabelLayoutItem{
 		width2Height: make(map[int]int),
-		text:         tl.Text(),
-		font:         tl.Font(),
-		minWidth:     tl.MinSizePixels().Width,
+		layoutFlags:  layoutFlags,
+		text:         s.text(),
+		font:         s.Font(),
+		minWidth:     s.MinSizePixels().Width,
+		wrap:         s.wrap,
+		maxLines:     s.maxLines,
+		lineHeight:   lineHeight,
 	}
 }
 
@@ -85,21 +98,39 @@ type textLabelLayoutItem struct {
 	LayoutItemBase
 	mutex        sync.Mutex
 	width2Height map[int]int // in native pixels
+	layoutFlags  LayoutFlags
 	text         string
 	font         *Font
 	minWidth     int // in native pixels
+	wrap         bool
+	maxLines     int
+	lineHeight   int // in native pixels, only if maxLines > 0
 }
 
-func (*textLabelLayoutItem) LayoutFlags() LayoutFlags {
-	return GrowableHorz | GrowableVert
+func (li *textLabelLayoutItem) LayoutFlags() LayoutFlags {
+	return li.layoutFlags
 }
 
 func (li *textLabelLayoutItem) IdealSize() Size {
-	return li.MinSize()
+	if !li.wrap {
+		return li.MinSize()
+	}
+
+	size := calculateTextSize(li.text, li.font, li.ctx.dpi, 0, li.handle)
+	size.Height = li.limitHeight(size.Height)
+
+	return maxSize(size, li.MinSize())
 }
 
 func (li *textLabelLayoutItem) MinSize() Size {
-	return calculateTextSize(li.text, li.font, li.ctx.dpi, li.minWidth, li.handle)
+	if !li.wrap {
+		return calculateTextSize(li.text, li.font, li.ctx.dpi, li.minWidth, li.handle)
+	}
+
+	// Measuring for a width of 1 yields the width of the longest word.
+	width := maxi(li.minWidth, calculateTextSize(li.text, li.font, li.ctx.dpi, 1, li.handle).Width)
+
+	return Size{width, li.HeightForWidth(width)}
 }
 
 func (li *textLabelLayoutItem) HasHeightForWidth() bool {
@@ -116,7 +147,18 @@ func (li *textLabelLayoutItem) HeightForWidth(width int) int {
 
 	size := calculateTextSize(li.text, li.font, li.ctx.dpi, width, li.handle)
 
-	li.width2Height[width] = size.Height
+	height := li.limitHeight(size.Height)
+
+	li.width2Height[width] = height
+
+	return height
+}
+
+// limitHeight returns height limited to maxLines lines.
+func (li *textLabelLayoutItem) limitHeight(height int) int {
+	if li.maxLines == 0 {
+		return height
+	}
 
-	return size.Height
+	return mini(height, li.maxLines*li.lineHeight)
 }
@@ -0,0 +1,153 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"unicode/utf16"
+
+	"github.com/lxn/win"
+)
+
+// FontMetrics describes the vertical metrics and character widths of a font
+// on a Canvas.
+type FontMetrics struct {
+	// Height is the height of a line of text without external leading, which
+	// is Ascent + Descent.
+	Height int
+
+	// Ascent is the distance from the top of a line of text to its baseline.
+	Ascent int
+
+	// Descent is the distance from the baseline to the bottom of a line of
+	// text.
+	Descent int
+
+	// InternalLeading is the space for accent marks and the like within
+	// Height.
+	InternalLeading int
+
+	// ExternalLeading is the extra space the font designer suggests between
+	// lines of text.
+	ExternalLeading int
+
+	// AverageCharWidth is the average width of the characters, usually that of
+	// "x".
+	AverageCharWidth int
+
+	// MaxCharWidth is the width of the widest character.
+	MaxCharWidth int
+}
+
+func (m FontMetrics) to96DPI(dpi int) FontMetrics {
+	return FontMetrics{
+		Height:           IntTo96DPI(m.Height, dpi),
+		Ascent:           IntTo96DPI(m.Ascent, dpi),
+		Descent:          IntTo96DPI(m.Descent, dpi),
+		InternalLeading:  IntTo96DPI(m.InternalLeading, dpi),
+		ExternalLeading:  IntTo96DPI(m.ExternalLeading, dpi),
+		AverageCharWidth: IntTo96DPI(m.AverageCharWidth, dpi),
+		MaxCharWidth:     IntTo96DPI(m.MaxCharWidth, dpi),
+	}
+}
+
+// FontMetrics returns the metrics of font in 1/96" units.
+func (c *Canvas) FontMetrics(font *Font) (FontMetrics, error) {
+	m, err := c.FontMetricsPixels(font)
+	if err != nil {
+		return FontMetrics{}, err
+	}
+
+	return m.to96DPI(c.DPI()), nil
+}
+
+// FontMetricsPixels returns the metrics of font in native pixels.
+func (c *Canvas) FontMetricsPixels(font *Font) (metrics FontMetrics, err error) {
+	err = c.withFontAndTextColor(font, 0, func() error {
+		var tm win.TEXTMETRIC
+		if !win.GetTextMetrics(c.hdc, &tm) {
+			return newError("GetTextMetrics failed")
+		}
+
+		metrics = FontMetrics{
+			Height:           int(tm.TmHeight),
+			Ascent:           int(tm.TmAscent),
+			Descent:          int(tm.TmDescent),
+			InternalLeading:  int(tm.TmInternalLeading),
+			ExternalLeading:  int(tm.TmExternalLeading),
+			AverageCharWidth: int(tm.TmAveCharWidth),
+			MaxCharWidth:     int(tm.TmMaxCharWidth),
+		}
+
+		return nil
+	})
+
+	return
+}
+
+// MeasureCharExtentsPixels returns for each rune of text the distance in
+// native pixels from the start of text to the end of that rune, when text is
+// drawn in a single line with font.
+//
+// The rune at index i covers the range from extents[i-1], or 0 for the first
+// one, to extents[i]. Together with CharIndexAtPixels, this maps between x
+// coordinates and rune indexes, e.g. for placing a caret.
+func (c *Canvas) MeasureCharExtentsPixels(text string, font *Font) (extents []int, err error) {
+	runes := []rune(text)
+	if len(runes) == 0 {
+		return nil, nil
+	}
+
+	units := utf16.Encode(runes)
+	dx := make([]int32, len(units))
+
+	err = c.withFontAndTextColor(font, 0, func() error {
+		var size win.SIZE
+		if !win.GetTextExtentExPoint(c.hdc, &units[0], int32(len(units)), 0, nil, &dx[0], &size) {
+			return newError("GetTextExtentExPoint failed")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	extents = make([]int, len(runes))
+	var unit int
+	for i, r := range runes {
+		// The extent of a surrogate pair is reported for its second unit.
+		if r >= 0x10000 {
+			unit++
+		}
+		extents[i] = int(dx[unit])
+		unit++
+	}
+
+	return extents, nil
+}
+
+// CharIndexAtPixels returns the index of the rune boundary in text that is
+// nearest to x in native pixels, when text is drawn in a single line with font
+// starting at x = 0. The result is in the range from 0 to the number of runes
+// in text, like a caret position.
+func (c *Canvas) CharIndexAtPixels(text string, font *Font, x int) (int, error) {
+	extents, err := c.MeasureCharExtentsPixels(text, font)
+	if err != nil {
+		return 0, err
+	}
+
+	var start int
+	for i, end := range extents {
+		if x < (start+end)/2 {
+			return i, nil
+		}
+
+		start = end
+	}
+
+	return len(extents), nil
+}
@@ -0,0 +1,1285 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"container/list"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+const thumbnailGridWindowClass = `\o/ Walk_ThumbnailGrid_Class \o/`
+
+func init() {
+	AppendToWalkInit(func() {
+		MustRegisterWindowClassWithStyle(thumbnailGridWindowClass, win.CS_DBLCLKS)
+	})
+}
+
+const (
+	thumbnailGridMinSize = 32
+	thumbnailGridMaxSize = 512
+)
+
+var (
+	bhidDataObject = syscall.GUID{Data1: 0xB8C0BD9F, Data2: 0xED24, Data3: 0x455C, Data4: [8]byte{0x83, 0xE6, 0xD5, 0x39, 0x0C, 0x4F, 0xE8, 0xC4}}
+	iidIDataObject = syscall.GUID{Data1: 0x0000010E, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+)
+
+type iShellItemArrayVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	BindToHandler  uintptr
+}
+
+type iShellItemArray struct {
+	LpVtbl *iShellItemArrayVtbl
+}
+
+// thumbnailKey identifies a cached thumbnail by file path and size in native
+// pixels.
+type thumbnailKey struct {
+	path string
+	size int
+}
+
+type thumbnailCacheEntry struct {
+	key    thumbnailKey
+	bitmap *Bitmap // nil if no thumbnail could be generated
+}
+
+// thumbnailCache is a least recently used cache of thumbnail bitmaps.
+type thumbnailCache struct {
+	capacity int
+	entries  map[thumbnailKey]*list.Element
+	lru      list.List
+}
+
+func newThumbnailCache(capacity int) *thumbnailCache {
+	return &thumbnailCache{
+		capacity: capacity,
+		entries:  make(map[thumbnailKey]*list.Element),
+	}
+}
+
+func (tc *thumbnailCache) get(key thumbnailKey) (bitmap *Bitmap, ok bool) {
+	elem, ok := tc.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	tc.lru.MoveToFront(elem)
+
+	return elem.Value.(*thumbnailCacheEntry).bitmap, true
+}
+
+func (tc *thumbnailCache) put(key thumbnailKey, bitmap *Bitmap) {
+	if elem, ok := tc.entries[key]; ok {
+		entry := elem.Value.(*thumbnailCacheEntry)
+		if entry.bitmap != nil && entry.bitmap != bitmap {
+			entry.bitmap.Dispose()
+		}
+		entry.bitmap = bitmap
+		tc.lru.MoveToFront(elem)
+		return
+	}
+
+	tc.entries[key] = tc.lru.PushFront(&thumbnailCacheEntry{key, bitmap})
+
+	tc.trim()
+}
+
+func (tc *thumbnailCache) trim() {
+	for tc.lru.Len() > tc.capacity {
+		elem := tc.lru.Back()
+		entry := elem.Value.(*thumbnailCacheEntry)
+
+		tc.lru.Remove(elem)
+		delete(tc.entries, entry.key)
+
+		if entry.bitmap != nil {
+			entry.bitmap.Dispose()
+		}
+	}
+}
+
+func (tc *thumbnailCache) clear() {
+	for _, elem := range tc.entries {
+		if bitmap := elem.Value.(*thumbnailCacheEntry).bitmap; bitmap != nil {
+			bitmap.Dispose()
+		}
+	}
+
+	tc.entries = make(map[thumbnailKey]*list.Element)
+	tc.lru.Init()
+}
+
+// thumbnailQueue hands out thumbnail requests to the worker goroutines of a
+// ThumbnailGrid. Requests are replaced as a whole whenever the visible items
+// change, so that scrolled away items are not loaded needlessly.
+type thumbnailQueue struct {
+	mutex    sync.Mutex
+	cond     *sync.Cond
+	requests []thumbnailKey
+	inFlight map[thumbnailKey]bool
+	closed   bool
+}
+
+func newThumbnailQueue() *thumbnailQueue {
+	q := &thumbnailQueue{inFlight: make(map[thumbnailKey]bool)}
+	q.cond = sync.NewCond(&q.mutex)
+	return q
+}
+
+func (q *thumbnailQueue) replace(requests []thumbnailKey) {
+	q.mutex.Lock()
+	q.requests = q.requests[:0]
+	for _, key := range requests {
+		if !q.inFlight[key] {
+			q.requests = append(q.requests, key)
+		}
+	}
+	q.mutex.Unlock()
+
+	q.cond.Broadcast()
+}
+
+func (q *thumbnailQueue) pop() (key thumbnailKey, ok bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for len(q.requests) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+
+	if q.closed {
+		return thumbnailKey{}, false
+	}
+
+	key = q.requests[0]
+	q.requests = q.requests[1:]
+	q.inFlight[key] = true
+
+	return key, true
+}
+
+func (q *thumbnailQueue) done(key thumbnailKey) {
+	q.mutex.Lock()
+	delete(q.inFlight, key)
+	q.mutex.Unlock()
+}
+
+func (q *thumbnailQueue) close() {
+	q.mutex.Lock()
+	q.closed = true
+	q.requests = nil
+	q.mutex.Unlock()
+
+	q.cond.Broadcast()
+}
+
+// isThumbnailImageFile returns whether a thumbnail can be generated for the
+// file at path.
+func isThumbnailImageFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png", ".jpg", ".jpeg", ".gif":
+		return true
+	}
+
+	return false
+}
+
+// loadThumbnailImage decodes the image file at path and scales it down to
+// fit into a square of size pixels.
+func loadThumbnailImage(path string, size int) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	src, _, err := image.Decode(file)
+	if err != nil {
+		return nil, err
+	}
+
+	b := src.Bounds()
+	if b.Dx() <= size && b.Dy() <= size {
+		return src, nil
+	}
+
+	width, height := size, size
+	if b.Dx() > b.Dy() {
+		height = maxi(1, b.Dy()*size/b.Dx())
+	} else {
+		width = maxi(1, b.Dx()*size/b.Dy())
+	}
+
+	return scaleImageDown(src, width, height), nil
+}
+
+// scaleImageDown scales src to width x height, averaging four samples per
+// destination pixel.
+func scaleImageDown(src image.Image, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	b := src.Bounds()
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var r, g, bl, a uint32
+
+			for _, s := range [4][2]int{{1, 1}, {3, 1}, {1, 3}, {3, 3}} {
+				sx := b.Min.X + (x*4+s[0])*b.Dx()/(width*4)
+				sy := b.Min.Y + (y*4+s[1])*b.Dy()/(height*4)
+
+				cr, cg, cb, ca := src.At(sx, sy).RGBA()
+				r += cr
+				g += cg
+				bl += cb
+				a += ca
+			}
+
+			dst.SetRGBA(x, y, color.RGBA{uint8(r >> 10), uint8(g >> 10), uint8(bl >> 10), uint8(a >> 10)})
+		}
+	}
+
+	return dst
+}
+
+// ThumbnailGrid is a widget that displays thumbnails of image files in a
+// scrollable grid.
+//
+// Only the visible items are painted and thumbnails are generated
+// asynchronously while the user scrolls, so a ThumbnailGrid can display large
+// numbers of files. Generated thumbnails are kept in a cache of limited size.
+//
+// Items can be selected by clicking, using the keyboard or by dragging a
+// rubber band. Selected files can be dragged to other applications, e.g. to
+// Windows Explorer.
+type ThumbnailGrid struct {
+	WidgetBase
+	filePaths                     []string
+	selected                      []bool
+	currentIndex                  int
+	anchorIndex                   int
+	thumbnailSize                 int // in 1/96" units
+	scrollPos                     int // in native pixels
+	cache                         *thumbnailCache
+	queue                         *thumbnailQueue
+	workersStarted                bool
+	mouseDownPos                  Point
+	mouseDownIndex                int
+	dragPending                   bool
+	rubberBanding                 bool
+	rubberBand                    Rectangle // in content coordinates
+	rubberBandBaseSelection       []bool
+	zoomSlider                    *Slider
+	zoomSliderHandle              int
+	selectionChangedPublisher     EventPublisher
+	currentIndexChangedPublisher  EventPublisher
+	itemActivatedPublisher        EventPublisher
+	thumbnailSizeChangedPublisher EventPublisher
+}
+
+// NewThumbnailGrid creates and initializes a new ThumbnailGrid.
+func NewThumbnailGrid(parent Container) (*ThumbnailGrid, error) {
+	tg := &ThumbnailGrid{
+		currentIndex:   -1,
+		anchorIndex:    -1,
+		mouseDownIndex: -1,
+		thumbnailSize:  128,
+		cache:          newThumbnailCache(256),
+		queue:          newThumbnailQueue(),
+	}
+
+	if err := InitWidget(
+		tg,
+		parent,
+		thumbnailGridWindowClass,
+		win.WS_TABSTOP|win.WS_VISIBLE|win.WS_VSCROLL,
+		win.WS_EX_CLIENTEDGE|win.WS_EX_COMPOSITED); err != nil {
+		return nil, err
+	}
+
+	tg.Disposing().Attach(func() {
+		tg.queue.close()
+		tg.cache.clear()
+		tg.SetZoomSlider(nil)
+	})
+
+	tg.MustRegisterProperty("ThumbnailSize", NewProperty(
+		func() interface{} {
+			return tg.ThumbnailSize()
+		},
+		func(v interface{}) error {
+			tg.SetThumbnailSize(assertIntOr(v, 128))
+			return nil
+		},
+		tg.thumbnailSizeChangedPublisher.Event()))
+
+	return tg, nil
+}
+
+// FilePaths returns the paths of the files displayed by the ThumbnailGrid.
+func (tg *ThumbnailGrid) FilePaths() []string {
+	return append([]string(nil), tg.filePaths...)
+}
+
+// SetFilePaths sets the paths of the files displayed by the ThumbnailGrid.
+//
+// The selection is cleared.
+func (tg *ThumbnailGrid) SetFilePaths(filePaths []string) {
+	tg.filePaths = append([]string(nil), filePaths...)
+	tg.selected = make([]bool, len(filePaths))
+	tg.currentIndex = -1
+	tg.anchorIndex = -1
+	tg.scrollPos = 0
+
+	tg.updateScrollBar()
+	tg.Invalidate()
+
+	tg.currentIndexChangedPublisher.Publish()
+	tg.selectionChangedPublisher.Publish()
+}
+
+// ThumbnailSize returns the edge length of the thumbnails in 1/96" units.
+func (tg *ThumbnailGrid) ThumbnailSize() int {
+	return tg.thumbnailSize
+}
+
+// SetThumbnailSize sets the edge length of the thumbnails in 1/96" units.
+//
+// The value is clamped to the range [32, 512].
+func (tg *ThumbnailGrid) SetThumbnailSize(size int) {
+	size = maxi(thumbnailGridMinSize, mini(thumbnailGridMaxSize, size))
+	if size == tg.thumbnailSize {
+		return
+	}
+
+	// Keep the current item in view while zooming.
+	anchor := tg.currentIndex
+	if anchor < 0 || !tg.isItemVisible(anchor) {
+		anchor = tg.firstVisibleIndex()
+	}
+
+	tg.thumbnailSize = size
+
+	tg.updateScrollBar()
+	if anchor >= 0 {
+		tg.EnsureVisible(anchor)
+	}
+	tg.Invalidate()
+
+	if tg.zoomSlider != nil {
+		tg.zoomSlider.SetValue(size)
+	}
+
+	tg.thumbnailSizeChangedPublisher.Publish()
+}
+
+// ThumbnailSizeChanged returns the event that is published when the
+// thumbnail size changed.
+func (tg *ThumbnailGrid) ThumbnailSizeChanged() *Event {
+	return tg.thumbnailSizeChangedPublisher.Event()
+}
+
+// ZoomSlider returns the Slider that controls the thumbnail size, if any.
+func (tg *ThumbnailGrid) ZoomSlider() *Slider {
+	return tg.zoomSlider
+}
+
+// SetZoomSlider makes slider control the thumbnail size of the ThumbnailGrid.
+//
+// The range and value of slider are updated accordingly. Pass nil to
+// disconnect a previously set Slider.
+func (tg *ThumbnailGrid) SetZoomSlider(slider *Slider) {
+	if tg.zoomSlider != nil {
+		tg.zoomSlider.ValueChanged().Detach(tg.zoomSliderHandle)
+	}
+
+	tg.zoomSlider = slider
+
+	if slider == nil {
+		return
+	}
+
+	slider.SetRange(thumbnailGridMinSize, thumbnailGridMaxSize)
+	slider.SetValue(tg.thumbnailSize)
+
+	tg.zoomSliderHandle = slider.ValueChanged().Attach(func() {
+		tg.SetThumbnailSize(slider.Value())
+	})
+}
+
+// CacheSize returns the maximum number of thumbnails that are kept in memory.
+func (tg *ThumbnailGrid) CacheSize() int {
+	return tg.cache.capacity
+}
+
+// SetCacheSize sets the maximum number of thumbnails that are kept in memory.
+func (tg *ThumbnailGrid) SetCacheSize(size int) {
+	tg.cache.capacity = maxi(1, size)
+	tg.cache.trim()
+}
+
+// Reload discards all cached thumbnails, so they are generated again.
+func (tg *ThumbnailGrid) Reload() {
+	tg.cache.clear()
+	tg.Invalidate()
+}
+
+// CurrentIndex returns the index of the current item, or -1 if there is none.
+func (tg *ThumbnailGrid) CurrentIndex() int {
+	return tg.currentIndex
+}
+
+// SetCurrentIndex makes the item at index the current item and scrolls it
+// into view. The selection is not changed.
+func (tg *ThumbnailGrid) SetCurrentIndex(index int) error {
+	if index < -1 || index >= len(tg.filePaths) {
+		return newError("index out of range")
+	}
+
+	tg.setCurrentIndex(index)
+
+	return nil
+}
+
+// CurrentIndexChanged returns the event that is published when the current
+// item changed.
+func (tg *ThumbnailGrid) CurrentIndexChanged() *Event {
+	return tg.currentIndexChangedPublisher.Event()
+}
+
+// SelectedIndexes returns the indexes of the selected items.
+func (tg *ThumbnailGrid) SelectedIndexes() []int {
+	var indexes []int
+
+	for i, sel := range tg.selected {
+		if sel {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+// SetSelectedIndexes selects exactly the items at indexes.
+func (tg *ThumbnailGrid) SetSelectedIndexes(indexes []int) error {
+	selected := make([]bool, len(tg.filePaths))
+
+	for _, i := range indexes {
+		if i < 0 || i >= len(selected) {
+			return newError("index out of range")
+		}
+
+		selected[i] = true
+	}
+
+	tg.setSelection(selected)
+
+	return nil
+}
+
+// SelectedFilePaths returns the paths of the selected files.
+func (tg *ThumbnailGrid) SelectedFilePaths() []string {
+	var paths []string
+
+	for i, sel := range tg.selected {
+		if sel {
+			paths = append(paths, tg.filePaths[i])
+		}
+	}
+
+	return paths
+}
+
+// SelectAll selects all items.
+func (tg *ThumbnailGrid) SelectAll() {
+	selected := make([]bool, len(tg.filePaths))
+	for i := range selected {
+		selected[i] = true
+	}
+
+	tg.setSelection(selected)
+}
+
+// SelectionChanged returns the event that is published when the selection
+// changed.
+func (tg *ThumbnailGrid) SelectionChanged() *Event {
+	return tg.selectionChangedPublisher.Event()
+}
+
+// ItemActivated returns the event that is published when the user double
+// clicks an item or presses the Enter key.
+func (tg *ThumbnailGrid) ItemActivated() *Event {
+	return tg.itemActivatedPublisher.Event()
+}
+
+// EnsureVisible scrolls the ThumbnailGrid, so the item at index is visible.
+func (tg *ThumbnailGrid) EnsureVisible(index int) {
+	if index < 0 || index >= len(tg.filePaths) {
+		return
+	}
+
+	bounds := tg.itemBounds(index)
+	height := tg.ClientBoundsPixels().Height
+
+	if bounds.Y < tg.scrollPos {
+		tg.scrollTo(bounds.Y)
+	} else if bounds.Y+bounds.Height > tg.scrollPos+height {
+		tg.scrollTo(bounds.Y + bounds.Height - height)
+	}
+}
+
+func (tg *ThumbnailGrid) setCurrentIndex(index int) {
+	if index == tg.currentIndex {
+		return
+	}
+
+	if tg.currentIndex >= 0 {
+		tg.invalidateItem(tg.currentIndex)
+	}
+
+	tg.currentIndex = index
+
+	if index >= 0 {
+		tg.invalidateItem(index)
+		tg.EnsureVisible(index)
+	}
+
+	tg.currentIndexChangedPublisher.Publish()
+}
+
+func (tg *ThumbnailGrid) setSelection(selected []bool) {
+	changed := false
+
+	for i := range tg.selected {
+		if tg.selected[i] != selected[i] {
+			tg.selected[i] = selected[i]
+			tg.invalidateItem(i)
+			changed = true
+		}
+	}
+
+	if changed {
+		tg.selectionChangedPublisher.Publish()
+	}
+}
+
+func (tg *ThumbnailGrid) selectRange(from, to int, keep bool) {
+	if from > to {
+		from, to = to, from
+	}
+
+	selected := make([]bool, len(tg.filePaths))
+	if keep {
+		copy(selected, tg.selected)
+	}
+
+	for i := maxi(0, from); i <= to; i++ {
+		selected[i] = true
+	}
+
+	tg.setSelection(selected)
+}
+
+// cellSize returns the size of an item cell in native pixels.
+func (tg *ThumbnailGrid) cellSize() Size {
+	dpi := tg.DPI()
+	padding := IntFrom96DPI(4, dpi)
+	textHeight := tg.textHeight()
+	thumb := IntFrom96DPI(tg.thumbnailSize, dpi)
+
+	return Size{thumb + 2*padding, thumb + textHeight + 3*padding}
+}
+
+func (tg *ThumbnailGrid) textHeight() int {
+	return calculateTextSize("Wg", tg.Font(), tg.DPI(), 0, tg.hWnd).Height
+}
+
+func (tg *ThumbnailGrid) columnCount() int {
+	return maxi(1, tg.ClientBoundsPixels().Width/tg.cellSize().Width)
+}
+
+func (tg *ThumbnailGrid) contentHeight() int {
+	cols := tg.columnCount()
+	rows := (len(tg.filePaths) + cols - 1) / cols
+
+	return rows * tg.cellSize().Height
+}
+
+// itemBounds returns the bounds of the item at index in content coordinates.
+func (tg *ThumbnailGrid) itemBounds(index int) Rectangle {
+	cell := tg.cellSize()
+	cols := tg.columnCount()
+	offset := maxi(0, (tg.ClientBoundsPixels().Width-cols*cell.Width)/2)
+
+	return Rectangle{
+		X:      offset + index%cols*cell.Width,
+		Y:      index / cols * cell.Height,
+		Width:  cell.Width,
+		Height: cell.Height,
+	}
+}
+
+// indexAt returns the index of the item at p in client coordinates, or -1.
+func (tg *ThumbnailGrid) indexAt(p Point) int {
+	for i := tg.firstVisibleIndex(); i >= 0 && i < len(tg.filePaths); i++ {
+		b := tg.itemBounds(i)
+		if b.Y > tg.scrollPos+p.Y {
+			break
+		}
+
+		if rectangleContains(b, Point{p.X, p.Y + tg.scrollPos}) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (tg *ThumbnailGrid) firstVisibleIndex() int {
+	if len(tg.filePaths) == 0 {
+		return -1
+	}
+
+	return mini(len(tg.filePaths)-1, tg.scrollPos/tg.cellSize().Height*tg.columnCount())
+}
+
+// visibleRange returns the range of items that are at least partially
+// visible, extended by extraRows rows below.
+func (tg *ThumbnailGrid) visibleRange(extraRows int) (first, last int) {
+	cell := tg.cellSize()
+	cols := tg.columnCount()
+
+	firstRow := tg.scrollPos / cell.Height
+	lastRow := (tg.scrollPos+tg.ClientBoundsPixels().Height)/cell.Height + extraRows
+
+	first = firstRow * cols
+	last = mini(len(tg.filePaths), (lastRow+1)*cols) - 1
+
+	return
+}
+
+func (tg *ThumbnailGrid) isItemVisible(index int) bool {
+	first, last := tg.visibleRange(0)
+
+	return index >= first && index <= last
+}
+
+func (tg *ThumbnailGrid) invalidateItem(index int) {
+	b := tg.itemBounds(index)
+	b.Y -= tg.scrollPos
+
+	r := b.toRECT()
+	win.InvalidateRect(tg.hWnd, &r, false)
+}
+
+func (tg *ThumbnailGrid) updateScrollBar() {
+	height := tg.ClientBoundsPixels().Height
+	content := tg.contentHeight()
+
+	if maxPos := maxi(0, content-height); tg.scrollPos > maxPos {
+		tg.scrollPos = maxPos
+	}
+
+	var si win.SCROLLINFO
+	si.CbSize = uint32(unsafe.Sizeof(si))
+	si.FMask = win.SIF_PAGE | win.SIF_POS | win.SIF_RANGE
+	si.NMax = int32(content - 1)
+	si.NPage = uint32(height)
+	si.NPos = int32(tg.scrollPos)
+
+	win.SetScrollInfo(tg.hWnd, win.SB_VERT, &si, true)
+}
+
+func (tg *ThumbnailGrid) scrollTo(pos int) {
+	maxPos := maxi(0, tg.contentHeight()-tg.ClientBoundsPixels().Height)
+	if pos > maxPos {
+		pos = maxPos
+	}
+	if pos < 0 {
+		pos = 0
+	}
+
+	if pos == tg.scrollPos {
+		return
+	}
+
+	tg.scrollPos = pos
+
+	tg.updateScrollBar()
+	tg.Invalidate()
+}
+
+// requestThumbnails queues the thumbnails of the visible items and of the
+// next row that are not cached yet.
+func (tg *ThumbnailGrid) requestThumbnails() {
+	size := IntFrom96DPI(tg.thumbnailSize, tg.DPI())
+	first, last := tg.visibleRange(1)
+
+	var requests []thumbnailKey
+	for i := first; i <= last; i++ {
+		path := tg.filePaths[i]
+		if !isThumbnailImageFile(path) {
+			continue
+		}
+
+		key := thumbnailKey{path, size}
+		if _, ok := tg.cache.get(key); ok {
+			continue
+		}
+
+		requests = append(requests, key)
+	}
+
+	tg.queue.replace(requests)
+
+	if len(requests) > 0 && !tg.workersStarted {
+		tg.workersStarted = true
+
+		for i := mini(4, runtime.NumCPU()); i > 0; i-- {
+			go tg.generateThumbnails()
+		}
+	}
+}
+
+func (tg *ThumbnailGrid) generateThumbnails() {
+	for {
+		key, ok := tg.queue.pop()
+		if !ok {
+			return
+		}
+
+		img, err := loadThumbnailImage(key.path, key.size)
+
+		tg.Synchronize(func() {
+			tg.thumbnailLoaded(key, img, err)
+		})
+	}
+}
+
+func (tg *ThumbnailGrid) thumbnailLoaded(key thumbnailKey, img image.Image, err error) {
+	tg.queue.done(key)
+
+	if tg.IsDisposed() {
+		return
+	}
+
+	var bitmap *Bitmap
+	if err == nil {
+		bitmap, _ = NewBitmapFromImageForDPI(img, tg.DPI())
+	}
+
+	tg.cache.put(key, bitmap)
+
+	for i, path := range tg.filePaths {
+		if path == key.path && tg.isItemVisible(i) {
+			tg.invalidateItem(i)
+		}
+	}
+}
+
+func (tg *ThumbnailGrid) paint(canvas *Canvas, updateBounds Rectangle) error {
+	bgBrush, err := NewSystemColorBrush(SysColorWindow)
+	if err != nil {
+		return err
+	}
+	defer bgBrush.Dispose()
+
+	if err := canvas.FillRectanglePixels(bgBrush, updateBounds); err != nil {
+		return err
+	}
+
+	if len(tg.filePaths) == 0 {
+		return nil
+	}
+
+	selBrush, err := NewSystemColorBrush(SysColorHighlight)
+	if err != nil {
+		return err
+	}
+	defer selBrush.Dispose()
+
+	framePen, err := NewCosmeticPen(PenSolid, Color(win.GetSysColor(win.COLOR_BTNSHADOW)))
+	if err != nil {
+		return err
+	}
+	defer framePen.Dispose()
+
+	dpi := tg.DPI()
+	padding := IntFrom96DPI(4, dpi)
+	thumb := IntFrom96DPI(tg.thumbnailSize, dpi)
+	textHeight := tg.textHeight()
+	font := tg.Font()
+	focused := win.GetFocus() == tg.hWnd
+
+	first, last := tg.visibleRange(0)
+
+	for i := first; i <= last; i++ {
+		cell := tg.itemBounds(i)
+		cell.Y -= tg.scrollPos
+
+		if !rectanglesIntersect(cell, updateBounds) {
+			continue
+		}
+
+		path := tg.filePaths[i]
+		thumbBounds := Rectangle{cell.X + padding, cell.Y + padding, thumb, thumb}
+		textBounds := Rectangle{cell.X + padding, thumbBounds.Y + thumb + padding, thumb, textHeight}
+		textColor := Color(win.GetSysColor(win.COLOR_WINDOWTEXT))
+
+		if tg.selected[i] {
+			if err := canvas.FillRectanglePixels(selBrush, Rectangle{cell.X + 1, cell.Y + 1, cell.Width - 2, cell.Height - 2}); err != nil {
+				return err
+			}
+			textColor = Color(win.GetSysColor(win.COLOR_HIGHLIGHTTEXT))
+		}
+
+		if bitmap, ok := tg.cache.get(thumbnailKey{path, thumb}); ok && bitmap != nil {
+			size := bitmap.Size()
+			dst := Rectangle{
+				X:      thumbBounds.X + (thumb-size.Width)/2,
+				Y:      thumbBounds.Y + (thumb-size.Height)/2,
+				Width:  size.Width,
+				Height: size.Height,
+			}
+
+			if err := canvas.DrawImageStretchedPixels(bitmap, dst); err != nil {
+				return err
+			}
+		} else {
+			if err := canvas.DrawRectanglePixels(framePen, thumbBounds); err != nil {
+				return err
+			}
+
+			if ok || !isThumbnailImageFile(path) {
+				ext := strings.ToUpper(strings.TrimPrefix(filepath.Ext(path), "."))
+				if err := canvas.DrawTextPixels(ext, font, textColor, thumbBounds, TextCenter|TextVCenter|TextSingleLine|TextNoPrefix); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := canvas.DrawTextPixels(filepath.Base(path), font, textColor, textBounds, TextCenter|TextSingleLine|TextEndEllipsis|TextNoPrefix); err != nil {
+			return err
+		}
+
+		if i == tg.currentIndex && focused {
+			r := Rectangle{cell.X + 1, cell.Y + 1, cell.Width - 2, cell.Height - 2}.toRECT()
+			win.DrawFocusRect(canvas.HDC(), &r)
+		}
+	}
+
+	if tg.rubberBanding {
+		band := tg.rubberBand
+		band.Y -= tg.scrollPos
+
+		pen, err := NewCosmeticPen(PenDot, Color(win.GetSysColor(win.COLOR_HIGHLIGHT)))
+		if err != nil {
+			return err
+		}
+		defer pen.Dispose()
+
+		if err := canvas.DrawRectanglePixels(pen, band); err != nil {
+			return err
+		}
+	}
+
+	tg.requestThumbnails()
+
+	return nil
+}
+
+func rectangleContains(r Rectangle, p Point) bool {
+	return p.X >= r.X && p.X < r.X+r.Width && p.Y >= r.Y && p.Y < r.Y+r.Height
+}
+
+func rectanglesIntersect(a, b Rectangle) bool {
+	return a.X < b.X+b.Width && b.X < a.X+a.Width && a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
+}
+
+func normalizedRectangle(from, to Point) Rectangle {
+	r := Rectangle{X: mini(from.X, to.X), Y: mini(from.Y, to.Y)}
+	r.Width = maxi(from.X, to.X) - r.X
+	r.Height = maxi(from.Y, to.Y) - r.Y
+
+	return r
+}
+
+func (tg *ThumbnailGrid) updateRubberBand(p Point) {
+	start := Point{tg.mouseDownPos.X, tg.mouseDownPos.Y}
+
+	tg.rubberBand = normalizedRectangle(start, Point{p.X, p.Y + tg.scrollPos})
+
+	selected := make([]bool, len(tg.filePaths))
+	copy(selected, tg.rubberBandBaseSelection)
+
+	for i := range tg.filePaths {
+		if rectanglesIntersect(tg.itemBounds(i), tg.rubberBand) {
+			selected[i] = !tg.rubberBandBaseSelection[i]
+		}
+	}
+
+	tg.setSelection(selected)
+	tg.Invalidate()
+}
+
+func (tg *ThumbnailGrid) beginDrag() {
+	paths := tg.SelectedFilePaths()
+	if len(paths) == 0 {
+		return
+	}
+
+	var pidls []uintptr
+	defer func() {
+		for _, pidl := range pidls {
+			ilFree(pidl)
+		}
+	}()
+
+	for _, path := range paths {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			continue
+		}
+
+		if pidl := ilCreateFromPath(syscall.StringToUTF16Ptr(abs)); pidl != 0 {
+			pidls = append(pidls, pidl)
+		}
+	}
+
+	if len(pidls) == 0 {
+		return
+	}
+
+	var itemsPtr unsafe.Pointer
+	if hr := shCreateShellItemArrayFromIDLists(uint32(len(pidls)), &pidls[0], &itemsPtr); win.FAILED(hr) {
+		errorFromHRESULT("SHCreateShellItemArrayFromIDLists", hr)
+		return
+	}
+	items := (*iShellItemArray)(itemsPtr)
+	defer syscall.Syscall(items.LpVtbl.Release, 1, uintptr(itemsPtr), 0, 0)
+
+	var dataObject *win.IUnknown
+	if hr, _, _ := syscall.Syscall6(items.LpVtbl.BindToHandler, 5,
+		uintptr(itemsPtr),
+		0,
+		uintptr(unsafe.Pointer(&bhidDataObject)),
+		uintptr(unsafe.Pointer(&iidIDataObject)),
+		uintptr(unsafe.Pointer(&dataObject)),
+		0); win.FAILED(win.HRESULT(hr)) {
+
+		errorFromHRESULT("IShellItemArray.BindToHandler(BHID_DataObject)", win.HRESULT(hr))
+		return
+	}
+	defer syscall.Syscall(dataObject.LpVtbl.Release, 1, uintptr(unsafe.Pointer(dataObject)), 0, 0)
+
+	var effect uint32
+	shDoDragDrop(tg.hWnd, unsafe.Pointer(dataObject), nil, dropEffectCopy|dropEffectLink, &effect)
+}
+
+func (tg *ThumbnailGrid) handleKeyDown(key Key) {
+	count := len(tg.filePaths)
+	if count == 0 {
+		return
+	}
+
+	cols := tg.columnCount()
+	pageItems := maxi(1, tg.ClientBoundsPixels().Height/tg.cellSize().Height) * cols
+	index := tg.currentIndex
+
+	switch key {
+	case KeyLeft:
+		index--
+
+	case KeyRight:
+		index++
+
+	case KeyUp:
+		index -= cols
+
+	case KeyDown:
+		index += cols
+
+	case KeyPrior:
+		index -= pageItems
+
+	case KeyNext:
+		index += pageItems
+
+	case KeyHome:
+		index = 0
+
+	case KeyEnd:
+		index = count - 1
+
+	case KeySpace:
+		if index >= 0 {
+			selected := append([]bool(nil), tg.selected...)
+			if ControlDown() {
+				selected[index] = !selected[index]
+			} else {
+				selected[index] = true
+			}
+			tg.setSelection(selected)
+		}
+		return
+
+	case KeyReturn:
+		if index >= 0 {
+			tg.itemActivatedPublisher.Publish()
+		}
+		return
+
+	case KeyA:
+		if ControlDown() {
+			tg.SelectAll()
+		}
+		return
+
+	default:
+		return
+	}
+
+	index = maxi(0, mini(count-1, index))
+
+	if ShiftDown() {
+		if tg.anchorIndex < 0 {
+			tg.anchorIndex = maxi(0, tg.currentIndex)
+		}
+		tg.selectRange(tg.anchorIndex, index, ControlDown())
+	} else {
+		tg.anchorIndex = index
+		if !ControlDown() {
+			tg.selectRange(index, index, false)
+		}
+	}
+
+	tg.setCurrentIndex(index)
+}
+
+func (tg *ThumbnailGrid) handleMouseDown(p Point) {
+	tg.SetFocus()
+
+	index := tg.indexAt(p)
+	tg.mouseDownPos = Point{p.X, p.Y + tg.scrollPos}
+	tg.mouseDownIndex = index
+
+	if index < 0 {
+		if !ControlDown() && !ShiftDown() {
+			tg.setSelection(make([]bool, len(tg.filePaths)))
+		}
+
+		tg.rubberBanding = true
+		tg.rubberBand = Rectangle{tg.mouseDownPos.X, tg.mouseDownPos.Y, 0, 0}
+		tg.rubberBandBaseSelection = append([]bool(nil), tg.selected...)
+		return
+	}
+
+	switch {
+	case ShiftDown():
+		if tg.anchorIndex < 0 {
+			tg.anchorIndex = index
+		}
+		tg.selectRange(tg.anchorIndex, index, ControlDown())
+
+	case ControlDown():
+		selected := append([]bool(nil), tg.selected...)
+		selected[index] = !selected[index]
+		tg.setSelection(selected)
+		tg.anchorIndex = index
+
+	case tg.selected[index]:
+		// Keep a multi selection until the mouse is released, so it can be
+		// dragged out.
+		tg.dragPending = true
+		tg.anchorIndex = index
+
+	default:
+		tg.selectRange(index, index, false)
+		tg.dragPending = true
+		tg.anchorIndex = index
+	}
+
+	tg.setCurrentIndex(index)
+}
+
+func (tg *ThumbnailGrid) handleMouseMove(p Point) {
+	switch {
+	case tg.rubberBanding:
+		height := tg.ClientBoundsPixels().Height
+		if p.Y < 0 {
+			tg.scrollTo(tg.scrollPos + p.Y)
+		} else if p.Y > height {
+			tg.scrollTo(tg.scrollPos + p.Y - height)
+		}
+
+		tg.updateRubberBand(p)
+
+	case tg.dragPending:
+		dx := int(win.GetSystemMetrics(win.SM_CXDRAG))
+		dy := int(win.GetSystemMetrics(win.SM_CYDRAG))
+
+		if absi(p.X-tg.mouseDownPos.X) > dx || absi(p.Y+tg.scrollPos-tg.mouseDownPos.Y) > dy {
+			tg.dragPending = false
+			win.ReleaseCapture()
+			tg.beginDrag()
+		}
+	}
+}
+
+func (tg *ThumbnailGrid) handleMouseUp() {
+	if tg.rubberBanding {
+		tg.rubberBanding = false
+		tg.rubberBandBaseSelection = nil
+		tg.Invalidate()
+	}
+
+	if tg.dragPending {
+		tg.dragPending = false
+
+		if !ControlDown() && !ShiftDown() && tg.mouseDownIndex >= 0 {
+			tg.selectRange(tg.mouseDownIndex, tg.mouseDownIndex, false)
+		}
+	}
+}
+
+func (*ThumbnailGrid) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
+	return NewGreedyLayoutItem()
+}
+
+func (tg *ThumbnailGrid) ApplyDPI(dpi int) {
+	tg.WidgetBase.ApplyDPI(dpi)
+
+	tg.updateScrollBar()
+}
+
+func (tg *ThumbnailGrid) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_PAINT:
+		var ps win.PAINTSTRUCT
+
+		hdc := win.BeginPaint(hwnd, &ps)
+		if hdc == 0 {
+			newError("BeginPaint failed")
+			break
+		}
+		defer win.EndPaint(hwnd, &ps)
+
+		canvas, err := newCanvasFromHDC(hdc)
+		if err != nil {
+			break
+		}
+		defer canvas.Dispose()
+
+		tg.paint(canvas, rectangleFromRECT(ps.RcPaint))
+
+		return 0
+
+	case win.WM_ERASEBKGND:
+		return 1
+
+	case win.WM_VSCROLL:
+		line := tg.cellSize().Height / 4
+		page := tg.ClientBoundsPixels().Height
+
+		switch win.LOWORD(uint32(wParam)) {
+		case win.SB_LINEUP:
+			tg.scrollTo(tg.scrollPos - line)
+
+		case win.SB_LINEDOWN:
+			tg.scrollTo(tg.scrollPos + line)
+
+		case win.SB_PAGEUP:
+			tg.scrollTo(tg.scrollPos - page)
+
+		case win.SB_PAGEDOWN:
+			tg.scrollTo(tg.scrollPos + page)
+
+		case win.SB_TOP:
+			tg.scrollTo(0)
+
+		case win.SB_BOTTOM:
+			tg.scrollTo(tg.contentHeight())
+
+		case win.SB_THUMBTRACK, win.SB_THUMBPOSITION:
+			var si win.SCROLLINFO
+			si.CbSize = uint32(unsafe.Sizeof(si))
+			si.FMask = win.SIF_TRACKPOS
+			win.GetScrollInfo(hwnd, win.SB_VERT, &si)
+
+			tg.scrollTo(int(si.NTrackPos))
+		}
+
+		return 0
+
+	case win.WM_MOUSEWHEEL:
+		delta := int(int16(win.HIWORD(uint32(wParam))))
+
+		if ControlDown() {
+			tg.SetThumbnailSize(tg.thumbnailSize + delta*16/120)
+		} else {
+			tg.scrollTo(tg.scrollPos - delta*tg.cellSize().Height/2/120)
+		}
+
+		return 0
+
+	case win.WM_LBUTTONDOWN:
+		tg.handleMouseDown(Point{int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))})
+
+	case win.WM_MOUSEMOVE:
+		if wParam&win.MK_LBUTTON != 0 {
+			tg.handleMouseMove(Point{int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))})
+		}
+
+	case win.WM_LBUTTONUP:
+		tg.handleMouseUp()
+
+	case win.WM_LBUTTONDBLCLK:
+		if index := tg.indexAt(Point{int(win.GET_X_LPARAM(lParam)), int(win.GET_Y_LPARAM(lParam))}); index >= 0 {
+			tg.itemActivatedPublisher.Publish()
+		}
+
+	case win.WM_GETDLGCODE:
+		return win.DLGC_WANTARROWS
+
+	case win.WM_KEYDOWN:
+		tg.handleKeyDown(Key(wParam))
+
+	case win.WM_SETFOCUS, win.WM_KILLFOCUS:
+		if tg.currentIndex >= 0 {
+			tg.invalidateItem(tg.currentIndex)
+		}
+
+	case win.WM_WINDOWPOSCHANGED:
+		wp := (*win.WINDOWPOS)(unsafe.Pointer(lParam))
+
+		if wp.Flags&win.SWP_NOSIZE != 0 {
+			break
+		}
+
+		tg.updateScrollBar()
+		tg.Invalidate()
+	}
+
+	return tg.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
+}
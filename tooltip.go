@@ -18,8 +18,26 @@ import (
 // 1024 instead.
 const maxToolTipTextLen = 1024 // including NUL terminator
 
+// ToolTipIcon is the icon displayed next to the title of a tool tip.
+type ToolTipIcon int
+
+const (
+	ToolTipIconNone    ToolTipIcon = win.TTI_NONE
+	ToolTipIconInfo    ToolTipIcon = win.TTI_INFO
+	ToolTipIconWarning ToolTipIcon = win.TTI_WARNING
+	ToolTipIconError   ToolTipIcon = win.TTI_ERROR
+)
+
+// toolTipTitle is the title of a single tool of a ToolTip.
+type toolTipTitle struct {
+	title string
+	icon  ToolTipIcon
+}
+
 type ToolTip struct {
 	WindowBase
+	hwnd2Title map[win.HWND]toolTipTitle
+	titleHwnd  win.HWND
 }
 
 func NewToolTip() (*ToolTip, error) {
@@ -34,7 +52,7 @@ func NewToolTip() (*ToolTip, error) {
 }
 
 func newToolTip(style uint32) (*ToolTip, error) {
-	tt := new(ToolTip)
+	tt := &ToolTip{hwnd2Title: make(map[win.HWND]toolTipTitle)}
 
 	if err := InitWindow(
 		tt,
@@ -199,6 +217,11 @@ func (tt *ToolTip) removeTool(hwnd win.HWND) error {
 
 	tt.SendMessage(win.TTM_DELTOOL, 0, uintptr(unsafe.Pointer(&ti)))
 
+	delete(tt.hwnd2Title, hwnd)
+	if hwnd == tt.titleHwnd {
+		tt.titleHwnd = 0
+	}
+
 	return nil
 }
 
@@ -242,9 +265,74 @@ func (tt *ToolTip) setText(hwnd win.HWND, text string) error {
 
 	tt.SendMessage(win.TTM_SETTOOLINFO, 0, uintptr(unsafe.Pointer(ti)))
 
+	tt.updateIfShowing(hwnd)
+
 	return nil
 }
 
+func (tt *ToolTip) toolTitle(hwnd win.HWND) toolTipTitle {
+	return tt.hwnd2Title[hwnd]
+}
+
+// setToolTitle sets the title and icon, that are displayed while the tool
+// tip is shown for the tool hwnd.
+func (tt *ToolTip) setToolTitle(hwnd win.HWND, title toolTipTitle) error {
+	if title.icon < ToolTipIconNone || title.icon > ToolTipIconError {
+		return newError("invalid icon")
+	}
+
+	if title == (toolTipTitle{}) {
+		delete(tt.hwnd2Title, hwnd)
+	} else {
+		tt.hwnd2Title[hwnd] = title
+	}
+
+	if hwnd == tt.titleHwnd {
+		tt.titleHwnd = 0
+	}
+
+	tt.updateIfShowing(hwnd)
+
+	return nil
+}
+
+// prepareTool applies the title of the tool hwnd, which the mouse is over, so
+// it is in place when the tool tip pops up.
+func (tt *ToolTip) prepareTool(hwnd win.HWND) {
+	if hwnd == tt.titleHwnd || tt.titleHwnd == 0 && len(tt.hwnd2Title) == 0 {
+		return
+	}
+
+	tt.titleHwnd = hwnd
+
+	title := tt.hwnd2Title[hwnd]
+	tt.setTitle(title.title, uintptr(title.icon))
+}
+
+// updateIfShowing updates the tool tip if it is currently shown for the tool
+// hwnd, so changes of text and title appear immediately.
+func (tt *ToolTip) updateIfShowing(hwnd win.HWND) {
+	if !win.IsWindowVisible(tt.hWnd) {
+		return
+	}
+
+	var ti win.TOOLINFO
+	ti.CbSize = uint32(unsafe.Sizeof(ti))
+
+	if win.FALSE == tt.SendMessage(win.TTM_GETCURRENTTOOL, 0, uintptr(unsafe.Pointer(&ti))) || ti.UId != uintptr(hwnd) {
+		return
+	}
+
+	if tt.text(hwnd) == "" {
+		tt.SendMessage(win.TTM_POP, 0, 0)
+		return
+	}
+
+	tt.prepareTool(hwnd)
+
+	tt.SendMessage(win.TTM_UPDATE, 0, 0)
+}
+
 func (tt *ToolTip) toolInfo(hwnd win.HWND) *win.TOOLINFO {
 	var ti win.TOOLINFO
 	var buf [maxToolTipTextLen]uint16
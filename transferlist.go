@@ -0,0 +1,726 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"sort"
+
+	"github.com/lxn/win"
+)
+
+// TransferList lets the user pick items from a model by moving them from a
+// list of available items to a list of selected items, like the columns of a
+// table or the permissions of a user.
+//
+// Items are moved with the buttons between the lists, by double-clicking them
+// or by dragging them to the other list. The order of the selected items can
+// be changed with the buttons next to that list or by dragging them.
+type TransferList struct {
+	*Composite
+	model                           ListModel
+	providedModel                   interface{}
+	displayMember                   string
+	selected                        []int
+	availableModel                  *transferListSideModel
+	selectedModel                   *transferListSideModel
+	availableLabel                  *Label
+	selectedLabel                   *Label
+	availableListBox                *ListBox
+	selectedListBox                 *ListBox
+	addButton                       *PushButton
+	addAllButton                    *PushButton
+	removeButton                    *PushButton
+	removeAllButton                 *PushButton
+	moveUpButton                    *PushButton
+	moveDownButton                  *PushButton
+	drag                            transferListDrag
+	itemsResetHandlerHandle         int
+	itemChangedHandlerHandle        int
+	itemsInsertedHandlerHandle      int
+	itemsRemovedHandlerHandle       int
+	selectedIndexesChangedPublisher EventPublisher
+}
+
+// transferListDrag tracks the items dragged from one of the lists.
+type transferListDrag struct {
+	source  *ListBox
+	start   Point
+	active  bool
+	indexes []int // in the model of the TransferList
+}
+
+// transferListSideModel is the model of one of the lists, that maps its items
+// to those of the model of the TransferList.
+type transferListSideModel struct {
+	ListModelBase
+	tl      *TransferList
+	indexes []int
+}
+
+func (m *transferListSideModel) ItemCount() int {
+	return len(m.indexes)
+}
+
+func (m *transferListSideModel) Value(index int) interface{} {
+	return m.tl.model.Value(m.indexes[index])
+}
+
+// NewTransferList creates and initializes a new TransferList.
+func NewTransferList(parent Container) (*TransferList, error) {
+	composite, err := NewCompositeWithStyle(parent, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	tl := &TransferList{Composite: composite}
+	tl.availableModel = &transferListSideModel{tl: tl}
+	tl.selectedModel = &transferListSideModel{tl: tl}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			tl.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(tl); err != nil {
+		return nil, err
+	}
+
+	layout := NewHBoxLayout()
+	layout.SetMargins(Margins{})
+	if err := tl.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	if tl.availableLabel, tl.availableListBox, err = tl.newList(tr("Available", "walk")); err != nil {
+		return nil, err
+	}
+
+	transferButtons, err := newTransferListButtonColumn(tl)
+	if err != nil {
+		return nil, err
+	}
+
+	if tl.addButton, err = newTransferListButton(transferButtons, ">", tl.addSelected); err != nil {
+		return nil, err
+	}
+	if tl.addAllButton, err = newTransferListButton(transferButtons, ">>", tl.addAll); err != nil {
+		return nil, err
+	}
+	if tl.removeButton, err = newTransferListButton(transferButtons, "<", tl.removeSelected); err != nil {
+		return nil, err
+	}
+	if tl.removeAllButton, err = newTransferListButton(transferButtons, "<<", tl.removeAll); err != nil {
+		return nil, err
+	}
+	if _, err := NewVSpacer(transferButtons); err != nil {
+		return nil, err
+	}
+
+	if tl.selectedLabel, tl.selectedListBox, err = tl.newList(tr("Selected", "walk")); err != nil {
+		return nil, err
+	}
+
+	orderButtons, err := newTransferListButtonColumn(tl)
+	if err != nil {
+		return nil, err
+	}
+
+	if tl.moveUpButton, err = newTransferListButton(orderButtons, tr("Move Up", "walk"), func() { tl.moveSelected(-1) }); err != nil {
+		return nil, err
+	}
+	if tl.moveDownButton, err = newTransferListButton(orderButtons, tr("Move Down", "walk"), func() { tl.moveSelected(1) }); err != nil {
+		return nil, err
+	}
+	if _, err := NewVSpacer(orderButtons); err != nil {
+		return nil, err
+	}
+
+	if err := tl.availableListBox.SetModel(tl.availableModel); err != nil {
+		return nil, err
+	}
+	if err := tl.selectedListBox.SetModel(tl.selectedModel); err != nil {
+		return nil, err
+	}
+
+	tl.availableListBox.ItemActivated().Attach(tl.addSelected)
+	tl.selectedListBox.ItemActivated().Attach(tl.removeSelected)
+
+	tl.MustRegisterProperty("SelectedIndexes", NewProperty(
+		func() interface{} {
+			return tl.SelectedIndexes()
+		},
+		func(v interface{}) error {
+			indexes, _ := v.([]int)
+			return tl.SetSelectedIndexes(indexes)
+		},
+		tl.selectedIndexesChangedPublisher.Event()))
+
+	tl.updateButtons()
+
+	succeeded = true
+
+	return tl, nil
+}
+
+// newList creates a column with a title and a ListBox.
+func (tl *TransferList) newList(title string) (*Label, *ListBox, error) {
+	column, err := NewComposite(tl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	layout := NewVBoxLayout()
+	layout.SetMargins(Margins{})
+	layout.SetSpacing(2)
+	if err := column.SetLayout(layout); err != nil {
+		return nil, nil, err
+	}
+
+	label, err := NewLabel(column)
+	if err != nil {
+		return nil, nil, err
+	}
+	label.SetText(title)
+
+	lb, err := NewListBoxWithStyle(column, win.LBS_EXTENDEDSEL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lb.SelectedIndexesChanged().Attach(tl.updateButtons)
+
+	lb.MouseDown().Attach(func(x, y int, button MouseButton) {
+		if button == LeftButton {
+			tl.drag = transferListDrag{source: lb, start: Point{x, y}}
+		}
+	})
+	lb.MouseMove().Attach(func(x, y int, button MouseButton) {
+		tl.onDragMove(lb, x, y, button)
+	})
+	lb.MouseUp().Attach(func(x, y int, button MouseButton) {
+		if button == LeftButton {
+			tl.onDrop(lb, x, y)
+		}
+	})
+
+	return label, lb, nil
+}
+
+func newTransferListButtonColumn(parent Container) (*Composite, error) {
+	column, err := NewComposite(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := NewVBoxLayout()
+	layout.SetMargins(Margins{})
+	if err := column.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	// Align the buttons with the top of the lists below their titles.
+	if _, err := NewVSpacerFixed(column, 16); err != nil {
+		return nil, err
+	}
+
+	return column, nil
+}
+
+func newTransferListButton(parent Container, text string, clicked func()) (*PushButton, error) {
+	pb, err := NewPushButton(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pb.SetText(text); err != nil {
+		return nil, err
+	}
+
+	pb.Clicked().Attach(clicked)
+
+	return pb, nil
+}
+
+// Model returns the model of the TransferList.
+func (tl *TransferList) Model() interface{} {
+	return tl.providedModel
+}
+
+// SetModel sets the model of the TransferList, which contains all items that
+// can be selected. The selection is cleared.
+//
+// It is required that mdl either implements walk.ListModel or
+// walk.ReflectListModel or be a slice of pointers to struct or a []string.
+func (tl *TransferList) SetModel(mdl interface{}) error {
+	model, ok := mdl.(ListModel)
+	if !ok && mdl != nil {
+		var err error
+		if model, err = newReflectListModel(mdl); err != nil {
+			return err
+		}
+
+		if _, ok := mdl.([]string); !ok {
+			if badms, ok := model.(bindingAndDisplayMemberSetter); ok {
+				badms.setDisplayMember(tl.displayMember)
+			}
+		}
+	}
+	tl.providedModel = mdl
+
+	if tl.model != nil {
+		tl.model.ItemsReset().Detach(tl.itemsResetHandlerHandle)
+		tl.model.ItemChanged().Detach(tl.itemChangedHandlerHandle)
+		tl.model.ItemsInserted().Detach(tl.itemsInsertedHandlerHandle)
+		tl.model.ItemsRemoved().Detach(tl.itemsRemovedHandlerHandle)
+	}
+
+	tl.model = model
+
+	if model != nil {
+		tl.itemsResetHandlerHandle = model.ItemsReset().Attach(tl.onItemsReset)
+		tl.itemChangedHandlerHandle = model.ItemChanged().Attach(tl.onItemChanged)
+		tl.itemsInsertedHandlerHandle = model.ItemsInserted().Attach(tl.onItemsInserted)
+		tl.itemsRemovedHandlerHandle = model.ItemsRemoved().Attach(tl.onItemsRemoved)
+	}
+
+	tl.update(nil, nil)
+
+	return nil
+}
+
+// DisplayMember returns the member from the model of the TransferList that is
+// displayed in its lists.
+//
+// This is only applicable to walk.ReflectListModel models and simple slices of
+// pointers to struct.
+func (tl *TransferList) DisplayMember() string {
+	return tl.displayMember
+}
+
+// SetDisplayMember sets the member from the model of the TransferList that is
+// displayed in its lists.
+//
+// This is only applicable to walk.ReflectListModel models and simple slices of
+// pointers to struct.
+func (tl *TransferList) SetDisplayMember(displayMember string) error {
+	if displayMember != "" {
+		if _, ok := tl.providedModel.([]string); ok {
+			return newError("invalid for []string model")
+		}
+	}
+
+	tl.displayMember = displayMember
+
+	if badms, ok := tl.model.(bindingAndDisplayMemberSetter); ok {
+		badms.setDisplayMember(displayMember)
+		tl.update(tl.selected, nil)
+	}
+
+	return nil
+}
+
+// AvailableTitle returns the title above the list of available items.
+func (tl *TransferList) AvailableTitle() string {
+	return tl.availableLabel.Text()
+}
+
+// SetAvailableTitle sets the title above the list of available items.
+func (tl *TransferList) SetAvailableTitle(title string) error {
+	return tl.availableLabel.SetText(title)
+}
+
+// SelectedTitle returns the title above the list of selected items.
+func (tl *TransferList) SelectedTitle() string {
+	return tl.selectedLabel.Text()
+}
+
+// SetSelectedTitle sets the title above the list of selected items.
+func (tl *TransferList) SetSelectedTitle(title string) error {
+	return tl.selectedLabel.SetText(title)
+}
+
+// SelectedIndexes returns the indexes in the model of the selected items, in
+// the order they are listed.
+func (tl *TransferList) SelectedIndexes() []int {
+	indexes := make([]int, len(tl.selected))
+	copy(indexes, tl.selected)
+
+	return indexes
+}
+
+// SetSelectedIndexes sets the indexes in the model of the selected items, in
+// the order they are listed.
+func (tl *TransferList) SetSelectedIndexes(indexes []int) error {
+	count := tl.itemCount()
+	seen := make(map[int]bool, len(indexes))
+
+	for _, index := range indexes {
+		if index < 0 || index >= count {
+			return newError("index out of range")
+		}
+		if seen[index] {
+			return newError("duplicate index")
+		}
+
+		seen[index] = true
+	}
+
+	tl.update(append([]int(nil), indexes...), nil)
+
+	return nil
+}
+
+// SelectedValues returns the values of the selected items, in the order they
+// are listed. If the model is a BindingValueProvider, its binding values are
+// returned.
+func (tl *TransferList) SelectedValues() []interface{} {
+	values := make([]interface{}, len(tl.selected))
+
+	bvp, _ := tl.model.(BindingValueProvider)
+
+	for i, index := range tl.selected {
+		if bvp != nil {
+			values[i] = bvp.BindingValue(index)
+		} else {
+			values[i] = tl.model.Value(index)
+		}
+	}
+
+	return values
+}
+
+// SelectedIndexesChanged returns the event that is published, when items
+// were selected, deselected or reordered.
+func (tl *TransferList) SelectedIndexesChanged() *Event {
+	return tl.selectedIndexesChangedPublisher.Event()
+}
+
+func (tl *TransferList) itemCount() int {
+	if tl.model == nil {
+		return 0
+	}
+
+	return tl.model.ItemCount()
+}
+
+// update sets the selected items and refreshes the lists. The items at
+// highlight in the model are selected in the lists.
+func (tl *TransferList) update(selected, highlight []int) {
+	changed := len(selected) != len(tl.selected)
+	if !changed {
+		for i, index := range selected {
+			if tl.selected[i] != index {
+				changed = true
+				break
+			}
+		}
+	}
+
+	tl.selected = selected
+
+	isSelected := make(map[int]bool, len(selected))
+	for _, index := range selected {
+		isSelected[index] = true
+	}
+
+	count := tl.itemCount()
+	available := make([]int, 0, count-len(selected))
+	for i := 0; i < count; i++ {
+		if !isSelected[i] {
+			available = append(available, i)
+		}
+	}
+
+	tl.availableModel.indexes = available
+	tl.selectedModel.indexes = append([]int(nil), selected...)
+
+	tl.availableModel.PublishItemsReset()
+	tl.selectedModel.PublishItemsReset()
+
+	tl.highlight(tl.availableListBox, tl.availableModel, highlight)
+	tl.highlight(tl.selectedListBox, tl.selectedModel, highlight)
+
+	tl.updateButtons()
+
+	if changed {
+		tl.selectedIndexesChangedPublisher.Publish()
+	}
+}
+
+// highlight selects the items at indexes in the model in lb.
+func (tl *TransferList) highlight(lb *ListBox, model *transferListSideModel, indexes []int) {
+	if len(indexes) == 0 {
+		return
+	}
+
+	wanted := make(map[int]bool, len(indexes))
+	for _, index := range indexes {
+		wanted[index] = true
+	}
+
+	var positions []int
+	for pos, index := range model.indexes {
+		if wanted[index] {
+			positions = append(positions, pos)
+		}
+	}
+
+	if len(positions) > 0 {
+		lb.SetSelectedIndexes(positions)
+		lb.EnsureItemVisible(positions[0])
+	}
+}
+
+// modelIndexes returns the indexes in the model of the items selected in lb.
+func (tl *TransferList) modelIndexes(lb *ListBox, model *transferListSideModel) []int {
+	positions := lb.SelectedIndexes()
+
+	indexes := make([]int, 0, len(positions))
+	for _, pos := range positions {
+		if pos >= 0 && pos < len(model.indexes) {
+			indexes = append(indexes, model.indexes[pos])
+		}
+	}
+
+	return indexes
+}
+
+func (tl *TransferList) updateButtons() {
+	availableSel := tl.availableListBox.SelectedIndexes()
+	selectedSel := tl.selectedListBox.SelectedIndexes()
+
+	tl.addButton.SetEnabled(len(availableSel) > 0)
+	tl.addAllButton.SetEnabled(len(tl.availableModel.indexes) > 0)
+	tl.removeButton.SetEnabled(len(selectedSel) > 0)
+	tl.removeAllButton.SetEnabled(len(tl.selected) > 0)
+
+	sort.Ints(selectedSel)
+	tl.moveUpButton.SetEnabled(len(selectedSel) > 0 && selectedSel[0] > 0)
+	tl.moveDownButton.SetEnabled(len(selectedSel) > 0 && selectedSel[len(selectedSel)-1] < len(tl.selected)-1)
+}
+
+func (tl *TransferList) addSelected() {
+	tl.insertSelected(tl.modelIndexes(tl.availableListBox, tl.availableModel), -1)
+}
+
+func (tl *TransferList) addAll() {
+	tl.insertSelected(tl.availableModel.indexes, -1)
+}
+
+func (tl *TransferList) removeSelected() {
+	tl.deselect(tl.modelIndexes(tl.selectedListBox, tl.selectedModel))
+}
+
+func (tl *TransferList) removeAll() {
+	tl.deselect(tl.selected)
+}
+
+// insertSelected inserts the items at indexes in the model into the list of
+// selected items before position, moving those already selected. A position
+// of -1 appends them.
+func (tl *TransferList) insertSelected(indexes []int, position int) {
+	if len(indexes) == 0 {
+		return
+	}
+
+	if position < 0 || position > len(tl.selected) {
+		position = len(tl.selected)
+	}
+
+	moving := make(map[int]bool, len(indexes))
+	for _, index := range indexes {
+		moving[index] = true
+	}
+
+	rest := make([]int, 0, len(tl.selected))
+	insertAt := 0
+	for pos, index := range tl.selected {
+		if moving[index] {
+			continue
+		}
+
+		if pos < position {
+			insertAt++
+		}
+		rest = append(rest, index)
+	}
+
+	selected := make([]int, 0, len(rest)+len(indexes))
+	selected = append(selected, rest[:insertAt]...)
+	selected = append(selected, indexes...)
+	selected = append(selected, rest[insertAt:]...)
+
+	tl.update(selected, append([]int(nil), indexes...))
+}
+
+// deselect moves the items at indexes in the model to the list of available
+// items.
+func (tl *TransferList) deselect(indexes []int) {
+	if len(indexes) == 0 {
+		return
+	}
+
+	removing := make(map[int]bool, len(indexes))
+	for _, index := range indexes {
+		removing[index] = true
+	}
+
+	selected := make([]int, 0, len(tl.selected))
+	for _, index := range tl.selected {
+		if !removing[index] {
+			selected = append(selected, index)
+		}
+	}
+
+	tl.update(selected, append([]int(nil), indexes...))
+}
+
+// moveSelected moves the items selected in the list of selected items up
+// (delta -1) or down (delta 1) by one position.
+func (tl *TransferList) moveSelected(delta int) {
+	positions := tl.selectedListBox.SelectedIndexes()
+	if len(positions) == 0 {
+		return
+	}
+
+	sort.Ints(positions)
+	if delta > 0 {
+		sort.Sort(sort.Reverse(sort.IntSlice(positions)))
+	}
+
+	selected := append([]int(nil), tl.selected...)
+	moved := make([]int, 0, len(positions))
+
+	for _, pos := range positions {
+		to := pos + delta
+		if to < 0 || to >= len(selected) {
+			return
+		}
+
+		selected[pos], selected[to] = selected[to], selected[pos]
+		moved = append(moved, selected[to])
+	}
+
+	tl.update(selected, moved)
+}
+
+func (tl *TransferList) onDragMove(lb *ListBox, x, y int, button MouseButton) {
+	if tl.drag.source != lb || button&LeftButton == 0 || tl.drag.active {
+		return
+	}
+
+	dx, dy := x-tl.drag.start.X, y-tl.drag.start.Y
+	if dx < 0 {
+		dx = -dx
+	}
+	if dy < 0 {
+		dy = -dy
+	}
+
+	if dx <= int(win.GetSystemMetrics(win.SM_CXDRAG)) && dy <= int(win.GetSystemMetrics(win.SM_CYDRAG)) {
+		return
+	}
+
+	// The list box extends its selection while the mouse moves, so the items
+	// selected when the drag starts are the dragged ones.
+	model := tl.availableModel
+	if lb == tl.selectedListBox {
+		model = tl.selectedModel
+	}
+
+	tl.drag.indexes = tl.modelIndexes(lb, model)
+	tl.drag.active = len(tl.drag.indexes) > 0
+}
+
+func (tl *TransferList) onDrop(lb *ListBox, x, y int) {
+	drag := tl.drag
+	tl.drag = transferListDrag{}
+
+	if drag.source != lb || !drag.active {
+		return
+	}
+
+	pt := win.POINT{X: int32(x), Y: int32(y)}
+	win.ClientToScreen(lb.hWnd, &pt)
+
+	switch win.WindowFromPoint(pt) {
+	case tl.selectedListBox.hWnd:
+		win.ScreenToClient(tl.selectedListBox.hWnd, &pt)
+
+		position := len(tl.selected)
+		ret := tl.selectedListBox.SendMessage(win.LB_ITEMFROMPOINT, 0, uintptr(uint16(pt.X))|uintptr(uint16(pt.Y))<<16)
+		if win.HIWORD(uint32(ret)) == 0 {
+			position = int(win.LOWORD(uint32(ret)))
+		}
+
+		tl.insertSelected(drag.indexes, position)
+
+	case tl.availableListBox.hWnd:
+		if lb == tl.selectedListBox {
+			tl.deselect(drag.indexes)
+		}
+	}
+}
+
+func (tl *TransferList) onItemsReset() {
+	count := tl.itemCount()
+
+	selected := make([]int, 0, len(tl.selected))
+	for _, index := range tl.selected {
+		if index < count {
+			selected = append(selected, index)
+		}
+	}
+
+	tl.update(selected, nil)
+}
+
+func (tl *TransferList) onItemChanged(index int) {
+	for _, model := range []*transferListSideModel{tl.availableModel, tl.selectedModel} {
+		for pos, i := range model.indexes {
+			if i == index {
+				model.PublishItemChanged(pos)
+			}
+		}
+	}
+}
+
+func (tl *TransferList) onItemsInserted(from, to int) {
+	selected := make([]int, len(tl.selected))
+	for i, index := range tl.selected {
+		if index >= from {
+			index += to - from + 1
+		}
+		selected[i] = index
+	}
+
+	// Only the indexes changed, not the selected items.
+	tl.selected = selected
+	tl.update(selected, nil)
+}
+
+func (tl *TransferList) onItemsRemoved(from, to int) {
+	selected := make([]int, 0, len(tl.selected))
+	for _, index := range tl.selected {
+		if index >= from && index <= to {
+			continue
+		}
+		if index > to {
+			index -= to - from + 1
+		}
+		selected = append(selected, index)
+	}
+
+	if len(selected) == len(tl.selected) {
+		// Only the indexes changed, not the selected items.
+		tl.selected = selected
+	}
+
+	tl.update(selected, nil)
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"math"
+)
+
+// Transform is an affine transformation of 2D coordinates, like a rotation,
+// scaling, translation or a combination of them. It maps a point (x, y) to
+// (x*M11 + y*M21 + Dx, x*M12 + y*M22 + Dy).
+//
+// Transforms are applied to the drawing of a Canvas with its PushTransform
+// method. As the y axis points down, positive rotation angles rotate
+// clockwise.
+type Transform struct {
+	M11, M12 float64
+	M21, M22 float64
+	Dx, Dy   float64
+}
+
+// IdentityTransform returns the Transform that maps every point to itself.
+func IdentityTransform() Transform {
+	return Transform{M11: 1, M22: 1}
+}
+
+// TranslationTransform returns a Transform that moves points by dx, dy.
+func TranslationTransform(dx, dy float64) Transform {
+	return Transform{M11: 1, M22: 1, Dx: dx, Dy: dy}
+}
+
+// ScalingTransform returns a Transform that scales points by sx, sy relative
+// to the origin. Negative factors mirror.
+func ScalingTransform(sx, sy float64) Transform {
+	return Transform{M11: sx, M22: sy}
+}
+
+// RotationTransform returns a Transform that rotates points by degrees around
+// the origin.
+func RotationTransform(degrees float64) Transform {
+	sin, cos := math.Sincos(degrees * math.Pi / 180)
+
+	return Transform{M11: cos, M12: sin, M21: -sin, M22: cos}
+}
+
+// Then returns the Transform that applies t first and then u.
+func (t Transform) Then(u Transform) Transform {
+	return Transform{
+		M11: t.M11*u.M11 + t.M12*u.M21,
+		M12: t.M11*u.M12 + t.M12*u.M22,
+		M21: t.M21*u.M11 + t.M22*u.M21,
+		M22: t.M21*u.M12 + t.M22*u.M22,
+		Dx:  t.Dx*u.M11 + t.Dy*u.M21 + u.Dx,
+		Dy:  t.Dx*u.M12 + t.Dy*u.M22 + u.Dy,
+	}
+}
+
+// Translate returns the Transform that applies t and then moves by dx, dy.
+func (t Transform) Translate(dx, dy float64) Transform {
+	return t.Then(TranslationTransform(dx, dy))
+}
+
+// Scale returns the Transform that applies t and then scales by sx, sy.
+func (t Transform) Scale(sx, sy float64) Transform {
+	return t.Then(ScalingTransform(sx, sy))
+}
+
+// Rotate returns the Transform that applies t and then rotates by degrees
+// around the origin.
+func (t Transform) Rotate(degrees float64) Transform {
+	return t.Then(RotationTransform(degrees))
+}
+
+// RotateAt returns the Transform that applies t and then rotates by degrees
+// around center.
+func (t Transform) RotateAt(degrees float64, center Point) Transform {
+	cx, cy := float64(center.X), float64(center.Y)
+
+	return t.Translate(-cx, -cy).Rotate(degrees).Translate(cx, cy)
+}
+
+// Invert returns the Transform that undoes t. It returns false if t cannot be
+// undone, e.g. because it scales by 0.
+func (t Transform) Invert() (Transform, bool) {
+	det := t.M11*t.M22 - t.M12*t.M21
+	if det == 0 {
+		return Transform{}, false
+	}
+
+	return Transform{
+		M11: t.M22 / det,
+		M12: -t.M12 / det,
+		M21: -t.M21 / det,
+		M22: t.M11 / det,
+		Dx:  (t.M21*t.Dy - t.M22*t.Dx) / det,
+		Dy:  (t.M12*t.Dx - t.M11*t.Dy) / det,
+	}, true
+}
+
+// IsIdentity returns if t maps every point to itself.
+func (t Transform) IsIdentity() bool {
+	return t == IdentityTransform()
+}
+
+// TransformPoint returns p mapped by t, rounded to the nearest point.
+func (t Transform) TransformPoint(p Point) Point {
+	x, y := float64(p.X), float64(p.Y)
+
+	return Point{
+		int(math.Round(x*t.M11 + y*t.M21 + t.Dx)),
+		int(math.Round(x*t.M12 + y*t.M22 + t.Dy)),
+	}
+}
+
+// transformBounds returns the smallest rectangle containing r mapped by t.
+func (t Transform) transformBounds(r Rectangle) Rectangle {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+
+	for _, p := range [...][2]float64{
+		{float64(r.X), float64(r.Y)},
+		{float64(r.X + r.Width), float64(r.Y)},
+		{float64(r.X), float64(r.Y + r.Height)},
+		{float64(r.X + r.Width), float64(r.Y + r.Height)},
+	} {
+		x := p[0]*t.M11 + p[1]*t.M21 + t.Dx
+		y := p[0]*t.M12 + p[1]*t.M22 + t.Dy
+
+		minX, minY = math.Min(minX, x), math.Min(minY, y)
+		maxX, maxY = math.Max(maxX, x), math.Max(maxY, y)
+	}
+
+	left, top := int(math.Floor(minX)), int(math.Floor(minY))
+
+	return Rectangle{left, top, int(math.Ceil(maxX)) - left, int(math.Ceil(maxY)) - top}
+}
+
+// forDPI returns t with its translation, which is in 1/96" units, in native
+// pixels for dpi.
+func (t Transform) forDPI(dpi int) Transform {
+	factor := float64(dpi) / 96
+
+	t.Dx *= factor
+	t.Dy *= factor
+
+	return t
+}
+
+func transformFromXFORM(xf xform) Transform {
+	return Transform{
+		M11: float64(xf.EM11),
+		M12: float64(xf.EM12),
+		M21: float64(xf.EM21),
+		M22: float64(xf.EM22),
+		Dx:  float64(xf.EDx),
+		Dy:  float64(xf.EDy),
+	}
+}
+
+func (t Transform) toXFORM() xform {
+	return xform{
+		EM11: float32(t.M11),
+		EM12: float32(t.M12),
+		EM21: float32(t.M21),
+		EM22: float32(t.M22),
+		EDx:  float32(t.Dx),
+		EDy:  float32(t.Dy),
+	}
+}
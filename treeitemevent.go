@@ -9,6 +9,7 @@ package walk
 type treeItemEventHandlerInfo struct {
 	handler TreeItemEventHandler
 	once    bool
+	scope   eventHandlerScope // see AttachScoped
 }
 
 type TreeItemEventHandler func(item TreeItem)
@@ -18,7 +19,7 @@ type TreeItemEvent struct {
 }
 
 func (e *TreeItemEvent) Attach(handler TreeItemEventHandler) int {
-	handlerInfo := treeItemEventHandlerInfo{handler, false}
+	handlerInfo := treeItemEventHandlerInfo{handler: handler}
 
 	for i, h := range e.handlers {
 		if h.handler == nil {
@@ -33,6 +34,7 @@ func (e *TreeItemEvent) Attach(handler TreeItemEventHandler) int {
 }
 
 func (e *TreeItemEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
 	e.handlers[handle].handler = nil
 }
 
@@ -41,6 +43,17 @@ func (e *TreeItemEvent) Once(handler TreeItemEventHandler) {
 	e.handlers[i].once = true
 }
 
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *TreeItemEvent) AttachScoped(owner Window, handler TreeItemEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
 type TreeItemEventPublisher struct {
 	event TreeItemEvent
 }
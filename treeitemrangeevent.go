@@ -0,0 +1,75 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+type treeItemRangeEventHandlerInfo struct {
+	handler TreeItemRangeEventHandler
+	once    bool
+	scope   eventHandlerScope // see AttachScoped
+}
+
+type TreeItemRangeEventHandler func(parent TreeItem, from, to int)
+
+type TreeItemRangeEvent struct {
+	handlers []treeItemRangeEventHandlerInfo
+}
+
+func (e *TreeItemRangeEvent) Attach(handler TreeItemRangeEventHandler) int {
+	handlerInfo := treeItemRangeEventHandlerInfo{handler: handler}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *TreeItemRangeEvent) Detach(handle int) {
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+func (e *TreeItemRangeEvent) Once(handler TreeItemRangeEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *TreeItemRangeEvent) AttachScoped(owner Window, handler TreeItemRangeEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
+}
+
+type TreeItemRangeEventPublisher struct {
+	event TreeItemRangeEvent
+}
+
+func (p *TreeItemRangeEventPublisher) Event() *TreeItemRangeEvent {
+	return &p.event
+}
+
+func (p *TreeItemRangeEventPublisher) Publish(parent TreeItem, from, to int) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(parent, from, to)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
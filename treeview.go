@@ -7,12 +7,15 @@
 package walk
 
 import (
-	"syscall"
 	"unsafe"
 
 	"github.com/lxn/win"
 )
 
+// maxPooledTreeViewItemInfos limits the number of item infos a TreeView keeps
+// for reuse after its items were removed.
+const maxPooledTreeViewItemInfos = 65536
+
 type treeViewItemInfo struct {
 	handle       win.HTREEITEM
 	child2Handle map[TreeItem]win.HTREEITEM
@@ -20,22 +23,32 @@ type treeViewItemInfo struct {
 
 type TreeView struct {
 	WidgetBase
-	model                          TreeModel
-	lazyPopulation                 bool
-	itemsResetEventHandlerHandle   int
-	itemChangedEventHandlerHandle  int
-	itemInsertedEventHandlerHandle int
-	itemRemovedEventHandlerHandle  int
-	item2Info                      map[TreeItem]*treeViewItemInfo
-	handle2Item                    map[win.HTREEITEM]TreeItem
-	currItem                       TreeItem
-	hIml                           win.HIMAGELIST
-	usingSysIml                    bool
-	imageUintptr2Index             map[uintptr]int32
-	filePath2IconIndex             map[string]int32
-	expandedChangedPublisher       TreeItemEventPublisher
-	currentItemChangedPublisher    EventPublisher
-	itemActivatedPublisher         EventPublisher
+	model                           TreeModel
+	lazyPopulation                  bool
+	itemsResetEventHandlerHandle    int
+	itemChangedEventHandlerHandle   int
+	itemInsertedEventHandlerHandle  int
+	itemsInsertedEventHandlerHandle int
+	itemRemovedEventHandlerHandle   int
+	modelValidator                  *ModelValidator
+	item2Info                       map[TreeItem]*treeViewItemInfo
+	handle2Item                     map[win.HTREEITEM]TreeItem
+	infoPool                        []*treeViewItemInfo
+	updateCount                     int
+	currItem                        TreeItem
+	hIml                            win.HIMAGELIST
+	usingSysIml                     bool
+	imageUintptr2Index              map[uintptr]int32
+	filePath2IconIndex              map[string]int32
+	imageList                       *ManagedImageList
+	imageListDPI                    int
+	imageListChangedHandle          int
+	searchMode                      SearchMode
+	typeAhead                       typeAhead
+	findBar                         *findBar
+	expandedChangedPublisher        TreeItemEventPublisher
+	currentItemChangedPublisher     EventPublisher
+	itemActivatedPublisher          EventPublisher
 }
 
 func NewTreeView(parent Container) (*TreeView, error) {
@@ -100,6 +113,11 @@ func NewTreeView(parent Container) (*TreeView, error) {
 }
 
 func (tv *TreeView) Dispose() {
+	if tv.findBar != nil {
+		tv.findBar.dispose()
+		tv.findBar = nil
+	}
+
 	tv.WidgetBase.Dispose()
 
 	tv.disposeImageListAndCaches()
@@ -132,8 +150,16 @@ func (tv *TreeView) SetModel(model TreeModel) error {
 		tv.model.ItemsReset().Detach(tv.itemsResetEventHandlerHandle)
 		tv.model.ItemChanged().Detach(tv.itemChangedEventHandlerHandle)
 		tv.model.ItemInserted().Detach(tv.itemInsertedEventHandlerHandle)
+		if inserter, ok := tv.model.(TreeModelItemsInserter); ok {
+			inserter.ItemsInserted().Detach(tv.itemsInsertedEventHandlerHandle)
+		}
 		tv.model.ItemRemoved().Detach(tv.itemRemovedEventHandlerHandle)
 
+		if tv.modelValidator != nil {
+			tv.modelValidator.Dispose()
+			tv.modelValidator = nil
+		}
+
 		tv.disposeImageListAndCaches()
 	}
 
@@ -142,12 +168,18 @@ func (tv *TreeView) SetModel(model TreeModel) error {
 	if model != nil {
 		tv.lazyPopulation = model.LazyPopulation()
 
+		if validateModels {
+			// Attached first, so violations are reported before the handlers
+			// below trip over them.
+			tv.modelValidator = NewTreeModelValidator(model)
+		}
+
 		tv.itemsResetEventHandlerHandle = model.ItemsReset().Attach(func(parent TreeItem) {
 			if parent == nil {
 				tv.resetItems()
 			} else if tv.item2Info[parent] != nil {
-				tv.SetSuspended(true)
-				defer tv.SetSuspended(false)
+				tv.BeginUpdate()
+				defer tv.EndUpdate()
 
 				if err := tv.removeDescendants(parent); err != nil {
 					return
@@ -170,8 +202,8 @@ func (tv *TreeView) SetModel(model TreeModel) error {
 		})
 
 		tv.itemInsertedEventHandlerHandle = model.ItemInserted().Attach(func(item TreeItem) {
-			tv.SetSuspended(true)
-			defer tv.SetSuspended(false)
+			tv.BeginUpdate()
+			defer tv.EndUpdate()
 
 			var hInsertAfter win.HTREEITEM
 			parent := item.Parent()
@@ -190,6 +222,14 @@ func (tv *TreeView) SetModel(model TreeModel) error {
 			}
 		})
 
+		if inserter, ok := model.(TreeModelItemsInserter); ok {
+			tv.itemsInsertedEventHandlerHandle = inserter.ItemsInserted().Attach(func(parent TreeItem, from, to int) {
+				if err := tv.insertItemRange(parent, from, to); err != nil {
+					return
+				}
+			})
+		}
+
 		tv.itemRemovedEventHandlerHandle = model.ItemRemoved().Attach(func(item TreeItem) {
 			if err := tv.removeItem(item); err != nil {
 				return
@@ -275,9 +315,41 @@ func (tv *TreeView) SetItemHeight(height int) {
 	tv.SendMessage(win.TVM_SETITEMHEIGHT, uintptr(height), 0)
 }
 
+// BeginUpdate stops the TreeView from redrawing, until a matching call to
+// EndUpdate. Calls may be nested.
+//
+// Wrap the population of a tree with many items in BeginUpdate and EndUpdate,
+// to redraw it only once.
+func (tv *TreeView) BeginUpdate() {
+	tv.updateCount++
+
+	if tv.updateCount == 1 {
+		tv.SetSuspended(true)
+	}
+}
+
+// EndUpdate ends an update started by BeginUpdate. After the outermost
+// update, the TreeView is redrawn.
+func (tv *TreeView) EndUpdate() {
+	if tv.updateCount == 0 {
+		return
+	}
+
+	tv.updateCount--
+
+	if tv.updateCount == 0 {
+		tv.SetSuspended(false)
+	}
+}
+
+// Updating returns if the TreeView is between BeginUpdate and EndUpdate.
+func (tv *TreeView) Updating() bool {
+	return tv.updateCount > 0
+}
+
 func (tv *TreeView) resetItems() error {
-	tv.SetSuspended(true)
-	defer tv.SetSuspended(false)
+	tv.BeginUpdate()
+	defer tv.EndUpdate()
 
 	if err := tv.clearItems(); err != nil {
 		return err
@@ -299,12 +371,45 @@ func (tv *TreeView) clearItems() error {
 		return newError("SendMessage(TVM_DELETEITEM) failed")
 	}
 
+	for _, info := range tv.item2Info {
+		tv.releaseItemInfo(info)
+	}
+
 	tv.item2Info = make(map[TreeItem]*treeViewItemInfo)
 	tv.handle2Item = make(map[win.HTREEITEM]TreeItem)
 
 	return nil
 }
 
+// newItemInfo returns an item info for handle, reusing one of a removed item
+// if possible.
+func (tv *TreeView) newItemInfo(handle win.HTREEITEM) *treeViewItemInfo {
+	if n := len(tv.infoPool); n > 0 {
+		info := tv.infoPool[n-1]
+		tv.infoPool[n-1] = nil
+		tv.infoPool = tv.infoPool[:n-1]
+
+		info.handle = handle
+
+		return info
+	}
+
+	return &treeViewItemInfo{handle: handle}
+}
+
+func (tv *TreeView) releaseItemInfo(info *treeViewItemInfo) {
+	if len(tv.infoPool) >= maxPooledTreeViewItemInfos {
+		return
+	}
+
+	info.handle = 0
+	for child := range info.child2Handle {
+		delete(info.child2Handle, child)
+	}
+
+	tv.infoPool = append(tv.infoPool, info)
+}
+
 func (tv *TreeView) insertRoots() error {
 	for i := tv.model.RootCount() - 1; i >= 0; i-- {
 		if _, err := tv.insertItem(tv.model.RootAt(i)); err != nil {
@@ -318,11 +423,78 @@ func (tv *TreeView) insertRoots() error {
 func (tv *TreeView) ApplyDPI(dpi int) {
 	tv.WidgetBase.ApplyDPI(dpi)
 
+	if tv.findBar.visible() {
+		tv.findBar.applyFont(tv.Font(), dpi)
+	}
+
 	tv.disposeImageListAndCaches()
+
+	if tv.imageList != nil {
+		tv.applyImageListForImage(nil)
+	}
+}
+
+func (tv *TreeView) ApplySysColors() {
+	tv.WidgetBase.ApplySysColors()
+
+	if tv.imageList != nil {
+		tv.imageList.updateSysColorDependent()
+	}
+}
+
+// ImageList returns the ManagedImageList the images of the items are drawn
+// from, or nil if the TreeView manages its images itself.
+func (tv *TreeView) ImageList() *ManagedImageList {
+	return tv.imageList
+}
+
+// SetImageList sets a ManagedImageList, that the images of the items are added
+// to and drawn from, so they are rendered for the DPI of the TreeView and can
+// be shared with other views. If l is nil, the TreeView manages its images
+// itself.
+func (tv *TreeView) SetImageList(l *ManagedImageList) error {
+	if l == tv.imageList {
+		return nil
+	}
+
+	tv.disposeImageListAndCaches()
+
+	if tv.imageList != nil {
+		tv.imageList.Changed().Detach(tv.imageListChangedHandle)
+		tv.replaceResource(tv.imageList, nil)
+	}
+
+	tv.imageList = l
+
+	if l != nil {
+		tv.replaceResource(nil, l)
+		tv.imageListChangedHandle = l.Changed().AttachScoped(tv, func() {
+			tv.Invalidate()
+		})
+
+		tv.applyImageListForImage(nil)
+	}
+
+	for item := range tv.item2Info {
+		if err := tv.updateItem(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (tv *TreeView) applyImageListForImage(image interface{}) {
-	tv.hIml, tv.usingSysIml, _ = imageListForImage(image, tv.DPI())
+	if tv.imageList != nil {
+		hIml, err := tv.imageList.acquire(tv.DPI())
+		if err != nil {
+			wrapError(err)
+			return
+		}
+		tv.hIml, tv.usingSysIml, tv.imageListDPI = hIml, false, tv.DPI()
+	} else {
+		tv.hIml, tv.usingSysIml, _ = imageListForImage(image, tv.DPI())
+	}
 
 	tv.SendMessage(win.TVM_SETIMAGELIST, 0, uintptr(tv.hIml))
 
@@ -331,7 +503,13 @@ func (tv *TreeView) applyImageListForImage(image interface{}) {
 }
 
 func (tv *TreeView) disposeImageListAndCaches() {
-	if tv.hIml != 0 && !tv.usingSysIml {
+	if tv.imageList != nil && tv.hIml != 0 {
+		if tv.hWnd != 0 {
+			tv.SendMessage(win.TVM_SETIMAGELIST, 0, 0)
+		}
+
+		tv.imageList.release(tv.imageListDPI)
+	} else if tv.hIml != 0 && !tv.usingSysIml {
 		win.ImageList_Destroy(tv.hIml)
 	}
 	tv.hIml = 0
@@ -350,13 +528,17 @@ func (tv *TreeView) setTVITEMImageInfo(tvi *win.TVITEM, item TreeItem) {
 		// some default icon will show up, even though we have not asked for it.
 
 		tvi.Mask |= win.TVIF_IMAGE | win.TVIF_SELECTEDIMAGE
-		tvi.IImage = imageIndexMaybeAdd(
-			imager.Image(),
-			tv.hIml,
-			tv.usingSysIml,
-			tv.imageUintptr2Index,
-			tv.filePath2IconIndex,
-			tv.DPI())
+		if tv.imageList != nil {
+			tvi.IImage = tv.imageList.indexMaybeAdd(imager.Image())
+		} else {
+			tvi.IImage = imageIndexMaybeAdd(
+				imager.Image(),
+				tv.hIml,
+				tv.usingSysIml,
+				tv.imageUintptr2Index,
+				tv.filePath2IconIndex,
+				tv.DPI())
+		}
 
 		tvi.ISelectedImage = tvi.IImage
 	}
@@ -394,9 +576,17 @@ func (tv *TreeView) insertItemAfter(item TreeItem, hInsertAfter win.HTREEITEM) (
 	if hItem == 0 {
 		return 0, newError("TVM_INSERTITEM failed")
 	}
-	tv.item2Info[item] = &treeViewItemInfo{hItem, make(map[TreeItem]win.HTREEITEM)}
+	tv.item2Info[item] = tv.newItemInfo(hItem)
 	tv.handle2Item[hItem] = item
 
+	if parent != nil {
+		info := tv.item2Info[parent]
+		if info.child2Handle == nil {
+			info.child2Handle = make(map[TreeItem]win.HTREEITEM)
+		}
+		info.child2Handle[item] = hItem
+	}
+
 	if !tv.lazyPopulation {
 		if err := tv.insertChildren(item); err != nil {
 			return 0, err
@@ -407,16 +597,77 @@ func (tv *TreeView) insertItemAfter(item TreeItem, hInsertAfter win.HTREEITEM) (
 }
 
 func (tv *TreeView) insertChildren(parent TreeItem) error {
-	info := tv.item2Info[parent]
-
 	for i := parent.ChildCount() - 1; i >= 0; i-- {
-		child := parent.ChildAt(i)
+		if _, err := tv.insertItem(parent.ChildAt(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertItemRange inserts the children of parent, or the roots if parent is
+// nil, from index from to index to, that were inserted into the model.
+func (tv *TreeView) insertItemRange(parent TreeItem, from, to int) error {
+	count := tv.model.RootCount()
+	childAt := tv.model.RootAt
+	if parent != nil {
+		info := tv.item2Info[parent]
+		if info == nil {
+			// The parent is not populated yet, so its children are inserted
+			// with it.
+			return nil
+		}
+
+		if tv.lazyPopulation && len(info.child2Handle) == 0 {
+			// The children are inserted on expanding, but the parent may
+			// need a button now.
+			tvi := &win.TVITEM{
+				Mask:      win.TVIF_CHILDREN,
+				HItem:     info.handle,
+				CChildren: win.I_CHILDRENCALLBACK,
+			}
+
+			if 0 == tv.SendMessage(win.TVM_SETITEM, 0, uintptr(unsafe.Pointer(tvi))) {
+				return newError("SendMessage(TVM_SETITEM) failed")
+			}
+
+			return nil
+		}
+
+		count = parent.ChildCount()
+		childAt = parent.ChildAt
+	}
 
-		if handle, err := tv.insertItem(child); err != nil {
+	if from < 0 || to < from || to >= count {
+		return newError("invalid range")
+	}
+
+	tv.BeginUpdate()
+	defer tv.EndUpdate()
+
+	hInsertAfter := win.TVI_FIRST
+	if from > 0 {
+		if info := tv.item2Info[childAt(from-1)]; info != nil {
+			hInsertAfter = info.handle
+		}
+	}
+
+	for i := from; i <= to; i++ {
+		item := childAt(i)
+
+		if info := tv.item2Info[item]; info != nil {
+			// Already inserted while populating the parent.
+			hInsertAfter = info.handle
+			continue
+		}
+
+		hItem, err := tv.insertItemAfter(item, hInsertAfter)
+		if err != nil {
 			return err
-		} else {
-			info.child2Handle[child] = handle
 		}
+
+		hInsertAfter = hItem
 	}
 
 	return nil
@@ -439,15 +690,12 @@ func (tv *TreeView) updateItem(item TreeItem) error {
 }
 
 func (tv *TreeView) removeItem(item TreeItem) error {
-	if err := tv.removeDescendants(item); err != nil {
-		return err
-	}
-
 	info := tv.item2Info[item]
 	if info == nil {
 		return newError("invalid item")
 	}
 
+	// Deleting the item deletes its descendants, too.
 	if 0 == tv.SendMessage(win.TVM_DELETEITEM, 0, uintptr(info.handle)) {
 		return newError("SendMessage(TVM_DELETEITEM) failed")
 	}
@@ -455,14 +703,36 @@ func (tv *TreeView) removeItem(item TreeItem) error {
 	if parentInfo := tv.item2Info[item.Parent()]; parentInfo != nil {
 		delete(parentInfo.child2Handle, item)
 	}
+
+	tv.forgetItem(item, info)
+
+	return nil
+}
+
+// forgetItem drops the bookkeeping of item and its descendants, that were
+// deleted from the control.
+func (tv *TreeView) forgetItem(item TreeItem, info *treeViewItemInfo) {
+	for child := range info.child2Handle {
+		if childInfo := tv.item2Info[child]; childInfo != nil {
+			tv.forgetItem(child, childInfo)
+		}
+	}
+
 	delete(tv.item2Info, item)
 	delete(tv.handle2Item, info.handle)
 
-	return nil
+	if tv.currItem == item {
+		tv.currItem = nil
+	}
+
+	tv.releaseItemInfo(info)
 }
 
 func (tv *TreeView) removeDescendants(parent TreeItem) error {
-	for item, _ := range tv.item2Info[parent].child2Handle {
+	tv.BeginUpdate()
+	defer tv.EndUpdate()
+
+	for item := range tv.item2Info[parent].child2Handle {
 		if err := tv.removeItem(item); err != nil {
 			return err
 		}
@@ -476,8 +746,8 @@ func (tv *TreeView) ensureItemAndAncestorsInserted(item TreeItem) error {
 		return newError("invalid item")
 	}
 
-	tv.SetSuspended(true)
-	defer tv.SetSuspended(false)
+	tv.BeginUpdate()
+	defer tv.EndUpdate()
 
 	var hierarchy []TreeItem
 
@@ -556,13 +826,210 @@ func (tv *TreeView) ItemActivated() *Event {
 	return tv.itemActivatedPublisher.Event()
 }
 
+// SearchMode returns how type-ahead search and the find bar match the text of
+// the items.
+func (tv *TreeView) SearchMode() SearchMode {
+	return tv.searchMode
+}
+
+// SetSearchMode sets how type-ahead search and the find bar match the text of
+// the items.
+//
+// Type-ahead search only finds items whose parents are expanded. The find bar
+// also finds items in collapsed parents, as long as they were inserted, see
+// SetModel.
+func (tv *TreeView) SetSearchMode(mode SearchMode) {
+	tv.searchMode = mode
+
+	if mode == SearchDisabled {
+		tv.HideFindBar()
+	}
+
+	tv.Invalidate()
+}
+
+// ShowFindBar shows the find bar at the top right corner of the TreeView and
+// focuses it. The user shows it by pressing Ctrl+F.
+//
+// While the find bar is shown, the items matching its text are highlighted.
+// F3 and Shift+F3 find the next and previous match.
+func (tv *TreeView) ShowFindBar() error {
+	if tv.searchMode == SearchDisabled {
+		return newError("search is disabled")
+	}
+
+	if tv.findBar == nil {
+		fb, err := newFindBar(tv.hWnd, func(text string, next, backward bool) bool {
+			return tv.findItem(text, next, backward, false)
+		}, func() {
+			tv.Invalidate()
+		})
+		if err != nil {
+			return err
+		}
+
+		tv.findBar = fb
+	}
+
+	tv.findBar.show(tv.Font(), tv.DPI())
+
+	return nil
+}
+
+// HideFindBar hides the find bar.
+func (tv *TreeView) HideFindBar() {
+	if tv.findBar != nil {
+		tv.findBar.hide()
+	}
+}
+
+// FindBarVisible returns if the find bar is shown.
+func (tv *TreeView) FindBarVisible() bool {
+	return tv.findBar.visible()
+}
+
+// FindNext makes the next or, if backward is true, the previous item matching
+// the text of the find bar the current item. It returns if there is one.
+func (tv *TreeView) FindNext(backward bool) bool {
+	if tv.findBar == nil {
+		return false
+	}
+
+	return tv.findBar.findNext(backward)
+}
+
+// handleSearchKey handles the keys that show and hide the find bar and find
+// the next match. It returns if key was handled.
+func (tv *TreeView) handleSearchKey(key Key) bool {
+	if tv.searchMode == SearchDisabled {
+		return false
+	}
+
+	switch {
+	case key == KeyF && ControlDown():
+		if err := tv.ShowFindBar(); err != nil {
+			wrapError(err)
+		}
+		return true
+
+	case key == KeyF3 && tv.findBar != nil && tv.findBar.text != "":
+		tv.FindNext(ShiftDown())
+		return true
+
+	case key == KeyEscape && tv.findBar.visible():
+		tv.HideFindBar()
+		return true
+	}
+
+	return false
+}
+
+// findItem makes the first item whose text matches text the current item and
+// returns if there is one. The search starts at the current item, or next to
+// it if next is true, and wraps around. If visibleOnly is true, only items
+// whose parents are expanded are searched.
+func (tv *TreeView) findItem(text string, next, backward, visibleOnly bool) bool {
+	handles := tv.itemHandles(visibleOnly)
+	if len(handles) == 0 {
+		return false
+	}
+
+	start := 0
+	if tv.currItem != nil {
+		if info := tv.item2Info[tv.currItem]; info != nil {
+			for i, handle := range handles {
+				if handle == info.handle {
+					start = i
+					break
+				}
+			}
+		}
+
+		switch {
+		case next && backward:
+			start--
+
+		case next:
+			start++
+		}
+	}
+
+	index := searchIndexes(len(handles), start, backward, func(index int) bool {
+		item := tv.handle2Item[handles[index]]
+
+		return item != nil && searchMatches(item.Text(), text, tv.searchMode)
+	})
+	if index == -1 {
+		return false
+	}
+
+	if err := tv.SetCurrentItem(tv.handle2Item[handles[index]]); err != nil {
+		wrapError(err)
+	}
+
+	return true
+}
+
+// itemHandles returns the handles of the inserted items in display order. If
+// visibleOnly is true, only those of items whose parents are expanded.
+func (tv *TreeView) itemHandles(visibleOnly bool) []win.HTREEITEM {
+	var handles []win.HTREEITEM
+
+	nextItem := func(flag uintptr, handle win.HTREEITEM) win.HTREEITEM {
+		return win.HTREEITEM(tv.SendMessage(win.TVM_GETNEXTITEM, flag, uintptr(handle)))
+	}
+
+	if visibleOnly {
+		for handle := nextItem(tvgnRoot, 0); handle != 0; handle = nextItem(tvgnNextVisible, handle) {
+			handles = append(handles, handle)
+		}
+
+		return handles
+	}
+
+	var addSiblings func(handle win.HTREEITEM)
+	addSiblings = func(handle win.HTREEITEM) {
+		for ; handle != 0; handle = nextItem(tvgnNext, handle) {
+			handles = append(handles, handle)
+
+			addSiblings(nextItem(tvgnChild, handle))
+		}
+	}
+	addSiblings(nextItem(tvgnRoot, 0))
+
+	return handles
+}
+
 func (tv *TreeView) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	if result, ok := tv.findBar.handleParentMessage(msg, wParam, lParam); ok {
+		return result
+	}
+
 	switch msg {
 	case win.WM_GETDLGCODE:
 		if wParam == win.VK_RETURN {
 			return win.DLGC_WANTALLKEYS
 		}
 
+	case win.WM_KEYDOWN:
+		if tv.handleSearchKey(Key(wParam)) {
+			return 0
+		}
+
+	case win.WM_CHAR:
+		if tv.searchMode != SearchDisabled && wParam >= ' ' {
+			if text, next := tv.typeAhead.add(rune(wParam)); text != "" {
+				tv.findItem(text, next, false, true)
+			}
+
+			return 0
+		}
+
+	case win.WM_SIZE:
+		if tv.findBar.visible() {
+			tv.findBar.layout(tv.DPI())
+		}
+
 	case win.WM_NOTIFY:
 		nmhdr := (*win.NMHDR)(unsafe.Pointer(lParam))
 
@@ -573,11 +1040,7 @@ func (tv *TreeView) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 
 			if nmtvdi.Item.Mask&win.TVIF_TEXT != 0 {
 				text := item.Text()
-				utf16 := syscall.StringToUTF16(text)
-				buf := (*[264]uint16)(unsafe.Pointer(nmtvdi.Item.PszText))
-				max := mini(len(utf16), int(nmtvdi.Item.CchTextMax))
-				copy((*buf)[:], utf16[:max])
-				(*buf)[max-1] = 0
+				copyStringToUTF16Buffer((*[1 << 20]uint16)(unsafe.Pointer(nmtvdi.Item.PszText))[:nmtvdi.Item.CchTextMax:nmtvdi.Item.CchTextMax], text)
 			}
 			if nmtvdi.Item.Mask&win.TVIF_CHILDREN != 0 {
 				if hc, ok := item.(HasChilder); ok {
@@ -620,6 +1083,30 @@ func (tv *TreeView) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) u
 			case win.TVE_TOGGLE:
 			}
 
+		case win.NM_CUSTOMDRAW:
+			text := tv.findBar.searchText()
+			if text == "" {
+				break
+			}
+
+			nmtvcd := (*nmtvCustomDraw)(unsafe.Pointer(lParam))
+
+			switch nmtvcd.Nmcd.DwDrawStage {
+			case win.CDDS_PREPAINT:
+				return win.CDRF_NOTIFYITEMDRAW
+
+			case win.CDDS_ITEMPREPAINT:
+				if nmtvcd.Nmcd.UItemState&win.CDIS_SELECTED != 0 {
+					break
+				}
+
+				if item := tv.handle2Item[win.HTREEITEM(nmtvcd.Nmcd.DwItemSpec)]; item != nil && searchMatches(item.Text(), text, tv.searchMode) {
+					nmtvcd.ClrTextBk = win.COLORREF(findHighlightColor(Color(win.GetSysColor(win.COLOR_WINDOW))))
+				}
+
+				return win.CDRF_DODEFAULT
+			}
+
 		case win.NM_DBLCLK:
 			tv.itemActivatedPublisher.Publish()
 
@@ -0,0 +1,294 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"math"
+)
+
+// UniformGridLayout divides the container into rows and columns of equal
+// size and places the widgets in the cells in their order, row by row, like
+// the keys of a keypad or the tiles of a dashboard.
+//
+// Unlike GridLayout, the cells do not grow to the minimum sizes of the
+// widgets, so widgets may get clipped. Their minimum size only determines the
+// ideal size of the layout. Use SetMinCellSize to have a ScrollView scroll
+// instead.
+//
+// If neither the number of rows nor of columns is set, the grid is about
+// square. If only one of them is set, the other follows from the number of
+// widgets.
+type UniformGridLayout struct {
+	LayoutBase
+	rows             int
+	columns          int
+	minCellSize96dpi Size
+}
+
+func NewUniformGridLayout() *UniformGridLayout {
+	l := &UniformGridLayout{
+		LayoutBase: LayoutBase{
+			margins96dpi: Margins{9, 9, 9, 9},
+			spacing96dpi: 6,
+		},
+	}
+	l.layout = l
+
+	return l
+}
+
+// Rows returns the number of rows, 0 if it follows from the number of widgets.
+func (l *UniformGridLayout) Rows() int {
+	return l.rows
+}
+
+// SetRows sets the number of rows, 0 if it should follow from the number of
+// widgets.
+func (l *UniformGridLayout) SetRows(rows int) error {
+	if rows < 0 {
+		return newError("rows must be >= 0")
+	}
+
+	if rows != l.rows {
+		l.rows = rows
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// Columns returns the number of columns, 0 if it follows from the number of
+// widgets.
+func (l *UniformGridLayout) Columns() int {
+	return l.columns
+}
+
+// SetColumns sets the number of columns, 0 if it should follow from the
+// number of widgets.
+func (l *UniformGridLayout) SetColumns(columns int) error {
+	if columns < 0 {
+		return newError("columns must be >= 0")
+	}
+
+	if columns != l.columns {
+		l.columns = columns
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+// MinCellSize returns the minimum size in 1/96" units of the cells.
+func (l *UniformGridLayout) MinCellSize() Size {
+	return l.minCellSize96dpi
+}
+
+// SetMinCellSize sets the minimum size in 1/96" units of the cells.
+func (l *UniformGridLayout) SetMinCellSize(size Size) error {
+	if size.Width < 0 || size.Height < 0 {
+		return newError("invalid size")
+	}
+
+	if size != l.minCellSize96dpi {
+		l.minCellSize96dpi = size
+
+		if l.container != nil {
+			l.container.RequestLayout()
+		}
+	}
+
+	return nil
+}
+
+func (l *UniformGridLayout) CreateLayoutItem(ctx *LayoutContext) ContainerLayoutItem {
+	return &uniformGridLayoutItem{
+		rows:        l.rows,
+		columns:     l.columns,
+		minCellSize: SizeFrom96DPI(l.minCellSize96dpi, ctx.dpi),
+	}
+}
+
+type uniformGridLayoutItem struct {
+	ContainerLayoutItemBase
+	rows           int
+	columns        int
+	minCellSize    Size        // in native pixels
+	overlayColumns []Rectangle // in native pixels, see SetLayoutDebugOverlay
+	overlayRows    []Rectangle
+}
+
+func (li *uniformGridLayoutItem) items() []LayoutItem {
+	items := make([]LayoutItem, 0, len(li.children))
+
+	for _, item := range li.children {
+		if shouldLayoutItem(item) {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// dimensions returns the number of rows and columns for count items.
+func (li *uniformGridLayoutItem) dimensions(count int) (rows, columns int) {
+	rows, columns = li.rows, li.columns
+
+	switch {
+	case rows > 0 && columns > 0:
+
+	case columns > 0:
+		rows = (count + columns - 1) / columns
+
+	case rows > 0:
+		columns = (count + rows - 1) / rows
+
+	default:
+		columns = int(math.Ceil(math.Sqrt(float64(count))))
+		if columns > 0 {
+			rows = (count + columns - 1) / columns
+		}
+	}
+
+	return maxi(rows, 1), maxi(columns, 1)
+}
+
+// size returns the size of the layout for the given cell size.
+func (li *uniformGridLayoutItem) size(cellSize Size, count int) Size {
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	hSpacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
+	vSpacing := IntFrom96DPI(li.spacing96dpi(Vertical), li.ctx.dpi)
+
+	rows, columns := li.dimensions(count)
+
+	return Size{
+		Width:  margins.HNear + margins.HFar + columns*cellSize.Width + (columns-1)*hSpacing,
+		Height: margins.VNear + margins.VFar + rows*cellSize.Height + (rows-1)*vSpacing,
+	}
+}
+
+func (li *uniformGridLayoutItem) LayoutFlags() LayoutFlags {
+	flags := ShrinkableHorz | ShrinkableVert | GrowableHorz | GrowableVert
+
+	for _, item := range li.children {
+		if shouldLayoutItem(item) {
+			flags |= item.LayoutFlags() & (GreedyHorz | GreedyVert)
+		}
+	}
+
+	return flags
+}
+
+// IdealSize returns the size at which each cell is as large as the largest
+// ideal size of the widgets.
+func (li *uniformGridLayoutItem) IdealSize() Size {
+	items := li.items()
+
+	cellSize := li.minCellSize
+	for _, item := range items {
+		var s Size
+		if is, ok := item.(IdealSizer); ok {
+			s = is.IdealSize()
+		}
+
+		min := li.MinSizeEffectiveForChild(item)
+
+		cellSize.Width = maxi(cellSize.Width, maxi(s.Width, min.Width))
+		cellSize.Height = maxi(cellSize.Height, maxi(s.Height, min.Height))
+	}
+
+	return li.size(cellSize, len(items))
+}
+
+func (li *uniformGridLayoutItem) MinSize() Size {
+	return li.size(li.minCellSize, len(li.items()))
+}
+
+func (li *uniformGridLayoutItem) MinSizeForSize(size Size) Size {
+	return li.MinSize()
+}
+
+func (li *uniformGridLayoutItem) HeightForWidth(width int) int {
+	return li.MinSize().Height
+}
+
+func (li *uniformGridLayoutItem) PerformLayout() []LayoutResultItem {
+	items := li.items()
+	if len(items) == 0 {
+		return nil
+	}
+
+	margins := MarginsFrom96DPI(li.margins96dpi, li.ctx.dpi)
+	hSpacing := IntFrom96DPI(li.spacing96dpi(Horizontal), li.ctx.dpi)
+	vSpacing := IntFrom96DPI(li.spacing96dpi(Vertical), li.ctx.dpi)
+
+	rows, columns := li.dimensions(len(items))
+
+	clientSize := li.geometry.ClientSize
+	xs := uniformGridSections(margins.HNear, clientSize.Width-margins.HNear-margins.HFar, columns, hSpacing, li.minCellSize.Width)
+	ys := uniformGridSections(margins.VNear, clientSize.Height-margins.VNear-margins.VFar, rows, vSpacing, li.minCellSize.Height)
+
+	if layoutDebugOverlay {
+		li.overlayColumns, li.overlayRows = xs, ys
+	}
+
+	results := make([]LayoutResultItem, 0, len(items))
+
+	for i, item := range items {
+		row, column := i/columns, i%columns
+		if row >= rows {
+			// More widgets than cells.
+			break
+		}
+
+		results = append(results, LayoutResultItem{
+			Item:   item,
+			Bounds: Rectangle{xs[column].X, ys[row].X, xs[column].Width, ys[row].Width},
+		})
+	}
+
+	return results
+}
+
+func (li *uniformGridLayoutItem) overlayCells() (columns, rows []Rectangle) {
+	return li.overlayColumns, li.overlayRows
+}
+
+// uniformGridSections divides space starting at start into count sections
+// with spacing between them. The sizes differ by at most one pixel, the
+// larger sections coming first. The sections are returned as Rectangles with
+// X as position and Width as size.
+func uniformGridSections(start, space, count, spacing, minSize int) []Rectangle {
+	available := maxi(0, space-(count-1)*spacing)
+	size := maxi(minSize, available/count)
+
+	var remainder int
+	if size*count < available {
+		remainder = available - size*count
+	}
+
+	sections := make([]Rectangle, count)
+
+	pos := start
+	for i := range sections {
+		s := size
+		if i < remainder {
+			s++
+		}
+
+		sections[i] = Rectangle{X: pos, Width: s}
+
+		pos += s + spacing
+	}
+
+	return sections
+}
@@ -0,0 +1,267 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"container/list"
+	"sync"
+	"syscall"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	defaultUTF16CacheCapacity  = 256 * 1024 // in bytes
+	defaultUTF16CacheMaxLength = 256        // in UTF-16 code units
+)
+
+// UTF16CacheStats holds statistics of the UTF16StringCache.
+type UTF16CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Entries   int
+	Bytes     int // in bytes of UTF-16 text
+}
+
+// UTF16StringCache caches the UTF-16 conversions of strings, that walk passes
+// to Windows, like drawn texts, window titles and list items. Strings drawn
+// over and over, e.g. the cells of a table while scrolling, are converted
+// only once.
+//
+// The cache evicts the least recently used strings, once it holds more than
+// its capacity. Strings longer than the maximum length are never cached.
+//
+// Use UTF16Cache to get the cache of the application.
+type UTF16StringCache struct {
+	mutex     sync.Mutex
+	enabled   bool
+	capacity  int // in bytes
+	maxLength int // in UTF-16 code units
+	size      int // in bytes
+	lru       *list.List
+	entries   map[string]*list.Element
+	stats     UTF16CacheStats
+}
+
+type utf16CacheEntry struct {
+	s   string
+	buf []uint16
+}
+
+var theUTF16Cache = &UTF16StringCache{
+	enabled:   true,
+	capacity:  defaultUTF16CacheCapacity,
+	maxLength: defaultUTF16CacheMaxLength,
+	lru:       list.New(),
+	entries:   make(map[string]*list.Element),
+}
+
+// UTF16Cache returns the UTF16StringCache of the application.
+func UTF16Cache() *UTF16StringCache {
+	return theUTF16Cache
+}
+
+// Enabled returns if the cache is used.
+func (c *UTF16StringCache) Enabled() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.enabled
+}
+
+// SetEnabled sets if the cache is used. Disabling it clears it.
+func (c *UTF16StringCache) SetEnabled(enabled bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.enabled = enabled
+
+	if !enabled {
+		c.clear()
+	}
+}
+
+// Capacity returns the size in bytes the cached UTF-16 texts may take up.
+func (c *UTF16StringCache) Capacity() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.capacity
+}
+
+// SetCapacity sets the size in bytes the cached UTF-16 texts may take up.
+func (c *UTF16StringCache) SetCapacity(capacity int) error {
+	if capacity < 0 {
+		return newError("capacity must be >= 0")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.capacity = capacity
+	c.evict()
+
+	return nil
+}
+
+// MaxLength returns the maximum length in UTF-16 code units of the strings,
+// that are cached.
+func (c *UTF16StringCache) MaxLength() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.maxLength
+}
+
+// SetMaxLength sets the maximum length in UTF-16 code units of the strings,
+// that are cached.
+func (c *UTF16StringCache) SetMaxLength(maxLength int) error {
+	if maxLength < 0 {
+		return newError("maxLength must be >= 0")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.maxLength = maxLength
+
+	for e := c.lru.Front(); e != nil; {
+		next := e.Next()
+
+		if entry := e.Value.(*utf16CacheEntry); len(entry.buf)-1 > maxLength {
+			c.remove(e)
+		}
+
+		e = next
+	}
+
+	return nil
+}
+
+// Clear removes all strings from the cache.
+func (c *UTF16StringCache) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.clear()
+}
+
+// Stats returns statistics of the cache.
+func (c *UTF16StringCache) Stats() UTF16CacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	stats.Bytes = c.size
+
+	return stats
+}
+
+// utf16 returns the NUL terminated UTF-16 encoding of s. Like
+// syscall.StringToUTF16, it panics if s contains a NUL byte.
+//
+// The returned slice may be shared, so it must not be modified.
+func (c *UTF16StringCache) utf16(s string) []uint16 {
+	c.mutex.Lock()
+
+	if !c.enabled || len(s) > 3*c.maxLength {
+		// Even if all of its runes take up 3 bytes, s is too long.
+		c.mutex.Unlock()
+		return syscall.StringToUTF16(s)
+	}
+
+	if e, ok := c.entries[s]; ok {
+		c.lru.MoveToFront(e)
+		c.stats.Hits++
+
+		buf := e.Value.(*utf16CacheEntry).buf
+		c.mutex.Unlock()
+		return buf
+	}
+
+	c.stats.Misses++
+
+	c.mutex.Unlock()
+
+	buf := syscall.StringToUTF16(s)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(buf)-1 > c.maxLength || !c.enabled {
+		return buf
+	}
+
+	if _, ok := c.entries[s]; !ok {
+		c.entries[s] = c.lru.PushFront(&utf16CacheEntry{s, buf})
+		c.size += 2 * len(buf)
+		c.evict()
+	}
+
+	return buf
+}
+
+func (c *UTF16StringCache) evict() {
+	for c.size > c.capacity {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *UTF16StringCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*utf16CacheEntry)
+
+	delete(c.entries, entry.s)
+	c.size -= 2 * len(entry.buf)
+}
+
+func (c *UTF16StringCache) clear() {
+	c.lru.Init()
+	c.entries = make(map[string]*list.Element)
+	c.size = 0
+}
+
+// stringToUTF16 returns the NUL terminated UTF-16 encoding of s from the
+// UTF16Cache. The returned slice must not be modified.
+func stringToUTF16(s string) []uint16 {
+	return theUTF16Cache.utf16(s)
+}
+
+// stringToUTF16Ptr returns a pointer to the NUL terminated UTF-16 encoding of
+// s from the UTF16Cache. The text pointed to must not be modified.
+func stringToUTF16Ptr(s string) *uint16 {
+	return &stringToUTF16(s)[0]
+}
+
+// copyStringToUTF16Buffer copies s to buf as NUL terminated UTF-16, truncated
+// to fit, without allocating.
+func copyStringToUTF16Buffer(buf []uint16, s string) {
+	if len(buf) == 0 {
+		return
+	}
+
+	n := 0
+	for _, r := range s {
+		if r1, r2 := utf16.EncodeRune(r); r1 != utf8.RuneError {
+			if n+2 >= len(buf) {
+				break
+			}
+			buf[n], buf[n+1] = uint16(r1), uint16(r2)
+			n += 2
+		} else {
+			if n+1 >= len(buf) {
+				break
+			}
+			buf[n] = uint16(r)
+			n++
+		}
+	}
+
+	buf[n] = 0
+}
@@ -59,6 +59,14 @@ func mini(a, b int) int {
 	return b
 }
 
+func absi(a int) int {
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
+
 func boolToInt(value bool) int {
 	if value {
 		return 1
@@ -0,0 +1,220 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+// ViewPresetPicker is a small tool bar for switching between the view presets
+// of a TableView. It consists of a ComboBox that lists the presets and loads
+// the chosen one, and buttons to save the current view under the name entered
+// in the ComboBox and to delete the chosen preset.
+type ViewPresetPicker struct {
+	*Composite
+	tableView                  *TableView
+	comboBox                   *ComboBox
+	saveButton                 *PushButton
+	deleteButton               *PushButton
+	updating                   bool
+	viewPresetsChangedHandle   int
+	currentPresetChangedHandle int
+}
+
+// NewViewPresetPicker creates and initializes a new ViewPresetPicker for
+// tableView, which may be nil and set later with SetTableView.
+func NewViewPresetPicker(parent Container, tableView *TableView) (*ViewPresetPicker, error) {
+	composite, err := NewCompositeWithStyle(parent, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	vpp := &ViewPresetPicker{Composite: composite}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			vpp.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(vpp); err != nil {
+		return nil, err
+	}
+
+	layout := NewHBoxLayout()
+	layout.SetMargins(Margins{})
+	if err := vpp.SetLayout(layout); err != nil {
+		return nil, err
+	}
+
+	label, err := NewLabel(vpp)
+	if err != nil {
+		return nil, err
+	}
+	label.SetText(tr("View:", "walk"))
+
+	if vpp.comboBox, err = NewComboBox(vpp); err != nil {
+		return nil, err
+	}
+	vpp.comboBox.SetMinMaxSize(Size{150, 0}, Size{})
+	vpp.comboBox.CurrentIndexChanged().Attach(vpp.loadCurrent)
+	vpp.comboBox.TextChanged().Attach(vpp.updateButtons)
+
+	if vpp.saveButton, err = NewPushButton(vpp); err != nil {
+		return nil, err
+	}
+	vpp.saveButton.SetText(tr("Save", "walk"))
+	vpp.saveButton.Clicked().Attach(vpp.save)
+
+	if vpp.deleteButton, err = NewPushButton(vpp); err != nil {
+		return nil, err
+	}
+	vpp.deleteButton.SetText(tr("Delete", "walk"))
+	vpp.deleteButton.Clicked().Attach(vpp.delete)
+
+	if err := vpp.SetTableView(tableView); err != nil {
+		return nil, err
+	}
+
+	succeeded = true
+
+	return vpp, nil
+}
+
+// Dispose detaches the *ViewPresetPicker from its TableView and releases its
+// operating system resources.
+func (vpp *ViewPresetPicker) Dispose() {
+	if vpp.tableView != nil {
+		vpp.tableView.ViewPresetsChanged().Detach(vpp.viewPresetsChangedHandle)
+		vpp.tableView.CurrentViewPresetChanged().Detach(vpp.currentPresetChangedHandle)
+		vpp.tableView = nil
+	}
+
+	vpp.Composite.Dispose()
+}
+
+// TableView returns the TableView whose view presets are managed by the
+// *ViewPresetPicker.
+func (vpp *ViewPresetPicker) TableView() *TableView {
+	return vpp.tableView
+}
+
+// SetTableView sets the TableView whose view presets are managed by the
+// *ViewPresetPicker.
+func (vpp *ViewPresetPicker) SetTableView(tableView *TableView) error {
+	if tableView == vpp.tableView {
+		return nil
+	}
+
+	if vpp.tableView != nil {
+		vpp.tableView.ViewPresetsChanged().Detach(vpp.viewPresetsChangedHandle)
+		vpp.tableView.CurrentViewPresetChanged().Detach(vpp.currentPresetChangedHandle)
+	}
+
+	vpp.tableView = tableView
+
+	if tableView != nil {
+		vpp.viewPresetsChangedHandle = tableView.ViewPresetsChanged().Attach(vpp.reset)
+		vpp.currentPresetChangedHandle = tableView.CurrentViewPresetChanged().Attach(vpp.reset)
+	}
+
+	if vpp.comboBox == nil {
+		return nil
+	}
+
+	vpp.reset()
+
+	return nil
+}
+
+// reset fills the ComboBox with the current presets of the TableView.
+func (vpp *ViewPresetPicker) reset() {
+	vpp.updating = true
+	defer func() {
+		vpp.updating = false
+	}()
+
+	var names []string
+	var current string
+	if vpp.tableView != nil {
+		names = vpp.tableView.ViewPresets()
+		current = vpp.tableView.CurrentViewPreset()
+	}
+
+	vpp.comboBox.SetModel(names)
+
+	index := -1
+	for i, name := range names {
+		if name == current {
+			index = i
+			break
+		}
+	}
+	vpp.comboBox.SetCurrentIndex(index)
+	if index == -1 {
+		vpp.comboBox.SetText("")
+	}
+
+	vpp.updateButtons()
+}
+
+func (vpp *ViewPresetPicker) updateButtons() {
+	enabled := vpp.tableView != nil
+
+	vpp.comboBox.SetEnabled(enabled)
+	vpp.saveButton.SetEnabled(enabled && vpp.comboBox.Text() != "")
+	vpp.deleteButton.SetEnabled(enabled && vpp.presetIndex(vpp.comboBox.Text()) > -1)
+}
+
+func (vpp *ViewPresetPicker) presetIndex(name string) int {
+	if vpp.tableView == nil {
+		return -1
+	}
+
+	for i, preset := range vpp.tableView.ViewPresets() {
+		if preset == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (vpp *ViewPresetPicker) loadCurrent() {
+	if vpp.updating || vpp.tableView == nil {
+		return
+	}
+
+	names := vpp.tableView.ViewPresets()
+	index := vpp.comboBox.CurrentIndex()
+	if index < 0 || index >= len(names) {
+		return
+	}
+
+	if err := vpp.tableView.LoadViewPreset(names[index]); err != nil {
+		ShowError(vpp.Form(), err, nil)
+	}
+
+	vpp.updateButtons()
+}
+
+func (vpp *ViewPresetPicker) save() {
+	if vpp.tableView == nil {
+		return
+	}
+
+	if err := vpp.tableView.SaveViewPreset(vpp.comboBox.Text()); err != nil {
+		ShowError(vpp.Form(), err, nil)
+	}
+}
+
+func (vpp *ViewPresetPicker) delete() {
+	if vpp.tableView == nil {
+		return
+	}
+
+	if err := vpp.tableView.DeleteViewPreset(vpp.comboBox.Text()); err != nil {
+		ShowError(vpp.Form(), err, nil)
+	}
+}
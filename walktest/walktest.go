@@ -0,0 +1,229 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+// Package walktest provides helpers for testing walk user interfaces.
+package walktest
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/lxn/walk"
+)
+
+// DPI is the DPI golden images are rendered at.
+const DPI = 96
+
+var (
+	update  = flag.Bool("walktest.update", false, "write rendered widgets to their golden images instead of comparing them")
+	diffDir = flag.String("walktest.diffdir", "", "directory for the images written on mismatches, defaults to the directory of the golden image")
+)
+
+var (
+	libuser32                        = syscall.NewLazyDLL("user32.dll")
+	procSetThreadDpiAwarenessContext = libuser32.NewProc("SetThreadDpiAwarenessContext")
+)
+
+const dpiAwarenessContextUnaware = ^uintptr(0) // DPI_AWARENESS_CONTEXT_UNAWARE
+
+// PinDPI makes windows, that are created afterwards by the calling goroutine,
+// render at 96 DPI regardless of the display configuration. It locks the
+// goroutine to its thread and returns a function that restores the previous
+// state.
+//
+// Create the forms under test after calling PinDPI, so their rendering
+// matches golden images made on other machines.
+func PinDPI() (restore func()) {
+	runtime.LockOSThread()
+
+	if procSetThreadDpiAwarenessContext.Find() != nil {
+		return runtime.UnlockOSThread
+	}
+
+	old, _, _ := syscall.Syscall(procSetThreadDpiAwarenessContext.Addr(), 1,
+		dpiAwarenessContextUnaware,
+		0,
+		0)
+
+	return func() {
+		if old != 0 {
+			syscall.Syscall(procSetThreadDpiAwarenessContext.Addr(), 1,
+				old,
+				0,
+				0)
+		}
+
+		runtime.UnlockOSThread()
+	}
+}
+
+// Render renders widget with its children into an image. The widget does not
+// need to be visible on screen.
+func Render(widget walk.Widget) (*image.RGBA, error) {
+	bmp, err := walk.NewBitmapFromWindow(widget)
+	if err != nil {
+		return nil, err
+	}
+	defer bmp.Dispose()
+
+	img, err := bmp.ToImage()
+	if err != nil {
+		return nil, err
+	}
+
+	// GDI leaves the alpha channel undefined.
+	for i := 3; i < len(img.Pix); i += 4 {
+		img.Pix[i] = 0xFF
+	}
+
+	return img, nil
+}
+
+// Diff compares got with want pixel by pixel. It returns the number of pixels
+// that differ and an image, that shows want faded with the differing pixels
+// in red. Images of different sizes differ in all pixels.
+func Diff(got, want image.Image) (int, *image.RGBA) {
+	bounds := want.Bounds()
+	if got.Bounds().Size() != bounds.Size() {
+		return maxi(bounds.Dx()*bounds.Dy(), got.Bounds().Dx()*got.Bounds().Dy()), nil
+	}
+
+	diff := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	offset := got.Bounds().Min.Sub(bounds.Min)
+
+	var count int
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			gr, gg, gb, _ := got.At(x+offset.X, y+offset.Y).RGBA()
+			wr, wg, wb, _ := want.At(x, y).RGBA()
+
+			var c color.RGBA
+			if gr>>8 != wr>>8 || gg>>8 != wg>>8 || gb>>8 != wb>>8 {
+				c = color.RGBA{0xFF, 0, 0, 0xFF}
+				count++
+			} else {
+				gray := uint8((wr*299 + wg*587 + wb*114) / 1000 >> 8)
+				gray = 0xC0 + gray/4
+				c = color.RGBA{gray, gray, gray, 0xFF}
+			}
+
+			diff.SetRGBA(x-bounds.Min.X, y-bounds.Min.Y, c)
+		}
+	}
+
+	return count, diff
+}
+
+// AssertLooksLike renders widget and compares the result with the PNG image
+// at goldenPath. The test fails if the fraction of differing pixels exceeds
+// tolerance, which ranges from 0 for an exact match to 1.
+//
+// On mismatch, the rendered image and a diff image are written next to the
+// golden image, or to the directory given by the -walktest.diffdir flag, with
+// ".got.png" and ".diff.png" suffixes. Run the test with -walktest.update to
+// write the rendered image to goldenPath instead.
+//
+// The widget must have been created after PinDPI, so it renders at DPI.
+func AssertLooksLike(t testing.TB, widget walk.Widget, goldenPath string, tolerance float64) {
+	t.Helper()
+
+	if dpi := widget.DPI(); dpi != DPI {
+		t.Fatalf("walktest: widget renders at %d DPI, but golden images are made at %d DPI; create the form after calling PinDPI", dpi, DPI)
+	}
+
+	got, err := Render(widget)
+	if err != nil {
+		t.Fatalf("walktest: rendering widget failed: %v", err)
+	}
+
+	if *update {
+		if err := writePNG(goldenPath, got); err != nil {
+			t.Fatalf("walktest: writing golden image failed: %v", err)
+		}
+		return
+	}
+
+	want, err := readPNG(goldenPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			t.Fatalf("walktest: golden image %s does not exist; run the test with -walktest.update to create it", goldenPath)
+		}
+		t.Fatalf("walktest: reading golden image failed: %v", err)
+	}
+
+	count, diff := Diff(got, want)
+
+	total := want.Bounds().Dx() * want.Bounds().Dy()
+	if diff != nil && (total == 0 || float64(count)/float64(total) <= tolerance) {
+		return
+	}
+
+	base := strings.TrimSuffix(goldenPath, filepath.Ext(goldenPath))
+	if *diffDir != "" {
+		base = filepath.Join(*diffDir, filepath.Base(base))
+	}
+
+	outputs := []string{base + ".got.png"}
+	if err := writePNG(outputs[0], got); err != nil {
+		t.Errorf("walktest: writing rendered image failed: %v", err)
+	}
+	if diff != nil {
+		outputs = append(outputs, base+".diff.png")
+		if err := writePNG(outputs[1], diff); err != nil {
+			t.Errorf("walktest: writing diff image failed: %v", err)
+		}
+	}
+
+	if diff == nil {
+		t.Fatalf("walktest: rendered size %v does not match size %v of %s; see %s", got.Bounds().Size(), want.Bounds().Size(), goldenPath, strings.Join(outputs, ", "))
+	}
+
+	t.Fatalf("walktest: %d of %d pixels (%.2f%%) differ from %s, tolerance is %.2f%%; see %s", count, total, float64(count)*100/float64(total), goldenPath, tolerance*100, strings.Join(outputs, ", "))
+}
+
+func readPNG(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return png.Decode(file)
+}
+
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func maxi(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -98,24 +98,43 @@ func (eventData *WebViewNavigatingEventData) SetCanceled(value bool) {
 
 type WebViewNavigatingEventHandler func(eventData *WebViewNavigatingEventData)
 
+type webViewNavigatingEventHandlerInfo struct {
+	handler WebViewNavigatingEventHandler
+	scope   eventHandlerScope // see AttachScoped
+}
+
 type WebViewNavigatingEvent struct {
-	handlers []WebViewNavigatingEventHandler
+	handlers []webViewNavigatingEventHandlerInfo
 }
 
 func (e *WebViewNavigatingEvent) Attach(handler WebViewNavigatingEventHandler) int {
+	handlerInfo := webViewNavigatingEventHandlerInfo{handler: handler}
+
 	for i, h := range e.handlers {
-		if h == nil {
-			e.handlers[i] = handler
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
 			return i
 		}
 	}
 
-	e.handlers = append(e.handlers, handler)
+	e.handlers = append(e.handlers, handlerInfo)
 	return len(e.handlers) - 1
 }
 
 func (e *WebViewNavigatingEvent) Detach(handle int) {
-	e.handlers[handle] = nil
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *WebViewNavigatingEvent) AttachScoped(owner Window, handler WebViewNavigatingEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
 }
 
 type WebViewNavigatingEventPublisher struct {
@@ -127,9 +146,9 @@ func (p *WebViewNavigatingEventPublisher) Event() *WebViewNavigatingEvent {
 }
 
 func (p *WebViewNavigatingEventPublisher) Publish(eventData *WebViewNavigatingEventData) {
-	for _, handler := range p.event.handlers {
-		if handler != nil {
-			handler(eventData)
+	for _, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(eventData)
 		}
 	}
 }
@@ -191,24 +210,43 @@ func (eventData *WebViewNavigatedErrorEventData) SetCanceled(value bool) {
 
 type WebViewNavigatedErrorEventHandler func(eventData *WebViewNavigatedErrorEventData)
 
+type webViewNavigatedErrorEventHandlerInfo struct {
+	handler WebViewNavigatedErrorEventHandler
+	scope   eventHandlerScope // see AttachScoped
+}
+
 type WebViewNavigatedErrorEvent struct {
-	handlers []WebViewNavigatedErrorEventHandler
+	handlers []webViewNavigatedErrorEventHandlerInfo
 }
 
 func (e *WebViewNavigatedErrorEvent) Attach(handler WebViewNavigatedErrorEventHandler) int {
+	handlerInfo := webViewNavigatedErrorEventHandlerInfo{handler: handler}
+
 	for i, h := range e.handlers {
-		if h == nil {
-			e.handlers[i] = handler
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
 			return i
 		}
 	}
 
-	e.handlers = append(e.handlers, handler)
+	e.handlers = append(e.handlers, handlerInfo)
 	return len(e.handlers) - 1
 }
 
 func (e *WebViewNavigatedErrorEvent) Detach(handle int) {
-	e.handlers[handle] = nil
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *WebViewNavigatedErrorEvent) AttachScoped(owner Window, handler WebViewNavigatedErrorEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
 }
 
 type WebViewNavigatedErrorEventPublisher struct {
@@ -220,9 +258,9 @@ func (p *WebViewNavigatedErrorEventPublisher) Event() *WebViewNavigatedErrorEven
 }
 
 func (p *WebViewNavigatedErrorEventPublisher) Publish(eventData *WebViewNavigatedErrorEventData) {
-	for _, handler := range p.event.handlers {
-		if handler != nil {
-			handler(eventData)
+	for _, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(eventData)
 		}
 	}
 }
@@ -280,24 +318,43 @@ func (eventData *WebViewNewWindowEventData) Url() string {
 
 type WebViewNewWindowEventHandler func(eventData *WebViewNewWindowEventData)
 
+type webViewNewWindowEventHandlerInfo struct {
+	handler WebViewNewWindowEventHandler
+	scope   eventHandlerScope // see AttachScoped
+}
+
 type WebViewNewWindowEvent struct {
-	handlers []WebViewNewWindowEventHandler
+	handlers []webViewNewWindowEventHandlerInfo
 }
 
 func (e *WebViewNewWindowEvent) Attach(handler WebViewNewWindowEventHandler) int {
+	handlerInfo := webViewNewWindowEventHandlerInfo{handler: handler}
+
 	for i, h := range e.handlers {
-		if h == nil {
-			e.handlers[i] = handler
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
 			return i
 		}
 	}
 
-	e.handlers = append(e.handlers, handler)
+	e.handlers = append(e.handlers, handlerInfo)
 	return len(e.handlers) - 1
 }
 
 func (e *WebViewNewWindowEvent) Detach(handle int) {
-	e.handlers[handle] = nil
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *WebViewNewWindowEvent) AttachScoped(owner Window, handler WebViewNewWindowEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
 }
 
 type WebViewNewWindowEventPublisher struct {
@@ -309,9 +366,9 @@ func (p *WebViewNewWindowEventPublisher) Event() *WebViewNewWindowEvent {
 }
 
 func (p *WebViewNewWindowEventPublisher) Publish(eventData *WebViewNewWindowEventData) {
-	for _, handler := range p.event.handlers {
-		if handler != nil {
-			handler(eventData)
+	for _, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(eventData)
 		}
 	}
 }
@@ -356,24 +413,43 @@ func (eventData *WebViewWindowClosingEventData) SetCanceled(value bool) {
 
 type WebViewWindowClosingEventHandler func(eventData *WebViewWindowClosingEventData)
 
+type webViewWindowClosingEventHandlerInfo struct {
+	handler WebViewWindowClosingEventHandler
+	scope   eventHandlerScope // see AttachScoped
+}
+
 type WebViewWindowClosingEvent struct {
-	handlers []WebViewWindowClosingEventHandler
+	handlers []webViewWindowClosingEventHandlerInfo
 }
 
 func (e *WebViewWindowClosingEvent) Attach(handler WebViewWindowClosingEventHandler) int {
+	handlerInfo := webViewWindowClosingEventHandlerInfo{handler: handler}
+
 	for i, h := range e.handlers {
-		if h == nil {
-			e.handlers[i] = handler
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
 			return i
 		}
 	}
 
-	e.handlers = append(e.handlers, handler)
+	e.handlers = append(e.handlers, handlerInfo)
 	return len(e.handlers) - 1
 }
 
 func (e *WebViewWindowClosingEvent) Detach(handle int) {
-	e.handlers[handle] = nil
+	e.handlers[handle].scope.release()
+	e.handlers[handle].handler = nil
+}
+
+// AttachScoped attaches handler like Attach, but detaches it automatically
+// when owner is disposed of.
+func (e *WebViewWindowClosingEvent) AttachScoped(owner Window, handler WebViewWindowClosingEventHandler) int {
+	handle := e.Attach(handler)
+	e.handlers[handle].scope = newEventHandlerScope(owner, func() {
+		e.Detach(handle)
+	})
+
+	return handle
 }
 
 type WebViewWindowClosingEventPublisher struct {
@@ -385,9 +461,9 @@ func (p *WebViewWindowClosingEventPublisher) Event() *WebViewWindowClosingEvent
 }
 
 func (p *WebViewWindowClosingEventPublisher) Publish(eventData *WebViewWindowClosingEventData) {
-	for _, handler := range p.event.handlers {
-		if handler != nil {
-			handler(eventData)
+	for _, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(eventData)
 		}
 	}
 }
@@ -92,13 +92,17 @@ type Widget interface {
 
 type WidgetBase struct {
 	WindowBase
-	geometry                    Geometry
-	parent                      Container
-	toolTipTextProperty         Property
-	toolTipTextChangedPublisher EventPublisher
-	graphicsEffects             *WidgetGraphicsEffectList
-	alignment                   Alignment2D
-	alwaysConsumeSpace          bool
+	geometry                     Geometry
+	parent                       Container
+	toolTipTextProperty          Property
+	toolTipTextChangedPublisher  EventPublisher
+	toolTipTitleChangedPublisher EventPublisher
+	toolTipIconChangedPublisher  EventPublisher
+	graphicsEffects              *WidgetGraphicsEffectList
+	alignment                    Alignment2D
+	alwaysConsumeSpace           bool
+	layoutMargins96dpi           Margins
+	aspectRatio                  Size
 }
 
 // InitWidget initializes a Widget.
@@ -150,6 +154,32 @@ func (wb *WidgetBase) init(widget Widget) error {
 
 	wb.MustRegisterProperty("ToolTipText", wb.toolTipTextProperty)
 
+	wb.MustRegisterProperty("ToolTipTitle", NewProperty(
+		func() interface{} {
+			return wb.ToolTipTitle()
+		},
+		func(v interface{}) error {
+			return wb.SetToolTipTitle(assertStringOr(v, ""))
+		},
+		wb.toolTipTitleChangedPublisher.Event()))
+
+	wb.MustRegisterProperty("ToolTipIcon", NewProperty(
+		func() interface{} {
+			return wb.ToolTipIcon()
+		},
+		func(v interface{}) error {
+			switch v := v.(type) {
+			case ToolTipIcon:
+				return wb.SetToolTipIcon(v)
+
+			case int:
+				return wb.SetToolTipIcon(ToolTipIcon(v))
+			}
+
+			return wb.SetToolTipIcon(ToolTipIconNone)
+		},
+		wb.toolTipIconChangedPublisher.Event()))
+
 	return nil
 }
 
@@ -158,8 +188,18 @@ func (wb *WidgetBase) Dispose() {
 		return
 	}
 
-	if wb.parent != nil && win.GetParent(wb.hWnd) == wb.parent.Handle() {
-		wb.SetParent(nil)
+	if wb.parent != nil {
+		// Removing the widget from its parent drops its child size limits as
+		// well, but not if it was reparented behind the parent's back.
+		if layout := wb.parent.Layout(); layout != nil {
+			if lb := layout.asLayoutBase(); lb != nil {
+				lb.removeChildSizeLimits(wb.hWnd)
+			}
+		}
+
+		if win.GetParent(wb.hWnd) == wb.parent.Handle() {
+			wb.SetParent(nil)
+		}
 	}
 
 	if tt := wb.group.ToolTip(); tt != nil {
@@ -261,6 +301,60 @@ func (wb *WidgetBase) SetAlignment(alignment Alignment2D) error {
 	return nil
 }
 
+// LayoutMargins returns the margins in 1/96" units, that layouts keep around
+// the *WidgetBase.
+func (wb *WidgetBase) LayoutMargins() Margins {
+	return wb.layoutMargins96dpi
+}
+
+// SetLayoutMargins sets the margins in 1/96" units, that layouts keep around
+// the *WidgetBase, in addition to the margins and spacing of its parent's
+// layout. This adds room around a single widget, without wrapping it in a
+// Composite.
+//
+// Spacers ignore their layout margins.
+func (wb *WidgetBase) SetLayoutMargins(margins Margins) error {
+	if margins == wb.layoutMargins96dpi {
+		return nil
+	}
+
+	if margins.HNear < 0 || margins.VNear < 0 || margins.HFar < 0 || margins.VFar < 0 {
+		return newError("margins must be positive")
+	}
+
+	wb.layoutMargins96dpi = margins
+
+	wb.RequestLayout()
+
+	return nil
+}
+
+// AspectRatio returns the ratio of width to height, that GridLayout and
+// BoxLayout keep for the *WidgetBase, or 0, 0 if it has none.
+func (wb *WidgetBase) AspectRatio() (w, h int) {
+	return wb.aspectRatio.Width, wb.aspectRatio.Height
+}
+
+// SetAspectRatio sets the ratio of width to height, e.g. 16, 9 for a video
+// preview or 1, 1 for a square button, that GridLayout and BoxLayout keep for
+// the *WidgetBase, when they give it bounds. Space left over by the ratio is
+// distributed according to the alignment of the *WidgetBase or its layout.
+//
+// Pass 0, 0 to remove the constraint.
+func (wb *WidgetBase) SetAspectRatio(w, h int) error {
+	if w < 0 || h < 0 || (w == 0) != (h == 0) {
+		return newError("invalid aspect ratio")
+	}
+
+	if ratio := (Size{w, h}); ratio != wb.aspectRatio {
+		wb.aspectRatio = ratio
+
+		wb.RequestLayout()
+	}
+
+	return nil
+}
+
 // SetMinMaxSize sets the minimum and maximum outer size of the *WidgetBase,
 // including decorations.
 //
@@ -290,6 +384,59 @@ func (wb *WidgetBase) SetAlwaysConsumeSpace(b bool) error {
 }
 
 // Parent returns the Container of the WidgetBase.
+// PaintIsolated returns whether the widget is painted to its own compositor
+// surface.
+func (wb *WidgetBase) PaintIsolated() bool {
+	return wb.hasExtendedStyleBits(win.WS_EX_LAYERED)
+}
+
+// SetPaintIsolated sets whether the widget is painted to its own compositor
+// surface.
+//
+// This is meant for widgets that repaint often or expensively, like charts,
+// video or terminals. Their repaints then do not force their ancestors to
+// repaint and vice versa, which reduces flicker in complex windows. To
+// achieve that, the ancestors of the widget clip their children while
+// painting, so transparent widgets among them may need an opaque
+// background.
+//
+// Isolated painting requires Windows 8 or later and an application manifest
+// that declares compatibility with it.
+func (wb *WidgetBase) SetPaintIsolated(isolated bool) error {
+	if isolated == wb.PaintIsolated() {
+		return nil
+	}
+
+	if !isolated {
+		return wb.ensureExtendedStyleBits(win.WS_EX_LAYERED, false)
+	}
+
+	if err := wb.ensureStyleBits(win.WS_CLIPSIBLINGS, true); err != nil {
+		return err
+	}
+
+	if err := wb.ensureExtendedStyleBits(win.WS_EX_LAYERED, true); err != nil {
+		return err
+	}
+
+	if !setLayeredWindowAttributes(wb.hWnd, 0, 255, lwaAlpha) {
+		err := lastError("SetLayeredWindowAttributes")
+		wb.ensureExtendedStyleBits(win.WS_EX_LAYERED, false)
+		return err
+	}
+
+	wb.clipAncestorsForIsolatedPaint()
+
+	return nil
+}
+
+func (wb *WidgetBase) clipAncestorsForIsolatedPaint() {
+	wb.ForEachAncestor(func(window Window) bool {
+		window.AsWindowBase().ensureStyleBits(win.WS_CLIPCHILDREN, true)
+		return true
+	})
+}
+
 func (wb *WidgetBase) Parent() Container {
 	return wb.parent
 }
@@ -354,6 +501,16 @@ func (wb *WidgetBase) SetParent(parent Container) (err error) {
 
 	wb.parent = parent
 
+	if parent != nil && wb.PaintIsolated() {
+		wb.clipAncestorsForIsolatedPaint()
+	}
+
+	if parent != nil && parent.RightToLeft() != wb.rightToLeft {
+		if err := wb.SetRightToLeft(parent.RightToLeft()); err != nil {
+			return err
+		}
+	}
+
 	var oldChildren, newChildren *WidgetList
 	if oldParent != nil {
 		oldChildren = oldParent.Children()
@@ -414,6 +571,69 @@ func (wb *WidgetBase) SetToolTipText(s string) error {
 	return nil
 }
 
+// ToolTipTitle returns the title, that is displayed in bold above the tool tip
+// text of the WidgetBase.
+func (wb *WidgetBase) ToolTipTitle() string {
+	if tt := wb.group.ToolTip(); tt != nil {
+		return tt.toolTitle(tt.hwndForTool(wb.window.(Widget))).title
+	}
+	return ""
+}
+
+// SetToolTipTitle sets the title, that is displayed in bold above the tool
+// tip text of the WidgetBase. The title is only displayed together with a
+// tool tip text.
+func (wb *WidgetBase) SetToolTipTitle(title string) error {
+	if tt := wb.group.ToolTip(); tt != nil {
+		hwnd := tt.hwndForTool(wb.window.(Widget))
+
+		t := tt.toolTitle(hwnd)
+		if title == t.title {
+			return nil
+		}
+		t.title = title
+
+		if err := tt.setToolTitle(hwnd, t); err != nil {
+			return err
+		}
+	}
+
+	wb.toolTipTitleChangedPublisher.Publish()
+
+	return nil
+}
+
+// ToolTipIcon returns the icon, that is displayed next to the tool tip title
+// of the WidgetBase.
+func (wb *WidgetBase) ToolTipIcon() ToolTipIcon {
+	if tt := wb.group.ToolTip(); tt != nil {
+		return tt.toolTitle(tt.hwndForTool(wb.window.(Widget))).icon
+	}
+	return ToolTipIconNone
+}
+
+// SetToolTipIcon sets the icon, that is displayed next to the tool tip title
+// of the WidgetBase. The icon is only displayed together with a title.
+func (wb *WidgetBase) SetToolTipIcon(icon ToolTipIcon) error {
+	if tt := wb.group.ToolTip(); tt != nil {
+		hwnd := tt.hwndForTool(wb.window.(Widget))
+
+		t := tt.toolTitle(hwnd)
+		if icon == t.icon {
+			return nil
+		}
+		t.icon = icon
+
+		if err := tt.setToolTitle(hwnd, t); err != nil {
+			return err
+		}
+	}
+
+	wb.toolTipIconChangedPublisher.Publish()
+
+	return nil
+}
+
 // GraphicsEffects returns a list of WidgetGraphicsEffects that are applied to the WidgetBase.
 func (wb *WidgetBase) GraphicsEffects() *WidgetGraphicsEffectList {
 	return wb.graphicsEffects
@@ -0,0 +1,1222 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/lxn/win"
+)
+
+// This file contains bindings for Win32 APIs that are not (yet) provided by
+// github.com/lxn/win.
+
+const (
+	whKeyboardLL = 13
+	hcAction     = 0
+	llkhfAltDown = 0x20
+)
+
+const (
+	cbSetMinVisible = 0x1701
+	cbGetMinVisible = 0x1702
+)
+
+const (
+	esSystemRequired  = 0x00000001
+	esDisplayRequired = 0x00000002
+	esContinuous      = 0x80000000
+)
+
+const (
+	dwmTnpRectDestination      = 0x00000001
+	dwmTnpRectSource           = 0x00000002
+	dwmTnpOpacity              = 0x00000004
+	dwmTnpVisible              = 0x00000008
+	dwmTnpSourceClientAreaOnly = 0x00000010
+)
+
+const (
+	pipeAccessDuplex        = 0x00000003
+	pipeTypeByte            = 0x00000000
+	pipeReadModeByte        = 0x00000000
+	pipeWait                = 0x00000000
+	pipeRejectRemoteClients = 0x00000008
+	pipeUnlimitedInstances  = 255
+
+	fileFlagFirstPipeInstance = 0x00080000
+	sddlRevision1             = 1
+
+	errorPipeBusy      syscall.Errno = 231
+	errorPipeConnected syscall.Errno = 535
+)
+
+const (
+	wmWTSSessionChange   = 0x02B1
+	notifyForThisSession = 0
+)
+
+const (
+	dbtDeviceArrival         = 0x8000
+	dbtDeviceRemoveComplete  = 0x8004
+	dbtDevTypDeviceInterface = 0x00000005
+
+	deviceNotifyWindowHandle        = 0x00000000
+	deviceNotifyAllInterfaceClasses = 0x00000004
+)
+
+const (
+	dropEffectCopy = 0x00000001
+	dropEffectLink = 0x00000004
+)
+
+const (
+	pwClientOnly        = 0x00000001
+	pwRenderFullContent = 0x00000002
+)
+
+const (
+	wmGesture = 0x0119
+
+	gidPan = 4
+
+	gfBegin   = 0x00000001
+	gfInertia = 0x00000002
+	gfEnd     = 0x00000004
+)
+
+const (
+	lwaColorKey = 0x00000001
+	lwaAlpha    = 0x00000002
+)
+
+const (
+	gmCompatible = 1
+	gmAdvanced   = 2
+
+	mwtIdentity = 1
+)
+
+const (
+	tvgnRoot        = 0x0000
+	tvgnNext        = 0x0001
+	tvgnChild       = 0x0004
+	tvgnNextVisible = 0x0006
+)
+
+const (
+	gpFillModeAlternate = 0
+
+	gpFlushIntentionSync = 1
+
+	gpHotkeyPrefixNone = 0
+	gpHotkeyPrefixShow = 1
+	gpHotkeyPrefixHide = 2
+
+	gpLineJoinRound = 2
+
+	gpPixelOffsetModeHalf = 4
+
+	gpSmoothingModeAntiAlias = 4
+
+	gpStringAlignmentNear   = 0
+	gpStringAlignmentCenter = 1
+	gpStringAlignmentFar    = 2
+
+	gpStringFormatFlagsDirectionRightToLeft = 0x00000001
+	gpStringFormatFlagsNoWrap               = 0x00001000
+	gpStringFormatFlagsNoClip               = 0x00004000
+
+	gpStringTrimmingNone              = 0
+	gpStringTrimmingEllipsisCharacter = 3
+	gpStringTrimmingEllipsisWord      = 4
+	gpStringTrimmingEllipsisPath      = 5
+
+	gpTextRenderingHintAntiAliasGridFit = 3
+	gpTextRenderingHintClearTypeGridFit = 5
+
+	gpUnitWorld = 0
+)
+
+type devBroadcastDeviceInterface struct {
+	DbccSize       uint32
+	DbccDeviceType uint32
+	DbccReserved   uint32
+	DbccClassGuid  syscall.GUID
+	DbccName       [1]uint16
+}
+
+type dwmThumbnailProperties struct {
+	DwFlags               uint32
+	RcDestination         win.RECT
+	RcSource              win.RECT
+	Opacity               byte
+	FVisible              win.BOOL
+	FSourceClientAreaOnly win.BOOL
+}
+
+type gestureInfo struct {
+	CbSize       uint32
+	DwFlags      uint32
+	DwID         uint32
+	HwndTarget   win.HWND
+	PtsLocation  struct{ X, Y int16 }
+	DwInstanceID uint32
+	DwSequenceID uint32
+	UllArguments uint64
+	CbExtraArgs  uint32
+}
+
+// The GDI+ objects are only used through pointers.
+type (
+	gpBrush        struct{}
+	gpFont         struct{}
+	gpGraphics     struct{}
+	gpMatrix       struct{}
+	gpPath         struct{}
+	gpPen          struct{}
+	gpStringFormat struct{}
+)
+
+type gpPointF struct {
+	X float32
+	Y float32
+}
+
+type gpRectF struct {
+	X      float32
+	Y      float32
+	Width  float32
+	Height float32
+}
+
+type kbdllHookStruct struct {
+	VkCode      uint32
+	ScanCode    uint32
+	Flags       uint32
+	Time        uint32
+	DwExtraInfo uintptr
+}
+
+type nmtvCustomDraw struct {
+	Nmcd      win.NMCUSTOMDRAW
+	ClrText   win.COLORREF
+	ClrTextBk win.COLORREF
+	ILevel    int32
+}
+
+type xform struct {
+	EM11 float32
+	EM12 float32
+	EM21 float32
+	EM22 float32
+	EDx  float32
+	EDy  float32
+}
+
+type lastInputInfo struct {
+	CbSize uint32
+	DwTime uint32
+}
+
+var (
+	libdwmapi   = syscall.NewLazyDLL("dwmapi.dll")
+	libgdi32    = syscall.NewLazyDLL("gdi32.dll")
+	libgdiplus  = syscall.NewLazyDLL("gdiplus.dll")
+	libkernel32 = syscall.NewLazyDLL("kernel32.dll")
+	libshell32  = syscall.NewLazyDLL("shell32.dll")
+	libuser32   = syscall.NewLazyDLL("user32.dll")
+	libwtsapi32 = syscall.NewLazyDLL("wtsapi32.dll")
+
+	procDwmQueryThumbnailSourceSize       = libdwmapi.NewProc("DwmQueryThumbnailSourceSize")
+	procDwmRegisterThumbnail              = libdwmapi.NewProc("DwmRegisterThumbnail")
+	procDwmUnregisterThumbnail            = libdwmapi.NewProc("DwmUnregisterThumbnail")
+	procDwmUpdateThumbnailProperties      = libdwmapi.NewProc("DwmUpdateThumbnailProperties")
+	procConnectNamedPipe                  = libkernel32.NewProc("ConnectNamedPipe")
+	procCreateEvent                       = libkernel32.NewProc("CreateEventW")
+	procCreateNamedPipe                   = libkernel32.NewProc("CreateNamedPipeW")
+	procGetNamedPipeServerProcessId       = libkernel32.NewProc("GetNamedPipeServerProcessId")
+	procGetOverlappedResult               = libkernel32.NewProc("GetOverlappedResult")
+	procWaitNamedPipe                     = libkernel32.NewProc("WaitNamedPipeW")
+	procProcessIdToSessionId              = libkernel32.NewProc("ProcessIdToSessionId")
+	procWTSGetActiveConsoleSessionId      = libkernel32.NewProc("WTSGetActiveConsoleSessionId")
+	procWTSRegisterSessionNotification    = libwtsapi32.NewProc("WTSRegisterSessionNotification")
+	procWTSUnRegisterSessionNotification  = libwtsapi32.NewProc("WTSUnRegisterSessionNotification")
+	procILCreateFromPath                  = libshell32.NewProc("ILCreateFromPathW")
+	procILFree                            = libshell32.NewProc("ILFree")
+	procSHCreateShellItemArrayFromIDLists = libshell32.NewProc("SHCreateShellItemArrayFromIDLists")
+	procSHDoDragDrop                      = libshell32.NewProc("SHDoDragDrop")
+	procShellExecute                      = libshell32.NewProc("ShellExecuteW")
+	procGetTickCount                      = libkernel32.NewProc("GetTickCount")
+	procSetThreadExecutionState           = libkernel32.NewProc("SetThreadExecutionState")
+	procEnumDisplayMonitors               = libuser32.NewProc("EnumDisplayMonitors")
+	procCallNextHookEx                    = libuser32.NewProc("CallNextHookEx")
+	procCloseGestureInfoHandle            = libuser32.NewProc("CloseGestureInfoHandle")
+	procGetGestureInfo                    = libuser32.NewProc("GetGestureInfo")
+	procGetLastInputInfo                  = libuser32.NewProc("GetLastInputInfo")
+	procPrintWindow                       = libuser32.NewProc("PrintWindow")
+	procCreateIconFromResourceEx          = libuser32.NewProc("CreateIconFromResourceEx")
+	procRegisterClipboardFormat           = libuser32.NewProc("RegisterClipboardFormatW")
+	procRegisterDeviceNotification        = libuser32.NewProc("RegisterDeviceNotificationW")
+	procSetLayeredWindowAttributes        = libuser32.NewProc("SetLayeredWindowAttributes")
+	procSetWindowRgn                      = libuser32.NewProc("SetWindowRgn")
+	procSetWindowsHookEx                  = libuser32.NewProc("SetWindowsHookExW")
+	procUnhookWindowsHookEx               = libuser32.NewProc("UnhookWindowsHookEx")
+	procUnregisterDeviceNotification      = libuser32.NewProc("UnregisterDeviceNotification")
+	procGetClipBox                        = libgdi32.NewProc("GetClipBox")
+	procGetGraphicsMode                   = libgdi32.NewProc("GetGraphicsMode")
+	procGetWorldTransform                 = libgdi32.NewProc("GetWorldTransform")
+	procModifyWorldTransform              = libgdi32.NewProc("ModifyWorldTransform")
+	procPolygon                           = libgdi32.NewProc("Polygon")
+	procSetGraphicsMode                   = libgdi32.NewProc("SetGraphicsMode")
+	procSetWorldTransform                 = libgdi32.NewProc("SetWorldTransform")
+	procGdipAddPathArc                    = libgdiplus.NewProc("GdipAddPathArc")
+	procGdipAddPathBezier                 = libgdiplus.NewProc("GdipAddPathBezier")
+	procGdipAddPathEllipse                = libgdiplus.NewProc("GdipAddPathEllipse")
+	procGdipAddPathLine                   = libgdiplus.NewProc("GdipAddPathLine")
+	procGdipAddPathRectangle              = libgdiplus.NewProc("GdipAddPathRectangle")
+	procGdipClosePathFigure               = libgdiplus.NewProc("GdipClosePathFigure")
+	procGdipCreateFontFromDC              = libgdiplus.NewProc("GdipCreateFontFromDC")
+	procGdipCreateFromHDC                 = libgdiplus.NewProc("GdipCreateFromHDC")
+	procGdipCreateMatrix2                 = libgdiplus.NewProc("GdipCreateMatrix2")
+	procGdipCreatePath                    = libgdiplus.NewProc("GdipCreatePath")
+	procGdipCreatePen1                    = libgdiplus.NewProc("GdipCreatePen1")
+	procGdipCreateSolidFill               = libgdiplus.NewProc("GdipCreateSolidFill")
+	procGdipCreateStringFormat            = libgdiplus.NewProc("GdipCreateStringFormat")
+	procGdipDeleteBrush                   = libgdiplus.NewProc("GdipDeleteBrush")
+	procGdipDeleteFont                    = libgdiplus.NewProc("GdipDeleteFont")
+	procGdipDeleteGraphics                = libgdiplus.NewProc("GdipDeleteGraphics")
+	procGdipDeleteMatrix                  = libgdiplus.NewProc("GdipDeleteMatrix")
+	procGdipDeletePath                    = libgdiplus.NewProc("GdipDeletePath")
+	procGdipDeletePen                     = libgdiplus.NewProc("GdipDeletePen")
+	procGdipDeleteStringFormat            = libgdiplus.NewProc("GdipDeleteStringFormat")
+	procGdipDrawEllipse                   = libgdiplus.NewProc("GdipDrawEllipse")
+	procGdipDrawLine                      = libgdiplus.NewProc("GdipDrawLine")
+	procGdipDrawLines                     = libgdiplus.NewProc("GdipDrawLines")
+	procGdipDrawPath                      = libgdiplus.NewProc("GdipDrawPath")
+	procGdipDrawPolygon                   = libgdiplus.NewProc("GdipDrawPolygon")
+	procGdipDrawRectangle                 = libgdiplus.NewProc("GdipDrawRectangle")
+	procGdipDrawString                    = libgdiplus.NewProc("GdipDrawString")
+	procGdipFillEllipse                   = libgdiplus.NewProc("GdipFillEllipse")
+	procGdipFillPath                      = libgdiplus.NewProc("GdipFillPath")
+	procGdipFillPolygon                   = libgdiplus.NewProc("GdipFillPolygon")
+	procGdipFillRectangle                 = libgdiplus.NewProc("GdipFillRectangle")
+	procGdipFlush                         = libgdiplus.NewProc("GdipFlush")
+	procGdipSetPenLineJoin                = libgdiplus.NewProc("GdipSetPenLineJoin")
+	procGdipSetPixelOffsetMode            = libgdiplus.NewProc("GdipSetPixelOffsetMode")
+	procGdipSetSmoothingMode              = libgdiplus.NewProc("GdipSetSmoothingMode")
+	procGdipSetStringFormatAlign          = libgdiplus.NewProc("GdipSetStringFormatAlign")
+	procGdipSetStringFormatHotkeyPrefix   = libgdiplus.NewProc("GdipSetStringFormatHotkeyPrefix")
+	procGdipSetStringFormatLineAlign      = libgdiplus.NewProc("GdipSetStringFormatLineAlign")
+	procGdipSetStringFormatTrimming       = libgdiplus.NewProc("GdipSetStringFormatTrimming")
+	procGdipSetTextRenderingHint          = libgdiplus.NewProc("GdipSetTextRenderingHint")
+	procGdipSetWorldTransform             = libgdiplus.NewProc("GdipSetWorldTransform")
+	procGdipStartPathFigure               = libgdiplus.NewProc("GdipStartPathFigure")
+)
+
+var (
+	libadvapi32 = syscall.NewLazyDLL("advapi32.dll")
+
+	procConvertStringSecurityDescriptorToSecurityDescriptor = libadvapi32.NewProc("ConvertStringSecurityDescriptorToSecurityDescriptorW")
+)
+
+func connectNamedPipe(hNamedPipe syscall.Handle, lpOverlapped *syscall.Overlapped) error {
+	ret, _, err := syscall.Syscall(procConnectNamedPipe.Addr(), 2,
+		uintptr(hNamedPipe),
+		uintptr(unsafe.Pointer(lpOverlapped)),
+		0)
+
+	if ret == 0 {
+		return err
+	}
+
+	return nil
+}
+
+func createEvent(manualReset, initialState bool) (syscall.Handle, error) {
+	ret, _, err := syscall.Syscall6(procCreateEvent.Addr(), 4,
+		0,
+		uintptr(win.BoolToBOOL(manualReset)),
+		uintptr(win.BoolToBOOL(initialState)),
+		0,
+		0,
+		0)
+
+	if ret == 0 {
+		return 0, err
+	}
+
+	return syscall.Handle(ret), nil
+}
+
+func convertStringSecurityDescriptorToSecurityDescriptor(stringSecurityDescriptor *uint16, stringSDRevision uint32, securityDescriptor *uintptr, securityDescriptorSize *uint32) error {
+	ret, _, err := syscall.Syscall6(procConvertStringSecurityDescriptorToSecurityDescriptor.Addr(), 4,
+		uintptr(unsafe.Pointer(stringSecurityDescriptor)),
+		uintptr(stringSDRevision),
+		uintptr(unsafe.Pointer(securityDescriptor)),
+		uintptr(unsafe.Pointer(securityDescriptorSize)),
+		0,
+		0)
+
+	if ret == 0 {
+		return err
+	}
+
+	return nil
+}
+
+func createNamedPipe(name *uint16, openMode, pipeMode, maxInstances, outBufferSize, inBufferSize, defaultTimeOut uint32, securityAttributes *syscall.SecurityAttributes) (syscall.Handle, error) {
+	ret, _, err := syscall.Syscall9(procCreateNamedPipe.Addr(), 8,
+		uintptr(unsafe.Pointer(name)),
+		uintptr(openMode),
+		uintptr(pipeMode),
+		uintptr(maxInstances),
+		uintptr(outBufferSize),
+		uintptr(inBufferSize),
+		uintptr(defaultTimeOut),
+		uintptr(unsafe.Pointer(securityAttributes)),
+		0)
+
+	if syscall.Handle(ret) == syscall.InvalidHandle {
+		return syscall.InvalidHandle, err
+	}
+
+	return syscall.Handle(ret), nil
+}
+
+func getNamedPipeServerProcessId(pipe syscall.Handle, serverProcessId *uint32) error {
+	ret, _, err := syscall.Syscall(procGetNamedPipeServerProcessId.Addr(), 2,
+		uintptr(pipe),
+		uintptr(unsafe.Pointer(serverProcessId)),
+		0)
+
+	if ret == 0 {
+		return err
+	}
+
+	return nil
+}
+
+func getOverlappedResult(hFile syscall.Handle, lpOverlapped *syscall.Overlapped, lpNumberOfBytesTransferred *uint32, bWait bool) error {
+	ret, _, err := syscall.Syscall6(procGetOverlappedResult.Addr(), 4,
+		uintptr(hFile),
+		uintptr(unsafe.Pointer(lpOverlapped)),
+		uintptr(unsafe.Pointer(lpNumberOfBytesTransferred)),
+		uintptr(win.BoolToBOOL(bWait)),
+		0,
+		0)
+
+	if ret == 0 {
+		return err
+	}
+
+	return nil
+}
+
+func processIdToSessionId(dwProcessId uint32, pSessionId *uint32) bool {
+	ret, _, _ := syscall.Syscall(procProcessIdToSessionId.Addr(), 2,
+		uintptr(dwProcessId),
+		uintptr(unsafe.Pointer(pSessionId)),
+		0)
+
+	return ret != 0
+}
+
+func wtsGetActiveConsoleSessionId() uint32 {
+	ret, _, _ := syscall.Syscall(procWTSGetActiveConsoleSessionId.Addr(), 0,
+		0,
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+func wtsRegisterSessionNotification(hWnd win.HWND, dwFlags uint32) bool {
+	ret, _, _ := syscall.Syscall(procWTSRegisterSessionNotification.Addr(), 2,
+		uintptr(hWnd),
+		uintptr(dwFlags),
+		0)
+
+	return ret != 0
+}
+
+func wtsUnRegisterSessionNotification(hWnd win.HWND) bool {
+	ret, _, _ := syscall.Syscall(procWTSUnRegisterSessionNotification.Addr(), 1,
+		uintptr(hWnd),
+		0,
+		0)
+
+	return ret != 0
+}
+
+func shellExecute(hWnd win.HWND, verb, file, args, cwd *uint16, showCmd int32) uintptr {
+	ret, _, _ := syscall.Syscall6(procShellExecute.Addr(), 6,
+		uintptr(hWnd),
+		uintptr(unsafe.Pointer(verb)),
+		uintptr(unsafe.Pointer(file)),
+		uintptr(unsafe.Pointer(args)),
+		uintptr(unsafe.Pointer(cwd)),
+		uintptr(showCmd))
+
+	return ret
+}
+
+func ilCreateFromPath(path *uint16) uintptr {
+	ret, _, _ := syscall.Syscall(procILCreateFromPath.Addr(), 1,
+		uintptr(unsafe.Pointer(path)),
+		0,
+		0)
+
+	return ret
+}
+
+func ilFree(pidl uintptr) {
+	syscall.Syscall(procILFree.Addr(), 1,
+		pidl,
+		0,
+		0)
+}
+
+func shCreateShellItemArrayFromIDLists(cidl uint32, rgpidl *uintptr, ppsiItemArray *unsafe.Pointer) win.HRESULT {
+	ret, _, _ := syscall.Syscall(procSHCreateShellItemArrayFromIDLists.Addr(), 3,
+		uintptr(cidl),
+		uintptr(unsafe.Pointer(rgpidl)),
+		uintptr(unsafe.Pointer(ppsiItemArray)))
+
+	return win.HRESULT(ret)
+}
+
+func shDoDragDrop(hwnd win.HWND, pdata unsafe.Pointer, pdsrc unsafe.Pointer, dwEffect uint32, pdwEffect *uint32) win.HRESULT {
+	ret, _, _ := syscall.Syscall6(procSHDoDragDrop.Addr(), 5,
+		uintptr(hwnd),
+		uintptr(pdata),
+		uintptr(pdsrc),
+		uintptr(dwEffect),
+		uintptr(unsafe.Pointer(pdwEffect)),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func waitNamedPipe(name *uint16, timeout uint32) error {
+	ret, _, err := syscall.Syscall(procWaitNamedPipe.Addr(), 2,
+		uintptr(unsafe.Pointer(name)),
+		uintptr(timeout),
+		0)
+
+	if ret == 0 {
+		return err
+	}
+
+	return nil
+}
+
+func dwmQueryThumbnailSourceSize(hThumbnail uintptr, pSize *win.SIZE) win.HRESULT {
+	ret, _, _ := syscall.Syscall(procDwmQueryThumbnailSourceSize.Addr(), 2,
+		hThumbnail,
+		uintptr(unsafe.Pointer(pSize)),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func dwmRegisterThumbnail(hwndDestination, hwndSource win.HWND, phThumbnailId *uintptr) win.HRESULT {
+	ret, _, _ := syscall.Syscall(procDwmRegisterThumbnail.Addr(), 3,
+		uintptr(hwndDestination),
+		uintptr(hwndSource),
+		uintptr(unsafe.Pointer(phThumbnailId)))
+
+	return win.HRESULT(ret)
+}
+
+func dwmUnregisterThumbnail(hThumbnailId uintptr) win.HRESULT {
+	ret, _, _ := syscall.Syscall(procDwmUnregisterThumbnail.Addr(), 1,
+		hThumbnailId,
+		0,
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func dwmUpdateThumbnailProperties(hThumbnailId uintptr, ptnProperties *dwmThumbnailProperties) win.HRESULT {
+	ret, _, _ := syscall.Syscall(procDwmUpdateThumbnailProperties.Addr(), 2,
+		hThumbnailId,
+		uintptr(unsafe.Pointer(ptnProperties)),
+		0)
+
+	return win.HRESULT(ret)
+}
+
+func getTickCount() uint32 {
+	ret, _, _ := syscall.Syscall(procGetTickCount.Addr(), 0,
+		0,
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+func callNextHookEx(hhk uintptr, nCode int32, wParam, lParam uintptr) uintptr {
+	ret, _, _ := syscall.Syscall6(procCallNextHookEx.Addr(), 4,
+		hhk,
+		uintptr(nCode),
+		wParam,
+		lParam,
+		0,
+		0)
+
+	return ret
+}
+
+func setThreadExecutionState(esFlags uint32) uint32 {
+	ret, _, _ := syscall.Syscall(procSetThreadExecutionState.Addr(), 1,
+		uintptr(esFlags),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+func enumDisplayMonitors(hdc win.HDC, lprcClip *win.RECT, lpfnEnum, dwData uintptr) bool {
+	ret, _, _ := syscall.Syscall6(procEnumDisplayMonitors.Addr(), 4,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(lprcClip)),
+		lpfnEnum,
+		dwData,
+		0,
+		0)
+
+	return ret != 0
+}
+
+func getLastInputInfo(plii *lastInputInfo) bool {
+	ret, _, _ := syscall.Syscall(procGetLastInputInfo.Addr(), 1,
+		uintptr(unsafe.Pointer(plii)),
+		0,
+		0)
+
+	return ret != 0
+}
+
+func closeGestureInfoHandle(hGestureInfo uintptr) bool {
+	ret, _, _ := syscall.Syscall(procCloseGestureInfoHandle.Addr(), 1,
+		hGestureInfo,
+		0,
+		0)
+
+	return ret != 0
+}
+
+func getGestureInfo(hGestureInfo uintptr, pGestureInfo *gestureInfo) bool {
+	ret, _, _ := syscall.Syscall(procGetGestureInfo.Addr(), 2,
+		hGestureInfo,
+		uintptr(unsafe.Pointer(pGestureInfo)),
+		0)
+
+	return ret != 0
+}
+
+func createIconFromResourceEx(presbits *byte, dwResSize uint32, fIcon bool, dwVer uint32, cxDesired, cyDesired int32, flags uint32) win.HICON {
+	var icon uintptr
+	if fIcon {
+		icon = 1
+	}
+
+	ret, _, _ := syscall.Syscall9(procCreateIconFromResourceEx.Addr(), 7,
+		uintptr(unsafe.Pointer(presbits)),
+		uintptr(dwResSize),
+		icon,
+		uintptr(dwVer),
+		uintptr(cxDesired),
+		uintptr(cyDesired),
+		uintptr(flags),
+		0,
+		0)
+
+	return win.HICON(ret)
+}
+
+func printWindow(hwnd win.HWND, hdcBlt win.HDC, nFlags uint32) bool {
+	ret, _, _ := syscall.Syscall(procPrintWindow.Addr(), 3,
+		uintptr(hwnd),
+		uintptr(hdcBlt),
+		uintptr(nFlags))
+
+	return ret != 0
+}
+
+func setLayeredWindowAttributes(hwnd win.HWND, crKey win.COLORREF, bAlpha byte, dwFlags uint32) bool {
+	ret, _, _ := syscall.Syscall6(procSetLayeredWindowAttributes.Addr(), 4,
+		uintptr(hwnd),
+		uintptr(crKey),
+		uintptr(bAlpha),
+		uintptr(dwFlags),
+		0,
+		0)
+
+	return ret != 0
+}
+
+func setWindowRgn(hwnd win.HWND, hRgn win.HRGN, bRedraw bool) bool {
+	ret, _, _ := syscall.Syscall(procSetWindowRgn.Addr(), 3,
+		uintptr(hwnd),
+		uintptr(hRgn),
+		uintptr(win.BoolToBOOL(bRedraw)))
+
+	return ret != 0
+}
+
+func setWindowsHookEx(idHook int32, lpfn uintptr, hmod win.HINSTANCE, dwThreadId uint32) uintptr {
+	ret, _, _ := syscall.Syscall6(procSetWindowsHookEx.Addr(), 4,
+		uintptr(idHook),
+		lpfn,
+		uintptr(hmod),
+		uintptr(dwThreadId),
+		0,
+		0)
+
+	return ret
+}
+
+func unhookWindowsHookEx(hhk uintptr) bool {
+	ret, _, _ := syscall.Syscall(procUnhookWindowsHookEx.Addr(), 1,
+		hhk,
+		0,
+		0)
+
+	return ret != 0
+}
+
+func registerClipboardFormat(lpszFormat *uint16) uint32 {
+	ret, _, _ := syscall.Syscall(procRegisterClipboardFormat.Addr(), 1,
+		uintptr(unsafe.Pointer(lpszFormat)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+func registerDeviceNotification(hRecipient win.HWND, notificationFilter unsafe.Pointer, flags uint32) uintptr {
+	ret, _, _ := syscall.Syscall(procRegisterDeviceNotification.Addr(), 3,
+		uintptr(hRecipient),
+		uintptr(notificationFilter),
+		uintptr(flags))
+
+	return ret
+}
+
+func unregisterDeviceNotification(handle uintptr) bool {
+	ret, _, _ := syscall.Syscall(procUnregisterDeviceNotification.Addr(), 1,
+		handle,
+		0,
+		0)
+
+	return ret != 0
+}
+
+func getGraphicsMode(hdc win.HDC) int32 {
+	ret, _, _ := syscall.Syscall(procGetGraphicsMode.Addr(), 1,
+		uintptr(hdc),
+		0,
+		0)
+
+	return int32(ret)
+}
+
+func getClipBox(hdc win.HDC, lprect *win.RECT) int32 {
+	ret, _, _ := syscall.Syscall(procGetClipBox.Addr(), 2,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(lprect)),
+		0)
+
+	return int32(ret)
+}
+
+func getWorldTransform(hdc win.HDC, lpxf *xform) bool {
+	ret, _, _ := syscall.Syscall(procGetWorldTransform.Addr(), 2,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(lpxf)),
+		0)
+
+	return ret != 0
+}
+
+func modifyWorldTransform(hdc win.HDC, lpxf *xform, mode uint32) bool {
+	ret, _, _ := syscall.Syscall(procModifyWorldTransform.Addr(), 3,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(lpxf)),
+		uintptr(mode))
+
+	return ret != 0
+}
+
+func polygon(hdc win.HDC, apt *win.POINT, cpt int32) bool {
+	ret, _, _ := syscall.Syscall(procPolygon.Addr(), 3,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(apt)),
+		uintptr(cpt))
+
+	return ret != 0
+}
+
+func setGraphicsMode(hdc win.HDC, iMode int32) int32 {
+	ret, _, _ := syscall.Syscall(procSetGraphicsMode.Addr(), 2,
+		uintptr(hdc),
+		uintptr(iMode),
+		0)
+
+	return int32(ret)
+}
+
+func setWorldTransform(hdc win.HDC, lpxf *xform) bool {
+	ret, _, _ := syscall.Syscall(procSetWorldTransform.Addr(), 2,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(lpxf)),
+		0)
+
+	return ret != 0
+}
+
+func gdipAddPathArc(path *gpPath, x, y, width, height, startAngle, sweepAngle float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall9(procGdipAddPathArc.Addr(), 7,
+		uintptr(unsafe.Pointer(path)),
+		uintptr(math.Float32bits(x)),
+		uintptr(math.Float32bits(y)),
+		uintptr(math.Float32bits(width)),
+		uintptr(math.Float32bits(height)),
+		uintptr(math.Float32bits(startAngle)),
+		uintptr(math.Float32bits(sweepAngle)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipAddPathBezier(path *gpPath, x1, y1, x2, y2, x3, y3, x4, y4 float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall9(procGdipAddPathBezier.Addr(), 9,
+		uintptr(unsafe.Pointer(path)),
+		uintptr(math.Float32bits(x1)),
+		uintptr(math.Float32bits(y1)),
+		uintptr(math.Float32bits(x2)),
+		uintptr(math.Float32bits(y2)),
+		uintptr(math.Float32bits(x3)),
+		uintptr(math.Float32bits(y3)),
+		uintptr(math.Float32bits(x4)),
+		uintptr(math.Float32bits(y4)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipAddPathEllipse(path *gpPath, x, y, width, height float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipAddPathEllipse.Addr(), 5,
+		uintptr(unsafe.Pointer(path)),
+		uintptr(math.Float32bits(x)),
+		uintptr(math.Float32bits(y)),
+		uintptr(math.Float32bits(width)),
+		uintptr(math.Float32bits(height)),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipAddPathLine(path *gpPath, x1, y1, x2, y2 float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipAddPathLine.Addr(), 5,
+		uintptr(unsafe.Pointer(path)),
+		uintptr(math.Float32bits(x1)),
+		uintptr(math.Float32bits(y1)),
+		uintptr(math.Float32bits(x2)),
+		uintptr(math.Float32bits(y2)),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipAddPathRectangle(path *gpPath, x, y, width, height float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipAddPathRectangle.Addr(), 5,
+		uintptr(unsafe.Pointer(path)),
+		uintptr(math.Float32bits(x)),
+		uintptr(math.Float32bits(y)),
+		uintptr(math.Float32bits(width)),
+		uintptr(math.Float32bits(height)),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipClosePathFigure(path *gpPath) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipClosePathFigure.Addr(), 1,
+		uintptr(unsafe.Pointer(path)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipCreateFontFromDC(hdc win.HDC, font **gpFont) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipCreateFontFromDC.Addr(), 2,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(font)),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipCreateFromHDC(hdc win.HDC, graphics **gpGraphics) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipCreateFromHDC.Addr(), 2,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(graphics)),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipCreateMatrix2(m11, m12, m21, m22, dx, dy float32, matrix **gpMatrix) win.GpStatus {
+	ret, _, _ := syscall.Syscall9(procGdipCreateMatrix2.Addr(), 7,
+		uintptr(math.Float32bits(m11)),
+		uintptr(math.Float32bits(m12)),
+		uintptr(math.Float32bits(m21)),
+		uintptr(math.Float32bits(m22)),
+		uintptr(math.Float32bits(dx)),
+		uintptr(math.Float32bits(dy)),
+		uintptr(unsafe.Pointer(matrix)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipCreatePath(brushMode int32, path **gpPath) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipCreatePath.Addr(), 2,
+		uintptr(brushMode),
+		uintptr(unsafe.Pointer(path)),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipCreatePen1(color uint32, width float32, unit int32, pen **gpPen) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipCreatePen1.Addr(), 4,
+		uintptr(color),
+		uintptr(math.Float32bits(width)),
+		uintptr(unit),
+		uintptr(unsafe.Pointer(pen)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipCreateSolidFill(color uint32, brush **gpBrush) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipCreateSolidFill.Addr(), 2,
+		uintptr(color),
+		uintptr(unsafe.Pointer(brush)),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipCreateStringFormat(formatAttributes int32, language uint16, format **gpStringFormat) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipCreateStringFormat.Addr(), 3,
+		uintptr(formatAttributes),
+		uintptr(language),
+		uintptr(unsafe.Pointer(format)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipDeleteBrush(brush *gpBrush) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipDeleteBrush.Addr(), 1,
+		uintptr(unsafe.Pointer(brush)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDeleteFont(font *gpFont) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipDeleteFont.Addr(), 1,
+		uintptr(unsafe.Pointer(font)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDeleteGraphics(graphics *gpGraphics) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipDeleteGraphics.Addr(), 1,
+		uintptr(unsafe.Pointer(graphics)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDeleteMatrix(matrix *gpMatrix) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipDeleteMatrix.Addr(), 1,
+		uintptr(unsafe.Pointer(matrix)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDeletePath(path *gpPath) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipDeletePath.Addr(), 1,
+		uintptr(unsafe.Pointer(path)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDeletePen(pen *gpPen) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipDeletePen.Addr(), 1,
+		uintptr(unsafe.Pointer(pen)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDeleteStringFormat(format *gpStringFormat) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipDeleteStringFormat.Addr(), 1,
+		uintptr(unsafe.Pointer(format)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDrawEllipse(graphics *gpGraphics, pen *gpPen, x, y, width, height float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipDrawEllipse.Addr(), 6,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		uintptr(math.Float32bits(x)),
+		uintptr(math.Float32bits(y)),
+		uintptr(math.Float32bits(width)),
+		uintptr(math.Float32bits(height)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipDrawLine(graphics *gpGraphics, pen *gpPen, x1, y1, x2, y2 float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipDrawLine.Addr(), 6,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		uintptr(math.Float32bits(x1)),
+		uintptr(math.Float32bits(y1)),
+		uintptr(math.Float32bits(x2)),
+		uintptr(math.Float32bits(y2)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipDrawLines(graphics *gpGraphics, pen *gpPen, points *gpPointF, count int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipDrawLines.Addr(), 4,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		uintptr(unsafe.Pointer(points)),
+		uintptr(count),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDrawPath(graphics *gpGraphics, pen *gpPen, path *gpPath) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipDrawPath.Addr(), 3,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		uintptr(unsafe.Pointer(path)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipDrawPolygon(graphics *gpGraphics, pen *gpPen, points *gpPointF, count int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipDrawPolygon.Addr(), 4,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		uintptr(unsafe.Pointer(points)),
+		uintptr(count),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipDrawRectangle(graphics *gpGraphics, pen *gpPen, x, y, width, height float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipDrawRectangle.Addr(), 6,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		uintptr(math.Float32bits(x)),
+		uintptr(math.Float32bits(y)),
+		uintptr(math.Float32bits(width)),
+		uintptr(math.Float32bits(height)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipDrawString(graphics *gpGraphics, str *uint16, length int32, font *gpFont, layoutRect *gpRectF, format *gpStringFormat, brush *gpBrush) win.GpStatus {
+	ret, _, _ := syscall.Syscall9(procGdipDrawString.Addr(), 7,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(str)),
+		uintptr(length),
+		uintptr(unsafe.Pointer(font)),
+		uintptr(unsafe.Pointer(layoutRect)),
+		uintptr(unsafe.Pointer(format)),
+		uintptr(unsafe.Pointer(brush)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipFillEllipse(graphics *gpGraphics, brush *gpBrush, x, y, width, height float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipFillEllipse.Addr(), 6,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(brush)),
+		uintptr(math.Float32bits(x)),
+		uintptr(math.Float32bits(y)),
+		uintptr(math.Float32bits(width)),
+		uintptr(math.Float32bits(height)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipFillPath(graphics *gpGraphics, brush *gpBrush, path *gpPath) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipFillPath.Addr(), 3,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(brush)),
+		uintptr(unsafe.Pointer(path)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipFillPolygon(graphics *gpGraphics, brush *gpBrush, points *gpPointF, count, fillMode int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipFillPolygon.Addr(), 5,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(brush)),
+		uintptr(unsafe.Pointer(points)),
+		uintptr(count),
+		uintptr(fillMode),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipFillRectangle(graphics *gpGraphics, brush *gpBrush, x, y, width, height float32) win.GpStatus {
+	ret, _, _ := syscall.Syscall6(procGdipFillRectangle.Addr(), 6,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(brush)),
+		uintptr(math.Float32bits(x)),
+		uintptr(math.Float32bits(y)),
+		uintptr(math.Float32bits(width)),
+		uintptr(math.Float32bits(height)))
+
+	return win.GpStatus(ret)
+}
+
+func gdipFlush(graphics *gpGraphics, intention int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipFlush.Addr(), 2,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(intention),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetPenLineJoin(pen *gpPen, lineJoin int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetPenLineJoin.Addr(), 2,
+		uintptr(unsafe.Pointer(pen)),
+		uintptr(lineJoin),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetPixelOffsetMode(graphics *gpGraphics, mode int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetPixelOffsetMode.Addr(), 2,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(mode),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetSmoothingMode(graphics *gpGraphics, mode int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetSmoothingMode.Addr(), 2,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(mode),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetStringFormatAlign(format *gpStringFormat, align int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetStringFormatAlign.Addr(), 2,
+		uintptr(unsafe.Pointer(format)),
+		uintptr(align),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetStringFormatHotkeyPrefix(format *gpStringFormat, hotkeyPrefix int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetStringFormatHotkeyPrefix.Addr(), 2,
+		uintptr(unsafe.Pointer(format)),
+		uintptr(hotkeyPrefix),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetStringFormatLineAlign(format *gpStringFormat, align int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetStringFormatLineAlign.Addr(), 2,
+		uintptr(unsafe.Pointer(format)),
+		uintptr(align),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetStringFormatTrimming(format *gpStringFormat, trimming int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetStringFormatTrimming.Addr(), 2,
+		uintptr(unsafe.Pointer(format)),
+		uintptr(trimming),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetTextRenderingHint(graphics *gpGraphics, mode int32) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetTextRenderingHint.Addr(), 2,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(mode),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipSetWorldTransform(graphics *gpGraphics, matrix *gpMatrix) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipSetWorldTransform.Addr(), 2,
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(matrix)),
+		0)
+
+	return win.GpStatus(ret)
+}
+
+func gdipStartPathFigure(path *gpPath) win.GpStatus {
+	ret, _, _ := syscall.Syscall(procGdipStartPathFigure.Addr(), 1,
+		uintptr(unsafe.Pointer(path)),
+		0,
+		0)
+
+	return win.GpStatus(ret)
+}
@@ -8,12 +8,14 @@ package walk
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"image"
 	"runtime"
 	"strings"
 	"sync/atomic"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/lxn/win"
@@ -207,6 +209,9 @@ type Window interface {
 	// RequestLayout either schedules or immediately starts performing layout.
 	RequestLayout()
 
+	// RightToLeft returns whether the Window is laid out from right to left.
+	RightToLeft() bool
+
 	// RightToLeftReading returns whether the reading order of the Window
 	// is from right to left.
 	RightToLeftReading() bool
@@ -288,6 +293,10 @@ type Window interface {
 	// details.
 	SetName(name string)
 
+	// SetRightToLeft sets whether the Window and its descendants are laid
+	// out from right to left.
+	SetRightToLeft(rtl bool) error
+
 	// SetRightToLeftReading sets whether the reading order of the Window
 	// is from right to left.
 	SetRightToLeftReading(rtl bool) error
@@ -415,6 +424,11 @@ type WindowBase struct {
 	shortcutActions           *ActionList
 	disposables               []Disposable
 	disposingPublisher        EventPublisher
+	disposedPublisher         EventPublisher
+	disposed                  bool
+	resources                 map[Disposable]int
+	ctx                       context.Context
+	cancelCtx                 context.CancelFunc
 	dropFilesPublisher        DropFilesEventPublisher
 	keyDownPublisher          KeyEventPublisher
 	keyPressPublisher         KeyEventPublisher
@@ -440,6 +454,7 @@ type WindowBase struct {
 	suspended                 bool
 	visible                   bool
 	enabled                   bool
+	rightToLeft               bool
 	acc                       *Accessibility
 }
 
@@ -873,6 +888,11 @@ func (wb *WindowBase) AddDisposable(d Disposable) {
 // Also, if a Container is disposed of, all its descendants will be released
 // as well.
 func (wb *WindowBase) Dispose() {
+	// The window stops using its resources first, so disposing of one, that
+	// was also added via AddDisposable, does not count as disposing of a
+	// resource in use.
+	wb.releaseResources()
+
 	for _, d := range wb.disposables {
 		d.Dispose()
 	}
@@ -883,9 +903,14 @@ func (wb *WindowBase) Dispose() {
 
 	hWnd := wb.hWnd
 	if hWnd != 0 {
+		if wb.cancelCtx != nil {
+			wb.cancelCtx()
+		}
+
 		wb.disposingPublisher.Publish()
 
 		wb.hWnd = 0
+		wb.disposed = true
 		if _, ok := hwnd2WindowBase[hWnd]; ok {
 			win.DestroyWindow(hWnd)
 		}
@@ -907,6 +932,8 @@ func (wb *WindowBase) Dispose() {
 	if hWnd != 0 {
 		wb.group.accClearHwndProps(wb.hWnd)
 		wb.group.Done()
+
+		wb.disposedPublisher.Publish()
 	}
 }
 
@@ -916,6 +943,30 @@ func (wb *WindowBase) Disposing() *Event {
 	return wb.disposingPublisher.Event()
 }
 
+// Disposed returns an Event that is published after the Window was disposed
+// of, i.e. after its handle was destroyed and the handlers attached with
+// Event.AttachScoped were detached.
+func (wb *WindowBase) Disposed() *Event {
+	return wb.disposedPublisher.Event()
+}
+
+// Context returns a context that is canceled when the Window is disposed of.
+//
+// Work started on behalf of the Window, e.g. by handlers attached with
+// Event.AttachAsync, should stop when it is done. Use SynchronizeContext with
+// it to access the Window from other goroutines.
+func (wb *WindowBase) Context() context.Context {
+	if wb.ctx == nil {
+		wb.ctx, wb.cancelCtx = context.WithCancel(context.WithValue(context.Background(), windowContextKey{}, wb.window))
+
+		if wb.hWnd == 0 {
+			wb.cancelCtx()
+		}
+	}
+
+	return wb.ctx
+}
+
 // IsDisposed returns if the *WindowBase has been disposed of.
 func (wb *WindowBase) IsDisposed() bool {
 	return wb.hWnd == 0
@@ -1089,6 +1140,8 @@ func (wb *WindowBase) Enabled() bool {
 
 // SetEnabled sets if the *WindowBase is enabled for user interaction.
 func (wb *WindowBase) SetEnabled(enabled bool) {
+	wb.mustNotBeDisposed("SetEnabled")
+
 	wb.enabled = enabled
 
 	wb.window.(applyEnableder).applyEnabled(wb.window.Enabled())
@@ -1127,7 +1180,18 @@ func (wb *WindowBase) Font() *Font {
 
 // SetFont sets the *Font of the *WindowBase.
 func (wb *WindowBase) SetFont(font *Font) {
+	wb.mustNotBeDisposed("SetFont")
+
 	if font != wb.font {
+		var prev, next Disposable
+		if wb.font != nil {
+			prev = wb.font
+		}
+		if font != nil {
+			next = font
+		}
+		wb.replaceResource(prev, next)
+
 		wb.font = font
 
 		wb.window.(applyFonter).applyFont(font)
@@ -1208,6 +1272,8 @@ func (wb *WindowBase) SetSuspended(suspend bool) {
 
 // Invalidate schedules a full repaint of the *WindowBase.
 func (wb *WindowBase) Invalidate() error {
+	wb.mustNotBeDisposed("Invalidate")
+
 	if !win.InvalidateRect(wb.hWnd, nil, true) {
 		return newError("InvalidateRect failed")
 	}
@@ -1235,7 +1301,7 @@ func windowText(hwnd win.HWND) string {
 }
 
 func setWindowText(hwnd win.HWND, text string) error {
-	if win.TRUE != win.SendMessage(hwnd, win.WM_SETTEXT, 0, uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(text)))) {
+	if win.TRUE != win.SendMessage(hwnd, win.WM_SETTEXT, 0, uintptr(unsafe.Pointer(stringToUTF16Ptr(text)))) {
 		return newError("WM_SETTEXT failed")
 	}
 
@@ -1342,6 +1408,8 @@ func (wb *WindowBase) Visible() bool {
 
 // SetVisible sets if the *WindowBase is visible.
 func (wb *WindowBase) SetVisible(visible bool) {
+	wb.mustNotBeDisposed("SetVisible")
+
 	old := wb.Visible()
 
 	setWindowVisible(wb.hWnd, visible)
@@ -1430,6 +1498,8 @@ func (wb *WindowBase) BoundsPixels() Rectangle {
 // For a Form, like *MainWindow or *Dialog, the rectangle is in screen
 // coordinates, for a child Window the coordinates are relative to its parent.
 func (wb *WindowBase) SetBoundsPixels(bounds Rectangle) error {
+	wb.mustNotBeDisposed("SetBoundsPixels")
+
 	if !win.MoveWindow(
 		wb.hWnd,
 		int32(bounds.X),
@@ -1514,6 +1584,7 @@ type fontInfoAndDPI struct {
 var (
 	dialogBaseUnitsUTF16StringPtr  *uint16
 	fontInfoAndDPI2DialogBaseUnits = make(map[fontInfoAndDPI]Size)
+	fontInfoAndDPI2Ascent          = make(map[fontInfoAndDPI]int)
 )
 
 // dialogBaseUnits returns dialog unit base size in native pixels.
@@ -1570,6 +1641,43 @@ func (wb *WindowBase) dialogBaseUnitsToPixels(dlus Size) (pixels Size) {
 	}
 }
 
+// fontAscent returns the ascent of the font of the window in native pixels,
+// i.e. the distance from the top of a line of text to its baseline.
+func (wb *WindowBase) fontAscent() int {
+	font := wb.window.Font()
+	fi := fontInfoAndDPI{
+		fontInfo: fontInfo{
+			family:    font.Family(),
+			pointSize: font.PointSize(),
+			style:     font.Style(),
+		},
+		dpi: wb.DPI()}
+	if ascent, ok := fontInfoAndDPI2Ascent[fi]; ok {
+		return ascent
+	}
+
+	hdc := win.GetDC(wb.hWnd)
+	defer win.ReleaseDC(wb.hWnd, hdc)
+
+	defer win.SelectObject(hdc, win.SelectObject(hdc, win.HGDIOBJ(font.handleForDPI(fi.dpi))))
+
+	var tm win.TEXTMETRIC
+	if !win.GetTextMetrics(hdc, &tm) {
+		newError("GetTextMetrics failed")
+		return 0
+	}
+
+	fontInfoAndDPI2Ascent[fi] = int(tm.TmAscent)
+
+	return int(tm.TmAscent)
+}
+
+// centeredTextBaseline returns the baseline offset in native pixels of a
+// single line of text, that is vertically centered in height.
+func (wb *WindowBase) centeredTextBaseline(height int) int {
+	return (height-wb.dialogBaseUnits().Height)/2 + wb.fontAscent()
+}
+
 // calculateTextSizeImpl returns text size in native pixels.
 func (wb *WindowBase) calculateTextSizeImpl(text string) Size {
 	return wb.calculateTextSizeImplForWidth(text, 0)
@@ -1649,7 +1757,7 @@ func calculateTextSize(text string, font *Font, dpi int, width int, hwnd win.HWN
 
 		for _, line := range lines {
 			var s win.SIZE
-			str := syscall.StringToUTF16(strings.TrimRight(line, "\r "))
+			str := stringToUTF16(strings.TrimRight(line, "\r "))
 
 			if !win.GetTextExtentPoint32(hdc, &str[0], int32(len(str)-1), &s) {
 				newError("GetTextExtentPoint32 failed")
@@ -1921,6 +2029,64 @@ func (wb *WindowBase) RequestLayout() {
 	}
 }
 
+// RightToLeft returns whether the Window is laid out from right to left.
+func (wb *WindowBase) RightToLeft() bool {
+	return wb.rightToLeft
+}
+
+// SetRightToLeft sets whether the Window and its descendants are laid out
+// from right to left, as needed for languages like Arabic and Hebrew.
+//
+// The layouts of right to left containers are mirrored, so the first widget
+// of an HBox is the rightmost one, and HNear means right. Other widgets get
+// the WS_EX_LAYOUTRTL extended style, which mirrors their content, and forms
+// mirror their title bar. Widgets added to a right to left container later
+// inherit the setting.
+func (wb *WindowBase) SetRightToLeft(rtl bool) error {
+	if rtl == wb.rightToLeft {
+		return nil
+	}
+
+	wb.rightToLeft = rtl
+
+	if rtla, ok := wb.window.(rightToLeftApplier); ok {
+		if err := rtla.applyRightToLeft(rtl); err != nil {
+			return err
+		}
+	} else if _, ok := wb.window.(Container); !ok {
+		if err := wb.ensureExtendedStyleBits(win.WS_EX_LAYOUTRTL, rtl); err != nil {
+			return err
+		}
+	}
+
+	if container, ok := wb.window.(Container); ok {
+		if children := container.Children(); children != nil {
+			for i := children.Len() - 1; i >= 0; i-- {
+				if err := children.At(i).SetRightToLeft(rtl); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	wb.window.RequestLayout()
+	wb.Invalidate()
+
+	return nil
+}
+
+// rightToLeftApplier is implemented by windows, that need to do more than
+// the default to switch to right to left layout.
+type rightToLeftApplier interface {
+	applyRightToLeft(rtl bool) error
+}
+
+// isMirroredByLayout returns whether the bounds of the child window hwnd are
+// mirrored by walk in a right to left container, instead of by the system.
+func isMirroredByLayout(hwnd win.HWND) bool {
+	return !hasWindowLongBits(win.GetParent(hwnd), win.GWL_EXSTYLE, win.WS_EX_LAYOUTRTL)
+}
+
 // RightToLeftReading returns whether the reading order of the Window
 // is from right to left.
 func (wb *WindowBase) RightToLeftReading() bool {
@@ -1956,6 +2122,8 @@ func (wb *WindowBase) Focused() bool {
 
 // SetFocus sets the keyboard input focus to the *WindowBase.
 func (wb *WindowBase) SetFocus() error {
+	wb.mustNotBeDisposed("SetFocus")
+
 	if win.SetFocus(wb.hWnd) == 0 {
 		return lastError("SetFocus")
 	}
@@ -2156,6 +2324,15 @@ func defaultWndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr) (result u
 		return win.DefWindowProc(hwnd, msg, wParam, lParam)
 	}
 
+	if msg == win.WM_PAINT && metricsEnabled {
+		if _, ok := wi.(Widget); ok {
+			started := time.Now()
+			defer func() {
+				recordPaint(hwnd, time.Since(started))
+			}()
+		}
+	}
+
 	result = wi.WndProc(hwnd, msg, wParam, lParam)
 
 	return
@@ -2377,6 +2554,12 @@ func (wb *WindowBase) WndProc(hwnd win.HWND, msg uint32, wParam, lParam uintptr)
 		wb.publishMouseEvent(&wb.mouseUpPublisher, msg, wParam, lParam)
 
 	case win.WM_MOUSEMOVE:
+		if wb.group != nil {
+			if tt := wb.group.ToolTip(); tt != nil {
+				tt.prepareTool(hwnd)
+			}
+		}
+
 		wb.publishMouseEvent(&wb.mouseMovePublisher, msg, wParam, lParam)
 
 	case win.WM_MOUSEWHEEL:
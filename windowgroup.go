@@ -97,6 +97,8 @@ type WindowGroup struct {
 	oleInit         bool
 	accPropServices *win.IAccPropServices
 
+	presentationModeForms int // Number of forms of the group in presentation mode
+
 	syncMutex           sync.Mutex
 	syncFuncs           []func()                   // Functions queued to run on the group's thread
 	layoutResultsByForm map[Form]*formLayoutResult // Layout computations queued for application on the group's thread
@@ -0,0 +1,127 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package walk
+
+import (
+	"github.com/lxn/win"
+)
+
+// Snapshot returns an opaque *Bitmap with the current appearance of the
+// *WindowBase and its children, including decorations, at the DPI of the
+// window.
+//
+// The window does not need to be visible on screen, e.g. it may be covered by
+// other windows or scrolled out of view, but it must have a size.
+func (wb *WindowBase) Snapshot() (*Bitmap, error) {
+	return wb.SnapshotForDPI(wb.DPI())
+}
+
+// SnapshotForDPI is like Snapshot, but returns a *Bitmap for dpi.
+//
+// The window is not laid out again for dpi, so the snapshot is captured at the
+// DPI of the window and then scaled.
+func (wb *WindowBase) SnapshotForDPI(dpi int) (*Bitmap, error) {
+	bmp, err := wb.captureSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	windowDPI := wb.DPI()
+	if dpi == windowDPI {
+		return bmp, nil
+	}
+	defer bmp.Dispose()
+
+	scaled, err := NewBitmapForDPI(scaleSize(bmp.size, float64(dpi)/float64(windowDPI)), dpi)
+	if err != nil {
+		return nil, err
+	}
+
+	canvas, err := NewCanvasFromImage(scaled)
+	if err != nil {
+		scaled.Dispose()
+		return nil, err
+	}
+	err = canvas.DrawImageStretchedPixels(bmp, Rectangle{Width: scaled.size.Width, Height: scaled.size.Height})
+	canvas.Dispose()
+	if err != nil {
+		scaled.Dispose()
+		return nil, err
+	}
+
+	return scaled, nil
+}
+
+// RenderTo draws the current appearance of the *WindowBase and its children
+// stretched into bounds of canvas, which are in 1/96" units.
+func (wb *WindowBase) RenderTo(canvas *Canvas, bounds Rectangle) error {
+	return wb.RenderToPixels(canvas, RectangleFrom96DPI(bounds, canvas.DPI()))
+}
+
+// RenderToPixels draws the current appearance of the *WindowBase and its
+// children stretched into bounds of canvas, which are in native pixels.
+func (wb *WindowBase) RenderToPixels(canvas *Canvas, bounds Rectangle) error {
+	bmp, err := wb.captureSnapshot()
+	if err != nil {
+		return err
+	}
+	defer bmp.Dispose()
+
+	return canvas.DrawImageStretchedPixels(bmp, bounds)
+}
+
+// captureSnapshot returns an opaque *Bitmap of the size of the window in
+// native pixels with its current appearance.
+func (wb *WindowBase) captureSnapshot() (*Bitmap, error) {
+	size := wb.SizePixels()
+	if size.Width <= 0 || size.Height <= 0 {
+		return nil, newError("window has no size")
+	}
+
+	bmp, err := NewBitmapForDPI(size, wb.DPI())
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			bmp.Dispose()
+		}
+	}()
+
+	if err := bmp.withSelectedIntoMemDC(func(hdcMem win.HDC) error {
+		// PW_RENDERFULLCONTENT also captures DirectComposition content like
+		// that of WebView, but is not supported before Windows 8.1.
+		if printWindow(wb.hWnd, hdcMem, pwRenderFullContent) || printWindow(wb.hWnd, hdcMem, 0) {
+			return nil
+		}
+
+		flags := win.PRF_CHILDREN | win.PRF_CLIENT | win.PRF_ERASEBKGND | win.PRF_NONCLIENT | win.PRF_OWNED
+		wb.SendMessage(win.WM_PRINT, uintptr(hdcMem), uintptr(flags))
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// GDI does not maintain the alpha channel, so the pixels are made opaque.
+	data, err := bmp.Lock()
+	if err != nil {
+		return nil, err
+	}
+	for i := 3; i < len(data.Pix); i += 4 {
+		data.Pix[i] = 0xff
+	}
+	if err := bmp.Unlock(); err != nil {
+		return nil, err
+	}
+
+	succeeded = true
+
+	return bmp, nil
+}